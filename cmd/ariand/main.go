@@ -6,11 +6,13 @@ import (
 	"ariand/internal/config"
 	"ariand/internal/db"
 	"ariand/internal/service"
+	"context"
 	"io"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/charmbracelet/log"
 	"golang.org/x/net/http2"
@@ -65,6 +67,13 @@ func main() {
 	}
 	logger.Info("services initialized")
 
+	// ----- background jobs --------
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	trashPurger := service.NewTrashPurger(store.Queries, logger.WithPrefix("trash"), cfg.TrashRetention)
+	go trashPurger.Run(jobsCtx, time.Hour)
+
 	// ----- api layer --------
 	srv := api.NewServer(services, logger.WithPrefix("api"))
 	authConfig := &middleware.AuthConfig{
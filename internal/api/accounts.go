@@ -71,3 +71,59 @@ func (s *Server) ListAccounts(ctx context.Context, req *connect.Request[pb.ListA
 
 	return connect.NewResponse(&pb.ListAccountsResponse{Accounts: accounts}), nil
 }
+
+func (s *Server) ListDeletedAccounts(ctx context.Context, req *connect.Request[pb.ListDeletedAccountsRequest]) (*connect.Response[pb.ListDeletedAccountsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.services.Accounts.ListDeleted(ctx, userID)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListDeletedAccountsResponse{Accounts: accounts}), nil
+}
+
+func (s *Server) RestoreAccount(ctx context.Context, req *connect.Request[pb.RestoreAccountRequest]) (*connect.Response[pb.RestoreAccountResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affectedRows, err := s.services.Accounts.Restore(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.RestoreAccountResponse{AffectedRows: affectedRows}), nil
+}
+
+func (s *Server) PurgeAccount(ctx context.Context, req *connect.Request[pb.PurgeAccountRequest]) (*connect.Response[pb.PurgeAccountResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affectedRows, err := s.services.Accounts.Purge(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.PurgeAccountResponse{AffectedRows: affectedRows}), nil
+}
+
+func (s *Server) GetProjectedBalance(ctx context.Context, req *connect.Request[pb.GetProjectedBalanceRequest]) (*connect.Response[pb.GetProjectedBalanceResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projection, err := s.services.Accounts.Projection(ctx, userID, req.Msg.GetId(), req.Msg.Days)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetProjectedBalanceResponse{Projection: projection}), nil
+}
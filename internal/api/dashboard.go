@@ -2,6 +2,7 @@ package api
 
 import (
 	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
 	"ariand/internal/service"
 	"context"
 	"fmt"
@@ -60,6 +61,22 @@ func (s *Server) GetTopCategories(ctx context.Context, req *connect.Request[pb.G
 	}), nil
 }
 
+func (s *Server) GetTopTags(ctx context.Context, req *connect.Request[pb.GetTopTagsRequest]) (*connect.Response[pb.GetTopTagsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.services.Dashboard.TopTags(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetTopTagsResponse{
+		Tags: tags,
+	}), nil
+}
+
 func (s *Server) GetTopMerchants(ctx context.Context, req *connect.Request[pb.GetTopMerchantsRequest]) (*connect.Response[pb.GetTopMerchantsResponse], error) {
 	userID, err := getUserID(ctx)
 	if err != nil {
@@ -204,11 +221,11 @@ func (s *Server) GetCategorySpendingComparison(ctx context.Context, req *connect
 
 	for _, spending := range merged {
 		currentSpending := &pb.PeriodSpending{
-			Amount:           centsToMoney(spending.CurrentCents, "CAD"),
+			Amount:           money.FromMinor(spending.CurrentCents, result.Currency),
 			TransactionCount: spending.CurrentCount,
 		}
 		previousSpending := &pb.PeriodSpending{
-			Amount:           centsToMoney(spending.PreviousCents, "CAD"),
+			Amount:           money.FromMinor(spending.PreviousCents, result.Currency),
 			TransactionCount: spending.PreviousCount,
 		}
 
@@ -256,8 +273,8 @@ func (s *Server) GetCategorySpendingComparison(ctx context.Context, req *connect
 
 	// Build totals
 	totals := &pb.CategorySpendingTotals{
-		CurrentPeriodTotal:  centsToMoney(totalCurrentCents, "CAD"),
-		PreviousPeriodTotal: centsToMoney(totalPreviousCents, "CAD"),
+		CurrentPeriodTotal:  money.FromMinor(totalCurrentCents, result.Currency),
+		PreviousPeriodTotal: money.FromMinor(totalPreviousCents, result.Currency),
 	}
 
 	return connect.NewResponse(&pb.GetCategorySpendingComparisonResponse{
@@ -280,10 +297,8 @@ func categoryKeyToString(id *int64) string {
 // sortCategoriesByCurrentSpending sorts categories by current period spending descending
 func sortCategoriesByCurrentSpending(categories []*pb.CategorySpendingItem) {
 	sort.Slice(categories, func(i, j int) bool {
-		iCents := categories[i].Spending.CurrentPeriod.Amount.Units*100 +
-			int64(categories[i].Spending.CurrentPeriod.Amount.Nanos/10000000)
-		jCents := categories[j].Spending.CurrentPeriod.Amount.Units*100 +
-			int64(categories[j].Spending.CurrentPeriod.Amount.Nanos/10000000)
+		iCents := money.ToMinor(categories[i].Spending.CurrentPeriod.Amount)
+		jCents := money.ToMinor(categories[j].Spending.CurrentPeriod.Amount)
 		return iCents > jCents
 	})
 }
@@ -380,3 +395,17 @@ func mapGranularity(g pb.Granularity) int32 {
 		return 1 // default to day
 	}
 }
+
+func (s *Server) GetProjectedBalances(ctx context.Context, req *connect.Request[pb.GetProjectedBalancesRequest]) (*connect.Response[pb.GetProjectedBalancesResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.services.Dashboard.ProjectedBalances(ctx, userID, req.Msg.Days)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetProjectedBalancesResponse{Accounts: accounts}), nil
+}
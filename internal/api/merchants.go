@@ -0,0 +1,106 @@
+package api
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Server) ListMerchants(ctx context.Context, req *connect.Request[pb.ListMerchantsRequest]) (*connect.Response[pb.ListMerchantsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchants, err := s.services.Merchants.List(ctx, userID)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListMerchantsResponse{Merchants: merchants}), nil
+}
+
+func (s *Server) GetMerchant(ctx context.Context, req *connect.Request[pb.GetMerchantRequest]) (*connect.Response[pb.GetMerchantResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, err := s.services.Merchants.Get(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetMerchantResponse{Merchant: merchant}), nil
+}
+
+func (s *Server) CreateMerchant(ctx context.Context, req *connect.Request[pb.CreateMerchantRequest]) (*connect.Response[pb.CreateMerchantResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, err := s.services.Merchants.Create(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.CreateMerchantResponse{Merchant: merchant}), nil
+}
+
+func (s *Server) UpdateMerchant(ctx context.Context, req *connect.Request[pb.UpdateMerchantRequest]) (*connect.Response[pb.UpdateMerchantResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, err := s.services.Merchants.Update(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UpdateMerchantResponse{Merchant: merchant}), nil
+}
+
+func (s *Server) DeleteMerchant(ctx context.Context, req *connect.Request[pb.DeleteMerchantRequest]) (*connect.Response[pb.DeleteMerchantResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Merchants.Delete(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteMerchantResponse{AffectedRows: affected}), nil
+}
+
+func (s *Server) MergeMerchants(ctx context.Context, req *connect.Request[pb.MergeMerchantsRequest]) (*connect.Response[pb.MergeMerchantsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, err := s.services.Merchants.Merge(ctx, userID, req.Msg.GetTargetId(), req.Msg.GetSourceIds())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.MergeMerchantsResponse{Merchant: merchant}), nil
+}
+
+func (s *Server) SplitMerchant(ctx context.Context, req *connect.Request[pb.SplitMerchantRequest]) (*connect.Response[pb.SplitMerchantResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, created, err := s.services.Merchants.Split(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SplitMerchantResponse{Merchant: merchant, NewMerchant: created}), nil
+}
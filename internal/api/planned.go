@@ -0,0 +1,78 @@
+package api
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Server) ListPlannedTransactions(ctx context.Context, req *connect.Request[pb.ListPlannedTransactionsRequest]) (*connect.Response[pb.ListPlannedTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := s.services.Planned.List(ctx, userID, req.Msg.AccountId)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListPlannedTransactionsResponse{PlannedTransactions: planned}), nil
+}
+
+func (s *Server) GetPlannedTransaction(ctx context.Context, req *connect.Request[pb.GetPlannedTransactionRequest]) (*connect.Response[pb.GetPlannedTransactionResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := s.services.Planned.Get(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetPlannedTransactionResponse{PlannedTransaction: planned}), nil
+}
+
+func (s *Server) CreatePlannedTransaction(ctx context.Context, req *connect.Request[pb.CreatePlannedTransactionRequest]) (*connect.Response[pb.CreatePlannedTransactionResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := s.services.Planned.Create(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.CreatePlannedTransactionResponse{PlannedTransaction: planned}), nil
+}
+
+func (s *Server) UpdatePlannedTransaction(ctx context.Context, req *connect.Request[pb.UpdatePlannedTransactionRequest]) (*connect.Response[pb.UpdatePlannedTransactionResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := s.services.Planned.Update(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UpdatePlannedTransactionResponse{PlannedTransaction: planned}), nil
+}
+
+func (s *Server) DeletePlannedTransaction(ctx context.Context, req *connect.Request[pb.DeletePlannedTransactionRequest]) (*connect.Response[pb.DeletePlannedTransactionResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affectedRows, err := s.services.Planned.Delete(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DeletePlannedTransactionResponse{AffectedRows: affectedRows}), nil
+}
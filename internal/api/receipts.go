@@ -0,0 +1,95 @@
+package api
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Server) UploadReceipt(ctx context.Context, req *connect.Request[pb.UploadReceiptRequest]) (*connect.Response[pb.UploadReceiptResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, candidates, err := s.services.Receipts.Upload(ctx, userID, req.Msg.GetContent(), req.Msg.GetContentType())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UploadReceiptResponse{
+		Receipt:    receipt,
+		Candidates: candidates,
+	}), nil
+}
+
+func (s *Server) GetReceipt(ctx context.Context, req *connect.Request[pb.GetReceiptRequest]) (*connect.Response[pb.GetReceiptResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := s.services.Receipts.Get(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetReceiptResponse{Receipt: receipt}), nil
+}
+
+func (s *Server) ListReceipts(ctx context.Context, req *connect.Request[pb.ListReceiptsRequest]) (*connect.Response[pb.ListReceiptsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := s.services.Receipts.List(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListReceiptsResponse{Receipts: receipts}), nil
+}
+
+func (s *Server) ConfirmReceiptMatch(ctx context.Context, req *connect.Request[pb.ConfirmReceiptMatchRequest]) (*connect.Response[pb.ConfirmReceiptMatchResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := s.services.Receipts.ConfirmMatch(ctx, userID, req.Msg.GetReceiptId(), req.Msg.TransactionId)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ConfirmReceiptMatchResponse{Receipt: receipt}), nil
+}
+
+func (s *Server) RejectReceiptMatch(ctx context.Context, req *connect.Request[pb.RejectReceiptMatchRequest]) (*connect.Response[pb.RejectReceiptMatchResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := s.services.Receipts.RejectMatch(ctx, userID, req.Msg.GetReceiptId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.RejectReceiptMatchResponse{Receipt: receipt}), nil
+}
+
+func (s *Server) DeleteReceipt(ctx context.Context, req *connect.Request[pb.DeleteReceiptRequest]) (*connect.Response[pb.DeleteReceiptResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Receipts.Delete(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteReceiptResponse{AffectedRows: affected}), nil
+}
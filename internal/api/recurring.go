@@ -0,0 +1,64 @@
+package api
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Server) DetectRecurring(ctx context.Context, req *connect.Request[pb.DetectRecurringRequest]) (*connect.Response[pb.DetectRecurringResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.services.Recurring.Detect(ctx, userID, req.Msg.LookbackDays)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DetectRecurringResponse{Series: series}), nil
+}
+
+func (s *Server) ListRecurring(ctx context.Context, req *connect.Request[pb.ListRecurringRequest]) (*connect.Response[pb.ListRecurringResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.services.Recurring.List(ctx, userID, req.Msg.Status)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListRecurringResponse{Series: series}), nil
+}
+
+func (s *Server) ConfirmRecurring(ctx context.Context, req *connect.Request[pb.ConfirmRecurringRequest]) (*connect.Response[pb.ConfirmRecurringResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.services.Recurring.Confirm(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ConfirmRecurringResponse{Series: series}), nil
+}
+
+func (s *Server) DismissRecurring(ctx context.Context, req *connect.Request[pb.DismissRecurringRequest]) (*connect.Response[pb.DismissRecurringResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.services.Recurring.Dismiss(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DismissRecurringResponse{Series: series}), nil
+}
@@ -7,13 +7,12 @@ import (
 	"encoding/json"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
-// TODO: validation can be simplified
-
 func (s *Server) ListRules(ctx context.Context, req *connect.Request[pb.ListRulesRequest]) (*connect.Response[pb.ListRulesResponse], error) {
 	userID, err := getUserID(ctx)
 	if err != nil {
@@ -62,30 +61,10 @@ func (s *Server) CreateRule(ctx context.Context, req *connect.Request[pb.CreateR
 		return nil, status.Error(codes.InvalidArgument, "At least one action (category_id or merchant) must be specified")
 	}
 
-	conditionsBytes, err := req.Msg.GetConditions().MarshalJSON()
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "Invalid conditions JSON")
-	}
-
-	validationResult := rules.ValidateRuleJSONDetailed(conditionsBytes)
-	if !validationResult.Valid {
-		errorMsg := "Rule validation failed:"
-		for _, validationErr := range validationResult.Errors {
-			errorMsg += " " + validationErr.Error() + ";"
-		}
-		return nil, status.Error(codes.InvalidArgument, errorMsg)
-	}
-
-	normalizedRule, err := rules.NormalizeAndValidateRule(conditionsBytes)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "Rule normalization failed: "+err.Error())
-	}
-
-	normalizedBytes, err := json.Marshal(normalizedRule)
+	conditionsBytes, err := normalizeRuleConditions(req.Msg.GetConditions())
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to serialize normalized rule")
+		return nil, err
 	}
-	conditionsBytes = normalizedBytes
 
 	rule, err := s.services.Rules.Create(ctx, userID, req.Msg.GetRuleName(), conditionsBytes, req.Msg.CategoryId, req.Msg.Merchant)
 	if err != nil {
@@ -121,30 +100,10 @@ func (s *Server) UpdateRule(ctx context.Context, req *connect.Request[pb.UpdateR
 
 	var conditionsBytes []byte
 	if req.Msg.Conditions != nil {
-		condBytes, err := req.Msg.Conditions.MarshalJSON()
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "Invalid conditions JSON")
-		}
-
-		validationResult := rules.ValidateRuleJSONDetailed(condBytes)
-		if !validationResult.Valid {
-			errorMsg := "Rule validation failed:"
-			for _, validationErr := range validationResult.Errors {
-				errorMsg += " " + validationErr.Error() + ";"
-			}
-			return nil, status.Error(codes.InvalidArgument, errorMsg)
-		}
-
-		normalizedRule, err := rules.NormalizeAndValidateRule(condBytes)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "Rule normalization failed: "+err.Error())
-		}
-
-		normalizedBytes, err := json.Marshal(normalizedRule)
+		conditionsBytes, err = normalizeRuleConditions(req.Msg.Conditions)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "Failed to serialize normalized rule")
+			return nil, err
 		}
-		conditionsBytes = normalizedBytes
 	}
 
 	err = s.services.Rules.Update(ctx, userID, ruleID, req.Msg.RuleName, conditionsBytes, req.Msg.CategoryId, req.Msg.Merchant)
@@ -229,3 +188,113 @@ func (s *Server) ValidateRule(ctx context.Context, req *connect.Request[pb.Valid
 
 	return connect.NewResponse(response), nil
 }
+
+func (s *Server) ReorderRules(ctx context.Context, req *connect.Request[pb.ReorderRulesRequest]) (*connect.Response[pb.ReorderRulesResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleIDs := make([]uuid.UUID, len(req.Msg.GetRuleIds()))
+	for i, raw := range req.Msg.GetRuleIds() {
+		if ruleIDs[i], err = parseUUID(raw); err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := s.services.Rules.Reorder(ctx, userID, ruleIDs)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ReorderRulesResponse{
+		Rules: rules,
+	}), nil
+}
+
+func (s *Server) SetRuleActive(ctx context.Context, req *connect.Request[pb.SetRuleActiveRequest]) (*connect.Response[pb.SetRuleActiveResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleID, err := parseUUID(req.Msg.GetRuleId())
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.services.Rules.SetActive(ctx, userID, ruleID, req.Msg.GetIsActive())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SetRuleActiveResponse{
+		Rule: rule,
+	}), nil
+}
+
+func (s *Server) AnalyzeRules(ctx context.Context, req *connect.Request[pb.AnalyzeRulesRequest]) (*connect.Response[pb.AnalyzeRulesResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.services.Rules.Analyze(ctx, userID)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) PreviewRule(ctx context.Context, req *connect.Request[pb.PreviewRuleRequest]) (*connect.Response[pb.PreviewRuleResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.CategoryId == nil && req.Msg.Merchant == nil {
+		return nil, status.Error(codes.InvalidArgument, "At least one action (category_id or merchant) must be specified")
+	}
+
+	conditionsBytes, err := normalizeRuleConditions(req.Msg.GetConditions())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.services.Rules.Preview(ctx, userID, conditionsBytes, req.Msg.CategoryId, req.Msg.Merchant, req.Msg.Limit, req.Msg.Offset)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// normalizeRuleConditions validates the conditions of a rule and returns them
+// in normalized JSON form.
+func normalizeRuleConditions(conditions *structpb.Struct) ([]byte, error) {
+	conditionsBytes, err := conditions.MarshalJSON()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid conditions JSON")
+	}
+
+	validationResult := rules.ValidateRuleJSONDetailed(conditionsBytes)
+	if !validationResult.Valid {
+		errorMsg := "Rule validation failed:"
+		for _, validationErr := range validationResult.Errors {
+			errorMsg += " " + validationErr.Error() + ";"
+		}
+		return nil, status.Error(codes.InvalidArgument, errorMsg)
+	}
+
+	normalizedRule, err := rules.NormalizeAndValidateRule(conditionsBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Rule normalization failed: "+err.Error())
+	}
+
+	normalizedBytes, err := json.Marshal(normalizedRule)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Failed to serialize normalized rule")
+	}
+	return normalizedBytes, nil
+}
@@ -27,6 +27,12 @@ func NewServer(services *service.Services, logger *log.Logger) *Server {
 		"arian.v1.RuleService",
 		"arian.v1.DashboardService",
 		"arian.v1.BackupService",
+		"arian.v1.ReceiptService",
+		"arian.v1.TagService",
+		"arian.v1.RecurringService",
+		"arian.v1.PlannedTransactionService",
+		"arian.v1.MerchantService",
+		"arian.v1.SharedExpenseService",
 	)
 
 	return &Server{
@@ -75,6 +81,12 @@ func (s *Server) registerServices(mux *http.ServeMux) {
 		"arian.v1.RuleService",
 		"arian.v1.DashboardService",
 		"arian.v1.BackupService",
+		"arian.v1.ReceiptService",
+		"arian.v1.TagService",
+		"arian.v1.RecurringService",
+		"arian.v1.PlannedTransactionService",
+		"arian.v1.MerchantService",
+		"arian.v1.SharedExpenseService",
 	)
 	reflectPath, reflectHandler := grpcreflect.NewHandlerV1(reflector)
 	mux.Handle(reflectPath, reflectHandler)
@@ -84,6 +96,7 @@ func (s *Server) registerServices(mux *http.ServeMux) {
 	interceptors := connect.WithInterceptors(
 		middleware.ConnectLoggingInterceptor(s.log),
 		middleware.UserIDExtractor(),
+		internalActorInterceptor(),
 	)
 
 	path, handler := arianv1connect.NewUserServiceHandler(s, interceptors)
@@ -107,6 +120,24 @@ func (s *Server) registerServices(mux *http.ServeMux) {
 	path, handler = arianv1connect.NewBackupServiceHandler(s, interceptors)
 	mux.Handle(path, handler)
 
+	path, handler = arianv1connect.NewReceiptServiceHandler(s, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = arianv1connect.NewTagServiceHandler(s, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = arianv1connect.NewRecurringServiceHandler(s, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = arianv1connect.NewPlannedTransactionServiceHandler(s, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = arianv1connect.NewMerchantServiceHandler(s, interceptors)
+	mux.Handle(path, handler)
+
+	path, handler = arianv1connect.NewSharedExpenseServiceHandler(s, interceptors)
+	mux.Handle(path, handler)
+
 	s.log.Info("all connect-go services registered",
 		"health_endpoint", healthPath,
 	)
@@ -0,0 +1,78 @@
+package api
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Server) SetSharedExpense(ctx context.Context, req *connect.Request[pb.SetSharedExpenseRequest]) (*connect.Response[pb.SetSharedExpenseResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expense, err := s.services.SharedExpenses.Set(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SetSharedExpenseResponse{SharedExpense: expense}), nil
+}
+
+func (s *Server) ClearSharedExpense(ctx context.Context, req *connect.Request[pb.ClearSharedExpenseRequest]) (*connect.Response[pb.ClearSharedExpenseResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.SharedExpenses.Clear(ctx, userID, req.Msg.GetTransactionId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ClearSharedExpenseResponse{AffectedRows: affected}), nil
+}
+
+func (s *Server) ListSharedBalances(ctx context.Context, req *connect.Request[pb.ListSharedBalancesRequest]) (*connect.Response[pb.ListSharedBalancesResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := s.services.SharedExpenses.ListBalances(ctx, userID)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListSharedBalancesResponse{Balances: balances}), nil
+}
+
+func (s *Server) SettleUp(ctx context.Context, req *connect.Request[pb.SettleUpRequest]) (*connect.Response[pb.SettleUpResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.services.SharedExpenses.SettleUp(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SettleUpResponse{Settlement: settlement}), nil
+}
+
+func (s *Server) ListSettlements(ctx context.Context, req *connect.Request[pb.ListSettlementsRequest]) (*connect.Response[pb.ListSettlementsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlements, err := s.services.SharedExpenses.ListSettlements(ctx, userID, req.Msg.OtherUserId)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListSettlementsResponse{Settlements: settlements}), nil
+}
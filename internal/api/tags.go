@@ -0,0 +1,106 @@
+package api
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+func (s *Server) ListTags(ctx context.Context, req *connect.Request[pb.ListTagsRequest]) (*connect.Response[pb.ListTagsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.services.Tags.List(ctx, userID)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListTagsResponse{Tags: tags}), nil
+}
+
+func (s *Server) GetTag(ctx context.Context, req *connect.Request[pb.GetTagRequest]) (*connect.Response[pb.GetTagResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.services.Tags.Get(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetTagResponse{Tag: tag}), nil
+}
+
+func (s *Server) CreateTag(ctx context.Context, req *connect.Request[pb.CreateTagRequest]) (*connect.Response[pb.CreateTagResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.services.Tags.Create(ctx, userID, req.Msg.GetSlug(), req.Msg.GetColor())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.CreateTagResponse{Tag: tag}), nil
+}
+
+func (s *Server) UpdateTag(ctx context.Context, req *connect.Request[pb.UpdateTagRequest]) (*connect.Response[pb.UpdateTagResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.services.Tags.Update(ctx, userID, req.Msg.GetId(), req.Msg.Slug, req.Msg.Color)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UpdateTagResponse{Tag: tag}), nil
+}
+
+func (s *Server) DeleteTag(ctx context.Context, req *connect.Request[pb.DeleteTagRequest]) (*connect.Response[pb.DeleteTagResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Tags.Delete(ctx, userID, req.Msg.GetId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteTagResponse{AffectedRows: affected}), nil
+}
+
+func (s *Server) TagTransactions(ctx context.Context, req *connect.Request[pb.TagTransactionsRequest]) (*connect.Response[pb.TagTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Tags.TagTransactions(ctx, userID, req.Msg.GetTransactionIds(), req.Msg.GetTagIds())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.TagTransactionsResponse{AffectedRows: affected}), nil
+}
+
+func (s *Server) UntagTransactions(ctx context.Context, req *connect.Request[pb.UntagTransactionsRequest]) (*connect.Response[pb.UntagTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Tags.UntagTransactions(ctx, userID, req.Msg.GetTransactionIds(), req.Msg.GetTagIds())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UntagTransactionsResponse{AffectedRows: affected}), nil
+}
@@ -47,14 +47,22 @@ func (s *Server) CreateTransaction(ctx context.Context, req *connect.Request[pb.
 		return nil, err
 	}
 
-	transactions, err := s.services.Transactions.Create(ctx, userID, req.Msg)
+	results, err := s.services.Transactions.Create(ctx, userID, req.Msg)
 	if err != nil {
 		return nil, wrapErr(err)
 	}
 
+	transactions := make([]*pb.Transaction, 0, len(results))
+	for _, result := range results {
+		if result.Transaction != nil {
+			transactions = append(transactions, result.Transaction)
+		}
+	}
+
 	return connect.NewResponse(&pb.CreateTransactionResponse{
 		Transactions: transactions,
 		CreatedCount: int32(len(transactions)),
+		Results:      results,
 	}), nil
 }
 
@@ -103,3 +111,316 @@ func (s *Server) CategorizeTransactions(ctx context.Context, req *connect.Reques
 		AffectedRows: int64(len(req.Msg.TransactionIds)),
 	}), nil
 }
+
+func (s *Server) StreamTransactions(ctx context.Context, req *connect.Request[pb.StreamTransactionsRequest], stream *connect.ServerStream[pb.StreamTransactionsResponse]) error {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.services.Transactions.Stream(ctx, userID, req.Msg, stream.Send); err != nil {
+		return wrapErr(err)
+	}
+	return nil
+}
+
+func (s *Server) AggregateTransactions(ctx context.Context, req *connect.Request[pb.AggregateTransactionsRequest]) (*connect.Response[pb.AggregateTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.services.Transactions.Aggregate(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) UpdateTransactionsByFilter(ctx context.Context, req *connect.Request[pb.UpdateTransactionsByFilterRequest]) (*connect.Response[pb.UpdateTransactionsByFilterResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.UpdateByFilter(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UpdateTransactionsByFilterResponse{
+		AffectedRows: affected,
+	}), nil
+}
+
+func (s *Server) DeleteTransactionsByFilter(ctx context.Context, req *connect.Request[pb.DeleteTransactionsByFilterRequest]) (*connect.Response[pb.DeleteTransactionsByFilterResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.DeleteByFilter(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteTransactionsByFilterResponse{
+		AffectedRows: affected,
+	}), nil
+}
+
+func (s *Server) ImportStatement(ctx context.Context, req *connect.Request[pb.ImportStatementRequest]) (*connect.Response[pb.ImportStatementResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.services.Transactions.Import(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) ListImportProfiles(ctx context.Context, req *connect.Request[pb.ListImportProfilesRequest]) (*connect.Response[pb.ListImportProfilesResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.services.Transactions.ListImportProfiles(ctx, userID)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListImportProfilesResponse{
+		Profiles: profiles,
+	}), nil
+}
+
+func (s *Server) SaveImportProfile(ctx context.Context, req *connect.Request[pb.SaveImportProfileRequest]) (*connect.Response[pb.SaveImportProfileResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.services.Transactions.SaveImportProfile(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SaveImportProfileResponse{
+		Profile: profile,
+	}), nil
+}
+
+func (s *Server) DeleteImportProfile(ctx context.Context, req *connect.Request[pb.DeleteImportProfileRequest]) (*connect.Response[pb.DeleteImportProfileResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.DeleteImportProfile(ctx, userID, req.Msg.GetName())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteImportProfileResponse{
+		AffectedRows: affected,
+	}), nil
+}
+
+func (s *Server) SetTransactionSplits(ctx context.Context, req *connect.Request[pb.SetTransactionSplitsRequest]) (*connect.Response[pb.SetTransactionSplitsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	splits, err := s.services.Transactions.SetSplits(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SetTransactionSplitsResponse{
+		Splits: splits,
+	}), nil
+}
+
+func (s *Server) LinkTransfer(ctx context.Context, req *connect.Request[pb.LinkTransferRequest]) (*connect.Response[pb.LinkTransferResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.services.Transactions.LinkTransfer(ctx, userID, req.Msg.GetOutgoingTransactionId(), req.Msg.GetIncomingTransactionId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.LinkTransferResponse{
+		Transfer: transfer,
+	}), nil
+}
+
+func (s *Server) UnlinkTransfer(ctx context.Context, req *connect.Request[pb.UnlinkTransferRequest]) (*connect.Response[pb.UnlinkTransferResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.UnlinkTransfer(ctx, userID, req.Msg.GetTransactionId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UnlinkTransferResponse{
+		AffectedRows: affected,
+	}), nil
+}
+
+func (s *Server) DetectTransfers(ctx context.Context, req *connect.Request[pb.DetectTransfersRequest]) (*connect.Response[pb.DetectTransfersResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := s.services.Transactions.DetectTransfers(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DetectTransfersResponse{
+		Transfers: transfers,
+	}), nil
+}
+
+func (s *Server) LinkRefund(ctx context.Context, req *connect.Request[pb.LinkRefundRequest]) (*connect.Response[pb.LinkRefundResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := s.services.Transactions.LinkRefund(ctx, userID, req.Msg.GetOriginalTransactionId(), req.Msg.GetRefundTransactionId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.LinkRefundResponse{
+		Refund: refund,
+	}), nil
+}
+
+func (s *Server) UnlinkRefund(ctx context.Context, req *connect.Request[pb.UnlinkRefundRequest]) (*connect.Response[pb.UnlinkRefundResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.UnlinkRefund(ctx, userID, req.Msg.GetTransactionId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.UnlinkRefundResponse{
+		AffectedRows: affected,
+	}), nil
+}
+
+func (s *Server) DetectRefunds(ctx context.Context, req *connect.Request[pb.DetectRefundsRequest]) (*connect.Response[pb.DetectRefundsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.services.Transactions.DetectRefunds(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.DetectRefundsResponse{
+		Refunds: refunds,
+	}), nil
+}
+
+func (s *Server) SearchTransactions(ctx context.Context, req *connect.Request[pb.SearchTransactionsRequest]) (*connect.Response[pb.SearchTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, hasMore, err := s.services.Transactions.Search(ctx, userID, req.Msg)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.SearchTransactionsResponse{
+		Results: results,
+		HasMore: hasMore,
+	}), nil
+}
+
+func (s *Server) GetTransactionHistory(ctx context.Context, req *connect.Request[pb.GetTransactionHistoryRequest]) (*connect.Response[pb.GetTransactionHistoryResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.services.Transactions.GetHistory(ctx, userID, req.Msg.GetTransactionId())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.GetTransactionHistoryResponse{
+		Changes: changes,
+	}), nil
+}
+
+func (s *Server) ListDeletedTransactions(ctx context.Context, req *connect.Request[pb.ListDeletedTransactionsRequest]) (*connect.Response[pb.ListDeletedTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.services.Transactions.ListDeleted(ctx, userID, req.Msg.GetLimit(), req.Msg.GetOffset())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.ListDeletedTransactionsResponse{
+		Transactions: transactions,
+	}), nil
+}
+
+func (s *Server) RestoreTransactions(ctx context.Context, req *connect.Request[pb.RestoreTransactionsRequest]) (*connect.Response[pb.RestoreTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.Restore(ctx, userID, req.Msg.GetIds())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.RestoreTransactionsResponse{
+		AffectedRows: affected,
+	}), nil
+}
+
+func (s *Server) PurgeTransactions(ctx context.Context, req *connect.Request[pb.PurgeTransactionsRequest]) (*connect.Response[pb.PurgeTransactionsResponse], error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.services.Transactions.Purge(ctx, userID, req.Msg.GetIds())
+	if err != nil {
+		return nil, wrapErr(err)
+	}
+
+	return connect.NewResponse(&pb.PurgeTransactionsResponse{
+		AffectedRows: affected,
+	}), nil
+}
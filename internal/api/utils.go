@@ -2,6 +2,8 @@ package api
 
 import (
 	"ariand/internal/api/middleware"
+	"ariand/internal/filter"
+	pb "ariand/internal/gen/arian/v1"
 	"ariand/internal/service"
 	"context"
 	"errors"
@@ -10,7 +12,6 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/type/date"
-	"google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -20,16 +21,52 @@ func wrapErr(err error) error {
 		return nil
 	}
 
-	if err == service.ErrValidation {
+	var filterErrs filter.Errors
+	if errors.As(err, &filterErrs) {
+		return filterErr(filterErrs)
+	}
+
+	if errors.Is(err, service.ErrValidation) {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
-	if err == service.ErrUnimplemented {
+	if errors.Is(err, service.ErrUnimplemented) {
 		return status.Error(codes.Unimplemented, err.Error())
 	}
 
 	return status.Errorf(codes.Internal, "internal error: %v", err)
 }
 
+// filterErr reports every bad term of a filter expression as a FilterError detail
+func filterErr(errs filter.Errors) error {
+	cerr := connect.NewError(connect.CodeInvalidArgument, errs)
+	for _, e := range errs {
+		detail, err := connect.NewErrorDetail(&pb.FilterError{
+			Position: int32(e.Pos),
+			End:      int32(e.End),
+			Term:     e.Term,
+			Message:  e.Msg,
+		})
+		if err != nil {
+			continue
+		}
+		cerr.AddDetail(detail)
+	}
+	return cerr
+}
+
+// internalActorInterceptor attributes changes made through the internal key
+// to the calling service in the transaction history.
+func internalActorInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if internal, _ := ctx.Value(middleware.InternalAuthKey).(bool); internal {
+				ctx = service.WithInternalActor(ctx)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
 func getUserID(ctx context.Context) (uuid.UUID, error) {
 	userID, ok := ctx.Value(middleware.UserIDKey).(uuid.UUID)
 	if !ok {
@@ -46,15 +83,6 @@ func dateToTime(d *date.Date) *time.Time {
 	return &t
 }
 
-// TODO: should be gone
-func centsToMoney(cents int64, currency string) *money.Money {
-	return &money.Money{
-		CurrencyCode: currency,
-		Units:        cents / 100,
-		Nanos:        int32((cents % 100) * 10_000_000),
-	}
-}
-
 // TODO: should be gone?
 func parseUUID(s string) (uuid.UUID, error) {
 	if s == "" {
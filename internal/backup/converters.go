@@ -50,6 +50,37 @@ func parseTransactionDirection(s string) (arian.TransactionDirection, error) {
 	}
 }
 
+func parseTransactionStatus(s *string) (arian.TransactionStatus, error) {
+	if s == nil {
+		return arian.TransactionStatus_TRANSACTION_STATUS_POSTED, nil
+	}
+	switch *s {
+	case "PENDING", "pending":
+		return arian.TransactionStatus_TRANSACTION_STATUS_PENDING, nil
+	case "POSTED", "posted", "":
+		return arian.TransactionStatus_TRANSACTION_STATUS_POSTED, nil
+	case "VOIDED", "voided":
+		return arian.TransactionStatus_TRANSACTION_STATUS_VOIDED, nil
+	default:
+		return 0, fmt.Errorf("unknown transaction status: %s", *s)
+	}
+}
+
+// formatTransactionStatus returns nil for posted transactions so older
+// backups and new ones look the same for the common case.
+func formatTransactionStatus(ts arian.TransactionStatus) *string {
+	var s string
+	switch ts {
+	case arian.TransactionStatus_TRANSACTION_STATUS_PENDING:
+		s = "PENDING"
+	case arian.TransactionStatus_TRANSACTION_STATUS_VOIDED:
+		s = "VOIDED"
+	default:
+		return nil
+	}
+	return &s
+}
+
 func formatTransactionDirection(td arian.TransactionDirection) string {
 	switch td {
 	case arian.TransactionDirection_DIRECTION_INCOMING:
@@ -3,23 +3,14 @@ package backup
 import (
 	"ariand/internal/db/sqlc"
 	arian "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
 	"context"
 	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
-	"google.golang.org/genproto/googleapis/type/money"
 )
 
-// centsToMoney converts cents to google.type.Money
-func centsToMoney(cents int64, currency string) *money.Money {
-	return &money.Money{
-		CurrencyCode: currency,
-		Units:        cents / 100,
-		Nanos:        int32((cents % 100) * 10_000_000),
-	}
-}
-
 func exportCategories(ctx context.Context, db *sqlc.Queries, userID uuid.UUID) ([]CategoryData, error) {
 	categories, err := db.ListCategories(ctx, userID)
 	if err != nil {
@@ -58,7 +49,7 @@ func exportAccounts(ctx context.Context, db *sqlc.Queries, userID uuid.UUID) ([]
 			data.AnchorDate = &acc.Account.AnchorDate
 		}
 
-		data.AnchorBalance = centsToMoney(acc.Account.AnchorBalanceCents, acc.Account.AnchorCurrency)
+		data.AnchorBalance = money.FromMinor(acc.Account.AnchorBalanceCents, acc.Account.AnchorCurrency)
 
 		result[i] = data
 	}
@@ -92,20 +83,21 @@ func exportTransactions(ctx context.Context, db *sqlc.Queries, userID uuid.UUID)
 		data := TransactionData{
 			AccountName:  accountName,
 			TxDate:       tx.TxDate,
-			TxAmount:     centsToMoney(tx.TxAmountCents, tx.TxCurrency),
+			TxAmount:     money.FromMinor(tx.TxAmountCents, tx.TxCurrency),
 			TxDirection:  formatTransactionDirection(arian.TransactionDirection(tx.TxDirection)),
 			TxDesc:       tx.TxDesc,
 			Merchant:     tx.Merchant,
 			UserNotes:    tx.UserNotes,
 			ExchangeRate: tx.ExchangeRate,
+			Status:       formatTransactionStatus(arian.TransactionStatus(tx.Status)),
 		}
 
 		if tx.BalanceAfterCents != nil && tx.BalanceCurrency != nil {
-			data.BalanceAfter = centsToMoney(*tx.BalanceAfterCents, *tx.BalanceCurrency)
+			data.BalanceAfter = money.FromMinor(*tx.BalanceAfterCents, *tx.BalanceCurrency)
 		}
 
 		if tx.ForeignAmountCents != nil && tx.ForeignCurrency != nil {
-			data.ForeignAmount = centsToMoney(*tx.ForeignAmountCents, *tx.ForeignCurrency)
+			data.ForeignAmount = money.FromMinor(*tx.ForeignAmountCents, *tx.ForeignCurrency)
 		}
 
 		if tx.CategoryID != nil {
@@ -2,22 +2,14 @@ package backup
 
 import (
 	"ariand/internal/db/sqlc"
+	"ariand/internal/money"
 	"context"
 	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
-	"google.golang.org/genproto/googleapis/type/money"
 )
 
-// moneyToCents converts google.type.Money to cents
-func moneyToCents(m *money.Money) int64 {
-	if m == nil {
-		return 0
-	}
-	return m.Units*100 + int64(m.Nanos)/10_000_000
-}
-
 func importCategories(ctx context.Context, db *sqlc.Queries, userID uuid.UUID, categories []CategoryData) error {
 	for _, cat := range categories {
 		if cat.Slug == "" || cat.Color == "" {
@@ -74,7 +66,7 @@ func importAccounts(ctx context.Context, db *sqlc.Queries, userID uuid.UUID, acc
 			return fmt.Errorf("invalid account_type for %q: %w", acc.Name, err)
 		}
 
-		anchorCents := moneyToCents(acc.AnchorBalance)
+		anchorCents := money.ToMinor(acc.AnchorBalance)
 		anchorCurrency := "CAD"
 		if acc.AnchorBalance != nil && acc.AnchorBalance.CurrencyCode != "" {
 			anchorCurrency = acc.AnchorBalance.CurrencyCode
@@ -130,7 +122,13 @@ func importTransactions(ctx context.Context, db *sqlc.Queries, userID uuid.UUID,
 			return fmt.Errorf("invalid tx_direction: %w", err)
 		}
 
-		txCents := moneyToCents(tx.TxAmount)
+		txStatus, err := parseTransactionStatus(tx.Status)
+		if err != nil {
+			return fmt.Errorf("invalid status: %w", err)
+		}
+		status := int16(txStatus)
+
+		txCents := money.ToMinor(tx.TxAmount)
 		txCurrency := "CAD"
 		if tx.TxAmount != nil && tx.TxAmount.CurrencyCode != "" {
 			txCurrency = tx.TxAmount.CurrencyCode
@@ -139,7 +137,7 @@ func importTransactions(ctx context.Context, db *sqlc.Queries, userID uuid.UUID,
 		var balanceAfterCents *int64
 		var balanceCurrency *string
 		if tx.BalanceAfter != nil {
-			cents := moneyToCents(tx.BalanceAfter)
+			cents := money.ToMinor(tx.BalanceAfter)
 			currency := tx.BalanceAfter.CurrencyCode
 			balanceAfterCents = &cents
 			balanceCurrency = &currency
@@ -148,7 +146,7 @@ func importTransactions(ctx context.Context, db *sqlc.Queries, userID uuid.UUID,
 		var foreignAmountCents *int64
 		var foreignCurrency *string
 		if tx.ForeignAmount != nil {
-			cents := moneyToCents(tx.ForeignAmount)
+			cents := money.ToMinor(tx.ForeignAmount)
 			currency := tx.ForeignAmount.CurrencyCode
 			foreignAmountCents = &cents
 			foreignCurrency = &currency
@@ -185,6 +183,7 @@ func importTransactions(ctx context.Context, db *sqlc.Queries, userID uuid.UUID,
 			ForeignAmountCents:  foreignAmountCents,
 			ForeignCurrency:     foreignCurrency,
 			ExchangeRate:        tx.ExchangeRate,
+			Status:              &status,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create transaction: %w", err)
@@ -43,6 +43,7 @@ type TransactionData struct {
 	UserNotes     *string      `json:"user_notes,omitempty"`
 	ForeignAmount *money.Money `json:"foreign_amount,omitempty"`
 	ExchangeRate  *float64     `json:"exchange_rate,omitempty"`
+	Status        *string      `json:"status,omitempty"` // omitted for posted
 }
 
 type RuleData struct {
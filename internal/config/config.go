@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/log"
 )
@@ -17,6 +18,11 @@ type Config struct {
 
 	ExchangeAPIURL string // exchange rate API URL
 
+	ReceiptsURL          string        // receipt parsing service URL
+	ReceiptParserTimeout time.Duration // per-request timeout for the receipt parser
+
+	TrashRetention time.Duration // how long soft-deleted rows are kept, 0 keeps them forever
+
 	LogLevel  log.Level // logging level
 	LogFormat string    // logging format: "json" or "text"
 }
@@ -63,6 +69,29 @@ func Load() Config {
 		panic("EXCHANGE_API_URL environment variable is required")
 	}
 
+	receiptsURL := os.Getenv("ARIAN_RECEIPTS_URL")
+	if receiptsURL == "" {
+		panic("ARIAN_RECEIPTS_URL environment variable is required")
+	}
+
+	receiptParserTimeout := 30 * time.Second
+	if raw := os.Getenv("RECEIPT_PARSER_TIMEOUT"); raw != "" {
+		timeout, err := time.ParseDuration(raw)
+		if err != nil || timeout <= 0 {
+			panic("RECEIPT_PARSER_TIMEOUT must be a positive duration, e.g. 30s")
+		}
+		receiptParserTimeout = timeout
+	}
+
+	trashRetention := 30 * 24 * time.Hour
+	if raw := os.Getenv("TRASH_RETENTION"); raw != "" {
+		retention, err := time.ParseDuration(raw)
+		if err != nil || retention < 0 {
+			panic("TRASH_RETENTION must be a duration, e.g. 720h, or 0 to keep trashed rows")
+		}
+		trashRetention = retention
+	}
+
 	logLevel, err := log.ParseLevel(os.Getenv("LOG_LEVEL"))
 	if err != nil {
 		logLevel = log.InfoLevel
@@ -79,7 +108,13 @@ func Load() Config {
 		DatabaseURL:    databaseURL,
 		BetterAuthURL:  betterAuthURL,
 		ExchangeAPIURL: exchangeAPIURL,
-		LogLevel:       logLevel,
-		LogFormat:      logFormat,
+
+		ReceiptsURL:          receiptsURL,
+		ReceiptParserTimeout: receiptParserTimeout,
+
+		TrashRetention: trashRetention,
+
+		LogLevel:  logLevel,
+		LogFormat: logFormat,
 	}
 }
@@ -49,6 +49,26 @@ func (s *DB) Pool() *pgxpool.Pool {
 	return s.pool
 }
 
+// InTx runs fn against a single database transaction. The transaction is
+// committed when fn returns nil and rolled back otherwise.
+func (s *DB) InTx(ctx context.Context, fn func(q *sqlc.Queries) error) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(s.Queries.WithTx(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+
+	return nil
+}
+
 func RunMigrations(dsn string, migrationsDir string) error {
 	db, err := sql.Open("pgx", dsn)
 	if err != nil {
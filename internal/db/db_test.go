@@ -0,0 +1,407 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"ariand/internal/db/sqlc"
+	"ariand/internal/money"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// testDB migrates a fresh schema in the database named by TEST_DATABASE_URL
+// and drops it when the test ends. Tests using it are skipped without one.
+func testDB(t *testing.T) *DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	ctx := context.Background()
+	admin, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer admin.Close(ctx)
+
+	schema := "ariand_test_" + uuid.NewString()[:8]
+	if _, err := admin.Exec(ctx, "create schema "+schema); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		conn, err := pgx.Connect(context.Background(), dsn)
+		if err != nil {
+			return
+		}
+		defer conn.Close(context.Background())
+		conn.Exec(context.Background(), "drop schema "+schema+" cascade")
+	})
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		t.Fatalf("TEST_DATABASE_URL must be a url: %v", err)
+	}
+	query := u.Query()
+	query.Set("search_path", schema+",public")
+	u.RawQuery = query.Encode()
+
+	if err := RunMigrations(u.String(), "migrations"); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	db, err := New(u.String())
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// testAccount creates a user with one CAD account anchored today.
+func testAccount(t *testing.T, db *DB, anchorCents int64) (uuid.UUID, int64) {
+	t.Helper()
+	ctx := context.Background()
+	id := uuid.New()
+	user, err := db.CreateUser(ctx, sqlc.CreateUserParams{ID: id, Email: id.String() + "@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := db.CreateAccount(ctx, sqlc.CreateAccountParams{
+		OwnerID:            user.ID,
+		Name:               "Chequing",
+		Bank:               "Bank",
+		AnchorBalanceCents: anchorCents,
+		AnchorCurrency:     "CAD",
+		MainCurrency:       "CAD",
+		Colors:             []string{"#1f2937", "#3b82f6", "#10b981"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user.ID, account.ID
+}
+
+func TestSyncAccountBalances(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+
+	// the anchor date defaults to today
+	userID, accountID := testAccount(t, db, 10000)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour).Add(12 * time.Hour)
+	create := func(days int, direction int16, cents int64) int64 {
+		tx, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			AccountID:     accountID,
+			TxDate:        today.AddDate(0, 0, days),
+			TxAmountCents: cents,
+			TxCurrency:    "CAD",
+			TxDirection:   direction,
+			UserID:        userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx.ID
+	}
+	credit := create(-3, 1, 500)
+	debit := create(-2, 2, 1000)
+	later := create(2, 2, 2000)
+
+	err := db.InTx(ctx, func(q *sqlc.Queries) error {
+		return q.SyncAccountBalances(ctx, accountID)
+	})
+	if err != nil {
+		t.Fatalf("SyncAccountBalances: %v", err)
+	}
+
+	want := map[int64]int64{credit: 11000, debit: 10000, later: 8000}
+	for id, balance := range want {
+		tx, err := db.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := "nil"
+		if tx.BalanceAfterCents != nil {
+			got = fmt.Sprint(*tx.BalanceAfterCents)
+		}
+		if got != fmt.Sprint(balance) {
+			t.Errorf("tx %d balance after = %s, want %d", id, got, balance)
+		}
+	}
+}
+
+// the minor units migration has its own copy of the currency exponents
+func TestMinorUnitMigrationMatchesMoney(t *testing.T) {
+	sql, err := os.ReadFile("migrations/0018_minor_units.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := regexp.MustCompile(`WHEN '([A-Z]{3})' THEN (\d)`).FindAllStringSubmatch(string(sql), -1)
+	if len(cases) < 20 {
+		t.Fatalf("found only %d currencies in the migration", len(cases))
+	}
+	for _, c := range cases {
+		digits, _ := strconv.Atoi(c[2])
+		if money.Digits(c[1]) != digits {
+			t.Errorf("%s has %d digits in the migration but %d in money", c[1], digits, money.Digits(c[1]))
+		}
+	}
+}
+
+// history can't be edited or deleted while its transaction exists, but a
+// purge takes it along
+func TestTransactionHistoryAppendOnly(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, accountID := testAccount(t, db, 0)
+
+	tx, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+		AccountID:     accountID,
+		TxDate:        time.Now(),
+		TxAmountCents: 100,
+		TxCurrency:    "CAD",
+		TxDirection:   2,
+		UserID:        userID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.InsertTransactionHistory(ctx, sqlc.InsertTransactionHistoryParams{
+		ActorType:      1,
+		ActorUserID:    &userID,
+		TransactionIds: []int64{tx.ID},
+		Fields:         []string{"tx_desc"},
+		OldValues:      []string{""},
+		NewValues:      []string{"coffee"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := func() int {
+		var n int
+		if err := db.Pool().QueryRow(ctx, "select count(*) from transaction_history where transaction_id = $1", tx.ID).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	if _, err := db.Pool().Exec(ctx, "update transaction_history set new_value = 'tea' where transaction_id = $1", tx.ID); err == nil {
+		t.Error("updating history succeeded")
+	}
+	if _, err := db.Pool().Exec(ctx, "delete from transaction_history where transaction_id = $1", tx.ID); err == nil {
+		t.Error("deleting history succeeded")
+	}
+
+	if _, err := db.DeleteTransaction(ctx, sqlc.DeleteTransactionParams{ID: tx.ID, UserID: userID}); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(); n != 1 {
+		t.Fatalf("trashing left %d history entries, want 1", n)
+	}
+	if _, err := db.PurgeTransactions(ctx, sqlc.PurgeTransactionsParams{TransactionIds: []int64{tx.ID}, UserID: userID}); err != nil {
+		t.Fatal(err)
+	}
+	if n := count(); n != 0 {
+		t.Errorf("purging left %d history entries, want 0", n)
+	}
+}
+
+func TestAggregateTransactionsIsSigned(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+
+	userID, accountID := testAccount(t, db, 0)
+	for _, tx := range []struct {
+		direction int16
+		cents     int64
+	}{{1, 500}, {2, 1000}, {2, 200}} {
+		_, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			AccountID:     accountID,
+			TxDate:        time.Now(),
+			TxAmountCents: tx.cents,
+			TxCurrency:    "CAD",
+			TxDirection:   tx.direction,
+			UserID:        userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := db.AggregateTransactions(ctx, sqlc.AggregateTransactionsParams{UserID: userID, Tz: "UTC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, want 1", len(rows))
+	}
+	if rows[0].TransactionCount != 3 || rows[0].SumCents != -700 || rows[0].MinCents != -1000 || rows[0].MaxCents != 500 {
+		t.Errorf("got count %d sum %d min %d max %d, want 3 -700 -1000 500",
+			rows[0].TransactionCount, rows[0].SumCents, rows[0].MinCents, rows[0].MaxCents)
+	}
+}
+
+func TestDashboardSummaryNetsRefunds(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, accountID := testAccount(t, db, 0)
+
+	create := func(direction int16, cents int64) int64 {
+		tx, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			AccountID:     accountID,
+			TxDate:        time.Now(),
+			TxAmountCents: cents,
+			TxCurrency:    "CAD",
+			TxDirection:   direction,
+			UserID:        userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx.ID
+	}
+	purchase := create(2, 1000)
+	refund := create(1, 300)
+	create(1, 5000)
+	if _, err := db.CreateRefund(ctx, sqlc.CreateRefundParams{UserID: userID, OriginalTxID: purchase, RefundTxID: refund}); err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := db.GetDashboardSummary(ctx, sqlc.GetDashboardSummaryParams{UserID: userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.TotalIncomeCents != 5000 || summary.TotalExpenseCents != 700 {
+		t.Errorf("got income %d expense %d, want 5000 700", summary.TotalIncomeCents, summary.TotalExpenseCents)
+	}
+}
+
+func TestDashboardSkipsTransfers(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, accountID := testAccount(t, db, 0)
+
+	create := func(direction int16, cents int64, merchant string) int64 {
+		tx, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			AccountID:     accountID,
+			TxDate:        time.Now(),
+			TxAmountCents: cents,
+			TxCurrency:    "CAD",
+			TxDirection:   direction,
+			Merchant:      &merchant,
+			UserID:        userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tx.ID
+	}
+	payment := create(2, 2000, "Card Payment")
+	received := create(1, 2000, "Card Payment")
+	create(2, 400, "Grocer")
+	if _, err := db.CreateTransfer(ctx, sqlc.CreateTransferParams{UserID: userID, OutgoingTxID: payment, IncomingTxID: received}); err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := db.GetDashboardSummary(ctx, sqlc.GetDashboardSummaryParams{UserID: userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.TotalTransactions != 3 || summary.TotalIncomeCents != 0 || summary.TotalExpenseCents != 400 {
+		t.Errorf("got %d transactions, income %d expense %d, want 3 0 400",
+			summary.TotalTransactions, summary.TotalIncomeCents, summary.TotalExpenseCents)
+	}
+
+	merchants, err := db.GetTopMerchants(ctx, sqlc.GetTopMerchantsParams{UserID: userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merchants) != 1 || merchants[0].Merchant != "Grocer" {
+		t.Errorf("got top merchants %+v, want only Grocer", merchants)
+	}
+}
+
+// ListTransactions and ListTransactionIDsByFilter share filter_transactions
+func TestListTransactionsFilters(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, accountID := testAccount(t, db, 0)
+
+	for _, direction := range []int16{1, 2, 2} {
+		_, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			AccountID:     accountID,
+			TxDate:        time.Now(),
+			TxAmountCents: 100,
+			TxCurrency:    "CAD",
+			TxDirection:   direction,
+			UserID:        userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	outgoing := int16(2)
+	list, err := db.ListTransactions(ctx, sqlc.ListTransactionsParams{UserID: userID, Direction: &outgoing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := db.ListTransactionIDsByFilter(ctx, sqlc.ListTransactionIDsByFilterParams{UserID: userID, Direction: &outgoing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || len(ids) != 2 {
+		t.Errorf("got %d listed and %d ids, want 2 outgoing", len(list), len(ids))
+	}
+}
+
+// previews page through GetTransactionsForRuleApplication with a keyset cursor
+func TestRuleApplicationBatches(t *testing.T) {
+	db := testDB(t)
+	ctx := context.Background()
+	userID, accountID := testAccount(t, db, 0)
+
+	now := time.Now()
+	for days := range 3 {
+		_, err := db.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			AccountID:     accountID,
+			TxDate:        now.AddDate(0, 0, -days),
+			TxAmountCents: 100,
+			TxCurrency:    "CAD",
+			TxDirection:   2,
+			UserID:        userID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	include, limit := true, int32(2)
+	params := sqlc.GetTransactionsForRuleApplicationParams{UserID: userID, IncludeManuallySet: &include, Limit: &limit}
+	first, err := db.GetTransactionsForRuleApplication(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 2 || !first[0].TxDate.After(first[1].TxDate) {
+		t.Fatalf("got first batch %+v, want the 2 newest", first)
+	}
+
+	params.CursorDate, params.CursorID = &first[1].TxDate, &first[1].ID
+	rest, err := db.GetTransactionsForRuleApplication(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 1 || !rest[0].TxDate.Before(first[1].TxDate) {
+		t.Errorf("got second batch %+v, want the oldest", rest)
+	}
+}
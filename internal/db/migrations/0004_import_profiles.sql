@@ -0,0 +1,25 @@
+-- +goose Up
+--- import_profiles ----------------------------------------------------
+CREATE TABLE import_profiles (
+  id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name       TEXT        NOT NULL,
+  bank       TEXT,
+  mapping    JSONB       NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT import_profiles_user_name_unique UNIQUE (user_id, name)
+);
+
+CREATE INDEX idx_import_profiles_user_id ON import_profiles(user_id);
+
+CREATE TRIGGER trg_import_profiles_update
+  BEFORE UPDATE ON import_profiles
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+-- Speeds up duplicate detection during statement imports
+CREATE INDEX idx_tx_account_amount_date ON transactions(account_id, tx_amount_cents, tx_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tx_account_amount_date;
+DROP TABLE IF EXISTS import_profiles;
@@ -0,0 +1,24 @@
+-- +goose Up
+--- transaction_splits -------------------------------------------------
+-- A split divides one transaction across several categories. Amounts are in
+-- the parent's tx_currency and must add up to tx_amount_cents; the service
+-- replaces the whole set at once so the invariant holds between writes.
+CREATE TABLE transaction_splits (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  transaction_id BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  amount_cents   BIGINT      NOT NULL CHECK (amount_cents > 0),
+  category_id    BIGINT      REFERENCES categories(id) ON DELETE SET NULL,
+  notes          TEXT,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_transaction_splits_transaction_id ON transaction_splits(transaction_id);
+CREATE INDEX idx_transaction_splits_category_id ON transaction_splits(category_id);
+
+CREATE TRIGGER trg_transaction_splits_update
+  BEFORE UPDATE ON transaction_splits
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_splits;
@@ -0,0 +1,25 @@
+-- +goose Up
+--- transfers ----------------------------------------------------------
+-- Pairs the outgoing and incoming halves of money moved between two of the
+-- user's accounts so reports don't count it as spending and income.
+-- Unlinking keeps the row with dismissed_at set, which stops auto-detection
+-- from pairing the same two transactions again.
+CREATE TABLE transfers (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id        UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  outgoing_tx_id BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  incoming_tx_id BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  auto_detected  BOOLEAN     NOT NULL DEFAULT false,
+  dismissed_at   TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT transfers_distinct_tx CHECK (outgoing_tx_id <> incoming_tx_id)
+);
+
+CREATE UNIQUE INDEX ux_transfers_outgoing_active
+  ON transfers(outgoing_tx_id) WHERE dismissed_at IS NULL;
+CREATE UNIQUE INDEX ux_transfers_incoming_active
+  ON transfers(incoming_tx_id) WHERE dismissed_at IS NULL;
+CREATE INDEX idx_transfers_user_id ON transfers(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS transfers;
@@ -0,0 +1,40 @@
+-- +goose Up
+--- receipts -----------------------------------------------------------
+-- match_status: 1 = no match, 2 = suggested, 3 = confirmed, 4 = rejected
+CREATE TABLE receipts (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id        UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  transaction_id BIGINT      REFERENCES transactions(id) ON DELETE SET NULL,
+  match_status   SMALLINT    NOT NULL DEFAULT 1 CHECK (match_status BETWEEN 1 AND 4),
+  match_score    REAL,
+  merchant       TEXT,
+  total_cents    BIGINT      NOT NULL,
+  currency       CHAR(3),
+  receipt_date   DATE,
+  content_type   TEXT        NOT NULL,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_receipts_user_id ON receipts(user_id);
+CREATE INDEX idx_receipts_transaction_id ON receipts(transaction_id);
+
+CREATE TRIGGER trg_receipts_update
+  BEFORE UPDATE ON receipts
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+CREATE TABLE receipt_items (
+  id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  receipt_id       BIGINT           NOT NULL REFERENCES receipts(id) ON DELETE CASCADE,
+  position         INT              NOT NULL,
+  name             TEXT             NOT NULL,
+  quantity         DOUBLE PRECISION NOT NULL DEFAULT 1,
+  unit_price_cents BIGINT,
+  total_cents      BIGINT           NOT NULL
+);
+
+CREATE INDEX idx_receipt_items_receipt_id ON receipt_items(receipt_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS receipt_items;
+DROP TABLE IF EXISTS receipts;
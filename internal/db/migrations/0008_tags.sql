@@ -0,0 +1,33 @@
+-- +goose Up
+--- tags ---------------------------------------------------------------
+-- Free-form labels that cut across categories, e.g. "vacation-2026" or
+-- "tax-deductible". A transaction can carry any number of them.
+CREATE TABLE tags (
+  id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id    UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  slug       TEXT        NOT NULL,
+  color      TEXT        NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT tags_user_slug_unique UNIQUE (user_id, slug),
+  CONSTRAINT tag_slug_lower CHECK (slug = lower(slug))
+);
+
+CREATE INDEX idx_tags_user_id ON tags(user_id);
+
+CREATE TRIGGER trg_tags_update
+  BEFORE UPDATE ON tags
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+CREATE TABLE transaction_tags (
+  transaction_id BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  tag_id         BIGINT      NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (transaction_id, tag_id)
+);
+
+CREATE INDEX idx_transaction_tags_tag_id ON transaction_tags(tag_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_tags;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,83 @@
+-- +goose Up
+--- transaction_search -------------------------------------------------
+-- Full-text document per transaction. It lives in its own table because the
+-- category slug comes from another row, which rules out a generated column.
+-- Weights: merchant A, description B, category C, notes D. The 'simple'
+-- config skips stemming: bank descriptions aren't prose and prefix search
+-- on "starb" should find "starbucks", not a stem of it.
+CREATE TABLE transaction_search (
+  transaction_id BIGINT   PRIMARY KEY REFERENCES transactions(id) ON DELETE CASCADE,
+  document       TSVECTOR NOT NULL
+);
+
+CREATE INDEX idx_transaction_search_document ON transaction_search USING gin (document);
+CREATE INDEX idx_tx_merchant_trgm ON transactions USING gin (lower(merchant) gin_trgm_ops);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION transaction_search_document(
+  p_desc TEXT, p_merchant TEXT, p_notes TEXT, p_category_id BIGINT
+) RETURNS TSVECTOR LANGUAGE sql STABLE AS $$
+  SELECT
+    setweight(to_tsvector('simple', coalesce(p_merchant, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(p_desc, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(
+      (SELECT replace(c.slug, '.', ' ') FROM categories c WHERE c.id = p_category_id), ''
+    )), 'C') ||
+    setweight(to_tsvector('simple', coalesce(p_notes, '')), 'D')
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION refresh_transaction_search()
+RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+  INSERT INTO transaction_search (transaction_id, document)
+  VALUES (NEW.id, transaction_search_document(NEW.tx_desc, NEW.merchant, NEW.user_notes, NEW.category_id))
+  ON CONFLICT (transaction_id) DO UPDATE SET document = EXCLUDED.document;
+  RETURN NULL;
+END$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER trg_transactions_search_insert
+  AFTER INSERT ON transactions
+  FOR EACH ROW EXECUTE FUNCTION refresh_transaction_search();
+
+CREATE TRIGGER trg_transactions_search_update
+  AFTER UPDATE OF tx_desc, merchant, user_notes, category_id ON transactions
+  FOR EACH ROW EXECUTE FUNCTION refresh_transaction_search();
+
+-- renaming a category changes the document of everything filed under it
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION refresh_category_search()
+RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+  UPDATE transaction_search ts
+  SET document = transaction_search_document(t.tx_desc, t.merchant, t.user_notes, t.category_id)
+  FROM transactions t
+  WHERE t.id = ts.transaction_id AND t.category_id = NEW.id;
+  RETURN NULL;
+END$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER trg_categories_search_update
+  AFTER UPDATE OF slug ON categories
+  FOR EACH ROW WHEN (OLD.slug IS DISTINCT FROM NEW.slug)
+  EXECUTE FUNCTION refresh_category_search();
+
+INSERT INTO transaction_search (transaction_id, document)
+SELECT id, transaction_search_document(tx_desc, merchant, user_notes, category_id)
+FROM transactions;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trg_categories_search_update ON categories;
+DROP TRIGGER IF EXISTS trg_transactions_search_update ON transactions;
+DROP TRIGGER IF EXISTS trg_transactions_search_insert ON transactions;
+
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS refresh_category_search();
+DROP FUNCTION IF EXISTS refresh_transaction_search();
+DROP FUNCTION IF EXISTS transaction_search_document(TEXT, TEXT, TEXT, BIGINT);
+-- +goose StatementEnd
+
+DROP INDEX IF EXISTS idx_tx_merchant_trgm;
+DROP TABLE IF EXISTS transaction_search;
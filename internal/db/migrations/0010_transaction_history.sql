@@ -0,0 +1,45 @@
+-- +goose Up
+--- transaction_history ------------------------------------------------
+-- Append-only log of field changes. Actor columns carry no foreign keys on
+-- purpose: the entry has to survive the rule or user that made it.
+CREATE TABLE transaction_history (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  transaction_id BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  field          TEXT        NOT NULL,
+  old_value      TEXT,
+  new_value      TEXT,
+  actor_type     SMALLINT    NOT NULL CHECK (actor_type BETWEEN 1 AND 3), -- 1=user, 2=internal service, 3=rule
+  actor_user_id  UUID,
+  actor_rule_id  UUID,
+  changed_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_transaction_history_tx ON transaction_history(transaction_id, changed_at);
+
+-- Entries can't be changed, nor removed while their transaction exists.
+-- Trashing a transaction keeps its history; purging deletes the transaction
+-- for good and the cascade takes the history with it, since the parent row
+-- is already gone by then.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION reject_history_update()
+RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+  IF TG_OP = 'DELETE' AND NOT EXISTS (SELECT 1 FROM transactions WHERE id = OLD.transaction_id) THEN
+    RETURN OLD;
+  END IF;
+  RAISE EXCEPTION 'transaction_history is append-only';
+END$$;
+-- +goose StatementEnd
+
+CREATE TRIGGER trg_transaction_history_append_only
+  BEFORE UPDATE OR DELETE ON transaction_history
+  FOR EACH ROW EXECUTE FUNCTION reject_history_update();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS trg_transaction_history_append_only ON transaction_history;
+
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS reject_history_update();
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS transaction_history;
@@ -0,0 +1,25 @@
+-- +goose Up
+--- soft delete --------------------------------------------------------
+-- Deleted rows keep their data until purged, either by hand or once they
+-- are older than the configured retention window.
+ALTER TABLE transactions ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE accounts ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_transactions_deleted_at ON transactions(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_accounts_deleted_at ON accounts(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- a trashed account shouldn't block reusing its name
+ALTER TABLE accounts DROP CONSTRAINT accounts_owner_name_unique;
+CREATE UNIQUE INDEX ux_accounts_owner_name_live ON accounts(owner_id, name) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS ux_accounts_owner_name_live;
+DELETE FROM accounts WHERE deleted_at IS NOT NULL;
+ALTER TABLE accounts ADD CONSTRAINT accounts_owner_name_unique UNIQUE (owner_id, name);
+
+DROP INDEX IF EXISTS idx_accounts_deleted_at;
+DROP INDEX IF EXISTS idx_transactions_deleted_at;
+
+DELETE FROM transactions WHERE deleted_at IS NOT NULL;
+ALTER TABLE accounts DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS deleted_at;
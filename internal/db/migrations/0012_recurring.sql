@@ -0,0 +1,59 @@
+-- +goose Up
+--- recurring_series ---------------------------------------------------
+-- Subscriptions and bills found by the recurring detector. A series is
+-- identified by its normalized merchant key, currency and direction so that
+-- re-running detection updates it instead of creating a new one.
+CREATE TABLE recurring_series (
+  id                        BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id                   UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  match_key                 TEXT        NOT NULL,
+  name                      TEXT        NOT NULL,
+  account_id                BIGINT      REFERENCES accounts(id) ON DELETE SET NULL,
+  cadence                   SMALLINT    NOT NULL CHECK (cadence BETWEEN 1 AND 5), -- 1=weekly, 2=biweekly, 3=monthly, 4=quarterly, 5=annual
+  direction                 SMALLINT    NOT NULL,
+  currency                  CHAR(3)     NOT NULL,
+  expected_amount_cents     BIGINT      NOT NULL,
+  last_amount_cents         BIGINT      NOT NULL,
+  last_date                 TIMESTAMPTZ NOT NULL,
+  next_due_date             DATE        NOT NULL,
+  occurrences               INT         NOT NULL,
+  status                    SMALLINT    NOT NULL DEFAULT 1 CHECK (status BETWEEN 1 AND 3), -- 1=suggested, 2=confirmed, 3=dismissed
+  price_increase_from_cents BIGINT, -- expected amount before a confirmed series got more expensive
+  created_at                TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at                TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT recurring_series_key_unique UNIQUE (user_id, match_key, currency, direction)
+);
+
+CREATE INDEX idx_recurring_series_user ON recurring_series(user_id, status);
+
+CREATE TRIGGER trg_recurring_series_update
+  BEFORE UPDATE ON recurring_series
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+CREATE TABLE recurring_series_transactions (
+  series_id      BIGINT NOT NULL REFERENCES recurring_series(id) ON DELETE CASCADE,
+  transaction_id BIGINT NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  PRIMARY KEY (series_id, transaction_id)
+);
+
+CREATE INDEX idx_recurring_series_transactions_tx ON recurring_series_transactions(transaction_id);
+
+-- recurring_key strips what changes between charges of the same merchant:
+-- digits (dates, invoice and store numbers), punctuation and extra spaces.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION recurring_key(p TEXT)
+RETURNS TEXT LANGUAGE sql IMMUTABLE AS $$
+  SELECT btrim(regexp_replace(
+    regexp_replace(lower(coalesce(p, '')), '[^[:alpha:][:space:]]+', ' ', 'g'),
+    '\s+', ' ', 'g'
+  ))
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS recurring_key(TEXT);
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS recurring_series_transactions;
+DROP TABLE IF EXISTS recurring_series;
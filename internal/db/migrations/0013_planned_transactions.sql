@@ -0,0 +1,40 @@
+-- +goose Up
+--- planned_transactions ------------------------------------------------
+-- Expected future money movements such as rent or a paycheck. They live
+-- outside transactions so they never feed balances; projections expand them
+-- with their recurrence rule instead.
+CREATE TABLE planned_transactions (
+  id              BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id         UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  account_id      BIGINT      NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+  tx_amount_cents BIGINT      NOT NULL CHECK (tx_amount_cents > 0),
+  tx_currency     CHAR(3)     NOT NULL,
+  tx_direction    SMALLINT    NOT NULL,
+  tx_desc         TEXT,
+  merchant        TEXT,
+  category_id     BIGINT      REFERENCES categories(id) ON DELETE SET NULL,
+  start_date      DATE        NOT NULL,
+  rrule           TEXT, -- RFC 5545 rule such as FREQ=MONTHLY;BYMONTHDAY=1, null = once on start_date
+  created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_planned_transactions_account ON planned_transactions(account_id);
+
+CREATE TRIGGER trg_planned_transactions_update
+  BEFORE UPDATE ON planned_transactions
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+-- one row per occurrence that a real transaction has taken care of
+CREATE TABLE planned_transaction_fulfillments (
+  planned_id      BIGINT      NOT NULL REFERENCES planned_transactions(id) ON DELETE CASCADE,
+  occurrence_date DATE        NOT NULL,
+  transaction_id  BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  fulfilled_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (planned_id, occurrence_date),
+  CONSTRAINT planned_fulfillments_transaction_unique UNIQUE (transaction_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS planned_transaction_fulfillments;
+DROP TABLE IF EXISTS planned_transactions;
@@ -0,0 +1,19 @@
+-- +goose Up
+--- transaction status -------------------------------------------------
+-- 1=pending authorization, 2=posted, 3=voided. Pending transactions only
+-- count towards the balance of accounts that opt in, voided ones never do.
+ALTER TABLE transactions
+  ADD COLUMN status SMALLINT NOT NULL DEFAULT 2 CHECK (status BETWEEN 1 AND 3);
+
+CREATE INDEX idx_transactions_pending ON transactions(account_id, tx_date)
+  WHERE status = 1 AND deleted_at IS NULL;
+
+ALTER TABLE accounts
+  ADD COLUMN include_pending BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE accounts DROP COLUMN IF EXISTS include_pending;
+
+DROP INDEX IF EXISTS idx_transactions_pending;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS status;
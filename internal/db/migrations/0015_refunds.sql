@@ -0,0 +1,25 @@
+-- +goose Up
+--- refunds ------------------------------------------------------------
+-- Links a refund or reimbursement to the purchase it pays back so reports
+-- can net it against the purchase's category and period instead of counting
+-- it as income. A purchase can be refunded in several parts, each refund
+-- pays back one purchase. Unlinking keeps the row with dismissed_at set so
+-- detection doesn't suggest the same pair again.
+CREATE TABLE refunds (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id        UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  original_tx_id BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  refund_tx_id   BIGINT      NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+  auto_detected  BOOLEAN     NOT NULL DEFAULT false,
+  dismissed_at   TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT refunds_distinct_tx CHECK (original_tx_id <> refund_tx_id)
+);
+
+CREATE UNIQUE INDEX ux_refunds_refund_active
+  ON refunds(refund_tx_id) WHERE dismissed_at IS NULL;
+CREATE INDEX idx_refunds_original ON refunds(original_tx_id) WHERE dismissed_at IS NULL;
+CREATE INDEX idx_refunds_user_id ON refunds(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS refunds;
@@ -0,0 +1,66 @@
+-- +goose Up
+--- merchants ----------------------------------------------------------
+-- A user's canonical payees. Raw descriptions like "AMZN Mktp CA*2K4" and
+-- "Amazon.ca" resolve to one merchant through its aliases so reports can
+-- group by the entity instead of by spelling.
+CREATE TABLE merchants (
+  id                  BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  user_id             UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  name                TEXT        NOT NULL,
+  default_category_id BIGINT      REFERENCES categories(id) ON DELETE SET NULL,
+  website_url         TEXT,
+  logo_url            TEXT,
+  created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_merchants_user_id ON merchants(user_id);
+
+CREATE TRIGGER trg_merchants_update
+  BEFORE UPDATE ON merchants
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+-- An alias matches a description whose recurring_key equals its match_key
+-- or starts with it followed by a word boundary, so "amazon" also covers
+-- "AMAZON MKTP 1234". A key belongs to at most one of the user's merchants.
+CREATE TABLE merchant_aliases (
+  id          BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  merchant_id BIGINT      NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+  user_id     UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  alias       TEXT        NOT NULL,
+  match_key   TEXT        NOT NULL CHECK (match_key <> ''),
+  created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT merchant_aliases_user_key_unique UNIQUE (user_id, match_key)
+);
+
+CREATE INDEX idx_merchant_aliases_merchant_id ON merchant_aliases(merchant_id);
+
+ALTER TABLE transactions
+  ADD COLUMN merchant_id BIGINT REFERENCES merchants(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_transactions_merchant_id ON transactions(merchant_id) WHERE merchant_id IS NOT NULL;
+
+-- resolve_merchant returns the user's merchant whose longest alias matches
+-- p_raw, or NULL.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION resolve_merchant(p_user_id UUID, p_raw TEXT)
+RETURNS BIGINT LANGUAGE sql STABLE AS $$
+  SELECT ma.merchant_id
+  FROM merchant_aliases ma
+  WHERE ma.user_id = p_user_id
+    AND (recurring_key(p_raw) = ma.match_key
+      OR recurring_key(p_raw) LIKE ma.match_key || ' %')
+  ORDER BY length(ma.match_key) DESC, ma.id
+  LIMIT 1
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS resolve_merchant(UUID, TEXT);
+-- +goose StatementEnd
+
+DROP INDEX IF EXISTS idx_transactions_merchant_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS merchant_id;
+DROP TABLE IF EXISTS merchant_aliases;
+DROP TABLE IF EXISTS merchants;
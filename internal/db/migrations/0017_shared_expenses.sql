@@ -0,0 +1,54 @@
+-- +goose Up
+--- shared_expenses ----------------------------------------------------
+-- Who paid for a transaction on a shared account and how the cost is divided
+-- among the account's members. Each part is stored as an exact amount in the
+-- transaction's currency; weight keeps the percentage or share count it was
+-- computed from so clients can show the split as entered.
+CREATE TABLE shared_expenses (
+  transaction_id BIGINT      PRIMARY KEY REFERENCES transactions(id) ON DELETE CASCADE,
+  payer_id       UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  split_method   SMALLINT    NOT NULL CHECK (split_method BETWEEN 1 AND 3), -- 1=percentage, 2=shares, 3=exact
+  created_by     UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_shared_expenses_payer ON shared_expenses(payer_id);
+
+CREATE TRIGGER trg_shared_expenses_update
+  BEFORE UPDATE ON shared_expenses
+  FOR EACH ROW EXECUTE FUNCTION touch_updated_at();
+
+CREATE TABLE shared_expense_parts (
+  transaction_id BIGINT  NOT NULL REFERENCES shared_expenses(transaction_id) ON DELETE CASCADE,
+  user_id        UUID    NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  amount_cents   BIGINT  NOT NULL CHECK (amount_cents >= 0),
+  weight         DOUBLE PRECISION,
+  PRIMARY KEY (transaction_id, user_id)
+);
+
+CREATE INDEX idx_shared_expense_parts_user ON shared_expense_parts(user_id);
+
+--- settlements --------------------------------------------------------
+-- Money one user paid another to even out shared expenses, optionally
+-- linked to the transaction that moved it.
+CREATE TABLE settlements (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  from_user_id   UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  to_user_id     UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  amount_cents   BIGINT      NOT NULL CHECK (amount_cents > 0),
+  currency       CHAR(3)     NOT NULL,
+  transaction_id BIGINT      REFERENCES transactions(id) ON DELETE SET NULL,
+  note           TEXT,
+  created_by     UUID        NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  CONSTRAINT settlements_distinct_users CHECK (from_user_id <> to_user_id)
+);
+
+CREATE INDEX idx_settlements_from ON settlements(from_user_id, to_user_id);
+CREATE INDEX idx_settlements_to ON settlements(to_user_id, from_user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS settlements;
+DROP TABLE IF EXISTS shared_expense_parts;
+DROP TABLE IF EXISTS shared_expenses;
@@ -0,0 +1,233 @@
+-- +goose Up
+--- minor units --------------------------------------------------------
+-- *_cents columns used to hold hundredths for every currency. They now hold
+-- minor units of the row's currency per ISO 4217 (internal/money), so 1200
+-- JPY is stored as 1200 and 1.250 KWD as 1250. This rescales every amount
+-- whose currency doesn't have two decimals; rounding is half away from zero.
+-- Split and shared-expense parts put any rounding difference on their
+-- largest part so they still add up to the transaction. Amounts recorded in
+-- transaction_history are rescaled too.
+
+-- +goose StatementBegin
+CREATE FUNCTION minor_unit_shift(code TEXT) RETURNS INT LANGUAGE sql IMMUTABLE AS $$
+  SELECT CASE upper(trim(code))
+    WHEN 'BIF' THEN 0 WHEN 'CLP' THEN 0 WHEN 'DJF' THEN 0 WHEN 'GNF' THEN 0
+    WHEN 'ISK' THEN 0 WHEN 'JPY' THEN 0 WHEN 'KMF' THEN 0 WHEN 'KRW' THEN 0
+    WHEN 'PYG' THEN 0 WHEN 'RWF' THEN 0 WHEN 'UGX' THEN 0 WHEN 'UYI' THEN 0
+    WHEN 'VND' THEN 0 WHEN 'VUV' THEN 0 WHEN 'XAF' THEN 0 WHEN 'XOF' THEN 0
+    WHEN 'XPF' THEN 0
+    WHEN 'BHD' THEN 3 WHEN 'IQD' THEN 3 WHEN 'JOD' THEN 3 WHEN 'KWD' THEN 3
+    WHEN 'LYD' THEN 3 WHEN 'OMR' THEN 3 WHEN 'TND' THEN 3
+    WHEN 'CLF' THEN 4 WHEN 'UYW' THEN 4
+    WHEN 'BTC' THEN 8 WHEN 'ETH' THEN 9
+    ELSE 2
+  END - 2
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION rescale_minor(amount BIGINT, shift INT) RETURNS BIGINT LANGUAGE sql IMMUTABLE STRICT AS $$
+  SELECT round(amount::numeric * power(10::numeric, shift))::bigint
+$$;
+-- +goose StatementEnd
+
+UPDATE accounts
+SET anchor_balance_cents = rescale_minor(anchor_balance_cents, minor_unit_shift(anchor_currency))
+WHERE minor_unit_shift(anchor_currency) <> 0;
+
+UPDATE transactions
+SET
+  tx_amount_cents = rescale_minor(tx_amount_cents, minor_unit_shift(tx_currency)),
+  balance_after_cents = rescale_minor(balance_after_cents, minor_unit_shift(balance_currency)),
+  foreign_amount_cents = rescale_minor(foreign_amount_cents, minor_unit_shift(foreign_currency))
+WHERE minor_unit_shift(tx_currency) <> 0
+   OR minor_unit_shift(balance_currency) <> 0
+   OR minor_unit_shift(foreign_currency) <> 0;
+
+UPDATE transaction_splits s
+SET amount_cents = GREATEST(rescale_minor(s.amount_cents, minor_unit_shift(t.tx_currency)), 1)
+FROM transactions t
+WHERE t.id = s.transaction_id AND minor_unit_shift(t.tx_currency) <> 0;
+
+UPDATE transaction_splits s
+SET amount_cents = s.amount_cents + d.diff
+FROM (
+  SELECT DISTINCT ON (s.transaction_id)
+    s.id, t.tx_amount_cents - sum(s.amount_cents) OVER (PARTITION BY s.transaction_id) AS diff
+  FROM transaction_splits s
+  JOIN transactions t ON t.id = s.transaction_id
+  WHERE minor_unit_shift(t.tx_currency) < 0
+  ORDER BY s.transaction_id, s.amount_cents DESC, s.id
+) d
+WHERE s.id = d.id AND d.diff <> 0;
+
+UPDATE shared_expense_parts p
+SET amount_cents = rescale_minor(p.amount_cents, minor_unit_shift(t.tx_currency))
+FROM transactions t
+WHERE t.id = p.transaction_id AND minor_unit_shift(t.tx_currency) <> 0;
+
+UPDATE shared_expense_parts p
+SET amount_cents = p.amount_cents + d.diff
+FROM (
+  SELECT DISTINCT ON (p.transaction_id)
+    p.transaction_id, p.user_id,
+    t.tx_amount_cents - sum(p.amount_cents) OVER (PARTITION BY p.transaction_id) AS diff
+  FROM shared_expense_parts p
+  JOIN transactions t ON t.id = p.transaction_id
+  WHERE minor_unit_shift(t.tx_currency) < 0
+  ORDER BY p.transaction_id, p.amount_cents DESC, p.user_id
+) d
+WHERE p.transaction_id = d.transaction_id AND p.user_id = d.user_id AND d.diff <> 0;
+
+UPDATE settlements
+SET amount_cents = GREATEST(rescale_minor(amount_cents, minor_unit_shift(currency)), 1)
+WHERE minor_unit_shift(currency) <> 0;
+
+UPDATE planned_transactions
+SET tx_amount_cents = GREATEST(rescale_minor(tx_amount_cents, minor_unit_shift(tx_currency)), 1)
+WHERE minor_unit_shift(tx_currency) <> 0;
+
+UPDATE recurring_series
+SET
+  expected_amount_cents = rescale_minor(expected_amount_cents, minor_unit_shift(currency)),
+  last_amount_cents = rescale_minor(last_amount_cents, minor_unit_shift(currency))
+WHERE minor_unit_shift(currency) <> 0;
+
+-- History keeps amounts as text. Each one is rescaled by the currency the
+-- transaction had at the time: the currency changed in the same edit, else
+-- the one a later edit changed away from, else the current one.
+ALTER TABLE transaction_history DISABLE TRIGGER trg_transaction_history_append_only;
+
+UPDATE transaction_history h
+SET
+  old_value = CASE WHEN h.old_value ~ '^-?[0-9]+$'
+    THEN rescale_minor(h.old_value::bigint, minor_unit_shift(c.old_currency))::text ELSE h.old_value END,
+  new_value = CASE WHEN h.new_value ~ '^-?[0-9]+$'
+    THEN rescale_minor(h.new_value::bigint, minor_unit_shift(c.new_currency))::text ELSE h.new_value END
+FROM (
+  SELECT h.id, coalesce(same.old_value, cur.currency) AS old_currency, cur.currency AS new_currency
+  FROM transaction_history h
+  JOIN transactions t ON t.id = h.transaction_id
+  CROSS JOIN LATERAL (
+    SELECT CASE h.field WHEN 'tx_amount_cents' THEN 'tx_currency' ELSE 'foreign_currency' END AS field
+  ) f
+  LEFT JOIN LATERAL (
+    SELECT s.old_value, s.new_value FROM transaction_history s
+    WHERE s.transaction_id = h.transaction_id AND s.changed_at = h.changed_at AND s.field = f.field
+    ORDER BY s.id LIMIT 1
+  ) same ON true
+  LEFT JOIN LATERAL (
+    SELECT l.old_value FROM transaction_history l
+    WHERE l.transaction_id = h.transaction_id AND l.changed_at > h.changed_at AND l.field = f.field
+    ORDER BY l.changed_at, l.id LIMIT 1
+  ) later ON true
+  CROSS JOIN LATERAL (
+    SELECT coalesce(same.new_value, later.old_value,
+      CASE h.field WHEN 'tx_amount_cents' THEN t.tx_currency ELSE t.foreign_currency END) AS currency
+  ) cur
+  WHERE h.field IN ('tx_amount_cents', 'foreign_amount_cents')
+) c
+WHERE h.id = c.id
+  AND (minor_unit_shift(c.old_currency) <> 0 OR minor_unit_shift(c.new_currency) <> 0);
+
+ALTER TABLE transaction_history ENABLE TRIGGER trg_transaction_history_append_only;
+
+UPDATE receipt_items i
+SET
+  unit_price_cents = rescale_minor(i.unit_price_cents, minor_unit_shift(r.currency)),
+  total_cents = rescale_minor(i.total_cents, minor_unit_shift(r.currency))
+FROM receipts r
+WHERE r.id = i.receipt_id AND minor_unit_shift(r.currency) <> 0;
+
+UPDATE receipts
+SET total_cents = rescale_minor(total_cents, minor_unit_shift(currency))
+WHERE minor_unit_shift(currency) <> 0;
+
+-- +goose Down
+-- Scales back to hundredths. Amounts rounded on the way up stay rounded.
+UPDATE accounts
+SET anchor_balance_cents = rescale_minor(anchor_balance_cents, -minor_unit_shift(anchor_currency))
+WHERE minor_unit_shift(anchor_currency) <> 0;
+
+UPDATE transactions
+SET
+  tx_amount_cents = rescale_minor(tx_amount_cents, -minor_unit_shift(tx_currency)),
+  balance_after_cents = rescale_minor(balance_after_cents, -minor_unit_shift(balance_currency)),
+  foreign_amount_cents = rescale_minor(foreign_amount_cents, -minor_unit_shift(foreign_currency))
+WHERE minor_unit_shift(tx_currency) <> 0
+   OR minor_unit_shift(balance_currency) <> 0
+   OR minor_unit_shift(foreign_currency) <> 0;
+
+UPDATE transaction_splits s
+SET amount_cents = rescale_minor(s.amount_cents, -minor_unit_shift(t.tx_currency))
+FROM transactions t
+WHERE t.id = s.transaction_id AND minor_unit_shift(t.tx_currency) <> 0;
+
+UPDATE shared_expense_parts p
+SET amount_cents = rescale_minor(p.amount_cents, -minor_unit_shift(t.tx_currency))
+FROM transactions t
+WHERE t.id = p.transaction_id AND minor_unit_shift(t.tx_currency) <> 0;
+
+UPDATE settlements
+SET amount_cents = GREATEST(rescale_minor(amount_cents, -minor_unit_shift(currency)), 1)
+WHERE minor_unit_shift(currency) <> 0;
+
+UPDATE planned_transactions
+SET tx_amount_cents = GREATEST(rescale_minor(tx_amount_cents, -minor_unit_shift(tx_currency)), 1)
+WHERE minor_unit_shift(tx_currency) <> 0;
+
+UPDATE recurring_series
+SET
+  expected_amount_cents = rescale_minor(expected_amount_cents, -minor_unit_shift(currency)),
+  last_amount_cents = rescale_minor(last_amount_cents, -minor_unit_shift(currency))
+WHERE minor_unit_shift(currency) <> 0;
+
+ALTER TABLE transaction_history DISABLE TRIGGER trg_transaction_history_append_only;
+
+UPDATE transaction_history h
+SET
+  old_value = CASE WHEN h.old_value ~ '^-?[0-9]+$'
+    THEN rescale_minor(h.old_value::bigint, -minor_unit_shift(c.old_currency))::text ELSE h.old_value END,
+  new_value = CASE WHEN h.new_value ~ '^-?[0-9]+$'
+    THEN rescale_minor(h.new_value::bigint, -minor_unit_shift(c.new_currency))::text ELSE h.new_value END
+FROM (
+  SELECT h.id, coalesce(same.old_value, cur.currency) AS old_currency, cur.currency AS new_currency
+  FROM transaction_history h
+  JOIN transactions t ON t.id = h.transaction_id
+  CROSS JOIN LATERAL (
+    SELECT CASE h.field WHEN 'tx_amount_cents' THEN 'tx_currency' ELSE 'foreign_currency' END AS field
+  ) f
+  LEFT JOIN LATERAL (
+    SELECT s.old_value, s.new_value FROM transaction_history s
+    WHERE s.transaction_id = h.transaction_id AND s.changed_at = h.changed_at AND s.field = f.field
+    ORDER BY s.id LIMIT 1
+  ) same ON true
+  LEFT JOIN LATERAL (
+    SELECT l.old_value FROM transaction_history l
+    WHERE l.transaction_id = h.transaction_id AND l.changed_at > h.changed_at AND l.field = f.field
+    ORDER BY l.changed_at, l.id LIMIT 1
+  ) later ON true
+  CROSS JOIN LATERAL (
+    SELECT coalesce(same.new_value, later.old_value,
+      CASE h.field WHEN 'tx_amount_cents' THEN t.tx_currency ELSE t.foreign_currency END) AS currency
+  ) cur
+  WHERE h.field IN ('tx_amount_cents', 'foreign_amount_cents')
+) c
+WHERE h.id = c.id
+  AND (minor_unit_shift(c.old_currency) <> 0 OR minor_unit_shift(c.new_currency) <> 0);
+
+ALTER TABLE transaction_history ENABLE TRIGGER trg_transaction_history_append_only;
+
+UPDATE receipt_items i
+SET
+  unit_price_cents = rescale_minor(i.unit_price_cents, -minor_unit_shift(r.currency)),
+  total_cents = rescale_minor(i.total_cents, -minor_unit_shift(r.currency))
+FROM receipts r
+WHERE r.id = i.receipt_id AND minor_unit_shift(r.currency) <> 0;
+
+UPDATE receipts
+SET total_cents = rescale_minor(total_cents, -minor_unit_shift(currency))
+WHERE minor_unit_shift(currency) <> 0;
+
+DROP FUNCTION IF EXISTS rescale_minor(BIGINT, INT);
+DROP FUNCTION IF EXISTS minor_unit_shift(TEXT);
@@ -0,0 +1,211 @@
+-- +goose Up
+--- transaction filter -------------------------------------------------
+-- The transactions a user sees that match the list filters, so every query
+-- filtering transactions shares one predicate. A NULL argument leaves its
+-- condition out. The function is a single STABLE select, so the planner
+-- inlines it into the calling query. Change it with CREATE OR REPLACE in a
+-- new migration.
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION filter_transactions(
+  p_user_id                   UUID,
+  p_start                     TIMESTAMPTZ,
+  p_end                       TIMESTAMPTZ,
+  p_amount_min_cents          BIGINT,
+  p_amount_max_cents          BIGINT,
+  p_direction                 SMALLINT,
+  p_account_ids               BIGINT[],
+  p_categories                TEXT[],
+  p_category_patterns         TEXT[],
+  p_exclude_category_patterns TEXT[],
+  p_account_names             TEXT[],
+  p_exclude_account_ids       BIGINT[],
+  p_exclude_account_names     TEXT[],
+  p_tags                      TEXT[],
+  p_exclude_tags              TEXT[],
+  p_merchant_q                TEXT,
+  p_exclude_merchant_q        TEXT,
+  p_desc_q                    TEXT,
+  p_currency                  CHAR(3),
+  p_tod_start                 TIME,
+  p_tod_end                   TIME,
+  p_uncategorized             BOOLEAN,
+  p_statuses                  SMALLINT[]
+)
+RETURNS SETOF transactions LANGUAGE sql STABLE AS $$
+  select
+    t.*
+  from
+    transactions t
+    join accounts a on t.account_id = a.id
+    left join account_users au on a.id = au.account_id
+    and au.user_id = p_user_id
+    left join categories c on t.category_id = c.id
+  where
+    (
+      a.owner_id = p_user_id
+      or au.user_id is not null
+    )
+    and t.deleted_at is null
+    and a.deleted_at is null
+    and (
+      p_start is null
+      or t.tx_date >= p_start
+    )
+    and (
+      p_end is null
+      or t.tx_date <= p_end
+    )
+    and (
+      p_amount_min_cents is null
+      or t.tx_amount_cents >= p_amount_min_cents
+    )
+    and (
+      p_amount_max_cents is null
+      or t.tx_amount_cents <= p_amount_max_cents
+    )
+    and (
+      p_direction is null
+      or t.tx_direction = p_direction
+    )
+    and (
+      p_account_ids is null
+      or t.account_id = ANY(p_account_ids)
+    )
+    and (
+      p_categories is null
+      or c.slug = ANY(p_categories)
+      or exists (
+        select
+          1
+        from
+          transaction_splits s
+          join categories sc on s.category_id = sc.id
+        where
+          s.transaction_id = t.id
+          and sc.slug = ANY(p_categories)
+      )
+    )
+    and (
+      p_category_patterns is null
+      or c.slug like any(p_category_patterns)
+      or exists (
+        select
+          1
+        from
+          transaction_splits s
+          join categories sc on s.category_id = sc.id
+        where
+          s.transaction_id = t.id
+          and sc.slug like any(p_category_patterns)
+      )
+    )
+    and (
+      p_exclude_category_patterns is null
+      or (
+        (
+          c.slug is null
+          or not c.slug like any(p_exclude_category_patterns)
+        )
+        and not exists (
+          select
+            1
+          from
+            transaction_splits s
+            join categories sc on s.category_id = sc.id
+          where
+            s.transaction_id = t.id
+            and sc.slug like any(p_exclude_category_patterns)
+        )
+      )
+    )
+    and (
+      p_account_names is null
+      or lower(a.name) = any(p_account_names)
+      or lower(a.alias) = any(p_account_names)
+    )
+    and (
+      p_exclude_account_ids is null
+      or not t.account_id = any(p_exclude_account_ids)
+    )
+    and (
+      p_exclude_account_names is null
+      or not (
+        lower(a.name) = any(p_exclude_account_names)
+        or coalesce(lower(a.alias), '') = any(p_exclude_account_names)
+      )
+    )
+    and (
+      p_tags is null
+      or exists (
+        select
+          1
+        from
+          transaction_tags tt
+          join tags g on tt.tag_id = g.id
+        where
+          tt.transaction_id = t.id
+          and g.user_id = p_user_id
+          and g.slug = ANY(p_tags)
+      )
+    )
+    and (
+      p_exclude_tags is null
+      or not exists (
+        select
+          1
+        from
+          transaction_tags tt
+          join tags g on tt.tag_id = g.id
+        where
+          tt.transaction_id = t.id
+          and g.user_id = p_user_id
+          and g.slug = ANY(p_exclude_tags)
+      )
+    )
+    and (
+      p_merchant_q is null
+      or t.merchant ILIKE ('%' || p_merchant_q || '%')
+    )
+    and (
+      p_exclude_merchant_q is null
+      or t.merchant is null
+      or t.merchant NOT ILIKE ('%' || p_exclude_merchant_q || '%')
+    )
+    and (
+      p_desc_q is null
+      or t.tx_desc ILIKE ('%' || p_desc_q || '%')
+    )
+    and (
+      p_currency is null
+      or t.tx_currency = p_currency
+    )
+    and (
+      p_tod_start is null
+      or t.tx_date::time >= p_tod_start
+    )
+    and (
+      p_tod_end is null
+      or t.tx_date::time <= p_tod_end
+    )
+    and (
+      p_uncategorized is null
+      or (
+        p_uncategorized = true
+        and t.category_id is null
+      )
+    )
+    and (
+      (
+        p_statuses is null
+        and t.status <> 3
+      )
+      or t.status = ANY(p_statuses)
+    )
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS filter_transactions(UUID, TIMESTAMPTZ, TIMESTAMPTZ, BIGINT, BIGINT, SMALLINT, BIGINT[], TEXT[], TEXT[], TEXT[], TEXT[], BIGINT[], TEXT[], TEXT[], TEXT[], TEXT, TEXT, TEXT, CHAR(3), TIME, TIME, BOOLEAN, SMALLINT[]);
+-- +goose StatementEnd
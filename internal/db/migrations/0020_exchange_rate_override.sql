@@ -0,0 +1,10 @@
+-- +goose Up
+--- exchange rate override ---------------------------------------------
+-- Set when the user gave the exchange rate of a foreign transaction, so
+-- later edits of its amount, date or account convert at that rate instead
+-- of the historical one. Cleared when the foreign currency changes.
+ALTER TABLE transactions
+  ADD COLUMN exchange_rate_manually_set BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate_manually_set;
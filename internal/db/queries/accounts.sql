@@ -5,6 +5,8 @@ select
     (select t.balance_after_cents
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_balance_cents
@@ -13,6 +15,8 @@ select
     (select t.balance_currency
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_currency
@@ -22,8 +26,11 @@ from
   left join account_users au on au.account_id = a.id
   and au.user_id = @user_id::uuid
 where
-  a.owner_id = @user_id::uuid
-  or au.user_id is not null
+  (
+    a.owner_id = @user_id::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null
 order by
   (a.owner_id = @user_id::uuid) desc,
   a.created_at;
@@ -35,6 +42,8 @@ select
     (select t.balance_after_cents
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_balance_cents
@@ -43,6 +52,8 @@ select
     (select t.balance_currency
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_currency
@@ -56,7 +67,8 @@ where
   and (
     a.owner_id = @user_id::uuid
     or au.user_id is not null
-  );
+  )
+  and a.deleted_at is null;
 
 -- name: CreateAccount :one
 insert into
@@ -69,7 +81,8 @@ insert into
     anchor_balance_cents,
     anchor_currency,
     main_currency,
-    colors
+    colors,
+    include_pending
   )
 values
   (
@@ -81,7 +94,8 @@ values
     @anchor_balance_cents::bigint,
     @anchor_currency::char(3),
     @main_currency::char(3),
-    @colors::text []
+    @colors::text [],
+    @include_pending::boolean
   )
 returning
   *;
@@ -98,17 +112,79 @@ set
   anchor_balance_cents = coalesce(sqlc.narg('anchor_balance_cents')::bigint, anchor_balance_cents),
   anchor_currency = coalesce(sqlc.narg('anchor_currency')::char(3), anchor_currency),
   main_currency = coalesce(sqlc.narg('main_currency')::char(3), main_currency),
-  colors = coalesce(sqlc.narg('colors')::text [], colors)
+  colors = coalesce(sqlc.narg('colors')::text [], colors),
+  include_pending = coalesce(sqlc.narg('include_pending')::boolean, include_pending)
 where
   id = @id::bigint
-  and owner_id = @user_id::uuid;
+  and owner_id = @user_id::uuid
+  and deleted_at is null;
 
 -- name: DeleteAccount :execrows
+-- Moves the account to the trash; its transactions stay but are hidden with it.
+update
+  accounts
+set
+  deleted_at = now()
+where
+  id = @id::bigint
+  and owner_id = @user_id::uuid
+  and deleted_at is null;
+
+-- name: ListDeletedAccounts :many
+select
+  sqlc.embed(a),
+  COALESCE(
+    (select t.balance_after_cents
+     from transactions t
+     where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
+     order by t.tx_date desc, t.id desc
+     limit 1),
+    a.anchor_balance_cents
+  ) as balance_cents,
+  COALESCE(
+    (select t.balance_currency
+     from transactions t
+     where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
+     order by t.tx_date desc, t.id desc
+     limit 1),
+    a.anchor_currency
+  ) as balance_currency
+from
+  accounts a
+where
+  a.owner_id = @user_id::uuid
+  and a.deleted_at is not null
+order by
+  a.deleted_at desc;
+
+-- name: RestoreAccount :execrows
+update
+  accounts
+set
+  deleted_at = null
+where
+  id = @id::bigint
+  and owner_id = @user_id::uuid
+  and deleted_at is not null;
+
+-- name: PurgeAccount :execrows
+-- Hard delete, only for accounts already in the trash. Cascades to transactions.
 delete from
   accounts
 where
   id = @id::bigint
-  and owner_id = @user_id::uuid;
+  and owner_id = @user_id::uuid
+  and deleted_at is not null;
+
+-- name: PurgeExpiredAccounts :execrows
+delete from
+  accounts
+where
+  deleted_at < @cutoff::timestamptz;
 
 -- name: SetAccountAnchor :execrows
 update
@@ -119,7 +195,8 @@ set
   anchor_currency = @anchor_currency::char(3)
 where
   id = @id::bigint
-  and owner_id = @user_id::uuid;
+  and owner_id = @user_id::uuid
+  and deleted_at is null;
 
 -- name: GetAccountAnchorBalance :one
 select
@@ -138,6 +215,8 @@ from
   transactions
 where
   account_id = @account_id::bigint
+  and deleted_at is null
+  and balance_after_cents is not null
 order by
   tx_date desc,
   id desc
@@ -152,10 +231,15 @@ from
   left join account_users au on a.id = au.account_id
   and au.user_id = @user_id::uuid
 where
-  a.owner_id = @user_id::uuid
-  or au.user_id is not null;
+  (
+    a.owner_id = @user_id::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null;
 
 -- name: SyncAccountBalances :exec
+-- Only posted transactions, plus pending ones on accounts that include them,
+-- get a running balance; the others have theirs cleared.
 with anchor_transactions as (
   select
     t.id,
@@ -172,6 +256,11 @@ with anchor_transactions as (
     join accounts a on t.account_id = a.id
   where
     t.account_id = @account_id::bigint
+    and t.deleted_at is null
+    and (
+      t.status = 2
+      or (t.status = 1 and a.include_pending)
+    )
 ),
 before_anchor as (
   select
@@ -209,14 +298,25 @@ after_anchor as (
     anchor_transactions
   where
     is_after_anchor = 1
+),
+balances as (
+  select
+    coalesce(ba.id, aa.id) as id,
+    coalesce(ba.balance_after_cents, aa.balance_after_cents) as balance_after_cents,
+    coalesce(ba.main_currency, aa.main_currency) as main_currency
+  from
+    before_anchor ba
+    full outer join after_anchor aa on ba.id = aa.id
 )
 update
   transactions
 set
-  balance_after_cents = coalesce(ba.balance_after_cents, aa.balance_after_cents),
-  balance_currency = coalesce(ba.main_currency, aa.main_currency)
+  balance_after_cents = b.balance_after_cents,
+  balance_currency = coalesce(b.main_currency, transactions.balance_currency)
 from
-  before_anchor ba
-  full outer join after_anchor aa on ba.id = aa.id
+  transactions x
+  left join balances b on b.id = x.id
 where
-  transactions.id = coalesce(ba.id, aa.id);
+  transactions.id = x.id
+  and x.account_id = @account_id::bigint
+  and x.deleted_at is null;
@@ -1,80 +1,234 @@
 -- name: GetDashboardTrends :many
+-- A linked refund counts as less spending on the day of the purchase, not as
+-- income on the day it arrives.
+with flows as (
+  select t.tx_date, t.tx_direction, t.tx_amount_cents
+  from transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+  where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and t.deleted_at is null
+    and t.status <> 3
+    and a.deleted_at is null
+    and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+    and not exists (
+      select 1 from refunds x
+      join transactions o on o.id = x.original_tx_id
+      where x.refund_tx_id = t.id and x.dismissed_at is null and o.deleted_at is null and o.status <> 3
+    )
+  union all
+  select o.tx_date, o.tx_direction, -r.tx_amount_cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join transactions o on o.id = x.original_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+  where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and o.deleted_at is null
+    and o.status <> 3
+    and a.deleted_at is null
+)
 select
-  to_char(t.tx_date::date, 'YYYY-MM-DD') as date,
-  SUM(case when t.tx_direction = 1 then t.tx_amount_cents else 0 end)::bigint as income_cents,
-  SUM(case when t.tx_direction = 2 then t.tx_amount_cents else 0 end)::bigint as expense_cents
-from transactions t
-join accounts a on t.account_id = a.id
-left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
-where (a.owner_id = @user_id::uuid or au.user_id is not null)
-  and (sqlc.narg('start')::timestamptz is null or t.tx_date >= sqlc.narg('start')::timestamptz)
-  and (sqlc.narg('end')::timestamptz is null or t.tx_date <= sqlc.narg('end')::timestamptz)
+  to_char(f.tx_date::date, 'YYYY-MM-DD') as date,
+  SUM(case when f.tx_direction = 1 then f.tx_amount_cents else 0 end)::bigint as income_cents,
+  SUM(case when f.tx_direction = 2 then f.tx_amount_cents else 0 end)::bigint as expense_cents
+from flows f
+where (sqlc.narg('start')::timestamptz is null or f.tx_date >= sqlc.narg('start')::timestamptz)
+  and (sqlc.narg('end')::timestamptz is null or f.tx_date <= sqlc.narg('end')::timestamptz)
 group by date
 order by date;
 
 -- name: GetDashboardSummary :one
+-- Linked refunds are taken off the purchase's spending in the purchase's
+-- period instead of counting as income, like in trends. Linked transfers
+-- count as transactions but not as income or expense.
+with refunded as (
+  select x.original_tx_id, SUM(r.tx_amount_cents)::bigint as cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+  where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and a.deleted_at is null
+  group by x.original_tx_id
+)
 select
   COUNT(distinct a.id)::bigint as total_accounts,
   COUNT(t.id)::bigint as total_transactions,
-  COALESCE(SUM(case when t.tx_direction = 1 then t.tx_amount_cents else 0 end), 0)::bigint as total_income_cents,
-  COALESCE(SUM(case when t.tx_direction = 2 then t.tx_amount_cents else 0 end), 0)::bigint as total_expense_cents,
+  COALESCE(SUM(case
+    when t.tx_direction = 1 and not exists (
+      select 1 from refunds x
+      join transactions o on o.id = x.original_tx_id
+      where x.refund_tx_id = t.id and x.dismissed_at is null and o.deleted_at is null and o.status <> 3
+    ) and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id)) then t.tx_amount_cents
+    else 0
+  end), 0)::bigint as total_income_cents,
+  COALESCE(SUM(case
+    when t.tx_direction = 2 and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+      then t.tx_amount_cents - coalesce(f.cents, 0)
+    else 0
+  end), 0)::bigint as total_expense_cents,
   COUNT(distinct case when t.tx_date >= CURRENT_DATE - interval '30 days' then t.id end)::bigint as transactions_last_30_days,
-  COUNT(distinct case when t.category_id is null then t.id end)::bigint as uncategorized_transactions
+  -- a split transaction is uncategorized while any of its parts is
+  COUNT(distinct case when (
+    case
+      when exists (select 1 from transaction_splits s where s.transaction_id = t.id)
+        then exists (select 1 from transaction_splits s where s.transaction_id = t.id and s.category_id is null)
+      else t.category_id is null
+    end
+  ) then t.id end)::bigint as uncategorized_transactions
 from accounts a
 left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
-left join transactions t on a.id = t.account_id
+left join transactions t on a.id = t.account_id and t.deleted_at is null and t.status <> 3
+left join refunded f on f.original_tx_id = t.id
 where (a.owner_id = @user_id::uuid or au.user_id is not null)
+  and a.deleted_at is null
   and (sqlc.narg('start')::timestamptz is null or t.tx_date >= sqlc.narg('start')::timestamptz)
   and (sqlc.narg('end')::timestamptz is null or t.tx_date <= sqlc.narg('end')::timestamptz);
 
 -- name: GetTopCategories :many
+-- Linked refunds are taken off the purchase's categories in the purchase's
+-- period; a split purchase gives back to each part pro rata.
+with refunded as (
+  select x.original_tx_id, SUM(r.tx_amount_cents)::bigint as cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+  where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and a.deleted_at is null
+  group by x.original_tx_id
+)
 select
   c.slug,
   c.color,
+  COUNT(distinct t.id)::bigint as transaction_count,
+  SUM(p.amount_cents - coalesce(f.cents * p.amount_cents / t.tx_amount_cents, 0))::bigint as total_amount_cents
+from transactions t
+-- split transactions contribute each part to its own category
+cross join lateral (
+  select s.category_id, s.amount_cents
+  from transaction_splits s
+  where s.transaction_id = t.id
+  union all
+  select t.category_id, t.tx_amount_cents
+  where not exists (select 1 from transaction_splits s where s.transaction_id = t.id)
+) p
+join categories c on p.category_id = c.id
+join accounts a on t.account_id = a.id
+left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+left join refunded f on f.original_tx_id = t.id
+where (a.owner_id = @user_id::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
+  and t.tx_direction = 2
+  and (sqlc.narg('start')::timestamptz is null or t.tx_date >= sqlc.narg('start')::timestamptz)
+  and (sqlc.narg('end')::timestamptz is null or t.tx_date <= sqlc.narg('end')::timestamptz)
+  and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+group by c.id, c.slug, c.color
+order by total_amount_cents desc
+limit COALESCE(sqlc.narg('limit')::int, 10);
+
+-- name: GetTopTags :many
+-- A transaction with several tags counts in full towards each of them.
+select
+  g.slug,
+  g.color,
   COUNT(t.id)::bigint as transaction_count,
   SUM(t.tx_amount_cents)::bigint as total_amount_cents
 from transactions t
-join categories c on t.category_id = c.id
+join transaction_tags tt on tt.transaction_id = t.id
+join tags g on tt.tag_id = g.id and g.user_id = @user_id::uuid
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
 where (a.owner_id = @user_id::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
   and t.tx_direction = 2
   and (sqlc.narg('start')::timestamptz is null or t.tx_date >= sqlc.narg('start')::timestamptz)
   and (sqlc.narg('end')::timestamptz is null or t.tx_date <= sqlc.narg('end')::timestamptz)
-group by c.id, c.slug, c.color
+  and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+group by g.id, g.slug, g.color
 order by total_amount_cents desc
 limit COALESCE(sqlc.narg('limit')::int, 10);
 
 -- name: GetTopMerchants :many
+-- Transactions resolved to a merchant are grouped under it whatever their
+-- spelling, the rest by their merchant text. Linked transfers are left out.
 select
-  t.merchant,
+  m.id as merchant_id,
+  coalesce(m.name, t.merchant)::text as merchant,
   COUNT(t.id)::bigint as transaction_count,
   SUM(t.tx_amount_cents)::bigint as total_amount_cents,
   AVG(t.tx_amount_cents)::bigint as avg_amount_cents
 from transactions t
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+left join merchants m on m.id = t.merchant_id
 where (a.owner_id = @user_id::uuid or au.user_id is not null)
-  and t.merchant is not null
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
+  and (t.merchant is not null or m.id is not null)
   and t.tx_direction = 2
   and (sqlc.narg('start')::timestamptz is null or t.tx_date >= sqlc.narg('start')::timestamptz)
   and (sqlc.narg('end')::timestamptz is null or t.tx_date <= sqlc.narg('end')::timestamptz)
-group by t.merchant
+  and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+group by m.id, coalesce(m.name, t.merchant)
 order by total_amount_cents desc
 limit COALESCE(sqlc.narg('limit')::int, 10);
 
 -- name: GetMonthlyComparison :many
+-- Linked refunds reduce spending in the month of the purchase, like in trends.
+with flows as (
+  select t.tx_date, t.tx_direction, t.tx_amount_cents
+  from transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+  where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and t.deleted_at is null
+    and t.status <> 3
+    and a.deleted_at is null
+    and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+    and not exists (
+      select 1 from refunds x
+      join transactions o on o.id = x.original_tx_id
+      where x.refund_tx_id = t.id and x.dismissed_at is null and o.deleted_at is null and o.status <> 3
+    )
+  union all
+  select o.tx_date, o.tx_direction, -r.tx_amount_cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join transactions o on o.id = x.original_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
+  where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and o.deleted_at is null
+    and o.status <> 3
+    and a.deleted_at is null
+)
 select
-  to_char(t.tx_date, 'YYYY-MM') as month,
-  SUM(case when t.tx_direction = 1 then t.tx_amount_cents else 0 end)::bigint as income_cents,
-  SUM(case when t.tx_direction = 2 then t.tx_amount_cents else 0 end)::bigint as expense_cents,
-  SUM(case when t.tx_direction = 1 then t.tx_amount_cents else -t.tx_amount_cents end)::bigint as net_cents
-from transactions t
-join accounts a on t.account_id = a.id
-left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
-where (a.owner_id = @user_id::uuid or au.user_id is not null)
-  and t.tx_date >= COALESCE(sqlc.narg('start')::timestamptz, CURRENT_DATE - interval '12 months')
-  and t.tx_date <= COALESCE(sqlc.narg('end')::timestamptz, CURRENT_DATE)
+  to_char(f.tx_date, 'YYYY-MM') as month,
+  SUM(case when f.tx_direction = 1 then f.tx_amount_cents else 0 end)::bigint as income_cents,
+  SUM(case when f.tx_direction = 2 then f.tx_amount_cents else 0 end)::bigint as expense_cents,
+  SUM(case when f.tx_direction = 1 then f.tx_amount_cents else -f.tx_amount_cents end)::bigint as net_cents
+from flows f
+where f.tx_date >= COALESCE(sqlc.narg('start')::timestamptz, CURRENT_DATE - interval '12 months')
+  and f.tx_date <= COALESCE(sqlc.narg('end')::timestamptz, CURRENT_DATE)
 group by month
 order by month;
 
@@ -87,7 +241,7 @@ select
   COALESCE(
     (select t.balance_after_cents
      from transactions t
-     where t.account_id = a.id
+     where t.account_id = a.id and t.deleted_at is null and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_balance_cents
@@ -95,6 +249,7 @@ select
 from accounts a
 left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
 where (a.owner_id = @user_id::uuid or au.user_id is not null)
+  and a.deleted_at is null
 order by
   case a.account_type
     when 1 then 1
@@ -129,11 +284,11 @@ account_balances_at_date as (
       -- If there's a transaction on or before the period date, use its balance
       WHEN EXISTS (
         select 1 from transactions t
-        where t.account_id = a.id and t.tx_date <= ds.period_date
+        where t.account_id = a.id and t.deleted_at is null and t.balance_after_cents is not null and t.tx_date <= ds.period_date
       ) THEN (
         select t.balance_after_cents
         from transactions t
-        where t.account_id = a.id and t.tx_date <= ds.period_date
+        where t.account_id = a.id and t.deleted_at is null and t.balance_after_cents is not null and t.tx_date <= ds.period_date
         order by t.tx_date desc, t.id desc
         limit 1
       )
@@ -146,6 +301,7 @@ account_balances_at_date as (
   cross join accounts a
   left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
   where (a.owner_id = @user_id::uuid or au.user_id is not null)
+    and a.deleted_at is null
 )
 select
   to_char(ab.period_date, 'YYYY-MM-DD') as date,
@@ -159,4 +315,7 @@ select MIN(t.tx_date)::date as earliest_date
 from transactions t
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
-where (a.owner_id = @user_id::uuid or au.user_id is not null);
+where (a.owner_id = @user_id::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null;
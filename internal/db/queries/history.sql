@@ -0,0 +1,48 @@
+-- name: InsertTransactionHistory :exec
+-- Empty strings in old_values/new_values are stored as NULL.
+insert into
+  transaction_history (
+    transaction_id,
+    field,
+    old_value,
+    new_value,
+    actor_type,
+    actor_user_id,
+    actor_rule_id
+  )
+select
+  c.transaction_id,
+  c.field,
+  nullif(c.old_value, ''),
+  nullif(c.new_value, ''),
+  sqlc.arg(actor_type)::smallint,
+  sqlc.narg('actor_user_id')::uuid,
+  sqlc.narg('actor_rule_id')::uuid
+from
+  unnest(
+    sqlc.arg(transaction_ids)::bigint [],
+    sqlc.arg(fields)::text [],
+    sqlc.arg(old_values)::text [],
+    sqlc.arg(new_values)::text []
+  ) as c(transaction_id, field, old_value, new_value);
+
+-- name: ListTransactionHistory :many
+select
+  h.*
+from
+  transaction_history h
+  join transactions t on h.transaction_id = t.id
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  h.transaction_id = sqlc.arg(transaction_id)::bigint
+  and (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null
+order by
+  h.changed_at,
+  h.id;
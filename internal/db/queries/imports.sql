@@ -0,0 +1,61 @@
+-- name: ListImportProfiles :many
+select
+  *
+from
+  import_profiles
+where
+  user_id = @user_id::uuid
+order by
+  name;
+
+-- name: GetImportProfileByName :one
+select
+  *
+from
+  import_profiles
+where
+  user_id = @user_id::uuid
+  and name = @name::text;
+
+-- name: UpsertImportProfile :one
+insert into
+  import_profiles (user_id, name, bank, mapping)
+values
+  (@user_id::uuid, @name::text, sqlc.narg('bank')::text, @mapping::jsonb) on CONFLICT (user_id, name) do
+update
+set
+  bank = excluded.bank,
+  mapping = excluded.mapping
+returning
+  *;
+
+-- name: DeleteImportProfile :execrows
+delete from
+  import_profiles
+where
+  user_id = @user_id::uuid
+  and name = @name::text;
+
+-- name: ListTransactionFingerprints :many
+select
+  t.tx_date,
+  t.tx_amount_cents,
+  t.tx_currency,
+  t.tx_direction,
+  t.foreign_amount_cents,
+  t.foreign_currency
+from
+  transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  t.account_id = sqlc.arg(account_id)::bigint
+  and t.tx_date >= sqlc.arg(start)::timestamptz
+  and t.tx_date < sqlc.arg('end')::timestamptz
+  and (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null;
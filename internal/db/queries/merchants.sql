@@ -0,0 +1,236 @@
+-- name: ListMerchants :many
+select
+  sqlc.embed(m),
+  (
+    select
+      COUNT(*)
+    from
+      transactions t
+    where
+      t.merchant_id = m.id
+      and t.deleted_at is null
+  )::bigint as transaction_count
+from
+  merchants m
+where
+  m.user_id = @user_id::uuid
+order by
+  m.name,
+  m.id;
+
+-- name: GetMerchant :one
+select
+  sqlc.embed(m),
+  (
+    select
+      COUNT(*)
+    from
+      transactions t
+    where
+      t.merchant_id = m.id
+      and t.deleted_at is null
+  )::bigint as transaction_count
+from
+  merchants m
+where
+  m.id = @id::bigint
+  and m.user_id = @user_id::uuid;
+
+-- name: CountUserMerchants :one
+select
+  COUNT(*)::bigint
+from
+  merchants
+where
+  user_id = @user_id::uuid
+  and id = ANY(@merchant_ids::bigint []);
+
+-- name: CreateMerchant :one
+insert into
+  merchants (
+    user_id,
+    name,
+    default_category_id,
+    website_url,
+    logo_url
+  )
+values
+  (
+    @user_id::uuid,
+    @name::text,
+    sqlc.narg('default_category_id')::bigint,
+    sqlc.narg('website_url')::text,
+    sqlc.narg('logo_url')::text
+  )
+returning
+  *;
+
+-- name: UpdateMerchant :one
+-- A default_category_id of 0 and empty urls clear the field.
+update
+  merchants
+set
+  name = coalesce(sqlc.narg('name')::text, name),
+  default_category_id = case
+    when sqlc.narg('default_category_id')::bigint is null then default_category_id
+    else nullif(sqlc.narg('default_category_id')::bigint, 0)
+  end,
+  website_url = case
+    when sqlc.narg('website_url')::text is null then website_url
+    else nullif(sqlc.narg('website_url')::text, '')
+  end,
+  logo_url = case
+    when sqlc.narg('logo_url')::text is null then logo_url
+    else nullif(sqlc.narg('logo_url')::text, '')
+  end
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid
+returning
+  *;
+
+-- name: DeleteMerchants :execrows
+delete from
+  merchants
+where
+  user_id = @user_id::uuid
+  and id = ANY(@merchant_ids::bigint []);
+
+-- name: ListMerchantAliases :many
+select
+  merchant_id,
+  alias
+from
+  merchant_aliases
+where
+  merchant_id = ANY(@merchant_ids::bigint [])
+order by
+  merchant_id,
+  alias;
+
+-- name: ListMerchantAliasConflicts :many
+-- Aliases of other merchants that normalize to the same key as one of aliases.
+select
+  ma.alias,
+  m.name
+from
+  merchant_aliases ma
+  join merchants m on m.id = ma.merchant_id
+where
+  ma.user_id = @user_id::uuid
+  and ma.merchant_id <> @merchant_id::bigint
+  and ma.match_key in (
+    select
+      recurring_key(a)
+    from
+      unnest(@aliases::text []) a
+  );
+
+-- name: AddMerchantAliases :exec
+-- Keys the merchant already has are skipped.
+insert into
+  merchant_aliases (merchant_id, user_id, alias, match_key)
+select
+  @merchant_id::bigint,
+  @user_id::uuid,
+  btrim(a),
+  recurring_key(a)
+from
+  unnest(@aliases::text []) a
+where
+  recurring_key(a) <> '' on CONFLICT do NOTHING;
+
+-- name: DeleteMerchantAliases :execrows
+delete from
+  merchant_aliases
+where
+  merchant_id = @merchant_id::bigint
+  and user_id = @user_id::uuid
+  and match_key in (
+    select
+      recurring_key(a)
+    from
+      unnest(@aliases::text []) a
+  );
+
+-- name: MoveMerchantAliases :execrows
+-- Moves every alias of the source merchants, or only those matching aliases
+-- when given, to the target.
+update
+  merchant_aliases
+set
+  merchant_id = @target_id::bigint
+where
+  user_id = @user_id::uuid
+  and merchant_id = ANY(@source_ids::bigint [])
+  and (
+    sqlc.narg('aliases')::text [] is null
+    or match_key in (
+      select
+        recurring_key(a)
+      from
+        unnest(sqlc.narg('aliases')::text []) a
+    )
+  );
+
+-- name: MoveMerchantTransactions :execrows
+update
+  transactions t
+set
+  merchant_id = @target_id::bigint
+from
+  merchants m
+where
+  m.id = t.merchant_id
+  and m.user_id = @user_id::uuid
+  and t.merchant_id = ANY(@source_ids::bigint []);
+
+-- name: ResolveTransactionMerchants :many
+-- Points transactions at the merchant their merchant text, or failing that
+-- their description, resolves to among the account owner's merchants. A
+-- newly resolved merchant's default category fills in a missing category
+-- unless the category was set by hand. Only changed rows are returned.
+with resolved as (
+  select
+    t.id,
+    t.merchant_id as old_merchant_id,
+    t.category_id as old_category_id,
+    coalesce(
+      resolve_merchant(a.owner_id, t.merchant),
+      resolve_merchant(a.owner_id, t.tx_desc)
+    ) as merchant_id
+  from
+    transactions t
+    join accounts a on t.account_id = a.id
+  where
+    t.deleted_at is null
+    and (
+      sqlc.narg('transaction_ids')::bigint [] is null
+      or t.id = ANY(sqlc.narg('transaction_ids')::bigint [])
+    )
+    and (
+      sqlc.narg('owner_id')::uuid is null
+      or a.owner_id = sqlc.narg('owner_id')::uuid
+    )
+)
+update
+  transactions t
+set
+  merchant_id = r.merchant_id,
+  category_id = case
+    when t.category_id is null
+    and not t.category_manually_set then m.default_category_id
+    else t.category_id
+  end
+from
+  resolved r
+  left join merchants m on m.id = r.merchant_id
+where
+  t.id = r.id
+  and t.merchant_id is distinct from r.merchant_id
+returning
+  t.id,
+  r.old_merchant_id,
+  t.merchant_id,
+  r.old_category_id,
+  t.category_id;
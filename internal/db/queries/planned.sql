@@ -0,0 +1,182 @@
+-- name: ListPlannedTransactions :many
+select
+  sqlc.embed(p)
+from
+  planned_transactions p
+  join accounts a on p.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null
+  and (
+    sqlc.narg('account_id')::bigint is null
+    or p.account_id = sqlc.narg('account_id')::bigint
+  )
+order by
+  p.start_date,
+  p.id;
+
+-- name: GetPlannedTransaction :one
+select
+  sqlc.embed(p)
+from
+  planned_transactions p
+  join accounts a on p.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  p.id = sqlc.arg(id)::bigint
+  and (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null;
+
+-- name: CreatePlannedTransaction :one
+insert into
+  planned_transactions (
+    user_id,
+    account_id,
+    tx_amount_cents,
+    tx_currency,
+    tx_direction,
+    tx_desc,
+    merchant,
+    category_id,
+    start_date,
+    rrule
+  )
+values
+  (
+    @user_id::uuid,
+    @account_id::bigint,
+    @tx_amount_cents::bigint,
+    @tx_currency::char(3),
+    @tx_direction::smallint,
+    sqlc.narg('tx_desc')::text,
+    sqlc.narg('merchant')::text,
+    sqlc.narg('category_id')::bigint,
+    @start_date::date,
+    sqlc.narg('rrule')::text
+  )
+returning
+  *;
+
+-- name: UpdatePlannedTransaction :one
+-- An empty rrule, description or merchant and a zero category clear them.
+update
+  planned_transactions
+set
+  tx_amount_cents = coalesce(sqlc.narg('tx_amount_cents')::bigint, tx_amount_cents),
+  tx_direction = coalesce(sqlc.narg('tx_direction')::smallint, tx_direction),
+  tx_desc = case
+    when sqlc.narg('tx_desc')::text is null then tx_desc
+    else nullif(sqlc.narg('tx_desc')::text, '')
+  end,
+  merchant = case
+    when sqlc.narg('merchant')::text is null then merchant
+    else nullif(sqlc.narg('merchant')::text, '')
+  end,
+  category_id = case
+    when sqlc.narg('category_id')::bigint is null then category_id
+    else nullif(sqlc.narg('category_id')::bigint, 0)
+  end,
+  start_date = coalesce(sqlc.narg('start_date')::date, start_date),
+  rrule = case
+    when sqlc.narg('rrule')::text is null then rrule
+    else nullif(sqlc.narg('rrule')::text, '')
+  end
+where
+  id = sqlc.arg(id)::bigint
+  and account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = sqlc.arg(user_id)::uuid
+    where
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
+  )
+returning
+  *;
+
+-- name: DeletePlannedTransaction :execrows
+delete from
+  planned_transactions
+where
+  id = sqlc.arg(id)::bigint
+  and account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = sqlc.arg(user_id)::uuid
+    where
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+  );
+
+-- name: ListPlannedTransactionsForAccounts :many
+-- Used when matching new transactions, access was checked when they were created.
+select
+  *
+from
+  planned_transactions
+where
+  account_id = ANY(@account_ids::bigint [])
+order by
+  id;
+
+-- name: ListPlannedFulfillments :many
+-- Fulfillments by voided transactions or ones in the trash don't count.
+select
+  f.planned_id,
+  f.occurrence_date,
+  f.transaction_id
+from
+  planned_transaction_fulfillments f
+  join transactions t on f.transaction_id = t.id
+where
+  f.planned_id = ANY(@planned_ids::bigint [])
+  and t.deleted_at is null
+  and t.status <> 3
+order by
+  f.planned_id,
+  f.occurrence_date;
+
+-- name: FulfillPlannedTransaction :execrows
+-- The fulfillment of a voided or trashed transaction is taken over by the new one.
+insert into
+  planned_transaction_fulfillments (planned_id, occurrence_date, transaction_id)
+values
+  (@planned_id::bigint, @occurrence_date::date, @transaction_id::bigint)
+on CONFLICT (planned_id, occurrence_date) do
+update
+set
+  transaction_id = excluded.transaction_id,
+  fulfilled_at = now()
+where
+  exists (
+    select
+      1
+    from
+      transactions t
+    where
+      t.id = planned_transaction_fulfillments.transaction_id
+      and (
+        t.deleted_at is not null
+        or t.status = 3
+      )
+  );
@@ -0,0 +1,113 @@
+-- name: CreateReceipt :one
+insert into
+  receipts (
+    user_id,
+    transaction_id,
+    match_status,
+    match_score,
+    merchant,
+    total_cents,
+    currency,
+    receipt_date,
+    content_type
+  )
+values
+  (
+    @user_id::uuid,
+    sqlc.narg('transaction_id')::bigint,
+    @match_status::smallint,
+    sqlc.narg('match_score')::real,
+    sqlc.narg('merchant')::text,
+    @total_cents::bigint,
+    sqlc.narg('currency')::char(3),
+    sqlc.narg('receipt_date')::date,
+    @content_type::text
+  )
+returning
+  *;
+
+-- name: BulkCreateReceiptItems :many
+insert into
+  receipt_items (
+    receipt_id,
+    position,
+    name,
+    quantity,
+    unit_price_cents,
+    total_cents
+  )
+select
+  @receipt_id::bigint,
+  u.ord::int,
+  u.name,
+  u.quantity,
+  nullif(u.unit_price_cents, -1),
+  u.total_cents
+from
+  unnest(
+    @names::text[],
+    @quantities::double precision[],
+    @unit_price_cents::bigint[],
+    @total_cents::bigint[]
+  ) with ordinality as u(name, quantity, unit_price_cents, total_cents, ord)
+order by
+  u.ord
+returning
+  *;
+
+-- name: GetReceipt :one
+select
+  *
+from
+  receipts
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid;
+
+-- name: ListReceipts :many
+select
+  *
+from
+  receipts
+where
+  user_id = @user_id::uuid
+  and (
+    sqlc.narg('match_status')::smallint is null
+    or match_status = sqlc.narg('match_status')::smallint
+  )
+order by
+  created_at desc,
+  id desc
+limit
+  COALESCE(sqlc.narg('limit')::int, 50) offset COALESCE(sqlc.narg('offset')::int, 0);
+
+-- name: ListReceiptItems :many
+select
+  *
+from
+  receipt_items
+where
+  receipt_id = ANY(@receipt_ids::bigint [])
+order by
+  receipt_id,
+  position;
+
+-- name: SetReceiptMatch :one
+update
+  receipts
+set
+  transaction_id = sqlc.narg('transaction_id')::bigint,
+  match_status = @match_status::smallint,
+  match_score = sqlc.narg('match_score')::real
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid
+returning
+  *;
+
+-- name: DeleteReceipt :execrows
+delete from
+  receipts
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid;
@@ -0,0 +1,160 @@
+-- name: ListRecurringCandidates :many
+-- Spending and income since @since with its normalized merchant key, oldest
+-- first. Transfers between the user's own accounts are not bills.
+select
+  t.id,
+  t.account_id,
+  t.tx_date,
+  t.tx_amount_cents,
+  t.tx_currency,
+  t.tx_direction,
+  coalesce(t.merchant, t.tx_desc, '')::text as name,
+  recurring_key(coalesce(t.merchant, t.tx_desc))::text as match_key
+from
+  transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null
+  and t.status <> 3
+  and t.tx_date >= sqlc.arg(since)::timestamptz
+  and recurring_key(coalesce(t.merchant, t.tx_desc)) <> ''
+  and not exists (
+    select
+      1
+    from
+      transfers x
+    where
+      x.dismissed_at is null
+      and t.id in (x.outgoing_tx_id, x.incoming_tx_id)
+  )
+order by
+  t.tx_date,
+  t.id;
+
+-- name: ListSimilarRecurringKeys :many
+-- Pairs of merchant keys close enough to be the same payee.
+select
+  k1.match_key::text as key_a,
+  k2.match_key::text as key_b
+from
+  unnest(@match_keys::text []) as k1(match_key)
+  join unnest(@match_keys::text []) as k2(match_key) on k1.match_key < k2.match_key
+where
+  similarity(k1.match_key, k2.match_key) >= @min_similarity::real;
+
+-- name: UpsertRecurringSeries :one
+-- Dismissed series stay dismissed. A confirmed series whose latest charge is
+-- more than 1% above the expected amount remembers the old amount.
+insert into
+  recurring_series (
+    user_id,
+    match_key,
+    name,
+    account_id,
+    cadence,
+    direction,
+    currency,
+    expected_amount_cents,
+    last_amount_cents,
+    last_date,
+    next_due_date,
+    occurrences
+  )
+values
+  (
+    @user_id::uuid,
+    @match_key::text,
+    @name::text,
+    @account_id::bigint,
+    @cadence::smallint,
+    @direction::smallint,
+    @currency::char(3),
+    @expected_amount_cents::bigint,
+    @last_amount_cents::bigint,
+    @last_date::timestamptz,
+    @next_due_date::date,
+    @occurrences::int
+  ) on CONFLICT (user_id, match_key, currency, direction) do
+update
+set
+  name = excluded.name,
+  account_id = excluded.account_id,
+  cadence = excluded.cadence,
+  expected_amount_cents = excluded.expected_amount_cents,
+  last_amount_cents = excluded.last_amount_cents,
+  last_date = excluded.last_date,
+  next_due_date = excluded.next_due_date,
+  occurrences = excluded.occurrences,
+  price_increase_from_cents = case
+    when recurring_series.status = 2
+    and recurring_series.last_date < excluded.last_date
+    and excluded.last_amount_cents * 100 > recurring_series.expected_amount_cents * 101
+    then recurring_series.expected_amount_cents
+    else recurring_series.price_increase_from_cents
+  end
+returning
+  *;
+
+-- name: DeleteRecurringSeriesTransactions :exec
+delete from
+  recurring_series_transactions
+where
+  series_id = @series_id::bigint;
+
+-- name: LinkRecurringSeriesTransactions :exec
+insert into
+  recurring_series_transactions (series_id, transaction_id)
+select
+  @series_id::bigint,
+  unnest(@transaction_ids::bigint [])
+on CONFLICT do NOTHING;
+
+-- name: ListRecurringSeries :many
+select
+  *
+from
+  recurring_series
+where
+  user_id = @user_id::uuid
+  and (
+    sqlc.narg('status')::smallint is null
+    or status = sqlc.narg('status')::smallint
+  )
+order by
+  next_due_date,
+  id;
+
+-- name: ListRecurringSeriesTransactionIDs :many
+select
+  series_id,
+  transaction_id
+from
+  recurring_series_transactions
+where
+  series_id = ANY(@series_ids::bigint [])
+order by
+  series_id,
+  transaction_id;
+
+-- name: SetRecurringSeriesStatus :one
+-- Confirming also acknowledges a flagged price increase.
+update
+  recurring_series
+set
+  status = @status::smallint,
+  price_increase_from_cents = case
+    when @status::smallint = 2 then null
+    else price_increase_from_cents
+  end
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid
+returning
+  *;
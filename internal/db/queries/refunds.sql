@@ -0,0 +1,172 @@
+-- name: ListRefundCandidates :many
+-- Incoming transactions that look like a refund of an earlier purchase: same
+-- currency, same or a similar merchant, at most window_days after the
+-- purchase and no larger than what hasn't been refunded yet. Exact amounts
+-- come first, then the most similar merchant and the closest purchase.
+select
+  o.id as original_id,
+  r.id as refund_id,
+  r.tx_amount_cents as refund_cents,
+  (o.tx_amount_cents - coalesce(prior.cents, 0))::bigint as remaining_cents,
+  similarity(
+    recurring_key(coalesce(o.merchant, o.tx_desc)),
+    recurring_key(coalesce(r.merchant, r.tx_desc))
+  ) as score
+from
+  transactions r
+  join accounts ra on r.account_id = ra.id
+  left join account_users rau on ra.id = rau.account_id
+  and rau.user_id = @user_id::uuid
+  join transactions o on o.tx_currency = r.tx_currency
+  and o.tx_direction = 2
+  and o.tx_date <= r.tx_date
+  and o.tx_date >= r.tx_date - make_interval(days => @window_days::int)
+  join accounts oa on o.account_id = oa.id
+  left join account_users oau on oa.id = oau.account_id
+  and oau.user_id = @user_id::uuid
+  left join lateral (
+    select
+      SUM(p.tx_amount_cents)::bigint as cents
+    from
+      refunds x
+      join transactions p on p.id = x.refund_tx_id
+    where
+      x.original_tx_id = o.id
+      and x.dismissed_at is null
+      and p.deleted_at is null
+      and p.status <> 3
+  ) prior on true
+where
+  (
+    ra.owner_id = @user_id::uuid
+    or rau.user_id is not null
+  )
+  and (
+    oa.owner_id = @user_id::uuid
+    or oau.user_id is not null
+  )
+  and ra.deleted_at is null
+  and oa.deleted_at is null
+  and r.deleted_at is null
+  and o.deleted_at is null
+  and r.status <> 3
+  and o.status <> 3
+  and r.tx_direction = 1
+  and r.tx_amount_cents <= o.tx_amount_cents - coalesce(prior.cents, 0)
+  and (
+    sqlc.narg('start')::timestamptz is null
+    or r.tx_date >= sqlc.narg('start')::timestamptz
+  )
+  and (
+    sqlc.narg('end')::timestamptz is null
+    or r.tx_date <= sqlc.narg('end')::timestamptz
+  )
+  and (
+    sqlc.narg('transaction_ids')::bigint [] is null
+    or r.id = ANY(sqlc.narg('transaction_ids')::bigint [])
+    or o.id = ANY(sqlc.narg('transaction_ids')::bigint [])
+  )
+  and recurring_key(coalesce(r.merchant, r.tx_desc)) <> ''
+  and (
+    recurring_key(coalesce(o.merchant, o.tx_desc)) = recurring_key(coalesce(r.merchant, r.tx_desc))
+    or similarity(
+      recurring_key(coalesce(o.merchant, o.tx_desc)),
+      recurring_key(coalesce(r.merchant, r.tx_desc))
+    ) >= @min_similarity::real
+  )
+  and not exists (
+    select
+      1
+    from
+      transfers x
+    where
+      x.dismissed_at is null
+      and (
+        x.outgoing_tx_id in (o.id, r.id)
+        or x.incoming_tx_id in (o.id, r.id)
+      )
+  )
+  and not exists (
+    select
+      1
+    from
+      refunds x
+    where
+      (
+        x.dismissed_at is null
+        and x.refund_tx_id = r.id
+      )
+      or (
+        x.original_tx_id = o.id
+        and x.refund_tx_id = r.id
+      )
+  )
+order by
+  (r.tx_amount_cents = o.tx_amount_cents) desc,
+  score desc,
+  r.tx_date - o.tx_date,
+  r.id,
+  o.id;
+
+-- name: GetRefundedCents :one
+-- How much of a purchase has been paid back by live refunds.
+select
+  coalesce(SUM(r.tx_amount_cents), 0)::bigint as cents
+from
+  refunds x
+  join transactions r on r.id = x.refund_tx_id
+where
+  x.original_tx_id = @original_tx_id::bigint
+  and x.dismissed_at is null
+  and r.deleted_at is null
+  and r.status <> 3;
+
+-- name: CreateRefund :one
+insert into
+  refunds (user_id, original_tx_id, refund_tx_id, auto_detected)
+values
+  (
+    @user_id::uuid,
+    @original_tx_id::bigint,
+    @refund_tx_id::bigint,
+    @auto_detected::boolean
+  )
+returning
+  *;
+
+-- name: DeleteDismissedRefund :execrows
+delete from
+  refunds
+where
+  user_id = @user_id::uuid
+  and original_tx_id = @original_tx_id::bigint
+  and refund_tx_id = @refund_tx_id::bigint
+  and dismissed_at is not null;
+
+-- name: DismissRefunds :execrows
+-- Unlinks the refund, or every refund of the purchase.
+update
+  refunds
+set
+  dismissed_at = NOW()
+where
+  user_id = @user_id::uuid
+  and dismissed_at is null
+  and (
+    original_tx_id = @transaction_id::bigint
+    or refund_tx_id = @transaction_id::bigint
+  );
+
+-- name: ListRefundsForTransactions :many
+select
+  *
+from
+  refunds
+where
+  dismissed_at is null
+  and (
+    original_tx_id = ANY(@transaction_ids::bigint [])
+    or refund_tx_id = ANY(@transaction_ids::bigint [])
+  )
+order by
+  id;
@@ -41,36 +41,73 @@ where user_id = @user_id::uuid
 order by priority_order, created_at;
 
 -- name: GetTransactionsForRuleApplication :many
+-- Newest first, the order previews page in. Previews read in batches after
+-- the cursor; without a limit every row is returned.
 select
   t.*
 from transactions t
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
 where (a.owner_id = @user_id::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and a.deleted_at is null
   and (sqlc.narg('transaction_ids')::bigint[] is null or t.id = ANY(sqlc.narg('transaction_ids')::bigint[]))
-  and (sqlc.narg('include_manually_set')::boolean = true or (t.category_manually_set = false and t.merchant_manually_set = false));
+  and (sqlc.narg('include_manually_set')::boolean = true or (t.category_manually_set = false and t.merchant_manually_set = false))
+  and (
+    sqlc.narg('cursor_date')::timestamptz is null
+    or (t.tx_date, t.id) < (sqlc.narg('cursor_date')::timestamptz, sqlc.narg('cursor_id')::bigint)
+  )
+order by t.tx_date desc, t.id desc
+limit sqlc.narg('limit')::int;
 
--- name: BulkApplyRuleToTransactions :execrows
-update transactions
+-- name: BulkApplyRuleToTransactions :many
+-- Returns old and new category and merchant of every touched row for the history log.
+update transactions t
 set
   category_id = case
-    when @category_id::bigint > 0 and category_manually_set = false
+    when @category_id::bigint > 0 and t.category_manually_set = false
     then @category_id::bigint
-    else category_id
+    else t.category_id
   end,
   merchant = case
-    when @merchant::text != '' and merchant_manually_set = false
+    when @merchant::text != '' and t.merchant_manually_set = false
     then @merchant::text
-    else merchant
+    else t.merchant
   end
-where id = ANY(@transaction_ids::bigint[])
-  and account_id in (
+from transactions old
+where old.id = t.id
+  and t.id = ANY(@transaction_ids::bigint[])
+  and t.account_id in (
     select a.id
     from accounts a
     left join account_users au on a.id = au.account_id and au.user_id = @user_id::uuid
-    where a.owner_id = @user_id::uuid or au.user_id is not null
+    where (a.owner_id = @user_id::uuid or au.user_id is not null)
+      and a.deleted_at is null
   )
+  and t.deleted_at is null
   and (
-    (@category_id::bigint > 0 and category_manually_set = false) or
-    (@merchant::text != '' and merchant_manually_set = false)
-  );
\ No newline at end of file
+    (@category_id::bigint > 0 and t.category_manually_set = false) or
+    (@merchant::text != '' and t.merchant_manually_set = false)
+  )
+returning
+  t.id,
+  old.category_id as old_category_id,
+  t.category_id,
+  old.merchant as old_merchant,
+  t.merchant;
+-- name: ReorderRules :execrows
+-- Sets priority_order to each rule's position in rule_ids, starting at 0.
+update transaction_rules r
+set priority_order = (o.position - 1)::int,
+  updated_at = now()
+from unnest(@rule_ids::uuid[]) with ordinality as o(rule_id, position)
+where r.rule_id = o.rule_id
+  and r.user_id = @user_id::uuid;
+
+-- name: SetRuleActive :one
+update transaction_rules
+set is_active = @is_active::boolean,
+  updated_at = now()
+where rule_id = @rule_id::uuid
+  and user_id = @user_id::uuid
+returning *;
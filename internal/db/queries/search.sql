@@ -0,0 +1,68 @@
+-- name: SearchTransactions :many
+-- @tsquery is a prepared prefix query such as "star:* & cof:*", @raw_query the
+-- user's text for trigram matching on descriptions and merchants. Highlights
+-- mark matches with chr(2)/chr(3) instead of markup so the caller can escape
+-- the stored text; both characters are stripped from the source first.
+select
+  sqlc.embed(t),
+  (
+    ts_rank_cd(coalesce(ts.document, ''::tsvector), to_tsquery('simple', @tsquery::text))
+    + 0.5 * greatest(
+      similarity(lower(coalesce(t.tx_desc, '')), lower(@raw_query::text)),
+      similarity(lower(coalesce(t.merchant, '')), lower(@raw_query::text))
+    )
+  )::float8 as rank,
+  ts_headline(
+    'simple',
+    translate(coalesce(t.tx_desc, ''), chr(2) || chr(3), ''),
+    to_tsquery('simple', @tsquery::text),
+    'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true'
+  )::text as description_highlight,
+  ts_headline(
+    'simple',
+    translate(coalesce(t.merchant, ''), chr(2) || chr(3), ''),
+    to_tsquery('simple', @tsquery::text),
+    'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', HighlightAll=true'
+  )::text as merchant_highlight,
+  ts_headline(
+    'simple',
+    translate(coalesce(t.user_notes, ''), chr(2) || chr(3), ''),
+    to_tsquery('simple', @tsquery::text),
+    'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxFragments=2, MaxWords=20, MinWords=5'
+  )::text as notes_highlight
+from
+  transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+  left join transaction_search ts on ts.transaction_id = t.id
+where
+  (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null
+  and (
+    ts.document @@ to_tsquery('simple', @tsquery::text)
+    or lower(t.tx_desc) % lower(@raw_query::text)
+    or lower(t.merchant) % lower(@raw_query::text)
+  )
+  and (
+    sqlc.narg('account_id')::bigint is null
+    or t.account_id = sqlc.narg('account_id')::bigint
+  )
+  and (
+    sqlc.narg('start')::timestamptz is null
+    or t.tx_date >= sqlc.narg('start')::timestamptz
+  )
+  and (
+    sqlc.narg('end')::timestamptz is null
+    or t.tx_date <= sqlc.narg('end')::timestamptz
+  )
+order by
+  rank desc,
+  t.tx_date desc,
+  t.id desc
+limit
+  sqlc.arg('limit')::int offset sqlc.arg('offset')::int;
@@ -0,0 +1,210 @@
+-- name: ListAccountMemberIDs :many
+-- The owner and everyone the account is shared with.
+select
+  a.owner_id::uuid as user_id
+from
+  accounts a
+where
+  a.id = @account_id::bigint
+union
+select
+  au.user_id::uuid as user_id
+from
+  account_users au
+where
+  au.account_id = @account_id::bigint;
+
+-- name: UpsertSharedExpense :one
+insert into
+  shared_expenses (transaction_id, payer_id, split_method, created_by)
+values
+  (
+    @transaction_id::bigint,
+    @payer_id::uuid,
+    @split_method::smallint,
+    @created_by::uuid
+  ) on CONFLICT (transaction_id) do
+update
+set
+  payer_id = EXCLUDED.payer_id,
+  split_method = EXCLUDED.split_method
+returning
+  *;
+
+-- name: DeleteSharedExpenseParts :exec
+delete from
+  shared_expense_parts
+where
+  transaction_id = @transaction_id::bigint;
+
+-- name: BulkCreateSharedExpenseParts :many
+insert into
+  shared_expense_parts (transaction_id, user_id, amount_cents, weight)
+select
+  @transaction_id::bigint,
+  u.user_id,
+  u.amount_cents,
+  nullif(u.weight, 0)
+from
+  unnest(
+    @user_ids::uuid [],
+    @amount_cents::bigint [],
+    @weights::float8 []
+  ) with ordinality as u(user_id, amount_cents, weight, ord)
+order by
+  u.ord
+returning
+  *;
+
+-- name: DeleteSharedExpense :execrows
+delete from
+  shared_expenses
+where
+  transaction_id = @transaction_id::bigint;
+
+-- name: CountSharedExpenses :one
+select
+  COUNT(*)::bigint
+from
+  shared_expenses
+where
+  transaction_id = @transaction_id::bigint;
+
+-- name: ListSharedExpenses :many
+select
+  *
+from
+  shared_expenses
+where
+  transaction_id = ANY(@transaction_ids::bigint []);
+
+-- name: ListSharedExpenseParts :many
+select
+  *
+from
+  shared_expense_parts
+where
+  transaction_id = ANY(@transaction_ids::bigint [])
+order by
+  transaction_id,
+  amount_cents desc,
+  user_id;
+
+-- name: ListSharedBalances :many
+-- What every other user owes user_id (negative when user_id owes them), per
+-- currency. Parts user_id paid for count in their favour, parts others paid
+-- for user_id against them, and settlements move the balance towards zero.
+-- Expenses in trashed transactions or accounts don't count.
+with entries as (
+  select
+    p.user_id as other_user_id,
+    t.tx_currency as currency,
+    p.amount_cents as cents
+  from
+    shared_expenses e
+    join shared_expense_parts p on p.transaction_id = e.transaction_id
+    join transactions t on t.id = e.transaction_id
+    join accounts a on a.id = t.account_id
+  where
+    e.payer_id = @user_id::uuid
+    and p.user_id <> @user_id::uuid
+    and t.deleted_at is null
+    and t.status <> 3
+    and a.deleted_at is null
+  union all
+  select
+    e.payer_id,
+    t.tx_currency,
+    -p.amount_cents
+  from
+    shared_expenses e
+    join shared_expense_parts p on p.transaction_id = e.transaction_id
+    join transactions t on t.id = e.transaction_id
+    join accounts a on a.id = t.account_id
+  where
+    p.user_id = @user_id::uuid
+    and e.payer_id <> @user_id::uuid
+    and t.deleted_at is null
+    and t.status <> 3
+    and a.deleted_at is null
+  union all
+  select
+    s.to_user_id,
+    s.currency,
+    s.amount_cents
+  from
+    settlements s
+  where
+    s.from_user_id = @user_id::uuid
+  union all
+  select
+    s.from_user_id,
+    s.currency,
+    -s.amount_cents
+  from
+    settlements s
+  where
+    s.to_user_id = @user_id::uuid
+)
+select
+  e.other_user_id::uuid as other_user_id,
+  u.email,
+  u.display_name,
+  e.currency::text as currency,
+  SUM(e.cents)::bigint as balance_cents
+from
+  entries e
+  join users u on u.id = e.other_user_id
+group by
+  e.other_user_id,
+  u.email,
+  u.display_name,
+  e.currency
+having
+  SUM(e.cents) <> 0
+order by
+  u.email,
+  e.currency;
+
+-- name: CreateSettlement :one
+insert into
+  settlements (
+    from_user_id,
+    to_user_id,
+    amount_cents,
+    currency,
+    transaction_id,
+    note,
+    created_by
+  )
+values
+  (
+    @from_user_id::uuid,
+    @to_user_id::uuid,
+    @amount_cents::bigint,
+    @currency::text,
+    sqlc.narg('transaction_id')::bigint,
+    sqlc.narg('note')::text,
+    @created_by::uuid
+  )
+returning
+  *;
+
+-- name: ListSettlements :many
+select
+  *
+from
+  settlements
+where
+  (
+    from_user_id = @user_id::uuid
+    or to_user_id = @user_id::uuid
+  )
+  and (
+    sqlc.narg('other_user_id')::uuid is null
+    or from_user_id = sqlc.narg('other_user_id')::uuid
+    or to_user_id = sqlc.narg('other_user_id')::uuid
+  )
+order by
+  created_at desc,
+  id desc;
@@ -0,0 +1,53 @@
+-- name: ListTransactionSplits :many
+select
+  s.*
+from
+  transaction_splits s
+  join transactions t on s.transaction_id = t.id
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  s.transaction_id = ANY(@transaction_ids::bigint [])
+  and (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null
+order by
+  s.transaction_id,
+  s.id;
+
+-- name: DeleteTransactionSplits :execrows
+delete from
+  transaction_splits
+where
+  transaction_id = @transaction_id::bigint;
+
+-- name: BulkCreateTransactionSplits :many
+insert into
+  transaction_splits (transaction_id, amount_cents, category_id, notes)
+select
+  @transaction_id::bigint,
+  u.amount_cents,
+  nullif(u.category_id, 0),
+  nullif(u.notes, '')
+from
+  unnest(
+    @amount_cents::bigint[],
+    @category_ids::bigint[],
+    @notes::text[]
+  ) with ordinality as u(amount_cents, category_id, notes, ord)
+order by
+  u.ord
+returning
+  *;
+
+-- name: CountTransactionSplits :one
+select
+  COUNT(*)::bigint
+from
+  transaction_splits
+where
+  transaction_id = @transaction_id::bigint;
@@ -0,0 +1,101 @@
+-- name: ListTags :many
+select
+  *
+from
+  tags
+where
+  user_id = @user_id::uuid
+order by
+  slug;
+
+-- name: GetTag :one
+select
+  *
+from
+  tags
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid;
+
+-- name: CreateTag :one
+insert into
+  tags (user_id, slug, color)
+values
+  (@user_id::uuid, @slug::text, @color::text)
+returning
+  *;
+
+-- name: UpdateTag :one
+update
+  tags
+set
+  slug = coalesce(sqlc.narg('slug')::text, slug),
+  color = coalesce(sqlc.narg('color')::text, color)
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid
+returning
+  *;
+
+-- name: DeleteTag :execrows
+delete from
+  tags
+where
+  id = @id::bigint
+  and user_id = @user_id::uuid;
+
+-- name: CountUserTags :one
+select
+  COUNT(*)::bigint
+from
+  tags
+where
+  user_id = @user_id::uuid
+  and id = ANY(@tag_ids::bigint []);
+
+-- name: BulkTagTransactions :execrows
+-- Links every accessible transaction to every tag; existing links are kept.
+insert into
+  transaction_tags (transaction_id, tag_id)
+select
+  t.id,
+  g.id
+from
+  transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+  cross join tags g
+where
+  t.id = ANY(@transaction_ids::bigint [])
+  and g.id = ANY(@tag_ids::bigint [])
+  and g.user_id = sqlc.arg(user_id)::uuid
+  and (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null on CONFLICT do NOTHING;
+
+-- name: BulkUntagTransactions :execrows
+delete from
+  transaction_tags tt using tags g
+where
+  tt.tag_id = g.id
+  and g.user_id = @user_id::uuid
+  and tt.transaction_id = ANY(@transaction_ids::bigint [])
+  and tt.tag_id = ANY(@tag_ids::bigint []);
+
+-- name: ListTagsForTransactions :many
+select
+  tt.transaction_id,
+  sqlc.embed(g)
+from
+  transaction_tags tt
+  join tags g on tt.tag_id = g.id
+where
+  g.user_id = @user_id::uuid
+  and tt.transaction_id = ANY(@transaction_ids::bigint [])
+order by
+  tt.transaction_id,
+  g.slug;
@@ -1,79 +1,40 @@
 -- name: ListTransactions :many
+-- The list filters live in filter_transactions, shared with bulk updates
+-- and deletes and AggregateTransactions.
 select
   t.*
 from
-  transactions t
-  join accounts a on t.account_id = a.id
-  left join account_users au on a.id = au.account_id
-  and au.user_id = sqlc.arg(user_id)::uuid
-  left join categories c on t.category_id = c.id
+  filter_transactions(
+    p_user_id => sqlc.arg(user_id)::uuid,
+    p_start => sqlc.narg('start')::timestamptz,
+    p_end => sqlc.narg('end')::timestamptz,
+    p_amount_min_cents => sqlc.narg('amount_min_cents')::bigint,
+    p_amount_max_cents => sqlc.narg('amount_max_cents')::bigint,
+    p_direction => sqlc.narg('direction')::smallint,
+    p_account_ids => sqlc.narg('account_ids')::bigint [],
+    p_categories => sqlc.narg('categories')::text [],
+    p_category_patterns => sqlc.narg('category_patterns')::text [],
+    p_exclude_category_patterns => sqlc.narg('exclude_category_patterns')::text [],
+    p_account_names => sqlc.narg('account_names')::text [],
+    p_exclude_account_ids => sqlc.narg('exclude_account_ids')::bigint [],
+    p_exclude_account_names => sqlc.narg('exclude_account_names')::text [],
+    p_tags => sqlc.narg('tags')::text [],
+    p_exclude_tags => sqlc.narg('exclude_tags')::text [],
+    p_merchant_q => sqlc.narg('merchant_q')::text,
+    p_exclude_merchant_q => sqlc.narg('exclude_merchant_q')::text,
+    p_desc_q => sqlc.narg('desc_q')::text,
+    p_currency => sqlc.narg('currency')::char(3),
+    p_tod_start => sqlc.narg('tod_start')::time,
+    p_tod_end => sqlc.narg('tod_end')::time,
+    p_uncategorized => sqlc.narg('uncategorized')::boolean,
+    p_statuses => sqlc.narg('statuses')::smallint []
+  ) t
 where
-  (
-    a.owner_id = sqlc.arg(user_id)::uuid
-    or au.user_id is not null
-  )
-  and (
-    sqlc.narg('cursor_date')::timestamptz is null
-    or sqlc.narg('cursor_id')::bigint is null
-    or (t.tx_date, t.id) < (
-      sqlc.narg('cursor_date')::timestamptz,
-      sqlc.narg('cursor_id')::bigint
-    )
-  )
-  and (
-    sqlc.narg('start')::timestamptz is null
-    or t.tx_date >= sqlc.narg('start')::timestamptz
-  )
-  and (
-    sqlc.narg('end')::timestamptz is null
-    or t.tx_date <= sqlc.narg('end')::timestamptz
-  )
-  and (
-    sqlc.narg('amount_min_cents')::bigint is null
-    or t.tx_amount_cents >= sqlc.narg('amount_min_cents')::bigint
-  )
-  and (
-    sqlc.narg('amount_max_cents')::bigint is null
-    or t.tx_amount_cents <= sqlc.narg('amount_max_cents')::bigint
-  )
-  and (
-    sqlc.narg('direction')::smallint is null
-    or t.tx_direction = sqlc.narg('direction')::smallint
-  )
-  and (
-    sqlc.narg('account_ids')::bigint [] is null
-    or t.account_id = ANY(sqlc.narg('account_ids')::bigint [])
-  )
-  and (
-    sqlc.narg('categories')::text [] is null
-    or c.slug = ANY(sqlc.narg('categories')::text [])
-  )
-  and (
-    sqlc.narg('merchant_q')::text is null
-    or t.merchant ILIKE ('%' || sqlc.narg('merchant_q')::text || '%')
-  )
-  and (
-    sqlc.narg('desc_q')::text is null
-    or t.tx_desc ILIKE ('%' || sqlc.narg('desc_q')::text || '%')
-  )
-  and (
-    sqlc.narg('currency')::char(3) is null
-    or t.tx_currency = sqlc.narg('currency')::char(3)
-  )
-  and (
-    sqlc.narg('tod_start')::time is null
-    or t.tx_date::time >= sqlc.narg('tod_start')::time
-  )
-  and (
-    sqlc.narg('tod_end')::time is null
-    or t.tx_date::time <= sqlc.narg('tod_end')::time
-  )
-  and (
-    sqlc.narg('uncategorized')::boolean is null
-    or (
-      sqlc.narg('uncategorized')::boolean = true
-      and t.category_id is null
-    )
+  sqlc.narg('cursor_date')::timestamptz is null
+  or sqlc.narg('cursor_id')::bigint is null
+  or (t.tx_date, t.id) < (
+    sqlc.narg('cursor_date')::timestamptz,
+    sqlc.narg('cursor_id')::bigint
   )
 order by
   t.tx_date desc,
@@ -81,6 +42,42 @@ order by
 limit
   COALESCE(sqlc.narg('limit')::int, 100);
 
+-- name: ListTransactionIDsByFilter :many
+-- The rows ListTransactions would return with the same filters, without
+-- paging, for bulk updates and deletes.
+select
+  t.id,
+  t.account_id,
+  t.tx_currency
+from
+  filter_transactions(
+    p_user_id => sqlc.arg(user_id)::uuid,
+    p_start => sqlc.narg('start')::timestamptz,
+    p_end => sqlc.narg('end')::timestamptz,
+    p_amount_min_cents => sqlc.narg('amount_min_cents')::bigint,
+    p_amount_max_cents => sqlc.narg('amount_max_cents')::bigint,
+    p_direction => sqlc.narg('direction')::smallint,
+    p_account_ids => sqlc.narg('account_ids')::bigint [],
+    p_categories => sqlc.narg('categories')::text [],
+    p_category_patterns => sqlc.narg('category_patterns')::text [],
+    p_exclude_category_patterns => sqlc.narg('exclude_category_patterns')::text [],
+    p_account_names => sqlc.narg('account_names')::text [],
+    p_exclude_account_ids => sqlc.narg('exclude_account_ids')::bigint [],
+    p_exclude_account_names => sqlc.narg('exclude_account_names')::text [],
+    p_tags => sqlc.narg('tags')::text [],
+    p_exclude_tags => sqlc.narg('exclude_tags')::text [],
+    p_merchant_q => sqlc.narg('merchant_q')::text,
+    p_exclude_merchant_q => sqlc.narg('exclude_merchant_q')::text,
+    p_desc_q => sqlc.narg('desc_q')::text,
+    p_currency => sqlc.narg('currency')::char(3),
+    p_tod_start => sqlc.narg('tod_start')::time,
+    p_tod_end => sqlc.narg('tod_end')::time,
+    p_uncategorized => sqlc.narg('uncategorized')::boolean,
+    p_statuses => sqlc.narg('statuses')::smallint []
+  ) t
+order by
+  t.id;
+
 -- name: GetTransaction :one
 select
   t.*
@@ -94,7 +91,9 @@ where
   and (
     a.owner_id = sqlc.arg(user_id)::uuid
     or au.user_id is not null
-  );
+  )
+  and t.deleted_at is null
+  and a.deleted_at is null;
 
 -- name: CreateTransaction :one
 insert into
@@ -116,7 +115,8 @@ insert into
     foreign_amount_cents,
     foreign_currency,
     exchange_rate,
-    suggestions
+    suggestions,
+    status
   )
 select
   sqlc.narg('email_id')::text,
@@ -136,7 +136,8 @@ select
   sqlc.narg('foreign_amount_cents')::bigint,
   sqlc.narg('foreign_currency')::char(3),
   sqlc.narg('exchange_rate')::double precision,
-  sqlc.narg('suggestions')::text []
+  sqlc.narg('suggestions')::text [],
+  coalesce(sqlc.narg('status')::smallint, 2)
 from
   accounts a
   left join account_users au on a.id = au.account_id
@@ -147,12 +148,14 @@ where
     a.owner_id = sqlc.arg(user_id)::uuid
     or au.user_id is not null
   )
+  and a.deleted_at is null
 returning
   *;
 
 -- name: BulkCreateTransactions :many
 insert into
   transactions (
+    email_id,
     account_id,
     tx_date,
     tx_amount_cents,
@@ -160,29 +163,141 @@ insert into
     tx_direction,
     tx_desc,
     category_id,
+    category_manually_set,
     merchant,
+    merchant_manually_set,
     user_notes,
     foreign_amount_cents,
     foreign_currency,
-    exchange_rate
+    exchange_rate,
+    status
   )
 select
-  unnest(@account_ids::bigint[]),
-  unnest(@tx_dates::timestamptz[]),
-  unnest(@tx_amount_cents::bigint[]),
-  unnest(@tx_currencies::char(3)[]),
-  unnest(@tx_directions::smallint[]),
-  unnest(@tx_descs::text[]),
-  unnest(@category_ids::bigint[]),
-  unnest(@merchants::text[]),
-  unnest(@user_notes::text[]),
-  unnest(@foreign_amount_cents::bigint[]),
-  unnest(@foreign_currencies::char(3)[]),
-  unnest(@exchange_rates::double precision[])
+  nullif(u.email_id, ''),
+  u.account_id,
+  u.tx_date,
+  u.tx_amount_cents,
+  u.tx_currency,
+  u.tx_direction,
+  nullif(u.tx_desc, ''),
+  nullif(u.category_id, 0),
+  u.category_manually_set,
+  nullif(u.merchant, ''),
+  u.merchant_manually_set,
+  nullif(u.user_notes, ''),
+  nullif(u.foreign_amount_cents, 0),
+  nullif(u.foreign_currency, ''),
+  nullif(u.exchange_rate, 0),
+  u.status
+from
+  unnest(
+    @email_ids::text[],
+    @account_ids::bigint[],
+    @tx_dates::timestamptz[],
+    @tx_amount_cents::bigint[],
+    @tx_currencies::char(3)[],
+    @tx_directions::smallint[],
+    @tx_descs::text[],
+    @category_ids::bigint[],
+    @category_manually_set::boolean[],
+    @merchants::text[],
+    @merchant_manually_set::boolean[],
+    @user_notes::text[],
+    @foreign_amount_cents::bigint[],
+    @foreign_currencies::char(3)[],
+    @exchange_rates::double precision[],
+    @statuses::smallint[]
+  ) with ordinality as u(
+    email_id,
+    account_id,
+    tx_date,
+    tx_amount_cents,
+    tx_currency,
+    tx_direction,
+    tx_desc,
+    category_id,
+    category_manually_set,
+    merchant,
+    merchant_manually_set,
+    user_notes,
+    foreign_amount_cents,
+    foreign_currency,
+    exchange_rate,
+    status,
+    ord
+  )
+order by
+  u.ord
+returning
+  *;
+
+-- name: FindPendingMatch :one
+-- The pending authorization a posted transaction settles: same account,
+-- direction and currency, up to 10 days earlier, and either the same amount
+-- or a similar description with an amount that changed by less than half
+-- (tips, hotel holds). Exact amounts win, then similarity, then proximity.
+select
+  t.*
+from
+  transactions t
+where
+  t.account_id = @account_id::bigint
+  and t.status = 1
+  and t.deleted_at is null
+  and t.tx_direction = @tx_direction::smallint
+  and t.tx_currency = @tx_currency::char(3)
+  and t.tx_date between @tx_date::timestamptz - interval '10 days'
+  and @tx_date::timestamptz + interval '1 day'
+  and t.tx_amount_cents between @tx_amount_cents::bigint / 2
+  and @tx_amount_cents::bigint * 3 / 2
+  and (
+    t.tx_amount_cents = @tx_amount_cents::bigint
+    or similarity(lower(coalesce(t.tx_desc, '')), lower(@tx_desc::text)) >= 0.3
+  )
+  and not t.id = ANY(@exclude_ids::bigint [])
+order by
+  (t.tx_amount_cents = @tx_amount_cents::bigint) desc,
+  similarity(lower(coalesce(t.tx_desc, '')), lower(@tx_desc::text)) desc,
+  abs(extract(epoch from (t.tx_date - @tx_date::timestamptz)))
+limit
+  1 for update;
+
+-- name: PostPendingTransaction :one
+-- Settles a pending transaction in place with the posted details so its id,
+-- category, notes and tags carry over. Blank values keep what was there.
+update
+  transactions
+set
+  status = 2,
+  tx_date = @tx_date::timestamptz,
+  tx_amount_cents = @tx_amount_cents::bigint,
+  email_id = coalesce(sqlc.narg('email_id')::text, email_id),
+  tx_desc = coalesce(sqlc.narg('tx_desc')::text, tx_desc),
+  merchant = case
+    when merchant_manually_set then merchant
+    else coalesce(sqlc.narg('merchant')::text, merchant)
+  end,
+  foreign_amount_cents = coalesce(sqlc.narg('foreign_amount_cents')::bigint, foreign_amount_cents),
+  foreign_currency = coalesce(sqlc.narg('foreign_currency')::char(3), foreign_currency),
+  exchange_rate = coalesce(sqlc.narg('exchange_rate')::double precision, exchange_rate)
+where
+  id = @id::bigint
+  and status = 1
 returning
   *;
 
+-- name: ListExistingEmailIDs :many
+-- Trashed rows count too: email_id is unique across the whole table.
+select
+  email_id::text
+from
+  transactions
+where
+  email_id = ANY(@email_ids::text []);
+
 -- name: UpdateTransaction :exec
+-- clear_foreign drops the conversion when the amount is already in the
+-- account's anchor currency.
 update
   transactions
 set
@@ -196,14 +311,29 @@ set
   category_id = coalesce(sqlc.narg('category_id')::bigint, category_id),
   merchant = coalesce(sqlc.narg('merchant')::text, merchant),
   user_notes = coalesce(sqlc.narg('user_notes')::text, user_notes),
-  foreign_amount_cents = coalesce(sqlc.narg('foreign_amount_cents')::bigint, foreign_amount_cents),
-  foreign_currency = coalesce(sqlc.narg('foreign_currency')::char(3), foreign_currency),
-  exchange_rate = coalesce(sqlc.narg('exchange_rate')::double precision, exchange_rate),
+  foreign_amount_cents = case
+    when sqlc.arg(clear_foreign)::boolean then null
+    else coalesce(sqlc.narg('foreign_amount_cents')::bigint, foreign_amount_cents)
+  end,
+  foreign_currency = case
+    when sqlc.arg(clear_foreign)::boolean then null
+    else coalesce(sqlc.narg('foreign_currency')::char(3), foreign_currency)
+  end,
+  exchange_rate = case
+    when sqlc.arg(clear_foreign)::boolean then null
+    else coalesce(sqlc.narg('exchange_rate')::double precision, exchange_rate)
+  end,
+  exchange_rate_manually_set = case
+    when sqlc.arg(clear_foreign)::boolean then false
+    else coalesce(sqlc.narg('exchange_rate_manually_set')::boolean, exchange_rate_manually_set)
+  end,
   suggestions = coalesce(sqlc.narg('suggestions')::text[], suggestions),
   category_manually_set = coalesce(sqlc.narg('category_manually_set')::boolean, category_manually_set),
-  merchant_manually_set = coalesce(sqlc.narg('merchant_manually_set')::boolean, merchant_manually_set)
+  merchant_manually_set = coalesce(sqlc.narg('merchant_manually_set')::boolean, merchant_manually_set),
+  status = coalesce(sqlc.narg('status')::smallint, status)
 where
   id = sqlc.arg(id)::bigint
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -212,15 +342,21 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = sqlc.arg(user_id)::uuid
     where
-      a.owner_id = sqlc.arg(user_id)::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   );
 
 -- name: DeleteTransaction :execrows
-delete from
+update
   transactions
+set
+  deleted_at = now()
 where
   id = sqlc.arg(id)::bigint
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -229,8 +365,11 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = sqlc.arg(user_id)::uuid
     where
-      a.owner_id = sqlc.arg(user_id)::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   );
 
 -- name: CategorizeTransactionAtomic :one
@@ -243,6 +382,7 @@ set
 where
   id = sqlc.arg(id)::bigint
   and category_manually_set = false
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -251,22 +391,30 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = sqlc.arg(user_id)::uuid
     where
-      a.owner_id = sqlc.arg(user_id)::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   )
 returning
   id,
   category_manually_set;
 
--- name: BulkCategorizeTransactions :execrows
+-- name: BulkCategorizeTransactions :many
+-- Returns the previous category alongside the new one for the history log.
 update
-  transactions
+  transactions t
 set
   category_id = sqlc.arg(category_id)::bigint,
   category_manually_set = true
+from
+  transactions old
 where
-  id = ANY(sqlc.arg(transaction_ids)::bigint [])
-  and account_id in (
+  old.id = t.id
+  and t.id = ANY(sqlc.arg(transaction_ids)::bigint [])
+  and t.deleted_at is null
+  and t.account_id in (
     select
       a.id
     from
@@ -274,15 +422,213 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = sqlc.arg(user_id)::uuid
     where
-      a.owner_id = sqlc.arg(user_id)::uuid
-      or au.user_id is not null
-  );
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
+  )
+returning
+  t.id,
+  old.category_id as old_category_id;
+
+-- name: AggregateTransactions :many
+-- Groups the transactions matching the list filters by up to three
+-- dimensions. dim1..dim3 are 1-based positions in the keys array (0 leaves
+-- the slot empty). Missing values, like the category of an uncategorized
+-- transaction, group under ''. Amounts are signed, incoming positive and
+-- outgoing negative, so a group mixing both directions nets out. Split
+-- transactions contribute each part to its own category when split_parts is
+-- set, and a transaction with several tags counts towards each of them.
+-- Dates are bucketed in tz.
+with facts as (
+  select
+    t.id,
+    t.tx_currency as currency,
+    case when t.tx_direction = 1 then p.amount_cents else -p.amount_cents end as amount_cents,
+    array [
+      coalesce(c.slug, ''),
+      coalesce(regexp_replace(c.slug, '\.[^.]*$', ''), ''),
+      coalesce(m.name, t.merchant, ''),
+      a.name,
+      coalesce(g.slug, ''),
+      case when t.tx_direction = 1 then 'incoming' else 'outgoing' end,
+      to_char(t.tx_date at time zone sqlc.arg(tz)::text, 'YYYY-MM-DD'),
+      to_char(date_trunc('week', t.tx_date at time zone sqlc.arg(tz)::text), 'YYYY-MM-DD'),
+      to_char(t.tx_date at time zone sqlc.arg(tz)::text, 'YYYY-MM'),
+      to_char(t.tx_date at time zone sqlc.arg(tz)::text, 'YYYY')
+    ]::text [] as keys
+  from
+    filter_transactions(
+      p_user_id => sqlc.arg(user_id)::uuid,
+      p_start => sqlc.narg('start')::timestamptz,
+      p_end => sqlc.narg('end')::timestamptz,
+      p_amount_min_cents => sqlc.narg('amount_min_cents')::bigint,
+      p_amount_max_cents => sqlc.narg('amount_max_cents')::bigint,
+      p_direction => sqlc.narg('direction')::smallint,
+      p_account_ids => sqlc.narg('account_ids')::bigint [],
+      p_categories => sqlc.narg('categories')::text [],
+      p_category_patterns => sqlc.narg('category_patterns')::text [],
+      p_exclude_category_patterns => sqlc.narg('exclude_category_patterns')::text [],
+      p_account_names => sqlc.narg('account_names')::text [],
+      p_exclude_account_ids => sqlc.narg('exclude_account_ids')::bigint [],
+      p_exclude_account_names => sqlc.narg('exclude_account_names')::text [],
+      p_tags => sqlc.narg('tags')::text [],
+      p_exclude_tags => sqlc.narg('exclude_tags')::text [],
+      p_merchant_q => sqlc.narg('merchant_q')::text,
+      p_exclude_merchant_q => sqlc.narg('exclude_merchant_q')::text,
+      p_desc_q => sqlc.narg('desc_q')::text,
+      p_currency => sqlc.narg('currency')::char(3),
+      p_tod_start => sqlc.narg('tod_start')::time,
+      p_tod_end => sqlc.narg('tod_end')::time,
+      p_uncategorized => sqlc.narg('uncategorized')::boolean,
+      p_statuses => sqlc.narg('statuses')::smallint []
+    ) t
+    join accounts a on t.account_id = a.id
+    cross join lateral (
+      select
+        s.category_id,
+        s.amount_cents
+      from
+        transaction_splits s
+      where
+        s.transaction_id = t.id
+        and sqlc.arg(split_parts)::boolean
+      union all
+      select
+        t.category_id,
+        t.tx_amount_cents
+      where
+        not (
+          sqlc.arg(split_parts)::boolean
+          and exists (
+            select
+              1
+            from
+              transaction_splits s
+            where
+              s.transaction_id = t.id
+          )
+        )
+    ) p
+    left join categories c on p.category_id = c.id
+    left join merchants m on t.merchant_id = m.id
+    left join lateral (
+      select
+        g.slug
+      from
+        transaction_tags tt
+        join tags g on tt.tag_id = g.id
+      where
+        tt.transaction_id = t.id
+        and sqlc.arg(by_tag)::boolean
+    ) g on true
+  where
+    (
+      sqlc.arg(include_transfers)::boolean
+      or not exists (
+        select
+          1
+        from
+          transfers x
+        where
+          x.dismissed_at is null
+          and t.id in (x.outgoing_tx_id, x.incoming_tx_id)
+      )
+    )
+)
+select
+  coalesce(f.keys [sqlc.arg(dim1)::int], '')::text as key1,
+  coalesce(f.keys [sqlc.arg(dim2)::int], '')::text as key2,
+  coalesce(f.keys [sqlc.arg(dim3)::int], '')::text as key3,
+  f.currency::text as currency,
+  COUNT(distinct f.id)::bigint as transaction_count,
+  SUM(f.amount_cents)::bigint as sum_cents,
+  round(AVG(f.amount_cents))::bigint as avg_cents,
+  MIN(f.amount_cents)::bigint as min_cents,
+  MAX(f.amount_cents)::bigint as max_cents,
+  round(percentile_cont(0.5) within group (order by f.amount_cents))::bigint as median_cents
+from
+  facts f
+group by
+  1,
+  2,
+  3,
+  f.currency
+order by
+  1,
+  2,
+  3,
+  f.currency;
+
+-- name: BulkUpdateTransactions :many
+-- Sets the given fields on every listed transaction. A zero category and an
+-- empty merchant or note clear the field. Returns the previous values
+-- alongside the new ones for the history log.
+update
+  transactions t
+set
+  category_id = case
+    when sqlc.narg('category_id')::bigint is null then t.category_id
+    else nullif(sqlc.narg('category_id')::bigint, 0)
+  end,
+  category_manually_set = case
+    when sqlc.narg('category_id')::bigint is null then t.category_manually_set
+    else sqlc.narg('category_id')::bigint <> 0
+  end,
+  merchant = case
+    when sqlc.narg('merchant')::text is null then t.merchant
+    else nullif(sqlc.narg('merchant')::text, '')
+  end,
+  merchant_manually_set = case
+    when sqlc.narg('merchant')::text is null then t.merchant_manually_set
+    else sqlc.narg('merchant')::text <> ''
+  end,
+  user_notes = case
+    when sqlc.narg('user_notes')::text is null then t.user_notes
+    else nullif(sqlc.narg('user_notes')::text, '')
+  end,
+  account_id = coalesce(sqlc.narg('account_id')::bigint, t.account_id)
+from
+  transactions old
+where
+  old.id = t.id
+  and t.id = ANY(sqlc.arg(transaction_ids)::bigint [])
+  and t.deleted_at is null
+  and t.account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = sqlc.arg(user_id)::uuid
+    where
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
+  )
+returning
+  t.id,
+  old.account_id as old_account_id,
+  t.account_id,
+  old.category_id as old_category_id,
+  t.category_id,
+  old.merchant as old_merchant,
+  t.merchant,
+  old.user_notes as old_user_notes,
+  t.user_notes;
 
 -- name: BulkDeleteTransactions :execrows
-delete from
+-- Moves the transactions to the trash, PurgeTransactions removes them for good.
+update
   transactions
+set
+  deleted_at = now()
 where
   id = ANY(sqlc.arg(transaction_ids)::bigint [])
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -291,8 +637,11 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = sqlc.arg(user_id)::uuid
     where
-      a.owner_id = sqlc.arg(user_id)::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   );
 
 -- name: GetTransactionCountByAccount :many
@@ -305,9 +654,13 @@ from
   left join account_users au on a.id = au.account_id
   and au.user_id = sqlc.arg(user_id)::uuid
   left join transactions t on a.id = t.account_id
+  and t.deleted_at is null
 where
-  a.owner_id = sqlc.arg(user_id)::uuid
-  or au.user_id is not null
+  (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null
 group by
   a.id,
   a.name
@@ -328,6 +681,8 @@ where
     a.owner_id = sqlc.arg(user_id)::uuid
     or au.user_id is not null
   )
+  and t.deleted_at is null
+  and a.deleted_at is null
   and t.tx_direction = 2
   and t.tx_date >= (sqlc.arg(date)::date - interval '60 days')
   and t.tx_amount_cents between sqlc.arg(total_cents)::bigint and (sqlc.arg(total_cents)::bigint * 120 / 100)
@@ -358,6 +713,80 @@ where
     a.owner_id = sqlc.arg(user_id)::uuid
     or au.user_id is not null
   )
+  and t.deleted_at is null
+  and a.deleted_at is null
 order by
   t.tx_date desc,
   t.id desc;
+
+-- name: ListDeletedTransactions :many
+-- Transactions in the trash. Those of a trashed account are listed with the account.
+select
+  t.*
+from
+  transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id
+  and au.user_id = sqlc.arg(user_id)::uuid
+where
+  (
+    a.owner_id = sqlc.arg(user_id)::uuid
+    or au.user_id is not null
+  )
+  and t.deleted_at is not null
+  and a.deleted_at is null
+order by
+  t.deleted_at desc,
+  t.id desc
+limit
+  sqlc.arg('limit')::int offset sqlc.arg('offset')::int;
+
+-- name: RestoreTransactions :many
+-- Returns the accounts of the restored rows so their balances can be resynced.
+update
+  transactions
+set
+  deleted_at = null
+where
+  id = ANY(sqlc.arg(transaction_ids)::bigint [])
+  and deleted_at is not null
+  and account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = sqlc.arg(user_id)::uuid
+    where
+      (
+        a.owner_id = sqlc.arg(user_id)::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
+  )
+returning
+  account_id;
+
+-- name: PurgeTransactions :execrows
+delete from
+  transactions
+where
+  id = ANY(sqlc.arg(transaction_ids)::bigint [])
+  and deleted_at is not null
+  and account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = sqlc.arg(user_id)::uuid
+    where
+      a.owner_id = sqlc.arg(user_id)::uuid
+      or au.user_id is not null
+  );
+
+-- name: PurgeExpiredTransactions :execrows
+delete from
+  transactions
+where
+  deleted_at < @cutoff::timestamptz;
@@ -0,0 +1,111 @@
+-- name: ListTransferCandidates :many
+-- Outgoing/incoming pairs across two accounts the user owns with the same
+-- amount and currency inside the date window, best description match first.
+select
+  o.id as outgoing_id,
+  i.id as incoming_id,
+  similarity(lower(coalesce(o.tx_desc, '')), lower(coalesce(i.tx_desc, ''))) as score,
+  abs(extract(epoch from (i.tx_date - o.tx_date)))::bigint as seconds_apart
+from
+  transactions o
+  join accounts oa on o.account_id = oa.id
+  join transactions i on i.tx_amount_cents = o.tx_amount_cents
+  and i.tx_currency = o.tx_currency
+  and i.account_id <> o.account_id
+  join accounts ia on i.account_id = ia.id
+where
+  oa.owner_id = @user_id::uuid
+  and ia.owner_id = @user_id::uuid
+  and oa.deleted_at is null
+  and ia.deleted_at is null
+  and o.deleted_at is null
+  and i.deleted_at is null
+  and o.tx_direction = 2
+  and i.tx_direction = 1
+  and i.tx_date between o.tx_date - make_interval(days => @window_days::int)
+  and o.tx_date + make_interval(days => @window_days::int)
+  and (
+    sqlc.narg('start')::timestamptz is null
+    or o.tx_date >= sqlc.narg('start')::timestamptz
+  )
+  and (
+    sqlc.narg('end')::timestamptz is null
+    or o.tx_date <= sqlc.narg('end')::timestamptz
+  )
+  and (
+    sqlc.narg('transaction_ids')::bigint [] is null
+    or o.id = ANY(sqlc.narg('transaction_ids')::bigint [])
+    or i.id = ANY(sqlc.narg('transaction_ids')::bigint [])
+  )
+  and similarity(lower(coalesce(o.tx_desc, '')), lower(coalesce(i.tx_desc, ''))) >= @min_similarity::real
+  and not exists (
+    select
+      1
+    from
+      transfers x
+    where
+      (
+        x.dismissed_at is null
+        and x.outgoing_tx_id in (o.id, i.id)
+      )
+      or (
+        x.dismissed_at is null
+        and x.incoming_tx_id in (o.id, i.id)
+      )
+      or (
+        x.outgoing_tx_id = o.id
+        and x.incoming_tx_id = i.id
+      )
+  )
+order by
+  score desc,
+  seconds_apart,
+  o.id,
+  i.id;
+
+-- name: CreateTransfer :one
+insert into
+  transfers (user_id, outgoing_tx_id, incoming_tx_id, auto_detected)
+values
+  (
+    @user_id::uuid,
+    @outgoing_tx_id::bigint,
+    @incoming_tx_id::bigint,
+    @auto_detected::boolean
+  )
+returning
+  *;
+
+-- name: DeleteDismissedTransfer :execrows
+delete from
+  transfers
+where
+  user_id = @user_id::uuid
+  and outgoing_tx_id = @outgoing_tx_id::bigint
+  and incoming_tx_id = @incoming_tx_id::bigint
+  and dismissed_at is not null;
+
+-- name: DismissTransfer :execrows
+update
+  transfers
+set
+  dismissed_at = NOW()
+where
+  user_id = @user_id::uuid
+  and dismissed_at is null
+  and (
+    outgoing_tx_id = @transaction_id::bigint
+    or incoming_tx_id = @transaction_id::bigint
+  );
+
+-- name: ListTransfersForTransactions :many
+select
+  *
+from
+  transfers
+where
+  dismissed_at is null
+  and (
+    outgoing_tx_id = ANY(@transaction_ids::bigint [])
+    or incoming_tx_id = ANY(@transaction_ids::bigint [])
+  );
@@ -23,7 +23,8 @@ insert into
     anchor_balance_cents,
     anchor_currency,
     main_currency,
-    colors
+    colors,
+    include_pending
   )
 values
   (
@@ -35,10 +36,11 @@ values
     $6::bigint,
     $7::char(3),
     $8::char(3),
-    $9::text []
+    $9::text [],
+    $10::boolean
   )
 returning
-  id, owner_id, name, bank, account_type, alias, anchor_date, anchor_balance_cents, anchor_currency, main_currency, colors, created_at, updated_at
+  id, owner_id, name, bank, account_type, alias, anchor_date, anchor_balance_cents, anchor_currency, main_currency, colors, created_at, updated_at, deleted_at, include_pending
 `
 
 type CreateAccountParams struct {
@@ -51,6 +53,7 @@ type CreateAccountParams struct {
 	AnchorCurrency     string    `db:"anchor_currency" json:"anchor_currency"`
 	MainCurrency       string    `db:"main_currency" json:"main_currency"`
 	Colors             []string  `db:"colors" json:"colors"`
+	IncludePending     bool      `db:"include_pending" json:"include_pending"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
@@ -64,6 +67,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		arg.AnchorCurrency,
 		arg.MainCurrency,
 		arg.Colors,
+		arg.IncludePending,
 	)
 	var i Account
 	err := row.Scan(
@@ -80,16 +84,21 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Colors,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.IncludePending,
 	)
 	return i, err
 }
 
 const deleteAccount = `-- name: DeleteAccount :execrows
-delete from
+update
   accounts
+set
+  deleted_at = now()
 where
   id = $1::bigint
   and owner_id = $2::uuid
+  and deleted_at is null
 `
 
 type DeleteAccountParams struct {
@@ -97,6 +106,7 @@ type DeleteAccountParams struct {
 	UserID uuid.UUID `db:"user_id" json:"user_id"`
 }
 
+// Moves the account to the trash; its transactions stay but are hidden with it.
 func (q *Queries) DeleteAccount(ctx context.Context, arg DeleteAccountParams) (int64, error) {
 	result, err := q.db.Exec(ctx, deleteAccount, arg.ID, arg.UserID)
 	if err != nil {
@@ -107,11 +117,13 @@ func (q *Queries) DeleteAccount(ctx context.Context, arg DeleteAccountParams) (i
 
 const getAccount = `-- name: GetAccount :one
 select
-  a.id, a.owner_id, a.name, a.bank, a.account_type, a.alias, a.anchor_date, a.anchor_balance_cents, a.anchor_currency, a.main_currency, a.colors, a.created_at, a.updated_at,
+  a.id, a.owner_id, a.name, a.bank, a.account_type, a.alias, a.anchor_date, a.anchor_balance_cents, a.anchor_currency, a.main_currency, a.colors, a.created_at, a.updated_at, a.deleted_at, a.include_pending,
   COALESCE(
     (select t.balance_after_cents
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_balance_cents
@@ -120,6 +132,8 @@ select
     (select t.balance_currency
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_currency
@@ -134,6 +148,7 @@ where
     a.owner_id = $1::uuid
     or au.user_id is not null
   )
+  and a.deleted_at is null
 `
 
 type GetAccountParams struct {
@@ -164,6 +179,8 @@ func (q *Queries) GetAccount(ctx context.Context, arg GetAccountParams) (GetAcco
 		&i.Account.Colors,
 		&i.Account.CreatedAt,
 		&i.Account.UpdatedAt,
+		&i.Account.DeletedAt,
+		&i.Account.IncludePending,
 		&i.BalanceCents,
 		&i.BalanceCurrency,
 	)
@@ -200,6 +217,8 @@ from
   transactions
 where
   account_id = $1::bigint
+  and deleted_at is null
+  and balance_after_cents is not null
 order by
   tx_date desc,
   id desc
@@ -227,8 +246,11 @@ from
   left join account_users au on a.id = au.account_id
   and au.user_id = $1::uuid
 where
-  a.owner_id = $1::uuid
-  or au.user_id is not null
+  (
+    a.owner_id = $1::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null
 `
 
 func (q *Queries) GetUserAccountsCount(ctx context.Context, userID uuid.UUID) (int64, error) {
@@ -240,11 +262,13 @@ func (q *Queries) GetUserAccountsCount(ctx context.Context, userID uuid.UUID) (i
 
 const listAccounts = `-- name: ListAccounts :many
 select
-  a.id, a.owner_id, a.name, a.bank, a.account_type, a.alias, a.anchor_date, a.anchor_balance_cents, a.anchor_currency, a.main_currency, a.colors, a.created_at, a.updated_at,
+  a.id, a.owner_id, a.name, a.bank, a.account_type, a.alias, a.anchor_date, a.anchor_balance_cents, a.anchor_currency, a.main_currency, a.colors, a.created_at, a.updated_at, a.deleted_at, a.include_pending,
   COALESCE(
     (select t.balance_after_cents
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_balance_cents
@@ -253,6 +277,8 @@ select
     (select t.balance_currency
      from transactions t
      where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_currency
@@ -262,8 +288,11 @@ from
   left join account_users au on au.account_id = a.id
   and au.user_id = $1::uuid
 where
-  a.owner_id = $1::uuid
-  or au.user_id is not null
+  (
+    a.owner_id = $1::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null
 order by
   (a.owner_id = $1::uuid) desc,
   a.created_at
@@ -298,6 +327,8 @@ func (q *Queries) ListAccounts(ctx context.Context, userID uuid.UUID) ([]ListAcc
 			&i.Account.Colors,
 			&i.Account.CreatedAt,
 			&i.Account.UpdatedAt,
+			&i.Account.DeletedAt,
+			&i.Account.IncludePending,
 			&i.BalanceCents,
 			&i.BalanceCurrency,
 		); err != nil {
@@ -311,6 +342,144 @@ func (q *Queries) ListAccounts(ctx context.Context, userID uuid.UUID) ([]ListAcc
 	return items, nil
 }
 
+const listDeletedAccounts = `-- name: ListDeletedAccounts :many
+select
+  a.id, a.owner_id, a.name, a.bank, a.account_type, a.alias, a.anchor_date, a.anchor_balance_cents, a.anchor_currency, a.main_currency, a.colors, a.created_at, a.updated_at, a.deleted_at, a.include_pending,
+  COALESCE(
+    (select t.balance_after_cents
+     from transactions t
+     where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
+     order by t.tx_date desc, t.id desc
+     limit 1),
+    a.anchor_balance_cents
+  ) as balance_cents,
+  COALESCE(
+    (select t.balance_currency
+     from transactions t
+     where t.account_id = a.id
+       and t.deleted_at is null
+       and t.balance_after_cents is not null
+     order by t.tx_date desc, t.id desc
+     limit 1),
+    a.anchor_currency
+  ) as balance_currency
+from
+  accounts a
+where
+  a.owner_id = $1::uuid
+  and a.deleted_at is not null
+order by
+  a.deleted_at desc
+`
+
+type ListDeletedAccountsRow struct {
+	Account         Account `db:"account" json:"account"`
+	BalanceCents    int64   `db:"balance_cents" json:"balance_cents"`
+	BalanceCurrency string  `db:"balance_currency" json:"balance_currency"`
+}
+
+func (q *Queries) ListDeletedAccounts(ctx context.Context, userID uuid.UUID) ([]ListDeletedAccountsRow, error) {
+	rows, err := q.db.Query(ctx, listDeletedAccounts, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeletedAccountsRow
+	for rows.Next() {
+		var i ListDeletedAccountsRow
+		if err := rows.Scan(
+			&i.Account.ID,
+			&i.Account.OwnerID,
+			&i.Account.Name,
+			&i.Account.Bank,
+			&i.Account.AccountType,
+			&i.Account.Alias,
+			&i.Account.AnchorDate,
+			&i.Account.AnchorBalanceCents,
+			&i.Account.AnchorCurrency,
+			&i.Account.MainCurrency,
+			&i.Account.Colors,
+			&i.Account.CreatedAt,
+			&i.Account.UpdatedAt,
+			&i.Account.DeletedAt,
+			&i.Account.IncludePending,
+			&i.BalanceCents,
+			&i.BalanceCurrency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeAccount = `-- name: PurgeAccount :execrows
+delete from
+  accounts
+where
+  id = $1::bigint
+  and owner_id = $2::uuid
+  and deleted_at is not null
+`
+
+type PurgeAccountParams struct {
+	ID     int64     `db:"id" json:"id"`
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+// Hard delete, only for accounts already in the trash. Cascades to transactions.
+func (q *Queries) PurgeAccount(ctx context.Context, arg PurgeAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeAccount, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeExpiredAccounts = `-- name: PurgeExpiredAccounts :execrows
+delete from
+  accounts
+where
+  deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeExpiredAccounts(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeExpiredAccounts, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreAccount = `-- name: RestoreAccount :execrows
+update
+  accounts
+set
+  deleted_at = null
+where
+  id = $1::bigint
+  and owner_id = $2::uuid
+  and deleted_at is not null
+`
+
+type RestoreAccountParams struct {
+	ID     int64     `db:"id" json:"id"`
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) RestoreAccount(ctx context.Context, arg RestoreAccountParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreAccount, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const setAccountAnchor = `-- name: SetAccountAnchor :execrows
 update
   accounts
@@ -321,6 +490,7 @@ set
 where
   id = $3::bigint
   and owner_id = $4::uuid
+  and deleted_at is null
 `
 
 type SetAccountAnchorParams struct {
@@ -360,6 +530,11 @@ with anchor_transactions as (
     join accounts a on t.account_id = a.id
   where
     t.account_id = $1::bigint
+    and t.deleted_at is null
+    and (
+      t.status = 2
+      or (t.status = 1 and a.include_pending)
+    )
 ),
 before_anchor as (
   select
@@ -397,19 +572,32 @@ after_anchor as (
     anchor_transactions
   where
     is_after_anchor = 1
+),
+balances as (
+  select
+    coalesce(ba.id, aa.id) as id,
+    coalesce(ba.balance_after_cents, aa.balance_after_cents) as balance_after_cents,
+    coalesce(ba.main_currency, aa.main_currency) as main_currency
+  from
+    before_anchor ba
+    full outer join after_anchor aa on ba.id = aa.id
 )
 update
   transactions
 set
-  balance_after_cents = coalesce(ba.balance_after_cents, aa.balance_after_cents),
-  balance_currency = coalesce(ba.main_currency, aa.main_currency)
+  balance_after_cents = b.balance_after_cents,
+  balance_currency = coalesce(b.main_currency, transactions.balance_currency)
 from
-  before_anchor ba
-  full outer join after_anchor aa on ba.id = aa.id
+  transactions x
+  left join balances b on b.id = x.id
 where
-  transactions.id = coalesce(ba.id, aa.id)
+  transactions.id = x.id
+  and x.account_id = $1::bigint
+  and x.deleted_at is null
 `
 
+// Only posted transactions, plus pending ones on accounts that include them,
+// get a running balance; the others have theirs cleared.
 func (q *Queries) SyncAccountBalances(ctx context.Context, accountID int64) error {
 	_, err := q.db.Exec(ctx, syncAccountBalances, accountID)
 	return err
@@ -427,10 +615,12 @@ set
   anchor_balance_cents = coalesce($6::bigint, anchor_balance_cents),
   anchor_currency = coalesce($7::char(3), anchor_currency),
   main_currency = coalesce($8::char(3), main_currency),
-  colors = coalesce($9::text [], colors)
+  colors = coalesce($9::text [], colors),
+  include_pending = coalesce($10::boolean, include_pending)
 where
-  id = $10::bigint
-  and owner_id = $11::uuid
+  id = $11::bigint
+  and owner_id = $12::uuid
+  and deleted_at is null
 `
 
 type UpdateAccountParams struct {
@@ -443,6 +633,7 @@ type UpdateAccountParams struct {
 	AnchorCurrency     *string    `db:"anchor_currency" json:"anchor_currency"`
 	MainCurrency       *string    `db:"main_currency" json:"main_currency"`
 	Colors             []string   `db:"colors" json:"colors"`
+	IncludePending     *bool      `db:"include_pending" json:"include_pending"`
 	ID                 int64      `db:"id" json:"id"`
 	UserID             uuid.UUID  `db:"user_id" json:"user_id"`
 }
@@ -458,6 +649,7 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) er
 		arg.AnchorCurrency,
 		arg.MainCurrency,
 		arg.Colors,
+		arg.IncludePending,
 		arg.ID,
 		arg.UserID,
 	)
@@ -22,7 +22,7 @@ select
   COALESCE(
     (select t.balance_after_cents
      from transactions t
-     where t.account_id = a.id
+     where t.account_id = a.id and t.deleted_at is null and t.balance_after_cents is not null
      order by t.tx_date desc, t.id desc
      limit 1),
     a.anchor_balance_cents
@@ -30,6 +30,7 @@ select
 from accounts a
 left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
 where (a.owner_id = $1::uuid or au.user_id is not null)
+  and a.deleted_at is null
 order by
   case a.account_type
     when 1 then 1
@@ -77,17 +78,50 @@ func (q *Queries) GetAccountBalances(ctx context.Context, userID uuid.UUID) ([]G
 }
 
 const getDashboardSummary = `-- name: GetDashboardSummary :one
+with refunded as (
+  select x.original_tx_id, SUM(r.tx_amount_cents)::bigint as cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+  where (a.owner_id = $1::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and a.deleted_at is null
+  group by x.original_tx_id
+)
 select
   COUNT(distinct a.id)::bigint as total_accounts,
   COUNT(t.id)::bigint as total_transactions,
-  COALESCE(SUM(case when t.tx_direction = 1 then t.tx_amount_cents else 0 end), 0)::bigint as total_income_cents,
-  COALESCE(SUM(case when t.tx_direction = 2 then t.tx_amount_cents else 0 end), 0)::bigint as total_expense_cents,
+  COALESCE(SUM(case
+    when t.tx_direction = 1 and not exists (
+      select 1 from refunds x
+      join transactions o on o.id = x.original_tx_id
+      where x.refund_tx_id = t.id and x.dismissed_at is null and o.deleted_at is null and o.status <> 3
+    ) and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id)) then t.tx_amount_cents
+    else 0
+  end), 0)::bigint as total_income_cents,
+  COALESCE(SUM(case
+    when t.tx_direction = 2 and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+      then t.tx_amount_cents - coalesce(f.cents, 0)
+    else 0
+  end), 0)::bigint as total_expense_cents,
   COUNT(distinct case when t.tx_date >= CURRENT_DATE - interval '30 days' then t.id end)::bigint as transactions_last_30_days,
-  COUNT(distinct case when t.category_id is null then t.id end)::bigint as uncategorized_transactions
+  -- a split transaction is uncategorized while any of its parts is
+  COUNT(distinct case when (
+    case
+      when exists (select 1 from transaction_splits s where s.transaction_id = t.id)
+        then exists (select 1 from transaction_splits s where s.transaction_id = t.id and s.category_id is null)
+      else t.category_id is null
+    end
+  ) then t.id end)::bigint as uncategorized_transactions
 from accounts a
 left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
-left join transactions t on a.id = t.account_id
+left join transactions t on a.id = t.account_id and t.deleted_at is null and t.status <> 3
+left join refunded f on f.original_tx_id = t.id
 where (a.owner_id = $1::uuid or au.user_id is not null)
+  and a.deleted_at is null
   and ($2::timestamptz is null or t.tx_date >= $2::timestamptz)
   and ($3::timestamptz is null or t.tx_date <= $3::timestamptz)
 `
@@ -107,6 +141,9 @@ type GetDashboardSummaryRow struct {
 	UncategorizedTransactions int64 `db:"uncategorized_transactions" json:"uncategorized_transactions"`
 }
 
+// Linked refunds are taken off the purchase's spending in the purchase's
+// period instead of counting as income, like in trends. Linked transfers
+// count as transactions but not as income or expense.
 func (q *Queries) GetDashboardSummary(ctx context.Context, arg GetDashboardSummaryParams) (GetDashboardSummaryRow, error) {
 	row := q.db.QueryRow(ctx, getDashboardSummary, arg.UserID, arg.Start, arg.End)
 	var i GetDashboardSummaryRow
@@ -122,16 +159,43 @@ func (q *Queries) GetDashboardSummary(ctx context.Context, arg GetDashboardSumma
 }
 
 const getDashboardTrends = `-- name: GetDashboardTrends :many
+with flows as (
+  select t.tx_date, t.tx_direction, t.tx_amount_cents
+  from transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+  where (a.owner_id = $1::uuid or au.user_id is not null)
+    and t.deleted_at is null
+    and t.status <> 3
+    and a.deleted_at is null
+    and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+    and not exists (
+      select 1 from refunds x
+      join transactions o on o.id = x.original_tx_id
+      where x.refund_tx_id = t.id and x.dismissed_at is null and o.deleted_at is null and o.status <> 3
+    )
+  union all
+  select o.tx_date, o.tx_direction, -r.tx_amount_cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join transactions o on o.id = x.original_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+  where (a.owner_id = $1::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and o.deleted_at is null
+    and o.status <> 3
+    and a.deleted_at is null
+)
 select
-  to_char(t.tx_date::date, 'YYYY-MM-DD') as date,
-  SUM(case when t.tx_direction = 1 then t.tx_amount_cents else 0 end)::bigint as income_cents,
-  SUM(case when t.tx_direction = 2 then t.tx_amount_cents else 0 end)::bigint as expense_cents
-from transactions t
-join accounts a on t.account_id = a.id
-left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
-where (a.owner_id = $1::uuid or au.user_id is not null)
-  and ($2::timestamptz is null or t.tx_date >= $2::timestamptz)
-  and ($3::timestamptz is null or t.tx_date <= $3::timestamptz)
+  to_char(f.tx_date::date, 'YYYY-MM-DD') as date,
+  SUM(case when f.tx_direction = 1 then f.tx_amount_cents else 0 end)::bigint as income_cents,
+  SUM(case when f.tx_direction = 2 then f.tx_amount_cents else 0 end)::bigint as expense_cents
+from flows f
+where ($2::timestamptz is null or f.tx_date >= $2::timestamptz)
+  and ($3::timestamptz is null or f.tx_date <= $3::timestamptz)
 group by date
 order by date
 `
@@ -148,6 +212,8 @@ type GetDashboardTrendsRow struct {
 	ExpenseCents int64  `db:"expense_cents" json:"expense_cents"`
 }
 
+// A linked refund counts as less spending on the day of the purchase, not as
+// income on the day it arrives.
 func (q *Queries) GetDashboardTrends(ctx context.Context, arg GetDashboardTrendsParams) ([]GetDashboardTrendsRow, error) {
 	rows, err := q.db.Query(ctx, getDashboardTrends, arg.UserID, arg.Start, arg.End)
 	if err != nil {
@@ -174,6 +240,9 @@ from transactions t
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
 where (a.owner_id = $1::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
 `
 
 func (q *Queries) GetEarliestTransactionDate(ctx context.Context, userID uuid.UUID) (time.Time, error) {
@@ -184,17 +253,44 @@ func (q *Queries) GetEarliestTransactionDate(ctx context.Context, userID uuid.UU
 }
 
 const getMonthlyComparison = `-- name: GetMonthlyComparison :many
+with flows as (
+  select t.tx_date, t.tx_direction, t.tx_amount_cents
+  from transactions t
+  join accounts a on t.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+  where (a.owner_id = $1::uuid or au.user_id is not null)
+    and t.deleted_at is null
+    and t.status <> 3
+    and a.deleted_at is null
+    and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+    and not exists (
+      select 1 from refunds x
+      join transactions o on o.id = x.original_tx_id
+      where x.refund_tx_id = t.id and x.dismissed_at is null and o.deleted_at is null and o.status <> 3
+    )
+  union all
+  select o.tx_date, o.tx_direction, -r.tx_amount_cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join transactions o on o.id = x.original_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+  where (a.owner_id = $1::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and o.deleted_at is null
+    and o.status <> 3
+    and a.deleted_at is null
+)
 select
-  to_char(t.tx_date, 'YYYY-MM') as month,
-  SUM(case when t.tx_direction = 1 then t.tx_amount_cents else 0 end)::bigint as income_cents,
-  SUM(case when t.tx_direction = 2 then t.tx_amount_cents else 0 end)::bigint as expense_cents,
-  SUM(case when t.tx_direction = 1 then t.tx_amount_cents else -t.tx_amount_cents end)::bigint as net_cents
-from transactions t
-join accounts a on t.account_id = a.id
-left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
-where (a.owner_id = $1::uuid or au.user_id is not null)
-  and t.tx_date >= COALESCE($2::timestamptz, CURRENT_DATE - interval '12 months')
-  and t.tx_date <= COALESCE($3::timestamptz, CURRENT_DATE)
+  to_char(f.tx_date, 'YYYY-MM') as month,
+  SUM(case when f.tx_direction = 1 then f.tx_amount_cents else 0 end)::bigint as income_cents,
+  SUM(case when f.tx_direction = 2 then f.tx_amount_cents else 0 end)::bigint as expense_cents,
+  SUM(case when f.tx_direction = 1 then f.tx_amount_cents else -f.tx_amount_cents end)::bigint as net_cents
+from flows f
+where f.tx_date >= COALESCE($2::timestamptz, CURRENT_DATE - interval '12 months')
+  and f.tx_date <= COALESCE($3::timestamptz, CURRENT_DATE)
 group by month
 order by month
 `
@@ -212,6 +308,7 @@ type GetMonthlyComparisonRow struct {
 	NetCents     int64  `db:"net_cents" json:"net_cents"`
 }
 
+// Linked refunds reduce spending in the month of the purchase, like in trends.
 func (q *Queries) GetMonthlyComparison(ctx context.Context, arg GetMonthlyComparisonParams) ([]GetMonthlyComparisonRow, error) {
 	rows, err := q.db.Query(ctx, getMonthlyComparison, arg.UserID, arg.Start, arg.End)
 	if err != nil {
@@ -260,11 +357,11 @@ account_balances_at_date as (
       -- If there's a transaction on or before the period date, use its balance
       WHEN EXISTS (
         select 1 from transactions t
-        where t.account_id = a.id and t.tx_date <= ds.period_date
+        where t.account_id = a.id and t.deleted_at is null and t.balance_after_cents is not null and t.tx_date <= ds.period_date
       ) THEN (
         select t.balance_after_cents
         from transactions t
-        where t.account_id = a.id and t.tx_date <= ds.period_date
+        where t.account_id = a.id and t.deleted_at is null and t.balance_after_cents is not null and t.tx_date <= ds.period_date
         order by t.tx_date desc, t.id desc
         limit 1
       )
@@ -277,6 +374,7 @@ account_balances_at_date as (
   cross join accounts a
   left join account_users au on a.id = au.account_id and au.user_id = $4::uuid
   where (a.owner_id = $4::uuid or au.user_id is not null)
+    and a.deleted_at is null
 )
 select
   to_char(ab.period_date, 'YYYY-MM-DD') as date,
@@ -324,19 +422,46 @@ func (q *Queries) GetNetWorthHistory(ctx context.Context, arg GetNetWorthHistory
 }
 
 const getTopCategories = `-- name: GetTopCategories :many
+with refunded as (
+  select x.original_tx_id, SUM(r.tx_amount_cents)::bigint as cents
+  from refunds x
+  join transactions r on r.id = x.refund_tx_id
+  join accounts a on r.account_id = a.id
+  left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+  where (a.owner_id = $1::uuid or au.user_id is not null)
+    and x.dismissed_at is null
+    and r.deleted_at is null
+    and r.status <> 3
+    and a.deleted_at is null
+  group by x.original_tx_id
+)
 select
   c.slug,
   c.color,
-  COUNT(t.id)::bigint as transaction_count,
-  SUM(t.tx_amount_cents)::bigint as total_amount_cents
+  COUNT(distinct t.id)::bigint as transaction_count,
+  SUM(p.amount_cents - coalesce(f.cents * p.amount_cents / t.tx_amount_cents, 0))::bigint as total_amount_cents
 from transactions t
-join categories c on t.category_id = c.id
+-- split transactions contribute each part to its own category
+cross join lateral (
+  select s.category_id, s.amount_cents
+  from transaction_splits s
+  where s.transaction_id = t.id
+  union all
+  select t.category_id, t.tx_amount_cents
+  where not exists (select 1 from transaction_splits s where s.transaction_id = t.id)
+) p
+join categories c on p.category_id = c.id
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+left join refunded f on f.original_tx_id = t.id
 where (a.owner_id = $1::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
   and t.tx_direction = 2
   and ($2::timestamptz is null or t.tx_date >= $2::timestamptz)
   and ($3::timestamptz is null or t.tx_date <= $3::timestamptz)
+  and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
 group by c.id, c.slug, c.color
 order by total_amount_cents desc
 limit COALESCE($4::int, 10)
@@ -356,6 +481,8 @@ type GetTopCategoriesRow struct {
 	TotalAmountCents int64  `db:"total_amount_cents" json:"total_amount_cents"`
 }
 
+// Linked refunds are taken off the purchase's categories in the purchase's
+// period; a split purchase gives back to each part pro rata.
 func (q *Queries) GetTopCategories(ctx context.Context, arg GetTopCategoriesParams) ([]GetTopCategoriesRow, error) {
 	rows, err := q.db.Query(ctx, getTopCategories,
 		arg.UserID,
@@ -388,19 +515,25 @@ func (q *Queries) GetTopCategories(ctx context.Context, arg GetTopCategoriesPara
 
 const getTopMerchants = `-- name: GetTopMerchants :many
 select
-  t.merchant,
+  m.id as merchant_id,
+  coalesce(m.name, t.merchant)::text as merchant,
   COUNT(t.id)::bigint as transaction_count,
   SUM(t.tx_amount_cents)::bigint as total_amount_cents,
   AVG(t.tx_amount_cents)::bigint as avg_amount_cents
 from transactions t
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+left join merchants m on m.id = t.merchant_id
 where (a.owner_id = $1::uuid or au.user_id is not null)
-  and t.merchant is not null
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
+  and (t.merchant is not null or m.id is not null)
   and t.tx_direction = 2
   and ($2::timestamptz is null or t.tx_date >= $2::timestamptz)
   and ($3::timestamptz is null or t.tx_date <= $3::timestamptz)
-group by t.merchant
+  and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+group by m.id, coalesce(m.name, t.merchant)
 order by total_amount_cents desc
 limit COALESCE($4::int, 10)
 `
@@ -413,12 +546,15 @@ type GetTopMerchantsParams struct {
 }
 
 type GetTopMerchantsRow struct {
-	Merchant         *string `db:"merchant" json:"merchant"`
-	TransactionCount int64   `db:"transaction_count" json:"transaction_count"`
-	TotalAmountCents int64   `db:"total_amount_cents" json:"total_amount_cents"`
-	AvgAmountCents   int64   `db:"avg_amount_cents" json:"avg_amount_cents"`
+	MerchantID       *int64 `db:"merchant_id" json:"merchant_id"`
+	Merchant         string `db:"merchant" json:"merchant"`
+	TransactionCount int64  `db:"transaction_count" json:"transaction_count"`
+	TotalAmountCents int64  `db:"total_amount_cents" json:"total_amount_cents"`
+	AvgAmountCents   int64  `db:"avg_amount_cents" json:"avg_amount_cents"`
 }
 
+// Transactions resolved to a merchant are grouped under it whatever their
+// spelling, the rest by their merchant text. Linked transfers are left out.
 func (q *Queries) GetTopMerchants(ctx context.Context, arg GetTopMerchantsParams) ([]GetTopMerchantsRow, error) {
 	rows, err := q.db.Query(ctx, getTopMerchants,
 		arg.UserID,
@@ -434,6 +570,7 @@ func (q *Queries) GetTopMerchants(ctx context.Context, arg GetTopMerchantsParams
 	for rows.Next() {
 		var i GetTopMerchantsRow
 		if err := rows.Scan(
+			&i.MerchantID,
 			&i.Merchant,
 			&i.TransactionCount,
 			&i.TotalAmountCents,
@@ -448,3 +585,72 @@ func (q *Queries) GetTopMerchants(ctx context.Context, arg GetTopMerchantsParams
 	}
 	return items, nil
 }
+
+const getTopTags = `-- name: GetTopTags :many
+select
+  g.slug,
+  g.color,
+  COUNT(t.id)::bigint as transaction_count,
+  SUM(t.tx_amount_cents)::bigint as total_amount_cents
+from transactions t
+join transaction_tags tt on tt.transaction_id = t.id
+join tags g on tt.tag_id = g.id and g.user_id = $1::uuid
+join accounts a on t.account_id = a.id
+left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
+where (a.owner_id = $1::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and t.status <> 3
+  and a.deleted_at is null
+  and t.tx_direction = 2
+  and ($2::timestamptz is null or t.tx_date >= $2::timestamptz)
+  and ($3::timestamptz is null or t.tx_date <= $3::timestamptz)
+  and not exists (select 1 from transfers x where x.dismissed_at is null and t.id in (x.outgoing_tx_id, x.incoming_tx_id))
+group by g.id, g.slug, g.color
+order by total_amount_cents desc
+limit COALESCE($4::int, 10)
+`
+
+type GetTopTagsParams struct {
+	UserID uuid.UUID  `db:"user_id" json:"user_id"`
+	Start  *time.Time `db:"start" json:"start"`
+	End    *time.Time `db:"end" json:"end"`
+	Limit  *int32     `db:"limit" json:"limit"`
+}
+
+type GetTopTagsRow struct {
+	Slug             string `db:"slug" json:"slug"`
+	Color            string `db:"color" json:"color"`
+	TransactionCount int64  `db:"transaction_count" json:"transaction_count"`
+	TotalAmountCents int64  `db:"total_amount_cents" json:"total_amount_cents"`
+}
+
+// A transaction with several tags counts in full towards each of them.
+func (q *Queries) GetTopTags(ctx context.Context, arg GetTopTagsParams) ([]GetTopTagsRow, error) {
+	rows, err := q.db.Query(ctx, getTopTags,
+		arg.UserID,
+		arg.Start,
+		arg.End,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTopTagsRow
+	for rows.Next() {
+		var i GetTopTagsRow
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Color,
+			&i.TransactionCount,
+			&i.TotalAmountCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -25,6 +25,8 @@ type Account struct {
 	Colors             []string          `db:"colors" json:"colors"`
 	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
 	UpdatedAt          time.Time         `db:"updated_at" json:"updated_at"`
+	DeletedAt          *time.Time        `db:"deleted_at" json:"deleted_at"`
+	IncludePending     bool              `db:"include_pending" json:"include_pending"`
 }
 
 type AccountUser struct {
@@ -42,28 +44,194 @@ type Category struct {
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
+type ImportProfile struct {
+	ID        int64     `db:"id" json:"id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	Name      string    `db:"name" json:"name"`
+	Bank      *string   `db:"bank" json:"bank"`
+	Mapping   []byte    `db:"mapping" json:"mapping"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type Merchant struct {
+	ID                int64     `db:"id" json:"id"`
+	UserID            uuid.UUID `db:"user_id" json:"user_id"`
+	Name              string    `db:"name" json:"name"`
+	DefaultCategoryID *int64    `db:"default_category_id" json:"default_category_id"`
+	WebsiteUrl        *string   `db:"website_url" json:"website_url"`
+	LogoUrl           *string   `db:"logo_url" json:"logo_url"`
+	CreatedAt         time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type MerchantAlias struct {
+	ID         int64     `db:"id" json:"id"`
+	MerchantID int64     `db:"merchant_id" json:"merchant_id"`
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	Alias      string    `db:"alias" json:"alias"`
+	MatchKey   string    `db:"match_key" json:"match_key"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+}
+
+type PlannedTransaction struct {
+	ID            int64                      `db:"id" json:"id"`
+	UserID        uuid.UUID                  `db:"user_id" json:"user_id"`
+	AccountID     int64                      `db:"account_id" json:"account_id"`
+	TxAmountCents int64                      `db:"tx_amount_cents" json:"tx_amount_cents"`
+	TxCurrency    string                     `db:"tx_currency" json:"tx_currency"`
+	TxDirection   arian.TransactionDirection `db:"tx_direction" json:"tx_direction"`
+	TxDesc        *string                    `db:"tx_desc" json:"tx_desc"`
+	Merchant      *string                    `db:"merchant" json:"merchant"`
+	CategoryID    *int64                     `db:"category_id" json:"category_id"`
+	StartDate     time.Time                  `db:"start_date" json:"start_date"`
+	Rrule         *string                    `db:"rrule" json:"rrule"`
+	CreatedAt     time.Time                  `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time                  `db:"updated_at" json:"updated_at"`
+}
+
+type PlannedTransactionFulfillment struct {
+	PlannedID      int64     `db:"planned_id" json:"planned_id"`
+	OccurrenceDate time.Time `db:"occurrence_date" json:"occurrence_date"`
+	TransactionID  int64     `db:"transaction_id" json:"transaction_id"`
+	FulfilledAt    time.Time `db:"fulfilled_at" json:"fulfilled_at"`
+}
+
+type Receipt struct {
+	ID            int64      `db:"id" json:"id"`
+	UserID        uuid.UUID  `db:"user_id" json:"user_id"`
+	TransactionID *int64     `db:"transaction_id" json:"transaction_id"`
+	MatchStatus   int16      `db:"match_status" json:"match_status"`
+	MatchScore    *float32   `db:"match_score" json:"match_score"`
+	Merchant      *string    `db:"merchant" json:"merchant"`
+	TotalCents    int64      `db:"total_cents" json:"total_cents"`
+	Currency      *string    `db:"currency" json:"currency"`
+	ReceiptDate   *time.Time `db:"receipt_date" json:"receipt_date"`
+	ContentType   string     `db:"content_type" json:"content_type"`
+	CreatedAt     time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+type ReceiptItem struct {
+	ID             int64   `db:"id" json:"id"`
+	ReceiptID      int64   `db:"receipt_id" json:"receipt_id"`
+	Position       int32   `db:"position" json:"position"`
+	Name           string  `db:"name" json:"name"`
+	Quantity       float64 `db:"quantity" json:"quantity"`
+	UnitPriceCents *int64  `db:"unit_price_cents" json:"unit_price_cents"`
+	TotalCents     int64   `db:"total_cents" json:"total_cents"`
+}
+
+type RecurringSeries struct {
+	ID                     int64                      `db:"id" json:"id"`
+	UserID                 uuid.UUID                  `db:"user_id" json:"user_id"`
+	MatchKey               string                     `db:"match_key" json:"match_key"`
+	Name                   string                     `db:"name" json:"name"`
+	AccountID              *int64                     `db:"account_id" json:"account_id"`
+	Cadence                int16                      `db:"cadence" json:"cadence"`
+	Direction              arian.TransactionDirection `db:"direction" json:"direction"`
+	Currency               string                     `db:"currency" json:"currency"`
+	ExpectedAmountCents    int64                      `db:"expected_amount_cents" json:"expected_amount_cents"`
+	LastAmountCents        int64                      `db:"last_amount_cents" json:"last_amount_cents"`
+	LastDate               time.Time                  `db:"last_date" json:"last_date"`
+	NextDueDate            time.Time                  `db:"next_due_date" json:"next_due_date"`
+	Occurrences            int32                      `db:"occurrences" json:"occurrences"`
+	Status                 int16                      `db:"status" json:"status"`
+	PriceIncreaseFromCents *int64                     `db:"price_increase_from_cents" json:"price_increase_from_cents"`
+	CreatedAt              time.Time                  `db:"created_at" json:"created_at"`
+	UpdatedAt              time.Time                  `db:"updated_at" json:"updated_at"`
+}
+
+type RecurringSeriesTransaction struct {
+	SeriesID      int64 `db:"series_id" json:"series_id"`
+	TransactionID int64 `db:"transaction_id" json:"transaction_id"`
+}
+
+type Refund struct {
+	ID           int64      `db:"id" json:"id"`
+	UserID       uuid.UUID  `db:"user_id" json:"user_id"`
+	OriginalTxID int64      `db:"original_tx_id" json:"original_tx_id"`
+	RefundTxID   int64      `db:"refund_tx_id" json:"refund_tx_id"`
+	AutoDetected bool       `db:"auto_detected" json:"auto_detected"`
+	DismissedAt  *time.Time `db:"dismissed_at" json:"dismissed_at"`
+	CreatedAt    time.Time  `db:"created_at" json:"created_at"`
+}
+
+type Settlement struct {
+	ID            int64     `db:"id" json:"id"`
+	FromUserID    uuid.UUID `db:"from_user_id" json:"from_user_id"`
+	ToUserID      uuid.UUID `db:"to_user_id" json:"to_user_id"`
+	AmountCents   int64     `db:"amount_cents" json:"amount_cents"`
+	Currency      string    `db:"currency" json:"currency"`
+	TransactionID *int64    `db:"transaction_id" json:"transaction_id"`
+	Note          *string   `db:"note" json:"note"`
+	CreatedBy     uuid.UUID `db:"created_by" json:"created_by"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+type SharedExpense struct {
+	TransactionID int64     `db:"transaction_id" json:"transaction_id"`
+	PayerID       uuid.UUID `db:"payer_id" json:"payer_id"`
+	SplitMethod   int16     `db:"split_method" json:"split_method"`
+	CreatedBy     uuid.UUID `db:"created_by" json:"created_by"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type SharedExpensePart struct {
+	TransactionID int64     `db:"transaction_id" json:"transaction_id"`
+	UserID        uuid.UUID `db:"user_id" json:"user_id"`
+	AmountCents   int64     `db:"amount_cents" json:"amount_cents"`
+	Weight        *float64  `db:"weight" json:"weight"`
+}
+
+type Tag struct {
+	ID        int64     `db:"id" json:"id"`
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	Slug      string    `db:"slug" json:"slug"`
+	Color     string    `db:"color" json:"color"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
 type Transaction struct {
-	ID                  int64                      `db:"id" json:"id"`
-	AccountID           int64                      `db:"account_id" json:"account_id"`
-	EmailID             *string                    `db:"email_id" json:"email_id"`
-	TxDate              time.Time                  `db:"tx_date" json:"tx_date"`
-	TxAmountCents       int64                      `db:"tx_amount_cents" json:"tx_amount_cents"`
-	TxCurrency          string                     `db:"tx_currency" json:"tx_currency"`
-	TxDirection         arian.TransactionDirection `db:"tx_direction" json:"tx_direction"`
-	TxDesc              *string                    `db:"tx_desc" json:"tx_desc"`
-	BalanceAfterCents   *int64                     `db:"balance_after_cents" json:"balance_after_cents"`
-	BalanceCurrency     *string                    `db:"balance_currency" json:"balance_currency"`
-	Merchant            *string                    `db:"merchant" json:"merchant"`
-	CategoryID          *int64                     `db:"category_id" json:"category_id"`
-	CategoryManuallySet bool                       `db:"category_manually_set" json:"category_manually_set"`
-	MerchantManuallySet bool                       `db:"merchant_manually_set" json:"merchant_manually_set"`
-	Suggestions         []string                   `db:"suggestions" json:"suggestions"`
-	UserNotes           *string                    `db:"user_notes" json:"user_notes"`
-	ForeignAmountCents  *int64                     `db:"foreign_amount_cents" json:"foreign_amount_cents"`
-	ForeignCurrency     *string                    `db:"foreign_currency" json:"foreign_currency"`
-	ExchangeRate        *float64                   `db:"exchange_rate" json:"exchange_rate"`
-	CreatedAt           time.Time                  `db:"created_at" json:"created_at"`
-	UpdatedAt           time.Time                  `db:"updated_at" json:"updated_at"`
+	ID                      int64                      `db:"id" json:"id"`
+	AccountID               int64                      `db:"account_id" json:"account_id"`
+	EmailID                 *string                    `db:"email_id" json:"email_id"`
+	TxDate                  time.Time                  `db:"tx_date" json:"tx_date"`
+	TxAmountCents           int64                      `db:"tx_amount_cents" json:"tx_amount_cents"`
+	TxCurrency              string                     `db:"tx_currency" json:"tx_currency"`
+	TxDirection             arian.TransactionDirection `db:"tx_direction" json:"tx_direction"`
+	TxDesc                  *string                    `db:"tx_desc" json:"tx_desc"`
+	BalanceAfterCents       *int64                     `db:"balance_after_cents" json:"balance_after_cents"`
+	BalanceCurrency         *string                    `db:"balance_currency" json:"balance_currency"`
+	Merchant                *string                    `db:"merchant" json:"merchant"`
+	CategoryID              *int64                     `db:"category_id" json:"category_id"`
+	CategoryManuallySet     bool                       `db:"category_manually_set" json:"category_manually_set"`
+	MerchantManuallySet     bool                       `db:"merchant_manually_set" json:"merchant_manually_set"`
+	Suggestions             []string                   `db:"suggestions" json:"suggestions"`
+	UserNotes               *string                    `db:"user_notes" json:"user_notes"`
+	ForeignAmountCents      *int64                     `db:"foreign_amount_cents" json:"foreign_amount_cents"`
+	ForeignCurrency         *string                    `db:"foreign_currency" json:"foreign_currency"`
+	ExchangeRate            *float64                   `db:"exchange_rate" json:"exchange_rate"`
+	CreatedAt               time.Time                  `db:"created_at" json:"created_at"`
+	UpdatedAt               time.Time                  `db:"updated_at" json:"updated_at"`
+	DeletedAt               *time.Time                 `db:"deleted_at" json:"deleted_at"`
+	Status                  int16                      `db:"status" json:"status"`
+	MerchantID              *int64                     `db:"merchant_id" json:"merchant_id"`
+	ExchangeRateManuallySet bool                       `db:"exchange_rate_manually_set" json:"exchange_rate_manually_set"`
+}
+
+type TransactionHistory struct {
+	ID            int64      `db:"id" json:"id"`
+	TransactionID int64      `db:"transaction_id" json:"transaction_id"`
+	Field         string     `db:"field" json:"field"`
+	OldValue      *string    `db:"old_value" json:"old_value"`
+	NewValue      *string    `db:"new_value" json:"new_value"`
+	ActorType     int16      `db:"actor_type" json:"actor_type"`
+	ActorUserID   *uuid.UUID `db:"actor_user_id" json:"actor_user_id"`
+	ActorRuleID   *uuid.UUID `db:"actor_rule_id" json:"actor_rule_id"`
+	ChangedAt     time.Time  `db:"changed_at" json:"changed_at"`
 }
 
 type TransactionRule struct {
@@ -83,6 +251,37 @@ type TransactionRule struct {
 	TimesApplied  *int32     `db:"times_applied" json:"times_applied"`
 }
 
+type TransactionSearch struct {
+	TransactionID int64       `db:"transaction_id" json:"transaction_id"`
+	Document      interface{} `db:"document" json:"document"`
+}
+
+type TransactionSplit struct {
+	ID            int64     `db:"id" json:"id"`
+	TransactionID int64     `db:"transaction_id" json:"transaction_id"`
+	AmountCents   int64     `db:"amount_cents" json:"amount_cents"`
+	CategoryID    *int64    `db:"category_id" json:"category_id"`
+	Notes         *string   `db:"notes" json:"notes"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type TransactionTag struct {
+	TransactionID int64     `db:"transaction_id" json:"transaction_id"`
+	TagID         int64     `db:"tag_id" json:"tag_id"`
+	CreatedAt     time.Time `db:"created_at" json:"created_at"`
+}
+
+type Transfer struct {
+	ID           int64      `db:"id" json:"id"`
+	UserID       uuid.UUID  `db:"user_id" json:"user_id"`
+	OutgoingTxID int64      `db:"outgoing_tx_id" json:"outgoing_tx_id"`
+	IncomingTxID int64      `db:"incoming_tx_id" json:"incoming_tx_id"`
+	AutoDetected bool       `db:"auto_detected" json:"auto_detected"`
+	DismissedAt  *time.Time `db:"dismissed_at" json:"dismissed_at"`
+	CreatedAt    time.Time  `db:"created_at" json:"created_at"`
+}
+
 type User struct {
 	ID              uuid.UUID `db:"id" json:"id"`
 	Email           string    `db:"email" json:"email"`
@@ -7,34 +7,45 @@ package sqlc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-const bulkApplyRuleToTransactions = `-- name: BulkApplyRuleToTransactions :execrows
-update transactions
+const bulkApplyRuleToTransactions = `-- name: BulkApplyRuleToTransactions :many
+update transactions t
 set
   category_id = case
-    when $1::bigint > 0 and category_manually_set = false
+    when $1::bigint > 0 and t.category_manually_set = false
     then $1::bigint
-    else category_id
+    else t.category_id
   end,
   merchant = case
-    when $2::text != '' and merchant_manually_set = false
+    when $2::text != '' and t.merchant_manually_set = false
     then $2::text
-    else merchant
+    else t.merchant
   end
-where id = ANY($3::bigint[])
-  and account_id in (
+from transactions old
+where old.id = t.id
+  and t.id = ANY($3::bigint[])
+  and t.account_id in (
     select a.id
     from accounts a
     left join account_users au on a.id = au.account_id and au.user_id = $4::uuid
-    where a.owner_id = $4::uuid or au.user_id is not null
+    where (a.owner_id = $4::uuid or au.user_id is not null)
+      and a.deleted_at is null
   )
+  and t.deleted_at is null
   and (
-    ($1::bigint > 0 and category_manually_set = false) or
-    ($2::text != '' and merchant_manually_set = false)
+    ($1::bigint > 0 and t.category_manually_set = false) or
+    ($2::text != '' and t.merchant_manually_set = false)
   )
+returning
+  t.id,
+  old.category_id as old_category_id,
+  t.category_id,
+  old.merchant as old_merchant,
+  t.merchant
 `
 
 type BulkApplyRuleToTransactionsParams struct {
@@ -44,17 +55,44 @@ type BulkApplyRuleToTransactionsParams struct {
 	UserID         uuid.UUID `db:"user_id" json:"user_id"`
 }
 
-func (q *Queries) BulkApplyRuleToTransactions(ctx context.Context, arg BulkApplyRuleToTransactionsParams) (int64, error) {
-	result, err := q.db.Exec(ctx, bulkApplyRuleToTransactions,
+type BulkApplyRuleToTransactionsRow struct {
+	ID            int64   `db:"id" json:"id"`
+	OldCategoryID *int64  `db:"old_category_id" json:"old_category_id"`
+	CategoryID    *int64  `db:"category_id" json:"category_id"`
+	OldMerchant   *string `db:"old_merchant" json:"old_merchant"`
+	Merchant      *string `db:"merchant" json:"merchant"`
+}
+
+// Returns old and new category and merchant of every touched row for the history log.
+func (q *Queries) BulkApplyRuleToTransactions(ctx context.Context, arg BulkApplyRuleToTransactionsParams) ([]BulkApplyRuleToTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, bulkApplyRuleToTransactions,
 		arg.CategoryID,
 		arg.Merchant,
 		arg.TransactionIds,
 		arg.UserID,
 	)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return result.RowsAffected(), nil
+	defer rows.Close()
+	var items []BulkApplyRuleToTransactionsRow
+	for rows.Next() {
+		var i BulkApplyRuleToTransactionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OldCategoryID,
+			&i.CategoryID,
+			&i.OldMerchant,
+			&i.Merchant,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const createRule = `-- name: CreateRule :one
@@ -197,23 +235,43 @@ func (q *Queries) GetRule(ctx context.Context, arg GetRuleParams) (TransactionRu
 
 const getTransactionsForRuleApplication = `-- name: GetTransactionsForRuleApplication :many
 select
-  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set
 from transactions t
 join accounts a on t.account_id = a.id
 left join account_users au on a.id = au.account_id and au.user_id = $1::uuid
 where (a.owner_id = $1::uuid or au.user_id is not null)
+  and t.deleted_at is null
+  and a.deleted_at is null
   and ($2::bigint[] is null or t.id = ANY($2::bigint[]))
   and ($3::boolean = true or (t.category_manually_set = false and t.merchant_manually_set = false))
+  and (
+    $4::timestamptz is null
+    or (t.tx_date, t.id) < ($4::timestamptz, $5::bigint)
+  )
+order by t.tx_date desc, t.id desc
+limit $6::int
 `
 
 type GetTransactionsForRuleApplicationParams struct {
-	UserID             uuid.UUID `db:"user_id" json:"user_id"`
-	TransactionIds     []int64   `db:"transaction_ids" json:"transaction_ids"`
-	IncludeManuallySet *bool     `db:"include_manually_set" json:"include_manually_set"`
+	UserID             uuid.UUID  `db:"user_id" json:"user_id"`
+	TransactionIds     []int64    `db:"transaction_ids" json:"transaction_ids"`
+	IncludeManuallySet *bool      `db:"include_manually_set" json:"include_manually_set"`
+	CursorDate         *time.Time `db:"cursor_date" json:"cursor_date"`
+	CursorID           *int64     `db:"cursor_id" json:"cursor_id"`
+	Limit              *int32     `db:"limit" json:"limit"`
 }
 
+// Newest first, the order previews page in. Previews read in batches after
+// the cursor; without a limit every row is returned.
 func (q *Queries) GetTransactionsForRuleApplication(ctx context.Context, arg GetTransactionsForRuleApplicationParams) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, getTransactionsForRuleApplication, arg.UserID, arg.TransactionIds, arg.IncludeManuallySet)
+	rows, err := q.db.Query(ctx, getTransactionsForRuleApplication,
+		arg.UserID,
+		arg.TransactionIds,
+		arg.IncludeManuallySet,
+		arg.CursorDate,
+		arg.CursorID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +301,10 @@ func (q *Queries) GetTransactionsForRuleApplication(ctx context.Context, arg Get
 			&i.ExchangeRate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.MerchantID,
+			&i.ExchangeRateManuallySet,
 		); err != nil {
 			return nil, err
 		}
@@ -296,6 +358,66 @@ func (q *Queries) ListRules(ctx context.Context, userID uuid.UUID) ([]Transactio
 	return items, nil
 }
 
+const reorderRules = `-- name: ReorderRules :execrows
+update transaction_rules r
+set priority_order = (o.position - 1)::int,
+  updated_at = now()
+from unnest($1::uuid[]) with ordinality as o(rule_id, position)
+where r.rule_id = o.rule_id
+  and r.user_id = $2::uuid
+`
+
+type ReorderRulesParams struct {
+	RuleIds []uuid.UUID `db:"rule_ids" json:"rule_ids"`
+	UserID  uuid.UUID   `db:"user_id" json:"user_id"`
+}
+
+// Sets priority_order to each rule's position in rule_ids, starting at 0.
+func (q *Queries) ReorderRules(ctx context.Context, arg ReorderRulesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reorderRules, arg.RuleIds, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setRuleActive = `-- name: SetRuleActive :one
+update transaction_rules
+set is_active = $1::boolean,
+  updated_at = now()
+where rule_id = $2::uuid
+  and user_id = $3::uuid
+returning rule_id, user_id, rule_name, category_id, merchant, conditions, logic_operator, is_active, priority_order, rule_source, created_at, updated_at, last_applied_at, times_applied
+`
+
+type SetRuleActiveParams struct {
+	IsActive bool      `db:"is_active" json:"is_active"`
+	RuleID   uuid.UUID `db:"rule_id" json:"rule_id"`
+	UserID   uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) SetRuleActive(ctx context.Context, arg SetRuleActiveParams) (TransactionRule, error) {
+	row := q.db.QueryRow(ctx, setRuleActive, arg.IsActive, arg.RuleID, arg.UserID)
+	var i TransactionRule
+	err := row.Scan(
+		&i.RuleID,
+		&i.UserID,
+		&i.RuleName,
+		&i.CategoryID,
+		&i.Merchant,
+		&i.Conditions,
+		&i.LogicOperator,
+		&i.IsActive,
+		&i.PriorityOrder,
+		&i.RuleSource,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.LastAppliedAt,
+		&i.TimesApplied,
+	)
+	return i, err
+}
+
 const updateRule = `-- name: UpdateRule :exec
 update transaction_rules
 set
@@ -13,15 +13,257 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const bulkCategorizeTransactions = `-- name: BulkCategorizeTransactions :execrows
+const aggregateTransactions = `-- name: AggregateTransactions :many
+with facts as (
+  select
+    t.id,
+    t.tx_currency as currency,
+    case when t.tx_direction = 1 then p.amount_cents else -p.amount_cents end as amount_cents,
+    array [
+      coalesce(c.slug, ''),
+      coalesce(regexp_replace(c.slug, '\.[^.]*$', ''), ''),
+      coalesce(m.name, t.merchant, ''),
+      a.name,
+      coalesce(g.slug, ''),
+      case when t.tx_direction = 1 then 'incoming' else 'outgoing' end,
+      to_char(t.tx_date at time zone $1::text, 'YYYY-MM-DD'),
+      to_char(date_trunc('week', t.tx_date at time zone $1::text), 'YYYY-MM-DD'),
+      to_char(t.tx_date at time zone $1::text, 'YYYY-MM'),
+      to_char(t.tx_date at time zone $1::text, 'YYYY')
+    ]::text [] as keys
+  from
+    filter_transactions(
+      p_user_id => $2::uuid,
+      p_start => $3::timestamptz,
+      p_end => $4::timestamptz,
+      p_amount_min_cents => $5::bigint,
+      p_amount_max_cents => $6::bigint,
+      p_direction => $7::smallint,
+      p_account_ids => $8::bigint [],
+      p_categories => $9::text [],
+      p_category_patterns => $10::text [],
+      p_exclude_category_patterns => $11::text [],
+      p_account_names => $12::text [],
+      p_exclude_account_ids => $13::bigint [],
+      p_exclude_account_names => $14::text [],
+      p_tags => $15::text [],
+      p_exclude_tags => $16::text [],
+      p_merchant_q => $17::text,
+      p_exclude_merchant_q => $18::text,
+      p_desc_q => $19::text,
+      p_currency => $20::char(3),
+      p_tod_start => $21::time,
+      p_tod_end => $22::time,
+      p_uncategorized => $23::boolean,
+      p_statuses => $24::smallint []
+    ) t
+    join accounts a on t.account_id = a.id
+    cross join lateral (
+      select
+        s.category_id,
+        s.amount_cents
+      from
+        transaction_splits s
+      where
+        s.transaction_id = t.id
+        and $25::boolean
+      union all
+      select
+        t.category_id,
+        t.tx_amount_cents
+      where
+        not (
+          $25::boolean
+          and exists (
+            select
+              1
+            from
+              transaction_splits s
+            where
+              s.transaction_id = t.id
+          )
+        )
+    ) p
+    left join categories c on p.category_id = c.id
+    left join merchants m on t.merchant_id = m.id
+    left join lateral (
+      select
+        g.slug
+      from
+        transaction_tags tt
+        join tags g on tt.tag_id = g.id
+      where
+        tt.transaction_id = t.id
+        and $26::boolean
+    ) g on true
+  where
+    (
+      $27::boolean
+      or not exists (
+        select
+          1
+        from
+          transfers x
+        where
+          x.dismissed_at is null
+          and t.id in (x.outgoing_tx_id, x.incoming_tx_id)
+      )
+    )
+)
+select
+  coalesce(f.keys [$28::int], '')::text as key1,
+  coalesce(f.keys [$29::int], '')::text as key2,
+  coalesce(f.keys [$30::int], '')::text as key3,
+  f.currency::text as currency,
+  COUNT(distinct f.id)::bigint as transaction_count,
+  SUM(f.amount_cents)::bigint as sum_cents,
+  round(AVG(f.amount_cents))::bigint as avg_cents,
+  MIN(f.amount_cents)::bigint as min_cents,
+  MAX(f.amount_cents)::bigint as max_cents,
+  round(percentile_cont(0.5) within group (order by f.amount_cents))::bigint as median_cents
+from
+  facts f
+group by
+  1,
+  2,
+  3,
+  f.currency
+order by
+  1,
+  2,
+  3,
+  f.currency
+`
+
+type AggregateTransactionsParams struct {
+	Tz                      string      `db:"tz" json:"tz"`
+	UserID                  uuid.UUID   `db:"user_id" json:"user_id"`
+	Start                   *time.Time  `db:"start" json:"start"`
+	End                     *time.Time  `db:"end" json:"end"`
+	AmountMinCents          *int64      `db:"amount_min_cents" json:"amount_min_cents"`
+	AmountMaxCents          *int64      `db:"amount_max_cents" json:"amount_max_cents"`
+	Direction               *int16      `db:"direction" json:"direction"`
+	AccountIds              []int64     `db:"account_ids" json:"account_ids"`
+	Categories              []string    `db:"categories" json:"categories"`
+	CategoryPatterns        []string    `db:"category_patterns" json:"category_patterns"`
+	ExcludeCategoryPatterns []string    `db:"exclude_category_patterns" json:"exclude_category_patterns"`
+	AccountNames            []string    `db:"account_names" json:"account_names"`
+	ExcludeAccountIds       []int64     `db:"exclude_account_ids" json:"exclude_account_ids"`
+	ExcludeAccountNames     []string    `db:"exclude_account_names" json:"exclude_account_names"`
+	Tags                    []string    `db:"tags" json:"tags"`
+	ExcludeTags             []string    `db:"exclude_tags" json:"exclude_tags"`
+	MerchantQ               *string     `db:"merchant_q" json:"merchant_q"`
+	ExcludeMerchantQ        *string     `db:"exclude_merchant_q" json:"exclude_merchant_q"`
+	DescQ                   *string     `db:"desc_q" json:"desc_q"`
+	Currency                *string     `db:"currency" json:"currency"`
+	TodStart                pgtype.Time `db:"tod_start" json:"tod_start"`
+	TodEnd                  pgtype.Time `db:"tod_end" json:"tod_end"`
+	Uncategorized           *bool       `db:"uncategorized" json:"uncategorized"`
+	Statuses                []int16     `db:"statuses" json:"statuses"`
+	SplitParts              bool        `db:"split_parts" json:"split_parts"`
+	ByTag                   bool        `db:"by_tag" json:"by_tag"`
+	IncludeTransfers        bool        `db:"include_transfers" json:"include_transfers"`
+	Dim1                    int32       `db:"dim1" json:"dim1"`
+	Dim2                    int32       `db:"dim2" json:"dim2"`
+	Dim3                    int32       `db:"dim3" json:"dim3"`
+}
+
+type AggregateTransactionsRow struct {
+	Key1             string `db:"key1" json:"key1"`
+	Key2             string `db:"key2" json:"key2"`
+	Key3             string `db:"key3" json:"key3"`
+	Currency         string `db:"currency" json:"currency"`
+	TransactionCount int64  `db:"transaction_count" json:"transaction_count"`
+	SumCents         int64  `db:"sum_cents" json:"sum_cents"`
+	AvgCents         int64  `db:"avg_cents" json:"avg_cents"`
+	MinCents         int64  `db:"min_cents" json:"min_cents"`
+	MaxCents         int64  `db:"max_cents" json:"max_cents"`
+	MedianCents      int64  `db:"median_cents" json:"median_cents"`
+}
+
+// Groups the transactions matching the list filters by up to three
+// dimensions. dim1..dim3 are 1-based positions in the keys array (0 leaves
+// the slot empty). Missing values, like the category of an uncategorized
+// transaction, group under ”. Amounts are signed, incoming positive and
+// outgoing negative, so a group mixing both directions nets out. Split
+// transactions contribute each part to its own category when split_parts is
+// set, and a transaction with several tags counts towards each of them.
+// Dates are bucketed in tz.
+func (q *Queries) AggregateTransactions(ctx context.Context, arg AggregateTransactionsParams) ([]AggregateTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, aggregateTransactions,
+		arg.Tz,
+		arg.UserID,
+		arg.Start,
+		arg.End,
+		arg.AmountMinCents,
+		arg.AmountMaxCents,
+		arg.Direction,
+		arg.AccountIds,
+		arg.Categories,
+		arg.CategoryPatterns,
+		arg.ExcludeCategoryPatterns,
+		arg.AccountNames,
+		arg.ExcludeAccountIds,
+		arg.ExcludeAccountNames,
+		arg.Tags,
+		arg.ExcludeTags,
+		arg.MerchantQ,
+		arg.ExcludeMerchantQ,
+		arg.DescQ,
+		arg.Currency,
+		arg.TodStart,
+		arg.TodEnd,
+		arg.Uncategorized,
+		arg.Statuses,
+		arg.SplitParts,
+		arg.ByTag,
+		arg.IncludeTransfers,
+		arg.Dim1,
+		arg.Dim2,
+		arg.Dim3,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AggregateTransactionsRow
+	for rows.Next() {
+		var i AggregateTransactionsRow
+		if err := rows.Scan(
+			&i.Key1,
+			&i.Key2,
+			&i.Key3,
+			&i.Currency,
+			&i.TransactionCount,
+			&i.SumCents,
+			&i.AvgCents,
+			&i.MinCents,
+			&i.MaxCents,
+			&i.MedianCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const bulkCategorizeTransactions = `-- name: BulkCategorizeTransactions :many
 update
-  transactions
+  transactions t
 set
   category_id = $1::bigint,
   category_manually_set = true
+from
+  transactions old
 where
-  id = ANY($2::bigint [])
-  and account_id in (
+  old.id = t.id
+  and t.id = ANY($2::bigint [])
+  and t.deleted_at is null
+  and t.account_id in (
     select
       a.id
     from
@@ -29,9 +271,15 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = $3::uuid
     where
-      a.owner_id = $3::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = $3::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   )
+returning
+  t.id,
+  old.category_id as old_category_id
 `
 
 type BulkCategorizeTransactionsParams struct {
@@ -40,17 +288,36 @@ type BulkCategorizeTransactionsParams struct {
 	UserID         uuid.UUID `db:"user_id" json:"user_id"`
 }
 
-func (q *Queries) BulkCategorizeTransactions(ctx context.Context, arg BulkCategorizeTransactionsParams) (int64, error) {
-	result, err := q.db.Exec(ctx, bulkCategorizeTransactions, arg.CategoryID, arg.TransactionIds, arg.UserID)
+type BulkCategorizeTransactionsRow struct {
+	ID            int64  `db:"id" json:"id"`
+	OldCategoryID *int64 `db:"old_category_id" json:"old_category_id"`
+}
+
+// Returns the previous category alongside the new one for the history log.
+func (q *Queries) BulkCategorizeTransactions(ctx context.Context, arg BulkCategorizeTransactionsParams) ([]BulkCategorizeTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, bulkCategorizeTransactions, arg.CategoryID, arg.TransactionIds, arg.UserID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return result.RowsAffected(), nil
+	defer rows.Close()
+	var items []BulkCategorizeTransactionsRow
+	for rows.Next() {
+		var i BulkCategorizeTransactionsRow
+		if err := rows.Scan(&i.ID, &i.OldCategoryID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const bulkCreateTransactions = `-- name: BulkCreateTransactions :many
 insert into
   transactions (
+    email_id,
     account_id,
     tx_date,
     tx_amount_cents,
@@ -58,46 +325,97 @@ insert into
     tx_direction,
     tx_desc,
     category_id,
+    category_manually_set,
     merchant,
+    merchant_manually_set,
     user_notes,
     foreign_amount_cents,
     foreign_currency,
-    exchange_rate
+    exchange_rate,
+    status
   )
 select
-  unnest($1::bigint[]),
-  unnest($2::timestamptz[]),
-  unnest($3::bigint[]),
-  unnest($4::char(3)[]),
-  unnest($5::smallint[]),
-  unnest($6::text[]),
-  unnest($7::bigint[]),
-  unnest($8::text[]),
-  unnest($9::text[]),
-  unnest($10::bigint[]),
-  unnest($11::char(3)[]),
-  unnest($12::double precision[])
+  nullif(u.email_id, ''),
+  u.account_id,
+  u.tx_date,
+  u.tx_amount_cents,
+  u.tx_currency,
+  u.tx_direction,
+  nullif(u.tx_desc, ''),
+  nullif(u.category_id, 0),
+  u.category_manually_set,
+  nullif(u.merchant, ''),
+  u.merchant_manually_set,
+  nullif(u.user_notes, ''),
+  nullif(u.foreign_amount_cents, 0),
+  nullif(u.foreign_currency, ''),
+  nullif(u.exchange_rate, 0),
+  u.status
+from
+  unnest(
+    $1::text[],
+    $2::bigint[],
+    $3::timestamptz[],
+    $4::bigint[],
+    $5::char(3)[],
+    $6::smallint[],
+    $7::text[],
+    $8::bigint[],
+    $9::boolean[],
+    $10::text[],
+    $11::boolean[],
+    $12::text[],
+    $13::bigint[],
+    $14::char(3)[],
+    $15::double precision[],
+    $16::smallint[]
+  ) with ordinality as u(
+    email_id,
+    account_id,
+    tx_date,
+    tx_amount_cents,
+    tx_currency,
+    tx_direction,
+    tx_desc,
+    category_id,
+    category_manually_set,
+    merchant,
+    merchant_manually_set,
+    user_notes,
+    foreign_amount_cents,
+    foreign_currency,
+    exchange_rate,
+    status,
+    ord
+  )
+order by
+  u.ord
 returning
-  id, account_id, email_id, tx_date, tx_amount_cents, tx_currency, tx_direction, tx_desc, balance_after_cents, balance_currency, merchant, category_id, category_manually_set, merchant_manually_set, suggestions, user_notes, foreign_amount_cents, foreign_currency, exchange_rate, created_at, updated_at
+  id, account_id, email_id, tx_date, tx_amount_cents, tx_currency, tx_direction, tx_desc, balance_after_cents, balance_currency, merchant, category_id, category_manually_set, merchant_manually_set, suggestions, user_notes, foreign_amount_cents, foreign_currency, exchange_rate, created_at, updated_at, deleted_at, status, merchant_id, exchange_rate_manually_set
 `
 
 type BulkCreateTransactionsParams struct {
-	AccountIds         []int64     `db:"account_ids" json:"account_ids"`
-	TxDates            []time.Time `db:"tx_dates" json:"tx_dates"`
-	TxAmountCents      []int64     `db:"tx_amount_cents" json:"tx_amount_cents"`
-	TxCurrencies       []string    `db:"tx_currencies" json:"tx_currencies"`
-	TxDirections       []int16     `db:"tx_directions" json:"tx_directions"`
-	TxDescs            []string    `db:"tx_descs" json:"tx_descs"`
-	CategoryIds        []int64     `db:"category_ids" json:"category_ids"`
-	Merchants          []string    `db:"merchants" json:"merchants"`
-	UserNotes          []string    `db:"user_notes" json:"user_notes"`
-	ForeignAmountCents []int64     `db:"foreign_amount_cents" json:"foreign_amount_cents"`
-	ForeignCurrencies  []string    `db:"foreign_currencies" json:"foreign_currencies"`
-	ExchangeRates      []float64   `db:"exchange_rates" json:"exchange_rates"`
+	EmailIds            []string    `db:"email_ids" json:"email_ids"`
+	AccountIds          []int64     `db:"account_ids" json:"account_ids"`
+	TxDates             []time.Time `db:"tx_dates" json:"tx_dates"`
+	TxAmountCents       []int64     `db:"tx_amount_cents" json:"tx_amount_cents"`
+	TxCurrencies        []string    `db:"tx_currencies" json:"tx_currencies"`
+	TxDirections        []int16     `db:"tx_directions" json:"tx_directions"`
+	TxDescs             []string    `db:"tx_descs" json:"tx_descs"`
+	CategoryIds         []int64     `db:"category_ids" json:"category_ids"`
+	CategoryManuallySet []bool      `db:"category_manually_set" json:"category_manually_set"`
+	Merchants           []string    `db:"merchants" json:"merchants"`
+	MerchantManuallySet []bool      `db:"merchant_manually_set" json:"merchant_manually_set"`
+	UserNotes           []string    `db:"user_notes" json:"user_notes"`
+	ForeignAmountCents  []int64     `db:"foreign_amount_cents" json:"foreign_amount_cents"`
+	ForeignCurrencies   []string    `db:"foreign_currencies" json:"foreign_currencies"`
+	ExchangeRates       []float64   `db:"exchange_rates" json:"exchange_rates"`
+	Statuses            []int16     `db:"statuses" json:"statuses"`
 }
 
 func (q *Queries) BulkCreateTransactions(ctx context.Context, arg BulkCreateTransactionsParams) ([]Transaction, error) {
 	rows, err := q.db.Query(ctx, bulkCreateTransactions,
+		arg.EmailIds,
 		arg.AccountIds,
 		arg.TxDates,
 		arg.TxAmountCents,
@@ -105,11 +423,14 @@ func (q *Queries) BulkCreateTransactions(ctx context.Context, arg BulkCreateTran
 		arg.TxDirections,
 		arg.TxDescs,
 		arg.CategoryIds,
+		arg.CategoryManuallySet,
 		arg.Merchants,
+		arg.MerchantManuallySet,
 		arg.UserNotes,
 		arg.ForeignAmountCents,
 		arg.ForeignCurrencies,
 		arg.ExchangeRates,
+		arg.Statuses,
 	)
 	if err != nil {
 		return nil, err
@@ -140,6 +461,10 @@ func (q *Queries) BulkCreateTransactions(ctx context.Context, arg BulkCreateTran
 			&i.ExchangeRate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.MerchantID,
+			&i.ExchangeRateManuallySet,
 		); err != nil {
 			return nil, err
 		}
@@ -152,10 +477,13 @@ func (q *Queries) BulkCreateTransactions(ctx context.Context, arg BulkCreateTran
 }
 
 const bulkDeleteTransactions = `-- name: BulkDeleteTransactions :execrows
-delete from
+update
   transactions
+set
+  deleted_at = now()
 where
   id = ANY($1::bigint [])
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -164,8 +492,11 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = $2::uuid
     where
-      a.owner_id = $2::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = $2::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   )
 `
 
@@ -174,6 +505,7 @@ type BulkDeleteTransactionsParams struct {
 	UserID         uuid.UUID `db:"user_id" json:"user_id"`
 }
 
+// Moves the transactions to the trash, PurgeTransactions removes them for good.
 func (q *Queries) BulkDeleteTransactions(ctx context.Context, arg BulkDeleteTransactionsParams) (int64, error) {
 	result, err := q.db.Exec(ctx, bulkDeleteTransactions, arg.TransactionIds, arg.UserID)
 	if err != nil {
@@ -182,6 +514,124 @@ func (q *Queries) BulkDeleteTransactions(ctx context.Context, arg BulkDeleteTran
 	return result.RowsAffected(), nil
 }
 
+const bulkUpdateTransactions = `-- name: BulkUpdateTransactions :many
+update
+  transactions t
+set
+  category_id = case
+    when $1::bigint is null then t.category_id
+    else nullif($1::bigint, 0)
+  end,
+  category_manually_set = case
+    when $1::bigint is null then t.category_manually_set
+    else $1::bigint <> 0
+  end,
+  merchant = case
+    when $2::text is null then t.merchant
+    else nullif($2::text, '')
+  end,
+  merchant_manually_set = case
+    when $2::text is null then t.merchant_manually_set
+    else $2::text <> ''
+  end,
+  user_notes = case
+    when $3::text is null then t.user_notes
+    else nullif($3::text, '')
+  end,
+  account_id = coalesce($4::bigint, t.account_id)
+from
+  transactions old
+where
+  old.id = t.id
+  and t.id = ANY($5::bigint [])
+  and t.deleted_at is null
+  and t.account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = $6::uuid
+    where
+      (
+        a.owner_id = $6::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
+  )
+returning
+  t.id,
+  old.account_id as old_account_id,
+  t.account_id,
+  old.category_id as old_category_id,
+  t.category_id,
+  old.merchant as old_merchant,
+  t.merchant,
+  old.user_notes as old_user_notes,
+  t.user_notes
+`
+
+type BulkUpdateTransactionsParams struct {
+	CategoryID     *int64    `db:"category_id" json:"category_id"`
+	Merchant       *string   `db:"merchant" json:"merchant"`
+	UserNotes      *string   `db:"user_notes" json:"user_notes"`
+	AccountID      *int64    `db:"account_id" json:"account_id"`
+	TransactionIds []int64   `db:"transaction_ids" json:"transaction_ids"`
+	UserID         uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+type BulkUpdateTransactionsRow struct {
+	ID            int64   `db:"id" json:"id"`
+	OldAccountID  int64   `db:"old_account_id" json:"old_account_id"`
+	AccountID     int64   `db:"account_id" json:"account_id"`
+	OldCategoryID *int64  `db:"old_category_id" json:"old_category_id"`
+	CategoryID    *int64  `db:"category_id" json:"category_id"`
+	OldMerchant   *string `db:"old_merchant" json:"old_merchant"`
+	Merchant      *string `db:"merchant" json:"merchant"`
+	OldUserNotes  *string `db:"old_user_notes" json:"old_user_notes"`
+	UserNotes     *string `db:"user_notes" json:"user_notes"`
+}
+
+// Sets the given fields on every listed transaction. A zero category and an
+// empty merchant or note clear the field. Returns the previous values
+// alongside the new ones for the history log.
+func (q *Queries) BulkUpdateTransactions(ctx context.Context, arg BulkUpdateTransactionsParams) ([]BulkUpdateTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, bulkUpdateTransactions,
+		arg.CategoryID,
+		arg.Merchant,
+		arg.UserNotes,
+		arg.AccountID,
+		arg.TransactionIds,
+		arg.UserID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BulkUpdateTransactionsRow
+	for rows.Next() {
+		var i BulkUpdateTransactionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OldAccountID,
+			&i.AccountID,
+			&i.OldCategoryID,
+			&i.CategoryID,
+			&i.OldMerchant,
+			&i.Merchant,
+			&i.OldUserNotes,
+			&i.UserNotes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const categorizeTransactionAtomic = `-- name: CategorizeTransactionAtomic :one
 update
   transactions
@@ -192,6 +642,7 @@ set
 where
   id = $4::bigint
   and category_manually_set = false
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -200,8 +651,11 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = $5::uuid
     where
-      a.owner_id = $5::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = $5::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   )
 returning
   id,
@@ -254,7 +708,8 @@ insert into
     foreign_amount_cents,
     foreign_currency,
     exchange_rate,
-    suggestions
+    suggestions,
+    status
   )
 select
   $1::text,
@@ -274,19 +729,21 @@ select
   $15::bigint,
   $16::char(3),
   $17::double precision,
-  $18::text []
+  $18::text [],
+  coalesce($19::smallint, 2)
 from
   accounts a
   left join account_users au on a.id = au.account_id
-  and au.user_id = $19::uuid
+  and au.user_id = $20::uuid
 where
   a.id = $2::bigint
   and (
-    a.owner_id = $19::uuid
+    a.owner_id = $20::uuid
     or au.user_id is not null
   )
+  and a.deleted_at is null
 returning
-  id, account_id, email_id, tx_date, tx_amount_cents, tx_currency, tx_direction, tx_desc, balance_after_cents, balance_currency, merchant, category_id, category_manually_set, merchant_manually_set, suggestions, user_notes, foreign_amount_cents, foreign_currency, exchange_rate, created_at, updated_at
+  id, account_id, email_id, tx_date, tx_amount_cents, tx_currency, tx_direction, tx_desc, balance_after_cents, balance_currency, merchant, category_id, category_manually_set, merchant_manually_set, suggestions, user_notes, foreign_amount_cents, foreign_currency, exchange_rate, created_at, updated_at, deleted_at, status, merchant_id, exchange_rate_manually_set
 `
 
 type CreateTransactionParams struct {
@@ -308,6 +765,7 @@ type CreateTransactionParams struct {
 	ForeignCurrency     *string   `db:"foreign_currency" json:"foreign_currency"`
 	ExchangeRate        *float64  `db:"exchange_rate" json:"exchange_rate"`
 	Suggestions         []string  `db:"suggestions" json:"suggestions"`
+	Status              *int16    `db:"status" json:"status"`
 	UserID              uuid.UUID `db:"user_id" json:"user_id"`
 }
 
@@ -331,6 +789,7 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.ForeignCurrency,
 		arg.ExchangeRate,
 		arg.Suggestions,
+		arg.Status,
 		arg.UserID,
 	)
 	var i Transaction
@@ -356,15 +815,22 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		&i.ExchangeRate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.MerchantID,
+		&i.ExchangeRateManuallySet,
 	)
 	return i, err
 }
 
 const deleteTransaction = `-- name: DeleteTransaction :execrows
-delete from
+update
   transactions
+set
+  deleted_at = now()
 where
   id = $1::bigint
+  and deleted_at is null
   and account_id in (
     select
       a.id
@@ -373,8 +839,11 @@ where
       left join account_users au on a.id = au.account_id
       and au.user_id = $2::uuid
     where
-      a.owner_id = $2::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = $2::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   )
 `
 
@@ -393,7 +862,7 @@ func (q *Queries) DeleteTransaction(ctx context.Context, arg DeleteTransactionPa
 
 const findCandidateTransactions = `-- name: FindCandidateTransactions :many
 select
-  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at,
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set,
   similarity(t.tx_desc::text, $1::text) as merchant_score
 from
   transactions t
@@ -405,6 +874,8 @@ where
     a.owner_id = $2::uuid
     or au.user_id is not null
   )
+  and t.deleted_at is null
+  and a.deleted_at is null
   and t.tx_direction = 2
   and t.tx_date >= ($3::date - interval '60 days')
   and t.tx_amount_cents between $4::bigint and ($4::bigint * 120 / 100)
@@ -463,6 +934,10 @@ func (q *Queries) FindCandidateTransactions(ctx context.Context, arg FindCandida
 			&i.Transaction.ExchangeRate,
 			&i.Transaction.CreatedAt,
 			&i.Transaction.UpdatedAt,
+			&i.Transaction.DeletedAt,
+			&i.Transaction.Status,
+			&i.Transaction.MerchantID,
+			&i.Transaction.ExchangeRateManuallySet,
 			&i.MerchantScore,
 		); err != nil {
 			return nil, err
@@ -475,6 +950,89 @@ func (q *Queries) FindCandidateTransactions(ctx context.Context, arg FindCandida
 	return items, nil
 }
 
+const findPendingMatch = `-- name: FindPendingMatch :one
+select
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set
+from
+  transactions t
+where
+  t.account_id = $1::bigint
+  and t.status = 1
+  and t.deleted_at is null
+  and t.tx_direction = $2::smallint
+  and t.tx_currency = $3::char(3)
+  and t.tx_date between $4::timestamptz - interval '10 days'
+  and $4::timestamptz + interval '1 day'
+  and t.tx_amount_cents between $5::bigint / 2
+  and $5::bigint * 3 / 2
+  and (
+    t.tx_amount_cents = $5::bigint
+    or similarity(lower(coalesce(t.tx_desc, '')), lower($6::text)) >= 0.3
+  )
+  and not t.id = ANY($7::bigint [])
+order by
+  (t.tx_amount_cents = $5::bigint) desc,
+  similarity(lower(coalesce(t.tx_desc, '')), lower($6::text)) desc,
+  abs(extract(epoch from (t.tx_date - $4::timestamptz)))
+limit
+  1 for update
+`
+
+type FindPendingMatchParams struct {
+	AccountID     int64     `db:"account_id" json:"account_id"`
+	TxDirection   int16     `db:"tx_direction" json:"tx_direction"`
+	TxCurrency    string    `db:"tx_currency" json:"tx_currency"`
+	TxDate        time.Time `db:"tx_date" json:"tx_date"`
+	TxAmountCents int64     `db:"tx_amount_cents" json:"tx_amount_cents"`
+	TxDesc        string    `db:"tx_desc" json:"tx_desc"`
+	ExcludeIds    []int64   `db:"exclude_ids" json:"exclude_ids"`
+}
+
+// The pending authorization a posted transaction settles: same account,
+// direction and currency, up to 10 days earlier, and either the same amount
+// or a similar description with an amount that changed by less than half
+// (tips, hotel holds). Exact amounts win, then similarity, then proximity.
+func (q *Queries) FindPendingMatch(ctx context.Context, arg FindPendingMatchParams) (Transaction, error) {
+	row := q.db.QueryRow(ctx, findPendingMatch,
+		arg.AccountID,
+		arg.TxDirection,
+		arg.TxCurrency,
+		arg.TxDate,
+		arg.TxAmountCents,
+		arg.TxDesc,
+		arg.ExcludeIds,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EmailID,
+		&i.TxDate,
+		&i.TxAmountCents,
+		&i.TxCurrency,
+		&i.TxDirection,
+		&i.TxDesc,
+		&i.BalanceAfterCents,
+		&i.BalanceCurrency,
+		&i.Merchant,
+		&i.CategoryID,
+		&i.CategoryManuallySet,
+		&i.MerchantManuallySet,
+		&i.Suggestions,
+		&i.UserNotes,
+		&i.ForeignAmountCents,
+		&i.ForeignCurrency,
+		&i.ExchangeRate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.MerchantID,
+		&i.ExchangeRateManuallySet,
+	)
+	return i, err
+}
+
 const getAccountIDsFromTransactionIDs = `-- name: GetAccountIDsFromTransactionIDs :many
 select
   distinct account_id
@@ -506,7 +1064,7 @@ func (q *Queries) GetAccountIDsFromTransactionIDs(ctx context.Context, ids []int
 
 const getTransaction = `-- name: GetTransaction :one
 select
-  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set
 from
   transactions t
   join accounts a on t.account_id = a.id
@@ -518,6 +1076,8 @@ where
     a.owner_id = $1::uuid
     or au.user_id is not null
   )
+  and t.deleted_at is null
+  and a.deleted_at is null
 `
 
 type GetTransactionParams struct {
@@ -550,6 +1110,10 @@ func (q *Queries) GetTransaction(ctx context.Context, arg GetTransactionParams)
 		&i.ExchangeRate,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.MerchantID,
+		&i.ExchangeRateManuallySet,
 	)
 	return i, err
 }
@@ -564,9 +1128,13 @@ from
   left join account_users au on a.id = au.account_id
   and au.user_id = $1::uuid
   left join transactions t on a.id = t.account_id
+  and t.deleted_at is null
 where
-  a.owner_id = $1::uuid
-  or au.user_id is not null
+  (
+    a.owner_id = $1::uuid
+    or au.user_id is not null
+  )
+  and a.deleted_at is null
 group by
   a.id,
   a.name
@@ -602,7 +1170,7 @@ func (q *Queries) GetTransactionCountByAccount(ctx context.Context, userID uuid.
 
 const listAllTransactions = `-- name: ListAllTransactions :many
 select
-  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set
 from
   transactions t
   join accounts a on t.account_id = a.id
@@ -613,6 +1181,8 @@ where
     a.owner_id = $1::uuid
     or au.user_id is not null
   )
+  and t.deleted_at is null
+  and a.deleted_at is null
 order by
   t.tx_date desc,
   t.id desc
@@ -649,6 +1219,10 @@ func (q *Queries) ListAllTransactions(ctx context.Context, userID uuid.UUID) ([]
 			&i.ExchangeRate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.MerchantID,
+			&i.ExchangeRateManuallySet,
 		); err != nil {
 			return nil, err
 		}
@@ -660,115 +1234,301 @@ func (q *Queries) ListAllTransactions(ctx context.Context, userID uuid.UUID) ([]
 	return items, nil
 }
 
-const listTransactions = `-- name: ListTransactions :many
+const listDeletedTransactions = `-- name: ListDeletedTransactions :many
 select
-  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set
 from
   transactions t
   join accounts a on t.account_id = a.id
   left join account_users au on a.id = au.account_id
   and au.user_id = $1::uuid
-  left join categories c on t.category_id = c.id
 where
   (
     a.owner_id = $1::uuid
     or au.user_id is not null
   )
-  and (
-    $2::timestamptz is null
-    or $3::bigint is null
-    or (t.tx_date, t.id) < (
-      $2::timestamptz,
-      $3::bigint
-    )
-  )
-  and (
-    $4::timestamptz is null
-    or t.tx_date >= $4::timestamptz
-  )
-  and (
-    $5::timestamptz is null
-    or t.tx_date <= $5::timestamptz
-  )
-  and (
-    $6::bigint is null
-    or t.tx_amount_cents >= $6::bigint
-  )
-  and (
-    $7::bigint is null
-    or t.tx_amount_cents <= $7::bigint
-  )
-  and (
-    $8::smallint is null
-    or t.tx_direction = $8::smallint
-  )
-  and (
-    $9::bigint [] is null
-    or t.account_id = ANY($9::bigint [])
-  )
-  and (
-    $10::text [] is null
-    or c.slug = ANY($10::text [])
-  )
-  and (
-    $11::text is null
-    or t.merchant ILIKE ('%' || $11::text || '%')
-  )
-  and (
-    $12::text is null
-    or t.tx_desc ILIKE ('%' || $12::text || '%')
-  )
-  and (
-    $13::char(3) is null
-    or t.tx_currency = $13::char(3)
-  )
-  and (
-    $14::time is null
-    or t.tx_date::time >= $14::time
-  )
-  and (
-    $15::time is null
-    or t.tx_date::time <= $15::time
-  )
-  and (
-    $16::boolean is null
-    or (
-      $16::boolean = true
-      and t.category_id is null
-    )
+  and t.deleted_at is not null
+  and a.deleted_at is null
+order by
+  t.deleted_at desc,
+  t.id desc
+limit
+  $2::int offset $3::int
+`
+
+type ListDeletedTransactionsParams struct {
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+	Limit  int32     `db:"limit" json:"limit"`
+	Offset int32     `db:"offset" json:"offset"`
+}
+
+// Transactions in the trash. Those of a trashed account are listed with the account.
+func (q *Queries) ListDeletedTransactions(ctx context.Context, arg ListDeletedTransactionsParams) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, listDeletedTransactions, arg.UserID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.EmailID,
+			&i.TxDate,
+			&i.TxAmountCents,
+			&i.TxCurrency,
+			&i.TxDirection,
+			&i.TxDesc,
+			&i.BalanceAfterCents,
+			&i.BalanceCurrency,
+			&i.Merchant,
+			&i.CategoryID,
+			&i.CategoryManuallySet,
+			&i.MerchantManuallySet,
+			&i.Suggestions,
+			&i.UserNotes,
+			&i.ForeignAmountCents,
+			&i.ForeignCurrency,
+			&i.ExchangeRate,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.MerchantID,
+			&i.ExchangeRateManuallySet,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExistingEmailIDs = `-- name: ListExistingEmailIDs :many
+select
+  email_id::text
+from
+  transactions
+where
+  email_id = ANY($1::text [])
+`
+
+// Trashed rows count too: email_id is unique across the whole table.
+func (q *Queries) ListExistingEmailIDs(ctx context.Context, emailIds []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listExistingEmailIDs, emailIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email_id string
+		if err := rows.Scan(&email_id); err != nil {
+			return nil, err
+		}
+		items = append(items, email_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactionIDsByFilter = `-- name: ListTransactionIDsByFilter :many
+select
+  t.id,
+  t.account_id,
+  t.tx_currency
+from
+  filter_transactions(
+    p_user_id => $1::uuid,
+    p_start => $2::timestamptz,
+    p_end => $3::timestamptz,
+    p_amount_min_cents => $4::bigint,
+    p_amount_max_cents => $5::bigint,
+    p_direction => $6::smallint,
+    p_account_ids => $7::bigint [],
+    p_categories => $8::text [],
+    p_category_patterns => $9::text [],
+    p_exclude_category_patterns => $10::text [],
+    p_account_names => $11::text [],
+    p_exclude_account_ids => $12::bigint [],
+    p_exclude_account_names => $13::text [],
+    p_tags => $14::text [],
+    p_exclude_tags => $15::text [],
+    p_merchant_q => $16::text,
+    p_exclude_merchant_q => $17::text,
+    p_desc_q => $18::text,
+    p_currency => $19::char(3),
+    p_tod_start => $20::time,
+    p_tod_end => $21::time,
+    p_uncategorized => $22::boolean,
+    p_statuses => $23::smallint []
+  ) t
+order by
+  t.id
+`
+
+type ListTransactionIDsByFilterParams struct {
+	UserID                  uuid.UUID   `db:"user_id" json:"user_id"`
+	Start                   *time.Time  `db:"start" json:"start"`
+	End                     *time.Time  `db:"end" json:"end"`
+	AmountMinCents          *int64      `db:"amount_min_cents" json:"amount_min_cents"`
+	AmountMaxCents          *int64      `db:"amount_max_cents" json:"amount_max_cents"`
+	Direction               *int16      `db:"direction" json:"direction"`
+	AccountIds              []int64     `db:"account_ids" json:"account_ids"`
+	Categories              []string    `db:"categories" json:"categories"`
+	CategoryPatterns        []string    `db:"category_patterns" json:"category_patterns"`
+	ExcludeCategoryPatterns []string    `db:"exclude_category_patterns" json:"exclude_category_patterns"`
+	AccountNames            []string    `db:"account_names" json:"account_names"`
+	ExcludeAccountIds       []int64     `db:"exclude_account_ids" json:"exclude_account_ids"`
+	ExcludeAccountNames     []string    `db:"exclude_account_names" json:"exclude_account_names"`
+	Tags                    []string    `db:"tags" json:"tags"`
+	ExcludeTags             []string    `db:"exclude_tags" json:"exclude_tags"`
+	MerchantQ               *string     `db:"merchant_q" json:"merchant_q"`
+	ExcludeMerchantQ        *string     `db:"exclude_merchant_q" json:"exclude_merchant_q"`
+	DescQ                   *string     `db:"desc_q" json:"desc_q"`
+	Currency                *string     `db:"currency" json:"currency"`
+	TodStart                pgtype.Time `db:"tod_start" json:"tod_start"`
+	TodEnd                  pgtype.Time `db:"tod_end" json:"tod_end"`
+	Uncategorized           *bool       `db:"uncategorized" json:"uncategorized"`
+	Statuses                []int16     `db:"statuses" json:"statuses"`
+}
+
+type ListTransactionIDsByFilterRow struct {
+	ID         int64  `db:"id" json:"id"`
+	AccountID  int64  `db:"account_id" json:"account_id"`
+	TxCurrency string `db:"tx_currency" json:"tx_currency"`
+}
+
+// The rows ListTransactions would return with the same filters, without
+// paging, for bulk updates and deletes.
+func (q *Queries) ListTransactionIDsByFilter(ctx context.Context, arg ListTransactionIDsByFilterParams) ([]ListTransactionIDsByFilterRow, error) {
+	rows, err := q.db.Query(ctx, listTransactionIDsByFilter,
+		arg.UserID,
+		arg.Start,
+		arg.End,
+		arg.AmountMinCents,
+		arg.AmountMaxCents,
+		arg.Direction,
+		arg.AccountIds,
+		arg.Categories,
+		arg.CategoryPatterns,
+		arg.ExcludeCategoryPatterns,
+		arg.AccountNames,
+		arg.ExcludeAccountIds,
+		arg.ExcludeAccountNames,
+		arg.Tags,
+		arg.ExcludeTags,
+		arg.MerchantQ,
+		arg.ExcludeMerchantQ,
+		arg.DescQ,
+		arg.Currency,
+		arg.TodStart,
+		arg.TodEnd,
+		arg.Uncategorized,
+		arg.Statuses,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionIDsByFilterRow
+	for rows.Next() {
+		var i ListTransactionIDsByFilterRow
+		if err := rows.Scan(&i.ID, &i.AccountID, &i.TxCurrency); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransactions = `-- name: ListTransactions :many
+select
+  t.id, t.account_id, t.email_id, t.tx_date, t.tx_amount_cents, t.tx_currency, t.tx_direction, t.tx_desc, t.balance_after_cents, t.balance_currency, t.merchant, t.category_id, t.category_manually_set, t.merchant_manually_set, t.suggestions, t.user_notes, t.foreign_amount_cents, t.foreign_currency, t.exchange_rate, t.created_at, t.updated_at, t.deleted_at, t.status, t.merchant_id, t.exchange_rate_manually_set
+from
+  filter_transactions(
+    p_user_id => $1::uuid,
+    p_start => $2::timestamptz,
+    p_end => $3::timestamptz,
+    p_amount_min_cents => $4::bigint,
+    p_amount_max_cents => $5::bigint,
+    p_direction => $6::smallint,
+    p_account_ids => $7::bigint [],
+    p_categories => $8::text [],
+    p_category_patterns => $9::text [],
+    p_exclude_category_patterns => $10::text [],
+    p_account_names => $11::text [],
+    p_exclude_account_ids => $12::bigint [],
+    p_exclude_account_names => $13::text [],
+    p_tags => $14::text [],
+    p_exclude_tags => $15::text [],
+    p_merchant_q => $16::text,
+    p_exclude_merchant_q => $17::text,
+    p_desc_q => $18::text,
+    p_currency => $19::char(3),
+    p_tod_start => $20::time,
+    p_tod_end => $21::time,
+    p_uncategorized => $22::boolean,
+    p_statuses => $23::smallint []
+  ) t
+where
+  $24::timestamptz is null
+  or $25::bigint is null
+  or (t.tx_date, t.id) < (
+    $24::timestamptz,
+    $25::bigint
   )
 order by
   t.tx_date desc,
   t.id desc
 limit
-  COALESCE($17::int, 100)
+  COALESCE($26::int, 100)
 `
 
 type ListTransactionsParams struct {
-	UserID         uuid.UUID   `db:"user_id" json:"user_id"`
-	CursorDate     *time.Time  `db:"cursor_date" json:"cursor_date"`
-	CursorID       *int64      `db:"cursor_id" json:"cursor_id"`
-	Start          *time.Time  `db:"start" json:"start"`
-	End            *time.Time  `db:"end" json:"end"`
-	AmountMinCents *int64      `db:"amount_min_cents" json:"amount_min_cents"`
-	AmountMaxCents *int64      `db:"amount_max_cents" json:"amount_max_cents"`
-	Direction      *int16      `db:"direction" json:"direction"`
-	AccountIds     []int64     `db:"account_ids" json:"account_ids"`
-	Categories     []string    `db:"categories" json:"categories"`
-	MerchantQ      *string     `db:"merchant_q" json:"merchant_q"`
-	DescQ          *string     `db:"desc_q" json:"desc_q"`
-	Currency       *string     `db:"currency" json:"currency"`
-	TodStart       pgtype.Time `db:"tod_start" json:"tod_start"`
-	TodEnd         pgtype.Time `db:"tod_end" json:"tod_end"`
-	Uncategorized  *bool       `db:"uncategorized" json:"uncategorized"`
-	Limit          *int32      `db:"limit" json:"limit"`
+	UserID                  uuid.UUID   `db:"user_id" json:"user_id"`
+	Start                   *time.Time  `db:"start" json:"start"`
+	End                     *time.Time  `db:"end" json:"end"`
+	AmountMinCents          *int64      `db:"amount_min_cents" json:"amount_min_cents"`
+	AmountMaxCents          *int64      `db:"amount_max_cents" json:"amount_max_cents"`
+	Direction               *int16      `db:"direction" json:"direction"`
+	AccountIds              []int64     `db:"account_ids" json:"account_ids"`
+	Categories              []string    `db:"categories" json:"categories"`
+	CategoryPatterns        []string    `db:"category_patterns" json:"category_patterns"`
+	ExcludeCategoryPatterns []string    `db:"exclude_category_patterns" json:"exclude_category_patterns"`
+	AccountNames            []string    `db:"account_names" json:"account_names"`
+	ExcludeAccountIds       []int64     `db:"exclude_account_ids" json:"exclude_account_ids"`
+	ExcludeAccountNames     []string    `db:"exclude_account_names" json:"exclude_account_names"`
+	Tags                    []string    `db:"tags" json:"tags"`
+	ExcludeTags             []string    `db:"exclude_tags" json:"exclude_tags"`
+	MerchantQ               *string     `db:"merchant_q" json:"merchant_q"`
+	ExcludeMerchantQ        *string     `db:"exclude_merchant_q" json:"exclude_merchant_q"`
+	DescQ                   *string     `db:"desc_q" json:"desc_q"`
+	Currency                *string     `db:"currency" json:"currency"`
+	TodStart                pgtype.Time `db:"tod_start" json:"tod_start"`
+	TodEnd                  pgtype.Time `db:"tod_end" json:"tod_end"`
+	Uncategorized           *bool       `db:"uncategorized" json:"uncategorized"`
+	Statuses                []int16     `db:"statuses" json:"statuses"`
+	CursorDate              *time.Time  `db:"cursor_date" json:"cursor_date"`
+	CursorID                *int64      `db:"cursor_id" json:"cursor_id"`
+	Limit                   *int32      `db:"limit" json:"limit"`
 }
 
+// The list filters live in filter_transactions, shared with bulk updates
+// and deletes and AggregateTransactions.
 func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]Transaction, error) {
 	rows, err := q.db.Query(ctx, listTransactions,
 		arg.UserID,
-		arg.CursorDate,
-		arg.CursorID,
 		arg.Start,
 		arg.End,
 		arg.AmountMinCents,
@@ -776,12 +1536,23 @@ func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsPara
 		arg.Direction,
 		arg.AccountIds,
 		arg.Categories,
+		arg.CategoryPatterns,
+		arg.ExcludeCategoryPatterns,
+		arg.AccountNames,
+		arg.ExcludeAccountIds,
+		arg.ExcludeAccountNames,
+		arg.Tags,
+		arg.ExcludeTags,
 		arg.MerchantQ,
+		arg.ExcludeMerchantQ,
 		arg.DescQ,
 		arg.Currency,
 		arg.TodStart,
 		arg.TodEnd,
 		arg.Uncategorized,
+		arg.Statuses,
+		arg.CursorDate,
+		arg.CursorID,
 		arg.Limit,
 	)
 	if err != nil {
@@ -813,6 +1584,10 @@ func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsPara
 			&i.ExchangeRate,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+			&i.MerchantID,
+			&i.ExchangeRateManuallySet,
 		); err != nil {
 			return nil, err
 		}
@@ -824,6 +1599,185 @@ func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsPara
 	return items, nil
 }
 
+const postPendingTransaction = `-- name: PostPendingTransaction :one
+update
+  transactions
+set
+  status = 2,
+  tx_date = $1::timestamptz,
+  tx_amount_cents = $2::bigint,
+  email_id = coalesce($3::text, email_id),
+  tx_desc = coalesce($4::text, tx_desc),
+  merchant = case
+    when merchant_manually_set then merchant
+    else coalesce($5::text, merchant)
+  end,
+  foreign_amount_cents = coalesce($6::bigint, foreign_amount_cents),
+  foreign_currency = coalesce($7::char(3), foreign_currency),
+  exchange_rate = coalesce($8::double precision, exchange_rate)
+where
+  id = $9::bigint
+  and status = 1
+returning
+  id, account_id, email_id, tx_date, tx_amount_cents, tx_currency, tx_direction, tx_desc, balance_after_cents, balance_currency, merchant, category_id, category_manually_set, merchant_manually_set, suggestions, user_notes, foreign_amount_cents, foreign_currency, exchange_rate, created_at, updated_at, deleted_at, status, merchant_id, exchange_rate_manually_set
+`
+
+type PostPendingTransactionParams struct {
+	TxDate             time.Time `db:"tx_date" json:"tx_date"`
+	TxAmountCents      int64     `db:"tx_amount_cents" json:"tx_amount_cents"`
+	EmailID            *string   `db:"email_id" json:"email_id"`
+	TxDesc             *string   `db:"tx_desc" json:"tx_desc"`
+	Merchant           *string   `db:"merchant" json:"merchant"`
+	ForeignAmountCents *int64    `db:"foreign_amount_cents" json:"foreign_amount_cents"`
+	ForeignCurrency    *string   `db:"foreign_currency" json:"foreign_currency"`
+	ExchangeRate       *float64  `db:"exchange_rate" json:"exchange_rate"`
+	ID                 int64     `db:"id" json:"id"`
+}
+
+// Settles a pending transaction in place with the posted details so its id,
+// category, notes and tags carry over. Blank values keep what was there.
+func (q *Queries) PostPendingTransaction(ctx context.Context, arg PostPendingTransactionParams) (Transaction, error) {
+	row := q.db.QueryRow(ctx, postPendingTransaction,
+		arg.TxDate,
+		arg.TxAmountCents,
+		arg.EmailID,
+		arg.TxDesc,
+		arg.Merchant,
+		arg.ForeignAmountCents,
+		arg.ForeignCurrency,
+		arg.ExchangeRate,
+		arg.ID,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.EmailID,
+		&i.TxDate,
+		&i.TxAmountCents,
+		&i.TxCurrency,
+		&i.TxDirection,
+		&i.TxDesc,
+		&i.BalanceAfterCents,
+		&i.BalanceCurrency,
+		&i.Merchant,
+		&i.CategoryID,
+		&i.CategoryManuallySet,
+		&i.MerchantManuallySet,
+		&i.Suggestions,
+		&i.UserNotes,
+		&i.ForeignAmountCents,
+		&i.ForeignCurrency,
+		&i.ExchangeRate,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+		&i.MerchantID,
+		&i.ExchangeRateManuallySet,
+	)
+	return i, err
+}
+
+const purgeExpiredTransactions = `-- name: PurgeExpiredTransactions :execrows
+delete from
+  transactions
+where
+  deleted_at < $1::timestamptz
+`
+
+func (q *Queries) PurgeExpiredTransactions(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeExpiredTransactions, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTransactions = `-- name: PurgeTransactions :execrows
+delete from
+  transactions
+where
+  id = ANY($1::bigint [])
+  and deleted_at is not null
+  and account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = $2::uuid
+    where
+      a.owner_id = $2::uuid
+      or au.user_id is not null
+  )
+`
+
+type PurgeTransactionsParams struct {
+	TransactionIds []int64   `db:"transaction_ids" json:"transaction_ids"`
+	UserID         uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) PurgeTransactions(ctx context.Context, arg PurgeTransactionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTransactions, arg.TransactionIds, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreTransactions = `-- name: RestoreTransactions :many
+update
+  transactions
+set
+  deleted_at = null
+where
+  id = ANY($1::bigint [])
+  and deleted_at is not null
+  and account_id in (
+    select
+      a.id
+    from
+      accounts a
+      left join account_users au on a.id = au.account_id
+      and au.user_id = $2::uuid
+    where
+      (
+        a.owner_id = $2::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
+  )
+returning
+  account_id
+`
+
+type RestoreTransactionsParams struct {
+	TransactionIds []int64   `db:"transaction_ids" json:"transaction_ids"`
+	UserID         uuid.UUID `db:"user_id" json:"user_id"`
+}
+
+// Returns the accounts of the restored rows so their balances can be resynced.
+func (q *Queries) RestoreTransactions(ctx context.Context, arg RestoreTransactionsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, restoreTransactions, arg.TransactionIds, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var account_id int64
+		if err := rows.Scan(&account_id); err != nil {
+			return nil, err
+		}
+		items = append(items, account_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateTransaction = `-- name: UpdateTransaction :exec
 update
   transactions
@@ -838,48 +1792,71 @@ set
   category_id = coalesce($8::bigint, category_id),
   merchant = coalesce($9::text, merchant),
   user_notes = coalesce($10::text, user_notes),
-  foreign_amount_cents = coalesce($11::bigint, foreign_amount_cents),
-  foreign_currency = coalesce($12::char(3), foreign_currency),
-  exchange_rate = coalesce($13::double precision, exchange_rate),
-  suggestions = coalesce($14::text[], suggestions),
-  category_manually_set = coalesce($15::boolean, category_manually_set),
-  merchant_manually_set = coalesce($16::boolean, merchant_manually_set)
+  foreign_amount_cents = case
+    when $11::boolean then null
+    else coalesce($12::bigint, foreign_amount_cents)
+  end,
+  foreign_currency = case
+    when $11::boolean then null
+    else coalesce($13::char(3), foreign_currency)
+  end,
+  exchange_rate = case
+    when $11::boolean then null
+    else coalesce($14::double precision, exchange_rate)
+  end,
+  exchange_rate_manually_set = case
+    when $11::boolean then false
+    else coalesce($15::boolean, exchange_rate_manually_set)
+  end,
+  suggestions = coalesce($16::text[], suggestions),
+  category_manually_set = coalesce($17::boolean, category_manually_set),
+  merchant_manually_set = coalesce($18::boolean, merchant_manually_set),
+  status = coalesce($19::smallint, status)
 where
-  id = $17::bigint
+  id = $20::bigint
+  and deleted_at is null
   and account_id in (
     select
       a.id
     from
       accounts a
       left join account_users au on a.id = au.account_id
-      and au.user_id = $18::uuid
+      and au.user_id = $21::uuid
     where
-      a.owner_id = $18::uuid
-      or au.user_id is not null
+      (
+        a.owner_id = $21::uuid
+        or au.user_id is not null
+      )
+      and a.deleted_at is null
   )
 `
 
 type UpdateTransactionParams struct {
-	EmailID             *string    `db:"email_id" json:"email_id"`
-	AccountID           *int64     `db:"account_id" json:"account_id"`
-	TxDate              *time.Time `db:"tx_date" json:"tx_date"`
-	TxAmountCents       *int64     `db:"tx_amount_cents" json:"tx_amount_cents"`
-	TxCurrency          *string    `db:"tx_currency" json:"tx_currency"`
-	TxDirection         *int16     `db:"tx_direction" json:"tx_direction"`
-	TxDesc              *string    `db:"tx_desc" json:"tx_desc"`
-	CategoryID          *int64     `db:"category_id" json:"category_id"`
-	Merchant            *string    `db:"merchant" json:"merchant"`
-	UserNotes           *string    `db:"user_notes" json:"user_notes"`
-	ForeignAmountCents  *int64     `db:"foreign_amount_cents" json:"foreign_amount_cents"`
-	ForeignCurrency     *string    `db:"foreign_currency" json:"foreign_currency"`
-	ExchangeRate        *float64   `db:"exchange_rate" json:"exchange_rate"`
-	Suggestions         []string   `db:"suggestions" json:"suggestions"`
-	CategoryManuallySet *bool      `db:"category_manually_set" json:"category_manually_set"`
-	MerchantManuallySet *bool      `db:"merchant_manually_set" json:"merchant_manually_set"`
-	ID                  int64      `db:"id" json:"id"`
-	UserID              uuid.UUID  `db:"user_id" json:"user_id"`
+	EmailID                 *string    `db:"email_id" json:"email_id"`
+	AccountID               *int64     `db:"account_id" json:"account_id"`
+	TxDate                  *time.Time `db:"tx_date" json:"tx_date"`
+	TxAmountCents           *int64     `db:"tx_amount_cents" json:"tx_amount_cents"`
+	TxCurrency              *string    `db:"tx_currency" json:"tx_currency"`
+	TxDirection             *int16     `db:"tx_direction" json:"tx_direction"`
+	TxDesc                  *string    `db:"tx_desc" json:"tx_desc"`
+	CategoryID              *int64     `db:"category_id" json:"category_id"`
+	Merchant                *string    `db:"merchant" json:"merchant"`
+	UserNotes               *string    `db:"user_notes" json:"user_notes"`
+	ClearForeign            bool       `db:"clear_foreign" json:"clear_foreign"`
+	ForeignAmountCents      *int64     `db:"foreign_amount_cents" json:"foreign_amount_cents"`
+	ForeignCurrency         *string    `db:"foreign_currency" json:"foreign_currency"`
+	ExchangeRate            *float64   `db:"exchange_rate" json:"exchange_rate"`
+	ExchangeRateManuallySet *bool      `db:"exchange_rate_manually_set" json:"exchange_rate_manually_set"`
+	Suggestions             []string   `db:"suggestions" json:"suggestions"`
+	CategoryManuallySet     *bool      `db:"category_manually_set" json:"category_manually_set"`
+	MerchantManuallySet     *bool      `db:"merchant_manually_set" json:"merchant_manually_set"`
+	Status                  *int16     `db:"status" json:"status"`
+	ID                      int64      `db:"id" json:"id"`
+	UserID                  uuid.UUID  `db:"user_id" json:"user_id"`
 }
 
+// clear_foreign drops the conversion when the amount is already in the
+// account's anchor currency.
 func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionParams) error {
 	_, err := q.db.Exec(ctx, updateTransaction,
 		arg.EmailID,
@@ -892,12 +1869,15 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		arg.CategoryID,
 		arg.Merchant,
 		arg.UserNotes,
+		arg.ClearForeign,
 		arg.ForeignAmountCents,
 		arg.ForeignCurrency,
 		arg.ExchangeRate,
+		arg.ExchangeRateManuallySet,
 		arg.Suggestions,
 		arg.CategoryManuallySet,
 		arg.MerchantManuallySet,
+		arg.Status,
 		arg.ID,
 		arg.UserID,
 	)
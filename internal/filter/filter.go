@@ -0,0 +1,121 @@
+// Package filter parses the query-string filter language shared by clients
+// and saved views, e.g.
+//
+//	merchant:starbucks amount>20 amount<100 after:2026-01-01 category:food.* account:"Visa" -tag:work
+//
+// Terms are separated by whitespace and all of them must match. Values with
+// spaces go in double quotes, a leading '-' negates the terms that allow it,
+// and list terms (category, account, tag, status) take comma separated alternatives.
+// Words without a key search the description.
+package filter
+
+import (
+	"ariand/internal/db/sqlc"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Filter is the parsed form of an expression. Category values are LIKE
+// patterns, everything else is ready to hand to the query as is.
+type Filter struct {
+	MerchantQ        *string
+	ExcludeMerchantQ *string
+	DescQ            *string
+	AmountMinCents   *int64
+	AmountMaxCents   *int64
+	Start            *time.Time
+	End              *time.Time
+	Currency         *string
+	Direction        *int16
+	Uncategorized    *bool
+	TodStart         *time.Duration // since midnight
+	TodEnd           *time.Duration
+
+	Categories          []string
+	ExcludeCategories   []string
+	AccountIDs          []int64
+	ExcludeAccountIDs   []int64
+	AccountNames        []string
+	ExcludeAccountNames []string
+	Tags                []string
+	ExcludeTags         []string
+	Statuses            []int16
+}
+
+// Error describes one term that could not be parsed. Pos and End are byte
+// offsets into the input so editors can underline the term.
+type Error struct {
+	Pos  int
+	End  int
+	Term string
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("filter: %q at %d: %s", e.Term, e.Pos, e.Msg)
+}
+
+// Errors is returned by Parse, it holds every bad term rather than just the first.
+type Errors []*Error
+
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Apply merges the filter into params. Scalar conditions replace what is
+// already there, list conditions are added to it.
+func (f *Filter) Apply(params *sqlc.ListTransactionsParams) {
+	if f.MerchantQ != nil {
+		params.MerchantQ = f.MerchantQ
+	}
+	if f.ExcludeMerchantQ != nil {
+		params.ExcludeMerchantQ = f.ExcludeMerchantQ
+	}
+	if f.DescQ != nil {
+		params.DescQ = f.DescQ
+	}
+	if f.AmountMinCents != nil {
+		params.AmountMinCents = f.AmountMinCents
+	}
+	if f.AmountMaxCents != nil {
+		params.AmountMaxCents = f.AmountMaxCents
+	}
+	if f.Start != nil {
+		params.Start = f.Start
+	}
+	if f.End != nil {
+		params.End = f.End
+	}
+	if f.Currency != nil {
+		params.Currency = f.Currency
+	}
+	if f.Direction != nil {
+		params.Direction = f.Direction
+	}
+	if f.Uncategorized != nil {
+		params.Uncategorized = f.Uncategorized
+	}
+	if f.TodStart != nil {
+		params.TodStart.Microseconds = f.TodStart.Microseconds()
+		params.TodStart.Valid = true
+	}
+	if f.TodEnd != nil {
+		params.TodEnd.Microseconds = f.TodEnd.Microseconds()
+		params.TodEnd.Valid = true
+	}
+
+	params.CategoryPatterns = append(params.CategoryPatterns, f.Categories...)
+	params.ExcludeCategoryPatterns = append(params.ExcludeCategoryPatterns, f.ExcludeCategories...)
+	params.AccountIds = append(params.AccountIds, f.AccountIDs...)
+	params.ExcludeAccountIds = append(params.ExcludeAccountIds, f.ExcludeAccountIDs...)
+	params.AccountNames = append(params.AccountNames, f.AccountNames...)
+	params.ExcludeAccountNames = append(params.ExcludeAccountNames, f.ExcludeAccountNames...)
+	params.Tags = append(params.Tags, f.Tags...)
+	params.ExcludeTags = append(params.ExcludeTags, f.ExcludeTags...)
+	params.Statuses = append(params.Statuses, f.Statuses...)
+}
@@ -0,0 +1,460 @@
+package filter
+
+import (
+	"ariand/internal/money"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	directionIncoming int16 = 1
+	directionOutgoing int16 = 2
+)
+
+var statuses = map[string]int16{
+	"pending": 1,
+	"posted":  2,
+	"voided":  3,
+}
+
+// term is one lexed piece of the input: [-]key op value, or a bare value.
+type term struct {
+	pos, end int
+	raw      string
+	negated  bool
+	key      string // empty for free text
+	op       string // ":", "=", ">", ">=", "<" or "<="
+	value    string
+}
+
+// Parse turns an expression into a Filter. Dates and times of day are read in
+// loc. Amounts are read in the currency: term's currency, or in currency when
+// there is none. An empty expression yields an empty Filter.
+func Parse(input string, loc *time.Location, currency string) (*Filter, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	terms, errs := lex(input)
+	p := parser{f: &Filter{}, loc: loc, errs: errs}
+	var words []string
+	for _, t := range terms {
+		if t.key == "" {
+			if t.negated {
+				p.fail(t, "free text can't be negated, use -merchant: or a keyed term")
+				continue
+			}
+			words = append(words, t.value)
+			continue
+		}
+		p.term(t)
+	}
+
+	// amounts wait for the whole expression, currency: can come after them
+	if p.f.Currency != nil {
+		currency = *p.f.Currency
+	}
+	for _, t := range p.amounts {
+		p.amount(t, currency)
+	}
+
+	if len(words) > 0 {
+		if p.f.DescQ != nil {
+			p.errs = append(p.errs, &Error{Pos: 0, End: len(input), Term: strings.Join(words, " "), Msg: "free text and desc: can't be combined"})
+		} else {
+			desc := strings.Join(words, " ")
+			p.f.DescQ = &desc
+		}
+	}
+
+	if len(p.errs) > 0 {
+		return nil, p.errs
+	}
+	return p.f, nil
+}
+
+type parser struct {
+	f       *Filter
+	loc     *time.Location
+	errs    Errors
+	amounts []term
+}
+
+func (p *parser) fail(t term, msg string) {
+	p.errs = append(p.errs, &Error{Pos: t.pos, End: t.end, Term: t.raw, Msg: msg})
+}
+
+func (p *parser) term(t term) {
+	negatable := map[string]bool{"merchant": true, "category": true, "cat": true, "account": true, "tag": true}
+	if t.negated && !negatable[t.key] {
+		p.fail(t, t.key+" can't be negated")
+		return
+	}
+	if t.value == "" {
+		p.fail(t, "missing value")
+		return
+	}
+
+	switch t.key {
+	case "merchant":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		target := &p.f.MerchantQ
+		if t.negated {
+			target = &p.f.ExcludeMerchantQ
+		}
+		p.setOnce(t, target, t.value)
+
+	case "desc", "description":
+		if p.expectOp(t, ":") {
+			p.setOnce(t, &p.f.DescQ, t.value)
+		}
+
+	case "amount":
+		p.amounts = append(p.amounts, t)
+
+	case "after", "before", "on":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		day, err := time.ParseInLocation("2006-01-02", t.value, p.loc)
+		if err != nil {
+			p.fail(t, "dates are written YYYY-MM-DD")
+			return
+		}
+		// after: includes the day, before: excludes it, on: is exactly that day
+		if t.key != "before" {
+			p.f.Start = laterTime(p.f.Start, day)
+		}
+		if t.key == "before" {
+			p.f.End = earlierTime(p.f.End, day.Add(-time.Microsecond))
+		}
+		if t.key == "on" {
+			p.f.End = earlierTime(p.f.End, day.AddDate(0, 0, 1).Add(-time.Microsecond))
+		}
+
+	case "category", "cat":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		for _, v := range p.list(t) {
+			pattern := categoryPattern(v)
+			if t.negated {
+				p.f.ExcludeCategories = append(p.f.ExcludeCategories, pattern)
+			} else {
+				p.f.Categories = append(p.f.Categories, pattern)
+			}
+		}
+
+	case "account":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		for _, v := range p.list(t) {
+			id, err := strconv.ParseInt(v, 10, 64)
+			switch {
+			case err == nil && id > 0 && t.negated:
+				p.f.ExcludeAccountIDs = append(p.f.ExcludeAccountIDs, id)
+			case err == nil && id > 0:
+				p.f.AccountIDs = append(p.f.AccountIDs, id)
+			case t.negated:
+				p.f.ExcludeAccountNames = append(p.f.ExcludeAccountNames, strings.ToLower(v))
+			default:
+				p.f.AccountNames = append(p.f.AccountNames, strings.ToLower(v))
+			}
+		}
+
+	case "tag":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		for _, v := range p.list(t) {
+			slug := strings.ToLower(strings.TrimPrefix(v, "#"))
+			if t.negated {
+				p.f.ExcludeTags = append(p.f.ExcludeTags, slug)
+			} else {
+				p.f.Tags = append(p.f.Tags, slug)
+			}
+		}
+
+	case "status":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		for _, v := range p.list(t) {
+			status, ok := statuses[strings.ToLower(v)]
+			if !ok {
+				p.fail(t, "status takes pending, posted or voided")
+				return
+			}
+			p.f.Statuses = append(p.f.Statuses, status)
+		}
+
+	case "currency":
+		if !p.expectOp(t, ":") {
+			return
+		}
+		if len(t.value) != 3 || strings.IndexFunc(t.value, func(r rune) bool { return !unicode.IsLetter(r) }) >= 0 {
+			p.fail(t, "currency must be a 3 letter code like CAD")
+			return
+		}
+		p.setOnce(t, &p.f.Currency, strings.ToUpper(t.value))
+
+	case "is":
+		if p.expectOp(t, ":") {
+			p.is(t)
+		}
+
+	case "time":
+		p.timeOfDay(t)
+
+	default:
+		p.fail(t, "unknown key "+t.key)
+	}
+}
+
+func (p *parser) amount(t term, currency string) {
+	cents, ok := parseMinor(t.value, money.Digits(currency))
+	if !ok {
+		p.fail(t, fmt.Sprintf("amounts are positive numbers with at most %d decimals", money.Digits(currency)))
+		return
+	}
+
+	// amounts are stored unsigned, direction is a separate is:in / is:out term
+	switch t.op {
+	case ":", "=":
+		p.f.AmountMinCents = larger(p.f.AmountMinCents, cents)
+		p.f.AmountMaxCents = smaller(p.f.AmountMaxCents, cents)
+	case ">":
+		p.f.AmountMinCents = larger(p.f.AmountMinCents, cents+1)
+	case ">=":
+		p.f.AmountMinCents = larger(p.f.AmountMinCents, cents)
+	case "<":
+		p.f.AmountMaxCents = smaller(p.f.AmountMaxCents, cents-1)
+	case "<=":
+		p.f.AmountMaxCents = smaller(p.f.AmountMaxCents, cents)
+	}
+}
+
+func (p *parser) is(t term) {
+	switch strings.ToLower(t.value) {
+	case "in", "incoming":
+		p.setDirection(t, directionIncoming)
+	case "out", "outgoing":
+		p.setDirection(t, directionOutgoing)
+	case "uncategorized":
+		uncategorized := true
+		p.f.Uncategorized = &uncategorized
+	default:
+		p.fail(t, "is: takes in, out or uncategorized")
+	}
+}
+
+func (p *parser) setDirection(t term, dir int16) {
+	if p.f.Direction != nil && *p.f.Direction != dir {
+		p.fail(t, "conflicts with an earlier is: term")
+		return
+	}
+	p.f.Direction = &dir
+}
+
+// timeOfDay accepts time>=09:00, time<=17:30 and the range time:09:00-17:30.
+func (p *parser) timeOfDay(t term) {
+	switch t.op {
+	case ">=":
+		if d, ok := parseClock(t.value); ok {
+			p.f.TodStart = &d
+			return
+		}
+	case "<=":
+		if d, ok := parseClock(t.value); ok {
+			p.f.TodEnd = &d
+			return
+		}
+	case ":":
+		from, to, found := strings.Cut(t.value, "-")
+		start, ok1 := parseClock(from)
+		end, ok2 := parseClock(to)
+		if found && ok1 && ok2 {
+			p.f.TodStart, p.f.TodEnd = &start, &end
+			return
+		}
+	default:
+		p.fail(t, "time takes >=, <= or a HH:MM-HH:MM range")
+		return
+	}
+	p.fail(t, "times are written HH:MM")
+}
+
+func (p *parser) expectOp(t term, op string) bool {
+	if t.op != op {
+		p.fail(t, t.key+" takes "+op)
+		return false
+	}
+	return true
+}
+
+func (p *parser) setOnce(t term, target **string, value string) {
+	if *target != nil {
+		p.fail(t, t.key+" is given more than once")
+		return
+	}
+	*target = &value
+}
+
+func (p *parser) list(t term) []string {
+	var values []string
+	for _, v := range strings.Split(t.value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		p.fail(t, "missing value")
+	}
+	return values
+}
+
+// ----- lexer -------------------------------------------------------------------------------
+
+func lex(input string) ([]term, Errors) {
+	var (
+		terms []term
+		errs  Errors
+	)
+
+	i := 0
+	for i < len(input) {
+		if isSpace(input[i]) {
+			i++
+			continue
+		}
+
+		t := term{pos: i}
+		if input[i] == '-' && i+1 < len(input) && !isSpace(input[i+1]) {
+			t.negated = true
+			i++
+		}
+
+		// a key is a run of letters directly followed by an operator
+		k := i
+		for k < len(input) && isKeyChar(input[k]) {
+			k++
+		}
+		if k > i && k < len(input) && isOpChar(input[k]) {
+			t.key = strings.ToLower(input[i:k])
+			o := k + 1
+			if o < len(input) && input[o] == '=' && input[k] != ':' && input[k] != '=' {
+				o++
+			}
+			t.op = input[k:o]
+			i = o
+		}
+
+		value, next, ok := lexValue(input, i)
+		t.value, t.end, t.raw = value, next, input[t.pos:next]
+		if !ok {
+			errs = append(errs, &Error{Pos: t.pos, End: next, Term: t.raw, Msg: "unterminated quote"})
+		} else {
+			terms = append(terms, t)
+		}
+		i = next
+	}
+
+	return terms, errs
+}
+
+// lexValue reads a bare word or a double quoted string (with \" escapes).
+func lexValue(input string, i int) (string, int, bool) {
+	if i >= len(input) || input[i] != '"' {
+		start := i
+		for i < len(input) && !isSpace(input[i]) {
+			i++
+		}
+		return input[start:i], i, true
+	}
+
+	var b strings.Builder
+	for i++; i < len(input); i++ {
+		switch {
+		case input[i] == '\\' && i+1 < len(input):
+			i++
+			b.WriteByte(input[i])
+		case input[i] == '"':
+			return b.String(), i + 1, true
+		default:
+			b.WriteByte(input[i])
+		}
+	}
+	return b.String(), i, false
+}
+
+func isSpace(c byte) bool   { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
+func isKeyChar(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' }
+func isOpChar(c byte) bool  { return c == ':' || c == '=' || c == '>' || c == '<' }
+
+// ----- value helpers -----------------------------------------------------------------------
+
+// categoryPattern turns "food.*" into the LIKE pattern "food.%".
+func categoryPattern(v string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`)
+	return r.Replace(strings.ToLower(v))
+}
+
+// parseMinor reads "20", "20.5" or "20.05" into minor units with places
+// decimals without going through floats.
+func parseMinor(v string, places int) (int64, bool) {
+	whole, frac, _ := strings.Cut(v, ".")
+	if whole == "" || len(frac) > places || strings.Trim(frac, "0123456789") != "" {
+		return 0, false
+	}
+	digits := whole + frac + strings.Repeat("0", places-len(frac))
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		return 0, false
+	}
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return minor, true
+}
+
+func parseClock(v string) (time.Duration, bool) {
+	clock, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute, true
+}
+
+func larger(cur *int64, v int64) *int64 {
+	if cur != nil && *cur >= v {
+		return cur
+	}
+	return &v
+}
+
+func smaller(cur *int64, v int64) *int64 {
+	if cur != nil && *cur <= v {
+		return cur
+	}
+	return &v
+}
+
+func laterTime(cur *time.Time, v time.Time) *time.Time {
+	if cur != nil && !cur.Before(v) {
+		return cur
+	}
+	return &v
+}
+
+func earlierTime(cur *time.Time, v time.Time) *time.Time {
+	if cur != nil && !cur.After(v) {
+		return cur
+	}
+	return &v
+}
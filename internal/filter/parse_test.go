@@ -0,0 +1,155 @@
+package filter
+
+import (
+	"ariand/internal/db/sqlc"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParse_Example(t *testing.T) {
+	f, err := Parse(`merchant:starbucks amount>20 amount<100 after:2026-01-01 category:food.* account:"Visa" -tag:work`, time.UTC, "CAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if f.MerchantQ == nil || *f.MerchantQ != "starbucks" {
+		t.Errorf("merchant = %v", f.MerchantQ)
+	}
+	if f.AmountMinCents == nil || *f.AmountMinCents != 2001 {
+		t.Errorf("amount min = %v, want 2001", f.AmountMinCents)
+	}
+	if f.AmountMaxCents == nil || *f.AmountMaxCents != 9999 {
+		t.Errorf("amount max = %v, want 9999", f.AmountMaxCents)
+	}
+	if want := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); f.Start == nil || !f.Start.Equal(want) {
+		t.Errorf("start = %v, want %v", f.Start, want)
+	}
+	if !reflect.DeepEqual(f.Categories, []string{"food.%"}) {
+		t.Errorf("categories = %v", f.Categories)
+	}
+	if !reflect.DeepEqual(f.AccountNames, []string{"visa"}) {
+		t.Errorf("account names = %v", f.AccountNames)
+	}
+	if !reflect.DeepEqual(f.ExcludeTags, []string{"work"}) {
+		t.Errorf("exclude tags = %v", f.ExcludeTags)
+	}
+}
+
+func TestParse_Terms(t *testing.T) {
+	toronto, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Skip("tzdata not available")
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		check func(*Filter) bool
+	}{
+		{"empty", "   ", func(f *Filter) bool { return reflect.DeepEqual(f, &Filter{}) }},
+		{"free text", `coffee "corner shop"`, func(f *Filter) bool { return *f.DescQ == "coffee corner shop" }},
+		{"exact amount", "amount:12.5", func(f *Filter) bool { return *f.AmountMinCents == 1250 && *f.AmountMaxCents == 1250 }},
+		{"amounts tighten", "amount>=10 amount>=5", func(f *Filter) bool { return *f.AmountMinCents == 1000 }},
+		{"account ids and names", "account:12,Chequing", func(f *Filter) bool {
+			return reflect.DeepEqual(f.AccountIDs, []int64{12}) && reflect.DeepEqual(f.AccountNames, []string{"chequing"})
+		}},
+		{"negated category", "-cat:transfers", func(f *Filter) bool { return reflect.DeepEqual(f.ExcludeCategories, []string{"transfers"}) }},
+		{"like metacharacters", "category:a_b%", func(f *Filter) bool { return f.Categories[0] == `a\_b\%` }},
+		{"before excludes the day", "before:2026-02-01", func(f *Filter) bool {
+			return f.End.Equal(time.Date(2026, 1, 31, 23, 59, 59, 999999000, time.UTC))
+		}},
+		{"direction", "is:out", func(f *Filter) bool { return *f.Direction == directionOutgoing }},
+		{"time range", "time:09:00-17:30", func(f *Filter) bool { return *f.TodStart == 9*time.Hour && *f.TodEnd == 17*time.Hour+30*time.Minute }},
+		{"statuses", "status:pending,Voided", func(f *Filter) bool { return reflect.DeepEqual(f.Statuses, []int16{1, 3}) }},
+		{"currency", "currency:usd", func(f *Filter) bool { return *f.Currency == "USD" }},
+		{"amount in currency", "amount>=1200 currency:jpy", func(f *Filter) bool { return *f.AmountMinCents == 1200 }},
+		{"amount in dinars", "currency:KWD amount:1.25", func(f *Filter) bool { return *f.AmountMinCents == 1250 }},
+		{"escaped quote", `merchant:"Joe\"s"`, func(f *Filter) bool { return *f.MerchantQ == `Joe"s` }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := Parse(tt.input, time.UTC, "CAD")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.check(f) {
+				t.Errorf("unexpected filter for %q: %+v", tt.input, f)
+			}
+		})
+	}
+
+	t.Run("dates use the given location", func(t *testing.T) {
+		f, err := Parse("on:2026-07-01", toronto, "CAD")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := time.Date(2026, 7, 1, 4, 0, 0, 0, time.UTC); !f.Start.Equal(want) {
+			t.Errorf("start = %v, want %v", f.Start.UTC(), want)
+		}
+	})
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		pos   int
+		term  string
+	}{
+		{"unknown key", "amount>5 colour:red", 9, "colour:red"},
+		{"bad amount", "amount>abc", 0, "amount>abc"},
+		{"too many decimals", "amount:1.234", 0, "amount:1.234"},
+		{"decimals for yen", "currency:JPY amount:1.5", 13, "amount:1.5"},
+		{"bad date", "after:01/02/2026", 0, "after:01/02/2026"},
+		{"unterminated quote", `merchant:"tim`, 0, `merchant:"tim`},
+		{"not negatable", "-amount>5", 0, "-amount>5"},
+		{"wrong operator", "tag>work", 0, "tag>work"},
+		{"missing value", "merchant:", 0, "merchant:"},
+		{"duplicate merchant", "merchant:a merchant:b", 11, "merchant:b"},
+		{"conflicting direction", "is:in is:out", 6, "is:out"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input, time.UTC, "CAD")
+			var errs Errors
+			if !errors.As(err, &errs) || len(errs) != 1 {
+				t.Fatalf("expected one parse error, got %v", err)
+			}
+			if errs[0].Pos != tt.pos || errs[0].Term != tt.term {
+				t.Errorf("error at %d %q, want %d %q", errs[0].Pos, errs[0].Term, tt.pos, tt.term)
+			}
+		})
+	}
+
+	t.Run("reports every bad term", func(t *testing.T) {
+		_, err := Parse("foo:1 bar:2", time.UTC, "CAD")
+		var errs Errors
+		if !errors.As(err, &errs) || len(errs) != 2 {
+			t.Fatalf("expected two errors, got %v", err)
+		}
+	})
+}
+
+func TestFilter_Apply(t *testing.T) {
+	f, err := Parse("tag:a time>=08:00 amount<=50", time.UTC, "CAD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	params := sqlc.ListTransactionsParams{Tags: []string{"b"}}
+	f.Apply(&params)
+
+	if !reflect.DeepEqual(params.Tags, []string{"b", "a"}) {
+		t.Errorf("tags = %v", params.Tags)
+	}
+	if !params.TodStart.Valid || params.TodStart.Microseconds != (8*time.Hour).Microseconds() {
+		t.Errorf("tod start = %+v", params.TodStart)
+	}
+	if params.AmountMaxCents == nil || *params.AmountMaxCents != 5000 {
+		t.Errorf("amount max = %v", params.AmountMaxCents)
+	}
+}
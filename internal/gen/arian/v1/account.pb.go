@@ -39,8 +39,12 @@ type Account struct {
 	MainCurrency  string                 `protobuf:"bytes,11,opt,name=main_currency,json=mainCurrency,proto3" json:"main_currency,omitempty"`
 	Colors        []string               `protobuf:"bytes,12,rep,name=colors,proto3" json:"colors,omitempty"`
 	Balance       *money.Money           `protobuf:"bytes,13,opt,name=balance,proto3" json:"balance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// set while the account is in the trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// whether pending transactions count towards the balance
+	IncludePending bool `protobuf:"varint,15,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
@@ -164,6 +168,20 @@ func (x *Account) GetBalance() *money.Money {
 	return nil
 }
 
+func (x *Account) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Account) GetIncludePending() bool {
+	if x != nil {
+		return x.IncludePending
+	}
+	return false
+}
+
 type AccountBalance struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -244,7 +262,7 @@ var File_arian_v1_account_proto protoreflect.FileDescriptor
 
 const file_arian_v1_account_proto_rawDesc = "" +
 	"\n" +
-	"\x16arian/v1/account.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\xf6\x04\n" +
+	"\x16arian/v1/account.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\xee\x05\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
 	"\bowner_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aownerId\x12\x1d\n" +
@@ -263,8 +281,12 @@ const file_arian_v1_account_proto_rawDesc = "" +
 	"\rmain_currency\x18\v \x01(\tB\x14\xbaH\x11r\x0f2\n" +
 	"^[A-Z]{3}$\x98\x01\x03R\fmainCurrency\x12<\n" +
 	"\x06colors\x18\f \x03(\tB$\xbaH!\x92\x01\x1e\b\x03\x10\x03\"\x18r\x162\x11^#[0-9a-fA-F]{6}$\x98\x01\aR\x06colors\x12,\n" +
-	"\abalance\x18\r \x01(\v2\x12.google.type.MoneyR\abalanceB\b\n" +
-	"\x06_alias\"\xc7\x01\n" +
+	"\abalance\x18\r \x01(\v2\x12.google.type.MoneyR\abalance\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tdeletedAt\x88\x01\x01\x12'\n" +
+	"\x0finclude_pending\x18\x0f \x01(\bR\x0eincludePendingB\b\n" +
+	"\x06_aliasB\r\n" +
+	"\v_deleted_at\"\xc7\x01\n" +
 	"\x0eAccountBalance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x128\n" +
@@ -300,13 +322,14 @@ var file_arian_v1_account_proto_depIdxs = []int32{
 	4, // 3: arian.v1.Account.created_at:type_name -> google.protobuf.Timestamp
 	4, // 4: arian.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
 	3, // 5: arian.v1.Account.balance:type_name -> google.type.Money
-	2, // 6: arian.v1.AccountBalance.account_type:type_name -> arian.v1.AccountType
-	3, // 7: arian.v1.AccountBalance.current_balance:type_name -> google.type.Money
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4, // 6: arian.v1.Account.deleted_at:type_name -> google.protobuf.Timestamp
+	2, // 7: arian.v1.AccountBalance.account_type:type_name -> arian.v1.AccountType
+	3, // 8: arian.v1.AccountBalance.current_balance:type_name -> google.type.Money
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_account_proto_init() }
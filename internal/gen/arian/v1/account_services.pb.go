@@ -210,17 +210,18 @@ func (x *GetAccountResponse) GetAccount() *Account {
 }
 
 type CreateAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Bank          string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
-	Type          AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=arian.v1.AccountType" json:"type,omitempty"`
-	Alias         *string                `protobuf:"bytes,5,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
-	AnchorBalance *money.Money           `protobuf:"bytes,6,opt,name=anchor_balance,json=anchorBalance,proto3" json:"anchor_balance,omitempty"`
-	MainCurrency  string                 `protobuf:"bytes,7,opt,name=main_currency,json=mainCurrency,proto3" json:"main_currency,omitempty"`
-	Colors        []string               `protobuf:"bytes,8,rep,name=colors,proto3" json:"colors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bank           string                 `protobuf:"bytes,3,opt,name=bank,proto3" json:"bank,omitempty"`
+	Type           AccountType            `protobuf:"varint,4,opt,name=type,proto3,enum=arian.v1.AccountType" json:"type,omitempty"`
+	Alias          *string                `protobuf:"bytes,5,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
+	AnchorBalance  *money.Money           `protobuf:"bytes,6,opt,name=anchor_balance,json=anchorBalance,proto3" json:"anchor_balance,omitempty"`
+	MainCurrency   string                 `protobuf:"bytes,7,opt,name=main_currency,json=mainCurrency,proto3" json:"main_currency,omitempty"`
+	Colors         []string               `protobuf:"bytes,8,rep,name=colors,proto3" json:"colors,omitempty"`
+	IncludePending bool                   `protobuf:"varint,9,opt,name=include_pending,json=includePending,proto3" json:"include_pending,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateAccountRequest) Reset() {
@@ -309,6 +310,13 @@ func (x *CreateAccountRequest) GetColors() []string {
 	return nil
 }
 
+func (x *CreateAccountRequest) GetIncludePending() bool {
+	if x != nil {
+		return x.IncludePending
+	}
+	return false
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -359,16 +367,17 @@ type UpdateAccountRequest struct {
 	Id         int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	// fields that can be updated
-	Name          *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Bank          *string                `protobuf:"bytes,5,opt,name=bank,proto3,oneof" json:"bank,omitempty"`
-	AccountType   *AccountType           `protobuf:"varint,6,opt,name=account_type,json=accountType,proto3,enum=arian.v1.AccountType,oneof" json:"account_type,omitempty"`
-	Alias         *string                `protobuf:"bytes,7,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
-	AnchorDate    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=anchor_date,json=anchorDate,proto3,oneof" json:"anchor_date,omitempty"`
-	AnchorBalance *money.Money           `protobuf:"bytes,9,opt,name=anchor_balance,json=anchorBalance,proto3,oneof" json:"anchor_balance,omitempty"`
-	MainCurrency  *string                `protobuf:"bytes,10,opt,name=main_currency,json=mainCurrency,proto3,oneof" json:"main_currency,omitempty"`
-	Colors        []string               `protobuf:"bytes,11,rep,name=colors,proto3" json:"colors,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Name           *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Bank           *string                `protobuf:"bytes,5,opt,name=bank,proto3,oneof" json:"bank,omitempty"`
+	AccountType    *AccountType           `protobuf:"varint,6,opt,name=account_type,json=accountType,proto3,enum=arian.v1.AccountType,oneof" json:"account_type,omitempty"`
+	Alias          *string                `protobuf:"bytes,7,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
+	AnchorDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=anchor_date,json=anchorDate,proto3,oneof" json:"anchor_date,omitempty"`
+	AnchorBalance  *money.Money           `protobuf:"bytes,9,opt,name=anchor_balance,json=anchorBalance,proto3,oneof" json:"anchor_balance,omitempty"`
+	MainCurrency   *string                `protobuf:"bytes,10,opt,name=main_currency,json=mainCurrency,proto3,oneof" json:"main_currency,omitempty"`
+	Colors         []string               `protobuf:"bytes,11,rep,name=colors,proto3" json:"colors,omitempty"`
+	IncludePending *bool                  `protobuf:"varint,12,opt,name=include_pending,json=includePending,proto3,oneof" json:"include_pending,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateAccountRequest) Reset() {
@@ -478,6 +487,13 @@ func (x *UpdateAccountRequest) GetColors() []string {
 	return nil
 }
 
+func (x *UpdateAccountRequest) GetIncludePending() bool {
+	if x != nil && x.IncludePending != nil {
+		return *x.IncludePending
+	}
+	return false
+}
+
 type UpdateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -610,11 +626,396 @@ func (x *DeleteAccountResponse) GetAffectedRows() int64 {
 	return 0
 }
 
+type ListDeletedAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedAccountsRequest) Reset() {
+	*x = ListDeletedAccountsRequest{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedAccountsRequest) ProtoMessage() {}
+
+func (x *ListDeletedAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDeletedAccountsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListDeletedAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedAccountsResponse) Reset() {
+	*x = ListDeletedAccountsResponse{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedAccountsResponse) ProtoMessage() {}
+
+func (x *ListDeletedAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDeletedAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type RestoreAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountRequest) Reset() {
+	*x = RestoreAccountRequest{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountRequest) ProtoMessage() {}
+
+func (x *RestoreAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAccountRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAccountResponse) Reset() {
+	*x = RestoreAccountResponse{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAccountResponse) ProtoMessage() {}
+
+func (x *RestoreAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAccountResponse.ProtoReflect.Descriptor instead.
+func (*RestoreAccountResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreAccountResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type PurgeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeAccountRequest) Reset() {
+	*x = PurgeAccountRequest{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAccountRequest) ProtoMessage() {}
+
+func (x *PurgeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAccountRequest.ProtoReflect.Descriptor instead.
+func (*PurgeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PurgeAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PurgeAccountRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeAccountResponse) Reset() {
+	*x = PurgeAccountResponse{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeAccountResponse) ProtoMessage() {}
+
+func (x *PurgeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeAccountResponse.ProtoReflect.Descriptor instead.
+func (*PurgeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PurgeAccountResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type GetProjectedBalanceRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id     int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// defaults to 30
+	Days          *int32 `protobuf:"varint,3,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectedBalanceRequest) Reset() {
+	*x = GetProjectedBalanceRequest{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectedBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectedBalanceRequest) ProtoMessage() {}
+
+func (x *GetProjectedBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectedBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectedBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetProjectedBalanceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetProjectedBalanceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProjectedBalanceRequest) GetDays() int32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+type GetProjectedBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projection    *AccountProjection     `protobuf:"bytes,1,opt,name=projection,proto3" json:"projection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectedBalanceResponse) Reset() {
+	*x = GetProjectedBalanceResponse{}
+	mi := &file_arian_v1_account_services_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectedBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectedBalanceResponse) ProtoMessage() {}
+
+func (x *GetProjectedBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_account_services_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectedBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectedBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_account_services_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetProjectedBalanceResponse) GetProjection() *AccountProjection {
+	if x != nil {
+		return x.Projection
+	}
+	return nil
+}
+
 var File_arian_v1_account_services_proto protoreflect.FileDescriptor
 
 const file_arian_v1_account_services_proto_rawDesc = "" +
 	"\n" +
-	"\x1farian/v1/account_services.proto\x12\barian.v1\x1a\x16arian/v1/account.proto\x1a\x14arian/v1/enums.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"8\n" +
+	"\x1farian/v1/account_services.proto\x12\barian.v1\x1a\x16arian/v1/account.proto\x1a\x14arian/v1/enums.proto\x1a\x16arian/v1/planned.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"8\n" +
 	"\x13ListAccountsRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"E\n" +
 	"\x14ListAccountsResponse\x12-\n" +
@@ -623,7 +1024,7 @@ const file_arian_v1_account_services_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
 	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"A\n" +
 	"\x12GetAccountResponse\x12+\n" +
-	"\aaccount\x18\x01 \x01(\v2\x11.arian.v1.AccountR\aaccount\"\xa9\x02\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.arian.v1.AccountR\aaccount\"\xd2\x02\n" +
 	"\x14CreateAccountRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -632,10 +1033,11 @@ const file_arian_v1_account_services_proto_rawDesc = "" +
 	"\x05alias\x18\x05 \x01(\tH\x00R\x05alias\x88\x01\x01\x129\n" +
 	"\x0eanchor_balance\x18\x06 \x01(\v2\x12.google.type.MoneyR\ranchorBalance\x12#\n" +
 	"\rmain_currency\x18\a \x01(\tR\fmainCurrency\x12\x16\n" +
-	"\x06colors\x18\b \x03(\tR\x06colorsB\b\n" +
+	"\x06colors\x18\b \x03(\tR\x06colors\x12'\n" +
+	"\x0finclude_pending\x18\t \x01(\bR\x0eincludePendingB\b\n" +
 	"\x06_alias\"D\n" +
 	"\x15CreateAccountResponse\x12+\n" +
-	"\aaccount\x18\x01 \x01(\v2\x11.arian.v1.AccountR\aaccount\"\xc1\x04\n" +
+	"\aaccount\x18\x01 \x01(\v2\x11.arian.v1.AccountR\aaccount\"\x83\x05\n" +
 	"\x14UpdateAccountRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
 	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12;\n" +
@@ -650,27 +1052,57 @@ const file_arian_v1_account_services_proto_rawDesc = "" +
 	"\x0eanchor_balance\x18\t \x01(\v2\x12.google.type.MoneyH\x05R\ranchorBalance\x88\x01\x01\x12(\n" +
 	"\rmain_currency\x18\n" +
 	" \x01(\tH\x06R\fmainCurrency\x88\x01\x01\x12\x16\n" +
-	"\x06colors\x18\v \x03(\tR\x06colorsB\a\n" +
+	"\x06colors\x18\v \x03(\tR\x06colors\x12,\n" +
+	"\x0finclude_pending\x18\f \x01(\bH\aR\x0eincludePending\x88\x01\x01B\a\n" +
 	"\x05_nameB\a\n" +
 	"\x05_bankB\x0f\n" +
 	"\r_account_typeB\b\n" +
 	"\x06_aliasB\x0e\n" +
 	"\f_anchor_dateB\x11\n" +
 	"\x0f_anchor_balanceB\x10\n" +
-	"\x0e_main_currency\"\x17\n" +
+	"\x0e_main_currencyB\x12\n" +
+	"\x10_include_pending\"\x17\n" +
 	"\x15UpdateAccountResponse\"R\n" +
 	"\x14DeleteAccountRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
 	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"<\n" +
 	"\x15DeleteAccountResponse\x12#\n" +
-	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows2\x9e\x03\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"?\n" +
+	"\x1aListDeletedAccountsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"L\n" +
+	"\x1bListDeletedAccountsResponse\x12-\n" +
+	"\baccounts\x18\x01 \x03(\v2\x11.arian.v1.AccountR\baccounts\"S\n" +
+	"\x15RestoreAccountRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"=\n" +
+	"\x16RestoreAccountResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"Q\n" +
+	"\x13PurgeAccountRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\";\n" +
+	"\x14PurgeAccountResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x86\x01\n" +
+	"\x1aGetProjectedBalanceRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12#\n" +
+	"\x04days\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xee\x02(\x01H\x00R\x04days\x88\x01\x01B\a\n" +
+	"\x05_days\"Z\n" +
+	"\x1bGetProjectedBalanceResponse\x12;\n" +
+	"\n" +
+	"projection\x18\x01 \x01(\v2\x1b.arian.v1.AccountProjectionR\n" +
+	"projection2\x8a\x06\n" +
 	"\x0eAccountService\x12M\n" +
 	"\fListAccounts\x12\x1d.arian.v1.ListAccountsRequest\x1a\x1e.arian.v1.ListAccountsResponse\x12G\n" +
 	"\n" +
 	"GetAccount\x12\x1b.arian.v1.GetAccountRequest\x1a\x1c.arian.v1.GetAccountResponse\x12P\n" +
 	"\rCreateAccount\x12\x1e.arian.v1.CreateAccountRequest\x1a\x1f.arian.v1.CreateAccountResponse\x12P\n" +
 	"\rUpdateAccount\x12\x1e.arian.v1.UpdateAccountRequest\x1a\x1f.arian.v1.UpdateAccountResponse\x12P\n" +
-	"\rDeleteAccount\x12\x1e.arian.v1.DeleteAccountRequest\x1a\x1f.arian.v1.DeleteAccountResponseB\x8b\x01\n" +
+	"\rDeleteAccount\x12\x1e.arian.v1.DeleteAccountRequest\x1a\x1f.arian.v1.DeleteAccountResponse\x12b\n" +
+	"\x13ListDeletedAccounts\x12$.arian.v1.ListDeletedAccountsRequest\x1a%.arian.v1.ListDeletedAccountsResponse\x12S\n" +
+	"\x0eRestoreAccount\x12\x1f.arian.v1.RestoreAccountRequest\x1a .arian.v1.RestoreAccountResponse\x12M\n" +
+	"\fPurgeAccount\x12\x1d.arian.v1.PurgeAccountRequest\x1a\x1e.arian.v1.PurgeAccountResponse\x12b\n" +
+	"\x13GetProjectedBalance\x12$.arian.v1.GetProjectedBalanceRequest\x1a%.arian.v1.GetProjectedBalanceResponseB\x8b\x01\n" +
 	"\fcom.arian.v1B\x14AccountServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
 
 var (
@@ -685,49 +1117,68 @@ func file_arian_v1_account_services_proto_rawDescGZIP() []byte {
 	return file_arian_v1_account_services_proto_rawDescData
 }
 
-var file_arian_v1_account_services_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_arian_v1_account_services_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_arian_v1_account_services_proto_goTypes = []any{
-	(*ListAccountsRequest)(nil),   // 0: arian.v1.ListAccountsRequest
-	(*ListAccountsResponse)(nil),  // 1: arian.v1.ListAccountsResponse
-	(*GetAccountRequest)(nil),     // 2: arian.v1.GetAccountRequest
-	(*GetAccountResponse)(nil),    // 3: arian.v1.GetAccountResponse
-	(*CreateAccountRequest)(nil),  // 4: arian.v1.CreateAccountRequest
-	(*CreateAccountResponse)(nil), // 5: arian.v1.CreateAccountResponse
-	(*UpdateAccountRequest)(nil),  // 6: arian.v1.UpdateAccountRequest
-	(*UpdateAccountResponse)(nil), // 7: arian.v1.UpdateAccountResponse
-	(*DeleteAccountRequest)(nil),  // 8: arian.v1.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil), // 9: arian.v1.DeleteAccountResponse
-	(*Account)(nil),               // 10: arian.v1.Account
-	(AccountType)(0),              // 11: arian.v1.AccountType
-	(*money.Money)(nil),           // 12: google.type.Money
-	(*fieldmaskpb.FieldMask)(nil), // 13: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*ListAccountsRequest)(nil),         // 0: arian.v1.ListAccountsRequest
+	(*ListAccountsResponse)(nil),        // 1: arian.v1.ListAccountsResponse
+	(*GetAccountRequest)(nil),           // 2: arian.v1.GetAccountRequest
+	(*GetAccountResponse)(nil),          // 3: arian.v1.GetAccountResponse
+	(*CreateAccountRequest)(nil),        // 4: arian.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),       // 5: arian.v1.CreateAccountResponse
+	(*UpdateAccountRequest)(nil),        // 6: arian.v1.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),       // 7: arian.v1.UpdateAccountResponse
+	(*DeleteAccountRequest)(nil),        // 8: arian.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),       // 9: arian.v1.DeleteAccountResponse
+	(*ListDeletedAccountsRequest)(nil),  // 10: arian.v1.ListDeletedAccountsRequest
+	(*ListDeletedAccountsResponse)(nil), // 11: arian.v1.ListDeletedAccountsResponse
+	(*RestoreAccountRequest)(nil),       // 12: arian.v1.RestoreAccountRequest
+	(*RestoreAccountResponse)(nil),      // 13: arian.v1.RestoreAccountResponse
+	(*PurgeAccountRequest)(nil),         // 14: arian.v1.PurgeAccountRequest
+	(*PurgeAccountResponse)(nil),        // 15: arian.v1.PurgeAccountResponse
+	(*GetProjectedBalanceRequest)(nil),  // 16: arian.v1.GetProjectedBalanceRequest
+	(*GetProjectedBalanceResponse)(nil), // 17: arian.v1.GetProjectedBalanceResponse
+	(*Account)(nil),                     // 18: arian.v1.Account
+	(AccountType)(0),                    // 19: arian.v1.AccountType
+	(*money.Money)(nil),                 // 20: google.type.Money
+	(*fieldmaskpb.FieldMask)(nil),       // 21: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),       // 22: google.protobuf.Timestamp
+	(*AccountProjection)(nil),           // 23: arian.v1.AccountProjection
 }
 var file_arian_v1_account_services_proto_depIdxs = []int32{
-	10, // 0: arian.v1.ListAccountsResponse.accounts:type_name -> arian.v1.Account
-	10, // 1: arian.v1.GetAccountResponse.account:type_name -> arian.v1.Account
-	11, // 2: arian.v1.CreateAccountRequest.type:type_name -> arian.v1.AccountType
-	12, // 3: arian.v1.CreateAccountRequest.anchor_balance:type_name -> google.type.Money
-	10, // 4: arian.v1.CreateAccountResponse.account:type_name -> arian.v1.Account
-	13, // 5: arian.v1.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
-	11, // 6: arian.v1.UpdateAccountRequest.account_type:type_name -> arian.v1.AccountType
-	14, // 7: arian.v1.UpdateAccountRequest.anchor_date:type_name -> google.protobuf.Timestamp
-	12, // 8: arian.v1.UpdateAccountRequest.anchor_balance:type_name -> google.type.Money
-	0,  // 9: arian.v1.AccountService.ListAccounts:input_type -> arian.v1.ListAccountsRequest
-	2,  // 10: arian.v1.AccountService.GetAccount:input_type -> arian.v1.GetAccountRequest
-	4,  // 11: arian.v1.AccountService.CreateAccount:input_type -> arian.v1.CreateAccountRequest
-	6,  // 12: arian.v1.AccountService.UpdateAccount:input_type -> arian.v1.UpdateAccountRequest
-	8,  // 13: arian.v1.AccountService.DeleteAccount:input_type -> arian.v1.DeleteAccountRequest
-	1,  // 14: arian.v1.AccountService.ListAccounts:output_type -> arian.v1.ListAccountsResponse
-	3,  // 15: arian.v1.AccountService.GetAccount:output_type -> arian.v1.GetAccountResponse
-	5,  // 16: arian.v1.AccountService.CreateAccount:output_type -> arian.v1.CreateAccountResponse
-	7,  // 17: arian.v1.AccountService.UpdateAccount:output_type -> arian.v1.UpdateAccountResponse
-	9,  // 18: arian.v1.AccountService.DeleteAccount:output_type -> arian.v1.DeleteAccountResponse
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	18, // 0: arian.v1.ListAccountsResponse.accounts:type_name -> arian.v1.Account
+	18, // 1: arian.v1.GetAccountResponse.account:type_name -> arian.v1.Account
+	19, // 2: arian.v1.CreateAccountRequest.type:type_name -> arian.v1.AccountType
+	20, // 3: arian.v1.CreateAccountRequest.anchor_balance:type_name -> google.type.Money
+	18, // 4: arian.v1.CreateAccountResponse.account:type_name -> arian.v1.Account
+	21, // 5: arian.v1.UpdateAccountRequest.update_mask:type_name -> google.protobuf.FieldMask
+	19, // 6: arian.v1.UpdateAccountRequest.account_type:type_name -> arian.v1.AccountType
+	22, // 7: arian.v1.UpdateAccountRequest.anchor_date:type_name -> google.protobuf.Timestamp
+	20, // 8: arian.v1.UpdateAccountRequest.anchor_balance:type_name -> google.type.Money
+	18, // 9: arian.v1.ListDeletedAccountsResponse.accounts:type_name -> arian.v1.Account
+	23, // 10: arian.v1.GetProjectedBalanceResponse.projection:type_name -> arian.v1.AccountProjection
+	0,  // 11: arian.v1.AccountService.ListAccounts:input_type -> arian.v1.ListAccountsRequest
+	2,  // 12: arian.v1.AccountService.GetAccount:input_type -> arian.v1.GetAccountRequest
+	4,  // 13: arian.v1.AccountService.CreateAccount:input_type -> arian.v1.CreateAccountRequest
+	6,  // 14: arian.v1.AccountService.UpdateAccount:input_type -> arian.v1.UpdateAccountRequest
+	8,  // 15: arian.v1.AccountService.DeleteAccount:input_type -> arian.v1.DeleteAccountRequest
+	10, // 16: arian.v1.AccountService.ListDeletedAccounts:input_type -> arian.v1.ListDeletedAccountsRequest
+	12, // 17: arian.v1.AccountService.RestoreAccount:input_type -> arian.v1.RestoreAccountRequest
+	14, // 18: arian.v1.AccountService.PurgeAccount:input_type -> arian.v1.PurgeAccountRequest
+	16, // 19: arian.v1.AccountService.GetProjectedBalance:input_type -> arian.v1.GetProjectedBalanceRequest
+	1,  // 20: arian.v1.AccountService.ListAccounts:output_type -> arian.v1.ListAccountsResponse
+	3,  // 21: arian.v1.AccountService.GetAccount:output_type -> arian.v1.GetAccountResponse
+	5,  // 22: arian.v1.AccountService.CreateAccount:output_type -> arian.v1.CreateAccountResponse
+	7,  // 23: arian.v1.AccountService.UpdateAccount:output_type -> arian.v1.UpdateAccountResponse
+	9,  // 24: arian.v1.AccountService.DeleteAccount:output_type -> arian.v1.DeleteAccountResponse
+	11, // 25: arian.v1.AccountService.ListDeletedAccounts:output_type -> arian.v1.ListDeletedAccountsResponse
+	13, // 26: arian.v1.AccountService.RestoreAccount:output_type -> arian.v1.RestoreAccountResponse
+	15, // 27: arian.v1.AccountService.PurgeAccount:output_type -> arian.v1.PurgeAccountResponse
+	17, // 28: arian.v1.AccountService.GetProjectedBalance:output_type -> arian.v1.GetProjectedBalanceResponse
+	20, // [20:29] is the sub-list for method output_type
+	11, // [11:20] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_account_services_proto_init() }
@@ -737,15 +1188,17 @@ func file_arian_v1_account_services_proto_init() {
 	}
 	file_arian_v1_account_proto_init()
 	file_arian_v1_enums_proto_init()
+	file_arian_v1_planned_proto_init()
 	file_arian_v1_account_services_proto_msgTypes[4].OneofWrappers = []any{}
 	file_arian_v1_account_services_proto_msgTypes[6].OneofWrappers = []any{}
+	file_arian_v1_account_services_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_account_services_proto_rawDesc), len(file_arian_v1_account_services_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AccountService_ListAccounts_FullMethodName  = "/arian.v1.AccountService/ListAccounts"
-	AccountService_GetAccount_FullMethodName    = "/arian.v1.AccountService/GetAccount"
-	AccountService_CreateAccount_FullMethodName = "/arian.v1.AccountService/CreateAccount"
-	AccountService_UpdateAccount_FullMethodName = "/arian.v1.AccountService/UpdateAccount"
-	AccountService_DeleteAccount_FullMethodName = "/arian.v1.AccountService/DeleteAccount"
+	AccountService_ListAccounts_FullMethodName        = "/arian.v1.AccountService/ListAccounts"
+	AccountService_GetAccount_FullMethodName          = "/arian.v1.AccountService/GetAccount"
+	AccountService_CreateAccount_FullMethodName       = "/arian.v1.AccountService/CreateAccount"
+	AccountService_UpdateAccount_FullMethodName       = "/arian.v1.AccountService/UpdateAccount"
+	AccountService_DeleteAccount_FullMethodName       = "/arian.v1.AccountService/DeleteAccount"
+	AccountService_ListDeletedAccounts_FullMethodName = "/arian.v1.AccountService/ListDeletedAccounts"
+	AccountService_RestoreAccount_FullMethodName      = "/arian.v1.AccountService/RestoreAccount"
+	AccountService_PurgeAccount_FullMethodName        = "/arian.v1.AccountService/PurgeAccount"
+	AccountService_GetProjectedBalance_FullMethodName = "/arian.v1.AccountService/GetProjectedBalance"
 )
 
 // AccountServiceClient is the client API for AccountService service.
@@ -35,6 +39,12 @@ type AccountServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	ListDeletedAccounts(ctx context.Context, in *ListDeletedAccountsRequest, opts ...grpc.CallOption) (*ListDeletedAccountsResponse, error)
+	RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error)
+	// permanently removes an account from the trash, with all its transactions
+	PurgeAccount(ctx context.Context, in *PurgeAccountRequest, opts ...grpc.CallOption) (*PurgeAccountResponse, error)
+	// current balance plus planned transactions for the next days
+	GetProjectedBalance(ctx context.Context, in *GetProjectedBalanceRequest, opts ...grpc.CallOption) (*GetProjectedBalanceResponse, error)
 }
 
 type accountServiceClient struct {
@@ -95,6 +105,46 @@ func (c *accountServiceClient) DeleteAccount(ctx context.Context, in *DeleteAcco
 	return out, nil
 }
 
+func (c *accountServiceClient) ListDeletedAccounts(ctx context.Context, in *ListDeletedAccountsRequest, opts ...grpc.CallOption) (*ListDeletedAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedAccountsResponse)
+	err := c.cc.Invoke(ctx, AccountService_ListDeletedAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RestoreAccount(ctx context.Context, in *RestoreAccountRequest, opts ...grpc.CallOption) (*RestoreAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_RestoreAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) PurgeAccount(ctx context.Context, in *PurgeAccountRequest, opts ...grpc.CallOption) (*PurgeAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_PurgeAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetProjectedBalance(ctx context.Context, in *GetProjectedBalanceRequest, opts ...grpc.CallOption) (*GetProjectedBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectedBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetProjectedBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility.
@@ -104,6 +154,12 @@ type AccountServiceServer interface {
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	ListDeletedAccounts(context.Context, *ListDeletedAccountsRequest) (*ListDeletedAccountsResponse, error)
+	RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error)
+	// permanently removes an account from the trash, with all its transactions
+	PurgeAccount(context.Context, *PurgeAccountRequest) (*PurgeAccountResponse, error)
+	// current balance plus planned transactions for the next days
+	GetProjectedBalance(context.Context, *GetProjectedBalanceRequest) (*GetProjectedBalanceResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -129,6 +185,18 @@ func (UnimplementedAccountServiceServer) UpdateAccount(context.Context, *UpdateA
 func (UnimplementedAccountServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
 }
+func (UnimplementedAccountServiceServer) ListDeletedAccounts(context.Context, *ListDeletedAccountsRequest) (*ListDeletedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedAccounts not implemented")
+}
+func (UnimplementedAccountServiceServer) RestoreAccount(context.Context, *RestoreAccountRequest) (*RestoreAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) PurgeAccount(context.Context, *PurgeAccountRequest) (*PurgeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) GetProjectedBalance(context.Context, *GetProjectedBalanceRequest) (*GetProjectedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectedBalance not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 func (UnimplementedAccountServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +308,78 @@ func _AccountService_DeleteAccount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_ListDeletedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListDeletedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ListDeletedAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListDeletedAccounts(ctx, req.(*ListDeletedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RestoreAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RestoreAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_RestoreAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RestoreAccount(ctx, req.(*RestoreAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_PurgeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).PurgeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_PurgeAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).PurgeAccount(ctx, req.(*PurgeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetProjectedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectedBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetProjectedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetProjectedBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetProjectedBalance(ctx, req.(*GetProjectedBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +407,22 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _AccountService_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "ListDeletedAccounts",
+			Handler:    _AccountService_ListDeletedAccounts_Handler,
+		},
+		{
+			MethodName: "RestoreAccount",
+			Handler:    _AccountService_RestoreAccount_Handler,
+		},
+		{
+			MethodName: "PurgeAccount",
+			Handler:    _AccountService_PurgeAccount_Handler,
+		},
+		{
+			MethodName: "GetProjectedBalance",
+			Handler:    _AccountService_GetProjectedBalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arian/v1/account_services.proto",
@@ -48,6 +48,18 @@ const (
 	// AccountServiceDeleteAccountProcedure is the fully-qualified name of the AccountService's
 	// DeleteAccount RPC.
 	AccountServiceDeleteAccountProcedure = "/arian.v1.AccountService/DeleteAccount"
+	// AccountServiceListDeletedAccountsProcedure is the fully-qualified name of the AccountService's
+	// ListDeletedAccounts RPC.
+	AccountServiceListDeletedAccountsProcedure = "/arian.v1.AccountService/ListDeletedAccounts"
+	// AccountServiceRestoreAccountProcedure is the fully-qualified name of the AccountService's
+	// RestoreAccount RPC.
+	AccountServiceRestoreAccountProcedure = "/arian.v1.AccountService/RestoreAccount"
+	// AccountServicePurgeAccountProcedure is the fully-qualified name of the AccountService's
+	// PurgeAccount RPC.
+	AccountServicePurgeAccountProcedure = "/arian.v1.AccountService/PurgeAccount"
+	// AccountServiceGetProjectedBalanceProcedure is the fully-qualified name of the AccountService's
+	// GetProjectedBalance RPC.
+	AccountServiceGetProjectedBalanceProcedure = "/arian.v1.AccountService/GetProjectedBalance"
 )
 
 // AccountServiceClient is a client for the arian.v1.AccountService service.
@@ -57,6 +69,12 @@ type AccountServiceClient interface {
 	CreateAccount(context.Context, *connect.Request[v1.CreateAccountRequest]) (*connect.Response[v1.CreateAccountResponse], error)
 	UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error)
 	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	ListDeletedAccounts(context.Context, *connect.Request[v1.ListDeletedAccountsRequest]) (*connect.Response[v1.ListDeletedAccountsResponse], error)
+	RestoreAccount(context.Context, *connect.Request[v1.RestoreAccountRequest]) (*connect.Response[v1.RestoreAccountResponse], error)
+	// permanently removes an account from the trash, with all its transactions
+	PurgeAccount(context.Context, *connect.Request[v1.PurgeAccountRequest]) (*connect.Response[v1.PurgeAccountResponse], error)
+	// current balance plus planned transactions for the next days
+	GetProjectedBalance(context.Context, *connect.Request[v1.GetProjectedBalanceRequest]) (*connect.Response[v1.GetProjectedBalanceResponse], error)
 }
 
 // NewAccountServiceClient constructs a client for the arian.v1.AccountService service. By default,
@@ -100,16 +118,44 @@ func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(accountServiceMethods.ByName("DeleteAccount")),
 			connect.WithClientOptions(opts...),
 		),
+		listDeletedAccounts: connect.NewClient[v1.ListDeletedAccountsRequest, v1.ListDeletedAccountsResponse](
+			httpClient,
+			baseURL+AccountServiceListDeletedAccountsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ListDeletedAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreAccount: connect.NewClient[v1.RestoreAccountRequest, v1.RestoreAccountResponse](
+			httpClient,
+			baseURL+AccountServiceRestoreAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RestoreAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeAccount: connect.NewClient[v1.PurgeAccountRequest, v1.PurgeAccountResponse](
+			httpClient,
+			baseURL+AccountServicePurgeAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("PurgeAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectedBalance: connect.NewClient[v1.GetProjectedBalanceRequest, v1.GetProjectedBalanceResponse](
+			httpClient,
+			baseURL+AccountServiceGetProjectedBalanceProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetProjectedBalance")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // accountServiceClient implements AccountServiceClient.
 type accountServiceClient struct {
-	listAccounts  *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
-	getAccount    *connect.Client[v1.GetAccountRequest, v1.GetAccountResponse]
-	createAccount *connect.Client[v1.CreateAccountRequest, v1.CreateAccountResponse]
-	updateAccount *connect.Client[v1.UpdateAccountRequest, v1.UpdateAccountResponse]
-	deleteAccount *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	listAccounts        *connect.Client[v1.ListAccountsRequest, v1.ListAccountsResponse]
+	getAccount          *connect.Client[v1.GetAccountRequest, v1.GetAccountResponse]
+	createAccount       *connect.Client[v1.CreateAccountRequest, v1.CreateAccountResponse]
+	updateAccount       *connect.Client[v1.UpdateAccountRequest, v1.UpdateAccountResponse]
+	deleteAccount       *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	listDeletedAccounts *connect.Client[v1.ListDeletedAccountsRequest, v1.ListDeletedAccountsResponse]
+	restoreAccount      *connect.Client[v1.RestoreAccountRequest, v1.RestoreAccountResponse]
+	purgeAccount        *connect.Client[v1.PurgeAccountRequest, v1.PurgeAccountResponse]
+	getProjectedBalance *connect.Client[v1.GetProjectedBalanceRequest, v1.GetProjectedBalanceResponse]
 }
 
 // ListAccounts calls arian.v1.AccountService.ListAccounts.
@@ -137,6 +183,26 @@ func (c *accountServiceClient) DeleteAccount(ctx context.Context, req *connect.R
 	return c.deleteAccount.CallUnary(ctx, req)
 }
 
+// ListDeletedAccounts calls arian.v1.AccountService.ListDeletedAccounts.
+func (c *accountServiceClient) ListDeletedAccounts(ctx context.Context, req *connect.Request[v1.ListDeletedAccountsRequest]) (*connect.Response[v1.ListDeletedAccountsResponse], error) {
+	return c.listDeletedAccounts.CallUnary(ctx, req)
+}
+
+// RestoreAccount calls arian.v1.AccountService.RestoreAccount.
+func (c *accountServiceClient) RestoreAccount(ctx context.Context, req *connect.Request[v1.RestoreAccountRequest]) (*connect.Response[v1.RestoreAccountResponse], error) {
+	return c.restoreAccount.CallUnary(ctx, req)
+}
+
+// PurgeAccount calls arian.v1.AccountService.PurgeAccount.
+func (c *accountServiceClient) PurgeAccount(ctx context.Context, req *connect.Request[v1.PurgeAccountRequest]) (*connect.Response[v1.PurgeAccountResponse], error) {
+	return c.purgeAccount.CallUnary(ctx, req)
+}
+
+// GetProjectedBalance calls arian.v1.AccountService.GetProjectedBalance.
+func (c *accountServiceClient) GetProjectedBalance(ctx context.Context, req *connect.Request[v1.GetProjectedBalanceRequest]) (*connect.Response[v1.GetProjectedBalanceResponse], error) {
+	return c.getProjectedBalance.CallUnary(ctx, req)
+}
+
 // AccountServiceHandler is an implementation of the arian.v1.AccountService service.
 type AccountServiceHandler interface {
 	ListAccounts(context.Context, *connect.Request[v1.ListAccountsRequest]) (*connect.Response[v1.ListAccountsResponse], error)
@@ -144,6 +210,12 @@ type AccountServiceHandler interface {
 	CreateAccount(context.Context, *connect.Request[v1.CreateAccountRequest]) (*connect.Response[v1.CreateAccountResponse], error)
 	UpdateAccount(context.Context, *connect.Request[v1.UpdateAccountRequest]) (*connect.Response[v1.UpdateAccountResponse], error)
 	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	ListDeletedAccounts(context.Context, *connect.Request[v1.ListDeletedAccountsRequest]) (*connect.Response[v1.ListDeletedAccountsResponse], error)
+	RestoreAccount(context.Context, *connect.Request[v1.RestoreAccountRequest]) (*connect.Response[v1.RestoreAccountResponse], error)
+	// permanently removes an account from the trash, with all its transactions
+	PurgeAccount(context.Context, *connect.Request[v1.PurgeAccountRequest]) (*connect.Response[v1.PurgeAccountResponse], error)
+	// current balance plus planned transactions for the next days
+	GetProjectedBalance(context.Context, *connect.Request[v1.GetProjectedBalanceRequest]) (*connect.Response[v1.GetProjectedBalanceResponse], error)
 }
 
 // NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -183,6 +255,30 @@ func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.Handler
 		connect.WithSchema(accountServiceMethods.ByName("DeleteAccount")),
 		connect.WithHandlerOptions(opts...),
 	)
+	accountServiceListDeletedAccountsHandler := connect.NewUnaryHandler(
+		AccountServiceListDeletedAccountsProcedure,
+		svc.ListDeletedAccounts,
+		connect.WithSchema(accountServiceMethods.ByName("ListDeletedAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRestoreAccountHandler := connect.NewUnaryHandler(
+		AccountServiceRestoreAccountProcedure,
+		svc.RestoreAccount,
+		connect.WithSchema(accountServiceMethods.ByName("RestoreAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServicePurgeAccountHandler := connect.NewUnaryHandler(
+		AccountServicePurgeAccountProcedure,
+		svc.PurgeAccount,
+		connect.WithSchema(accountServiceMethods.ByName("PurgeAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetProjectedBalanceHandler := connect.NewUnaryHandler(
+		AccountServiceGetProjectedBalanceProcedure,
+		svc.GetProjectedBalance,
+		connect.WithSchema(accountServiceMethods.ByName("GetProjectedBalance")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/arian.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AccountServiceListAccountsProcedure:
@@ -195,6 +291,14 @@ func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.Handler
 			accountServiceUpdateAccountHandler.ServeHTTP(w, r)
 		case AccountServiceDeleteAccountProcedure:
 			accountServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case AccountServiceListDeletedAccountsProcedure:
+			accountServiceListDeletedAccountsHandler.ServeHTTP(w, r)
+		case AccountServiceRestoreAccountProcedure:
+			accountServiceRestoreAccountHandler.ServeHTTP(w, r)
+		case AccountServicePurgeAccountProcedure:
+			accountServicePurgeAccountHandler.ServeHTTP(w, r)
+		case AccountServiceGetProjectedBalanceProcedure:
+			accountServiceGetProjectedBalanceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,3 +327,19 @@ func (UnimplementedAccountServiceHandler) UpdateAccount(context.Context, *connec
 func (UnimplementedAccountServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.AccountService.DeleteAccount is not implemented"))
 }
+
+func (UnimplementedAccountServiceHandler) ListDeletedAccounts(context.Context, *connect.Request[v1.ListDeletedAccountsRequest]) (*connect.Response[v1.ListDeletedAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.AccountService.ListDeletedAccounts is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RestoreAccount(context.Context, *connect.Request[v1.RestoreAccountRequest]) (*connect.Response[v1.RestoreAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.AccountService.RestoreAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) PurgeAccount(context.Context, *connect.Request[v1.PurgeAccountRequest]) (*connect.Response[v1.PurgeAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.AccountService.PurgeAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetProjectedBalance(context.Context, *connect.Request[v1.GetProjectedBalanceRequest]) (*connect.Response[v1.GetProjectedBalanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.AccountService.GetProjectedBalance is not implemented"))
+}
@@ -42,6 +42,9 @@ const (
 	// DashboardServiceGetTopCategoriesProcedure is the fully-qualified name of the DashboardService's
 	// GetTopCategories RPC.
 	DashboardServiceGetTopCategoriesProcedure = "/arian.v1.DashboardService/GetTopCategories"
+	// DashboardServiceGetTopTagsProcedure is the fully-qualified name of the DashboardService's
+	// GetTopTags RPC.
+	DashboardServiceGetTopTagsProcedure = "/arian.v1.DashboardService/GetTopTags"
 	// DashboardServiceGetTopMerchantsProcedure is the fully-qualified name of the DashboardService's
 	// GetTopMerchants RPC.
 	DashboardServiceGetTopMerchantsProcedure = "/arian.v1.DashboardService/GetTopMerchants"
@@ -57,6 +60,9 @@ const (
 	// DashboardServiceGetNetWorthHistoryProcedure is the fully-qualified name of the DashboardService's
 	// GetNetWorthHistory RPC.
 	DashboardServiceGetNetWorthHistoryProcedure = "/arian.v1.DashboardService/GetNetWorthHistory"
+	// DashboardServiceGetProjectedBalancesProcedure is the fully-qualified name of the
+	// DashboardService's GetProjectedBalances RPC.
+	DashboardServiceGetProjectedBalancesProcedure = "/arian.v1.DashboardService/GetProjectedBalances"
 )
 
 // DashboardServiceClient is a client for the arian.v1.DashboardService service.
@@ -64,6 +70,7 @@ type DashboardServiceClient interface {
 	GetDashboardSummary(context.Context, *connect.Request[v1.GetDashboardSummaryRequest]) (*connect.Response[v1.GetDashboardSummaryResponse], error)
 	GetMonthlyComparison(context.Context, *connect.Request[v1.GetMonthlyComparisonRequest]) (*connect.Response[v1.GetMonthlyComparisonResponse], error)
 	GetTopCategories(context.Context, *connect.Request[v1.GetTopCategoriesRequest]) (*connect.Response[v1.GetTopCategoriesResponse], error)
+	GetTopTags(context.Context, *connect.Request[v1.GetTopTagsRequest]) (*connect.Response[v1.GetTopTagsResponse], error)
 	GetTopMerchants(context.Context, *connect.Request[v1.GetTopMerchantsRequest]) (*connect.Response[v1.GetTopMerchantsResponse], error)
 	GetSpendingTrends(context.Context, *connect.Request[v1.GetSpendingTrendsRequest]) (*connect.Response[v1.GetSpendingTrendsResponse], error)
 	// combines total balance, debt, and net worth across all accounts
@@ -71,6 +78,8 @@ type DashboardServiceClient interface {
 	// compares category spending between current and previous period
 	GetCategorySpendingComparison(context.Context, *connect.Request[v1.GetCategorySpendingComparisonRequest]) (*connect.Response[v1.GetCategorySpendingComparisonResponse], error)
 	GetNetWorthHistory(context.Context, *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error)
+	// projected end-of-day balance per account including planned transactions
+	GetProjectedBalances(context.Context, *connect.Request[v1.GetProjectedBalancesRequest]) (*connect.Response[v1.GetProjectedBalancesResponse], error)
 }
 
 // NewDashboardServiceClient constructs a client for the arian.v1.DashboardService service. By
@@ -102,6 +111,12 @@ func NewDashboardServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(dashboardServiceMethods.ByName("GetTopCategories")),
 			connect.WithClientOptions(opts...),
 		),
+		getTopTags: connect.NewClient[v1.GetTopTagsRequest, v1.GetTopTagsResponse](
+			httpClient,
+			baseURL+DashboardServiceGetTopTagsProcedure,
+			connect.WithSchema(dashboardServiceMethods.ByName("GetTopTags")),
+			connect.WithClientOptions(opts...),
+		),
 		getTopMerchants: connect.NewClient[v1.GetTopMerchantsRequest, v1.GetTopMerchantsResponse](
 			httpClient,
 			baseURL+DashboardServiceGetTopMerchantsProcedure,
@@ -132,6 +147,12 @@ func NewDashboardServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(dashboardServiceMethods.ByName("GetNetWorthHistory")),
 			connect.WithClientOptions(opts...),
 		),
+		getProjectedBalances: connect.NewClient[v1.GetProjectedBalancesRequest, v1.GetProjectedBalancesResponse](
+			httpClient,
+			baseURL+DashboardServiceGetProjectedBalancesProcedure,
+			connect.WithSchema(dashboardServiceMethods.ByName("GetProjectedBalances")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -140,11 +161,13 @@ type dashboardServiceClient struct {
 	getDashboardSummary           *connect.Client[v1.GetDashboardSummaryRequest, v1.GetDashboardSummaryResponse]
 	getMonthlyComparison          *connect.Client[v1.GetMonthlyComparisonRequest, v1.GetMonthlyComparisonResponse]
 	getTopCategories              *connect.Client[v1.GetTopCategoriesRequest, v1.GetTopCategoriesResponse]
+	getTopTags                    *connect.Client[v1.GetTopTagsRequest, v1.GetTopTagsResponse]
 	getTopMerchants               *connect.Client[v1.GetTopMerchantsRequest, v1.GetTopMerchantsResponse]
 	getSpendingTrends             *connect.Client[v1.GetSpendingTrendsRequest, v1.GetSpendingTrendsResponse]
 	getFinancialSummary           *connect.Client[v1.GetFinancialSummaryRequest, v1.GetFinancialSummaryResponse]
 	getCategorySpendingComparison *connect.Client[v1.GetCategorySpendingComparisonRequest, v1.GetCategorySpendingComparisonResponse]
 	getNetWorthHistory            *connect.Client[v1.GetNetWorthHistoryRequest, v1.GetNetWorthHistoryResponse]
+	getProjectedBalances          *connect.Client[v1.GetProjectedBalancesRequest, v1.GetProjectedBalancesResponse]
 }
 
 // GetDashboardSummary calls arian.v1.DashboardService.GetDashboardSummary.
@@ -162,6 +185,11 @@ func (c *dashboardServiceClient) GetTopCategories(ctx context.Context, req *conn
 	return c.getTopCategories.CallUnary(ctx, req)
 }
 
+// GetTopTags calls arian.v1.DashboardService.GetTopTags.
+func (c *dashboardServiceClient) GetTopTags(ctx context.Context, req *connect.Request[v1.GetTopTagsRequest]) (*connect.Response[v1.GetTopTagsResponse], error) {
+	return c.getTopTags.CallUnary(ctx, req)
+}
+
 // GetTopMerchants calls arian.v1.DashboardService.GetTopMerchants.
 func (c *dashboardServiceClient) GetTopMerchants(ctx context.Context, req *connect.Request[v1.GetTopMerchantsRequest]) (*connect.Response[v1.GetTopMerchantsResponse], error) {
 	return c.getTopMerchants.CallUnary(ctx, req)
@@ -187,11 +215,17 @@ func (c *dashboardServiceClient) GetNetWorthHistory(ctx context.Context, req *co
 	return c.getNetWorthHistory.CallUnary(ctx, req)
 }
 
+// GetProjectedBalances calls arian.v1.DashboardService.GetProjectedBalances.
+func (c *dashboardServiceClient) GetProjectedBalances(ctx context.Context, req *connect.Request[v1.GetProjectedBalancesRequest]) (*connect.Response[v1.GetProjectedBalancesResponse], error) {
+	return c.getProjectedBalances.CallUnary(ctx, req)
+}
+
 // DashboardServiceHandler is an implementation of the arian.v1.DashboardService service.
 type DashboardServiceHandler interface {
 	GetDashboardSummary(context.Context, *connect.Request[v1.GetDashboardSummaryRequest]) (*connect.Response[v1.GetDashboardSummaryResponse], error)
 	GetMonthlyComparison(context.Context, *connect.Request[v1.GetMonthlyComparisonRequest]) (*connect.Response[v1.GetMonthlyComparisonResponse], error)
 	GetTopCategories(context.Context, *connect.Request[v1.GetTopCategoriesRequest]) (*connect.Response[v1.GetTopCategoriesResponse], error)
+	GetTopTags(context.Context, *connect.Request[v1.GetTopTagsRequest]) (*connect.Response[v1.GetTopTagsResponse], error)
 	GetTopMerchants(context.Context, *connect.Request[v1.GetTopMerchantsRequest]) (*connect.Response[v1.GetTopMerchantsResponse], error)
 	GetSpendingTrends(context.Context, *connect.Request[v1.GetSpendingTrendsRequest]) (*connect.Response[v1.GetSpendingTrendsResponse], error)
 	// combines total balance, debt, and net worth across all accounts
@@ -199,6 +233,8 @@ type DashboardServiceHandler interface {
 	// compares category spending between current and previous period
 	GetCategorySpendingComparison(context.Context, *connect.Request[v1.GetCategorySpendingComparisonRequest]) (*connect.Response[v1.GetCategorySpendingComparisonResponse], error)
 	GetNetWorthHistory(context.Context, *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error)
+	// projected end-of-day balance per account including planned transactions
+	GetProjectedBalances(context.Context, *connect.Request[v1.GetProjectedBalancesRequest]) (*connect.Response[v1.GetProjectedBalancesResponse], error)
 }
 
 // NewDashboardServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -226,6 +262,12 @@ func NewDashboardServiceHandler(svc DashboardServiceHandler, opts ...connect.Han
 		connect.WithSchema(dashboardServiceMethods.ByName("GetTopCategories")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dashboardServiceGetTopTagsHandler := connect.NewUnaryHandler(
+		DashboardServiceGetTopTagsProcedure,
+		svc.GetTopTags,
+		connect.WithSchema(dashboardServiceMethods.ByName("GetTopTags")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dashboardServiceGetTopMerchantsHandler := connect.NewUnaryHandler(
 		DashboardServiceGetTopMerchantsProcedure,
 		svc.GetTopMerchants,
@@ -256,6 +298,12 @@ func NewDashboardServiceHandler(svc DashboardServiceHandler, opts ...connect.Han
 		connect.WithSchema(dashboardServiceMethods.ByName("GetNetWorthHistory")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dashboardServiceGetProjectedBalancesHandler := connect.NewUnaryHandler(
+		DashboardServiceGetProjectedBalancesProcedure,
+		svc.GetProjectedBalances,
+		connect.WithSchema(dashboardServiceMethods.ByName("GetProjectedBalances")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/arian.v1.DashboardService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DashboardServiceGetDashboardSummaryProcedure:
@@ -264,6 +312,8 @@ func NewDashboardServiceHandler(svc DashboardServiceHandler, opts ...connect.Han
 			dashboardServiceGetMonthlyComparisonHandler.ServeHTTP(w, r)
 		case DashboardServiceGetTopCategoriesProcedure:
 			dashboardServiceGetTopCategoriesHandler.ServeHTTP(w, r)
+		case DashboardServiceGetTopTagsProcedure:
+			dashboardServiceGetTopTagsHandler.ServeHTTP(w, r)
 		case DashboardServiceGetTopMerchantsProcedure:
 			dashboardServiceGetTopMerchantsHandler.ServeHTTP(w, r)
 		case DashboardServiceGetSpendingTrendsProcedure:
@@ -274,6 +324,8 @@ func NewDashboardServiceHandler(svc DashboardServiceHandler, opts ...connect.Han
 			dashboardServiceGetCategorySpendingComparisonHandler.ServeHTTP(w, r)
 		case DashboardServiceGetNetWorthHistoryProcedure:
 			dashboardServiceGetNetWorthHistoryHandler.ServeHTTP(w, r)
+		case DashboardServiceGetProjectedBalancesProcedure:
+			dashboardServiceGetProjectedBalancesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -295,6 +347,10 @@ func (UnimplementedDashboardServiceHandler) GetTopCategories(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.DashboardService.GetTopCategories is not implemented"))
 }
 
+func (UnimplementedDashboardServiceHandler) GetTopTags(context.Context, *connect.Request[v1.GetTopTagsRequest]) (*connect.Response[v1.GetTopTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.DashboardService.GetTopTags is not implemented"))
+}
+
 func (UnimplementedDashboardServiceHandler) GetTopMerchants(context.Context, *connect.Request[v1.GetTopMerchantsRequest]) (*connect.Response[v1.GetTopMerchantsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.DashboardService.GetTopMerchants is not implemented"))
 }
@@ -314,3 +370,7 @@ func (UnimplementedDashboardServiceHandler) GetCategorySpendingComparison(contex
 func (UnimplementedDashboardServiceHandler) GetNetWorthHistory(context.Context, *connect.Request[v1.GetNetWorthHistoryRequest]) (*connect.Response[v1.GetNetWorthHistoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.DashboardService.GetNetWorthHistory is not implemented"))
 }
+
+func (UnimplementedDashboardServiceHandler) GetProjectedBalances(context.Context, *connect.Request[v1.GetProjectedBalancesRequest]) (*connect.Response[v1.GetProjectedBalancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.DashboardService.GetProjectedBalances is not implemented"))
+}
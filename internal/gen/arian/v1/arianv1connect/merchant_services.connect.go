@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: arian/v1/merchant_services.proto
+
+package arianv1connect
+
+import (
+	v1 "ariand/internal/gen/arian/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MerchantServiceName is the fully-qualified name of the MerchantService service.
+	MerchantServiceName = "arian.v1.MerchantService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MerchantServiceListMerchantsProcedure is the fully-qualified name of the MerchantService's
+	// ListMerchants RPC.
+	MerchantServiceListMerchantsProcedure = "/arian.v1.MerchantService/ListMerchants"
+	// MerchantServiceGetMerchantProcedure is the fully-qualified name of the MerchantService's
+	// GetMerchant RPC.
+	MerchantServiceGetMerchantProcedure = "/arian.v1.MerchantService/GetMerchant"
+	// MerchantServiceCreateMerchantProcedure is the fully-qualified name of the MerchantService's
+	// CreateMerchant RPC.
+	MerchantServiceCreateMerchantProcedure = "/arian.v1.MerchantService/CreateMerchant"
+	// MerchantServiceUpdateMerchantProcedure is the fully-qualified name of the MerchantService's
+	// UpdateMerchant RPC.
+	MerchantServiceUpdateMerchantProcedure = "/arian.v1.MerchantService/UpdateMerchant"
+	// MerchantServiceDeleteMerchantProcedure is the fully-qualified name of the MerchantService's
+	// DeleteMerchant RPC.
+	MerchantServiceDeleteMerchantProcedure = "/arian.v1.MerchantService/DeleteMerchant"
+	// MerchantServiceMergeMerchantsProcedure is the fully-qualified name of the MerchantService's
+	// MergeMerchants RPC.
+	MerchantServiceMergeMerchantsProcedure = "/arian.v1.MerchantService/MergeMerchants"
+	// MerchantServiceSplitMerchantProcedure is the fully-qualified name of the MerchantService's
+	// SplitMerchant RPC.
+	MerchantServiceSplitMerchantProcedure = "/arian.v1.MerchantService/SplitMerchant"
+)
+
+// MerchantServiceClient is a client for the arian.v1.MerchantService service.
+type MerchantServiceClient interface {
+	ListMerchants(context.Context, *connect.Request[v1.ListMerchantsRequest]) (*connect.Response[v1.ListMerchantsResponse], error)
+	GetMerchant(context.Context, *connect.Request[v1.GetMerchantRequest]) (*connect.Response[v1.GetMerchantResponse], error)
+	CreateMerchant(context.Context, *connect.Request[v1.CreateMerchantRequest]) (*connect.Response[v1.CreateMerchantResponse], error)
+	UpdateMerchant(context.Context, *connect.Request[v1.UpdateMerchantRequest]) (*connect.Response[v1.UpdateMerchantResponse], error)
+	DeleteMerchant(context.Context, *connect.Request[v1.DeleteMerchantRequest]) (*connect.Response[v1.DeleteMerchantResponse], error)
+	MergeMerchants(context.Context, *connect.Request[v1.MergeMerchantsRequest]) (*connect.Response[v1.MergeMerchantsResponse], error)
+	SplitMerchant(context.Context, *connect.Request[v1.SplitMerchantRequest]) (*connect.Response[v1.SplitMerchantResponse], error)
+}
+
+// NewMerchantServiceClient constructs a client for the arian.v1.MerchantService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMerchantServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MerchantServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	merchantServiceMethods := v1.File_arian_v1_merchant_services_proto.Services().ByName("MerchantService").Methods()
+	return &merchantServiceClient{
+		listMerchants: connect.NewClient[v1.ListMerchantsRequest, v1.ListMerchantsResponse](
+			httpClient,
+			baseURL+MerchantServiceListMerchantsProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("ListMerchants")),
+			connect.WithClientOptions(opts...),
+		),
+		getMerchant: connect.NewClient[v1.GetMerchantRequest, v1.GetMerchantResponse](
+			httpClient,
+			baseURL+MerchantServiceGetMerchantProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("GetMerchant")),
+			connect.WithClientOptions(opts...),
+		),
+		createMerchant: connect.NewClient[v1.CreateMerchantRequest, v1.CreateMerchantResponse](
+			httpClient,
+			baseURL+MerchantServiceCreateMerchantProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("CreateMerchant")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMerchant: connect.NewClient[v1.UpdateMerchantRequest, v1.UpdateMerchantResponse](
+			httpClient,
+			baseURL+MerchantServiceUpdateMerchantProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("UpdateMerchant")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMerchant: connect.NewClient[v1.DeleteMerchantRequest, v1.DeleteMerchantResponse](
+			httpClient,
+			baseURL+MerchantServiceDeleteMerchantProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("DeleteMerchant")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeMerchants: connect.NewClient[v1.MergeMerchantsRequest, v1.MergeMerchantsResponse](
+			httpClient,
+			baseURL+MerchantServiceMergeMerchantsProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("MergeMerchants")),
+			connect.WithClientOptions(opts...),
+		),
+		splitMerchant: connect.NewClient[v1.SplitMerchantRequest, v1.SplitMerchantResponse](
+			httpClient,
+			baseURL+MerchantServiceSplitMerchantProcedure,
+			connect.WithSchema(merchantServiceMethods.ByName("SplitMerchant")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// merchantServiceClient implements MerchantServiceClient.
+type merchantServiceClient struct {
+	listMerchants  *connect.Client[v1.ListMerchantsRequest, v1.ListMerchantsResponse]
+	getMerchant    *connect.Client[v1.GetMerchantRequest, v1.GetMerchantResponse]
+	createMerchant *connect.Client[v1.CreateMerchantRequest, v1.CreateMerchantResponse]
+	updateMerchant *connect.Client[v1.UpdateMerchantRequest, v1.UpdateMerchantResponse]
+	deleteMerchant *connect.Client[v1.DeleteMerchantRequest, v1.DeleteMerchantResponse]
+	mergeMerchants *connect.Client[v1.MergeMerchantsRequest, v1.MergeMerchantsResponse]
+	splitMerchant  *connect.Client[v1.SplitMerchantRequest, v1.SplitMerchantResponse]
+}
+
+// ListMerchants calls arian.v1.MerchantService.ListMerchants.
+func (c *merchantServiceClient) ListMerchants(ctx context.Context, req *connect.Request[v1.ListMerchantsRequest]) (*connect.Response[v1.ListMerchantsResponse], error) {
+	return c.listMerchants.CallUnary(ctx, req)
+}
+
+// GetMerchant calls arian.v1.MerchantService.GetMerchant.
+func (c *merchantServiceClient) GetMerchant(ctx context.Context, req *connect.Request[v1.GetMerchantRequest]) (*connect.Response[v1.GetMerchantResponse], error) {
+	return c.getMerchant.CallUnary(ctx, req)
+}
+
+// CreateMerchant calls arian.v1.MerchantService.CreateMerchant.
+func (c *merchantServiceClient) CreateMerchant(ctx context.Context, req *connect.Request[v1.CreateMerchantRequest]) (*connect.Response[v1.CreateMerchantResponse], error) {
+	return c.createMerchant.CallUnary(ctx, req)
+}
+
+// UpdateMerchant calls arian.v1.MerchantService.UpdateMerchant.
+func (c *merchantServiceClient) UpdateMerchant(ctx context.Context, req *connect.Request[v1.UpdateMerchantRequest]) (*connect.Response[v1.UpdateMerchantResponse], error) {
+	return c.updateMerchant.CallUnary(ctx, req)
+}
+
+// DeleteMerchant calls arian.v1.MerchantService.DeleteMerchant.
+func (c *merchantServiceClient) DeleteMerchant(ctx context.Context, req *connect.Request[v1.DeleteMerchantRequest]) (*connect.Response[v1.DeleteMerchantResponse], error) {
+	return c.deleteMerchant.CallUnary(ctx, req)
+}
+
+// MergeMerchants calls arian.v1.MerchantService.MergeMerchants.
+func (c *merchantServiceClient) MergeMerchants(ctx context.Context, req *connect.Request[v1.MergeMerchantsRequest]) (*connect.Response[v1.MergeMerchantsResponse], error) {
+	return c.mergeMerchants.CallUnary(ctx, req)
+}
+
+// SplitMerchant calls arian.v1.MerchantService.SplitMerchant.
+func (c *merchantServiceClient) SplitMerchant(ctx context.Context, req *connect.Request[v1.SplitMerchantRequest]) (*connect.Response[v1.SplitMerchantResponse], error) {
+	return c.splitMerchant.CallUnary(ctx, req)
+}
+
+// MerchantServiceHandler is an implementation of the arian.v1.MerchantService service.
+type MerchantServiceHandler interface {
+	ListMerchants(context.Context, *connect.Request[v1.ListMerchantsRequest]) (*connect.Response[v1.ListMerchantsResponse], error)
+	GetMerchant(context.Context, *connect.Request[v1.GetMerchantRequest]) (*connect.Response[v1.GetMerchantResponse], error)
+	CreateMerchant(context.Context, *connect.Request[v1.CreateMerchantRequest]) (*connect.Response[v1.CreateMerchantResponse], error)
+	UpdateMerchant(context.Context, *connect.Request[v1.UpdateMerchantRequest]) (*connect.Response[v1.UpdateMerchantResponse], error)
+	DeleteMerchant(context.Context, *connect.Request[v1.DeleteMerchantRequest]) (*connect.Response[v1.DeleteMerchantResponse], error)
+	MergeMerchants(context.Context, *connect.Request[v1.MergeMerchantsRequest]) (*connect.Response[v1.MergeMerchantsResponse], error)
+	SplitMerchant(context.Context, *connect.Request[v1.SplitMerchantRequest]) (*connect.Response[v1.SplitMerchantResponse], error)
+}
+
+// NewMerchantServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMerchantServiceHandler(svc MerchantServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	merchantServiceMethods := v1.File_arian_v1_merchant_services_proto.Services().ByName("MerchantService").Methods()
+	merchantServiceListMerchantsHandler := connect.NewUnaryHandler(
+		MerchantServiceListMerchantsProcedure,
+		svc.ListMerchants,
+		connect.WithSchema(merchantServiceMethods.ByName("ListMerchants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	merchantServiceGetMerchantHandler := connect.NewUnaryHandler(
+		MerchantServiceGetMerchantProcedure,
+		svc.GetMerchant,
+		connect.WithSchema(merchantServiceMethods.ByName("GetMerchant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	merchantServiceCreateMerchantHandler := connect.NewUnaryHandler(
+		MerchantServiceCreateMerchantProcedure,
+		svc.CreateMerchant,
+		connect.WithSchema(merchantServiceMethods.ByName("CreateMerchant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	merchantServiceUpdateMerchantHandler := connect.NewUnaryHandler(
+		MerchantServiceUpdateMerchantProcedure,
+		svc.UpdateMerchant,
+		connect.WithSchema(merchantServiceMethods.ByName("UpdateMerchant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	merchantServiceDeleteMerchantHandler := connect.NewUnaryHandler(
+		MerchantServiceDeleteMerchantProcedure,
+		svc.DeleteMerchant,
+		connect.WithSchema(merchantServiceMethods.ByName("DeleteMerchant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	merchantServiceMergeMerchantsHandler := connect.NewUnaryHandler(
+		MerchantServiceMergeMerchantsProcedure,
+		svc.MergeMerchants,
+		connect.WithSchema(merchantServiceMethods.ByName("MergeMerchants")),
+		connect.WithHandlerOptions(opts...),
+	)
+	merchantServiceSplitMerchantHandler := connect.NewUnaryHandler(
+		MerchantServiceSplitMerchantProcedure,
+		svc.SplitMerchant,
+		connect.WithSchema(merchantServiceMethods.ByName("SplitMerchant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/arian.v1.MerchantService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MerchantServiceListMerchantsProcedure:
+			merchantServiceListMerchantsHandler.ServeHTTP(w, r)
+		case MerchantServiceGetMerchantProcedure:
+			merchantServiceGetMerchantHandler.ServeHTTP(w, r)
+		case MerchantServiceCreateMerchantProcedure:
+			merchantServiceCreateMerchantHandler.ServeHTTP(w, r)
+		case MerchantServiceUpdateMerchantProcedure:
+			merchantServiceUpdateMerchantHandler.ServeHTTP(w, r)
+		case MerchantServiceDeleteMerchantProcedure:
+			merchantServiceDeleteMerchantHandler.ServeHTTP(w, r)
+		case MerchantServiceMergeMerchantsProcedure:
+			merchantServiceMergeMerchantsHandler.ServeHTTP(w, r)
+		case MerchantServiceSplitMerchantProcedure:
+			merchantServiceSplitMerchantHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMerchantServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMerchantServiceHandler struct{}
+
+func (UnimplementedMerchantServiceHandler) ListMerchants(context.Context, *connect.Request[v1.ListMerchantsRequest]) (*connect.Response[v1.ListMerchantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.ListMerchants is not implemented"))
+}
+
+func (UnimplementedMerchantServiceHandler) GetMerchant(context.Context, *connect.Request[v1.GetMerchantRequest]) (*connect.Response[v1.GetMerchantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.GetMerchant is not implemented"))
+}
+
+func (UnimplementedMerchantServiceHandler) CreateMerchant(context.Context, *connect.Request[v1.CreateMerchantRequest]) (*connect.Response[v1.CreateMerchantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.CreateMerchant is not implemented"))
+}
+
+func (UnimplementedMerchantServiceHandler) UpdateMerchant(context.Context, *connect.Request[v1.UpdateMerchantRequest]) (*connect.Response[v1.UpdateMerchantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.UpdateMerchant is not implemented"))
+}
+
+func (UnimplementedMerchantServiceHandler) DeleteMerchant(context.Context, *connect.Request[v1.DeleteMerchantRequest]) (*connect.Response[v1.DeleteMerchantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.DeleteMerchant is not implemented"))
+}
+
+func (UnimplementedMerchantServiceHandler) MergeMerchants(context.Context, *connect.Request[v1.MergeMerchantsRequest]) (*connect.Response[v1.MergeMerchantsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.MergeMerchants is not implemented"))
+}
+
+func (UnimplementedMerchantServiceHandler) SplitMerchant(context.Context, *connect.Request[v1.SplitMerchantRequest]) (*connect.Response[v1.SplitMerchantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.MerchantService.SplitMerchant is not implemented"))
+}
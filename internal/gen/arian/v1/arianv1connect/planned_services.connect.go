@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: arian/v1/planned_services.proto
+
+package arianv1connect
+
+import (
+	v1 "ariand/internal/gen/arian/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PlannedTransactionServiceName is the fully-qualified name of the PlannedTransactionService
+	// service.
+	PlannedTransactionServiceName = "arian.v1.PlannedTransactionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PlannedTransactionServiceListPlannedTransactionsProcedure is the fully-qualified name of the
+	// PlannedTransactionService's ListPlannedTransactions RPC.
+	PlannedTransactionServiceListPlannedTransactionsProcedure = "/arian.v1.PlannedTransactionService/ListPlannedTransactions"
+	// PlannedTransactionServiceGetPlannedTransactionProcedure is the fully-qualified name of the
+	// PlannedTransactionService's GetPlannedTransaction RPC.
+	PlannedTransactionServiceGetPlannedTransactionProcedure = "/arian.v1.PlannedTransactionService/GetPlannedTransaction"
+	// PlannedTransactionServiceCreatePlannedTransactionProcedure is the fully-qualified name of the
+	// PlannedTransactionService's CreatePlannedTransaction RPC.
+	PlannedTransactionServiceCreatePlannedTransactionProcedure = "/arian.v1.PlannedTransactionService/CreatePlannedTransaction"
+	// PlannedTransactionServiceUpdatePlannedTransactionProcedure is the fully-qualified name of the
+	// PlannedTransactionService's UpdatePlannedTransaction RPC.
+	PlannedTransactionServiceUpdatePlannedTransactionProcedure = "/arian.v1.PlannedTransactionService/UpdatePlannedTransaction"
+	// PlannedTransactionServiceDeletePlannedTransactionProcedure is the fully-qualified name of the
+	// PlannedTransactionService's DeletePlannedTransaction RPC.
+	PlannedTransactionServiceDeletePlannedTransactionProcedure = "/arian.v1.PlannedTransactionService/DeletePlannedTransaction"
+)
+
+// PlannedTransactionServiceClient is a client for the arian.v1.PlannedTransactionService service.
+type PlannedTransactionServiceClient interface {
+	ListPlannedTransactions(context.Context, *connect.Request[v1.ListPlannedTransactionsRequest]) (*connect.Response[v1.ListPlannedTransactionsResponse], error)
+	GetPlannedTransaction(context.Context, *connect.Request[v1.GetPlannedTransactionRequest]) (*connect.Response[v1.GetPlannedTransactionResponse], error)
+	CreatePlannedTransaction(context.Context, *connect.Request[v1.CreatePlannedTransactionRequest]) (*connect.Response[v1.CreatePlannedTransactionResponse], error)
+	UpdatePlannedTransaction(context.Context, *connect.Request[v1.UpdatePlannedTransactionRequest]) (*connect.Response[v1.UpdatePlannedTransactionResponse], error)
+	DeletePlannedTransaction(context.Context, *connect.Request[v1.DeletePlannedTransactionRequest]) (*connect.Response[v1.DeletePlannedTransactionResponse], error)
+}
+
+// NewPlannedTransactionServiceClient constructs a client for the arian.v1.PlannedTransactionService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPlannedTransactionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PlannedTransactionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	plannedTransactionServiceMethods := v1.File_arian_v1_planned_services_proto.Services().ByName("PlannedTransactionService").Methods()
+	return &plannedTransactionServiceClient{
+		listPlannedTransactions: connect.NewClient[v1.ListPlannedTransactionsRequest, v1.ListPlannedTransactionsResponse](
+			httpClient,
+			baseURL+PlannedTransactionServiceListPlannedTransactionsProcedure,
+			connect.WithSchema(plannedTransactionServiceMethods.ByName("ListPlannedTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlannedTransaction: connect.NewClient[v1.GetPlannedTransactionRequest, v1.GetPlannedTransactionResponse](
+			httpClient,
+			baseURL+PlannedTransactionServiceGetPlannedTransactionProcedure,
+			connect.WithSchema(plannedTransactionServiceMethods.ByName("GetPlannedTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+		createPlannedTransaction: connect.NewClient[v1.CreatePlannedTransactionRequest, v1.CreatePlannedTransactionResponse](
+			httpClient,
+			baseURL+PlannedTransactionServiceCreatePlannedTransactionProcedure,
+			connect.WithSchema(plannedTransactionServiceMethods.ByName("CreatePlannedTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePlannedTransaction: connect.NewClient[v1.UpdatePlannedTransactionRequest, v1.UpdatePlannedTransactionResponse](
+			httpClient,
+			baseURL+PlannedTransactionServiceUpdatePlannedTransactionProcedure,
+			connect.WithSchema(plannedTransactionServiceMethods.ByName("UpdatePlannedTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlannedTransaction: connect.NewClient[v1.DeletePlannedTransactionRequest, v1.DeletePlannedTransactionResponse](
+			httpClient,
+			baseURL+PlannedTransactionServiceDeletePlannedTransactionProcedure,
+			connect.WithSchema(plannedTransactionServiceMethods.ByName("DeletePlannedTransaction")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// plannedTransactionServiceClient implements PlannedTransactionServiceClient.
+type plannedTransactionServiceClient struct {
+	listPlannedTransactions  *connect.Client[v1.ListPlannedTransactionsRequest, v1.ListPlannedTransactionsResponse]
+	getPlannedTransaction    *connect.Client[v1.GetPlannedTransactionRequest, v1.GetPlannedTransactionResponse]
+	createPlannedTransaction *connect.Client[v1.CreatePlannedTransactionRequest, v1.CreatePlannedTransactionResponse]
+	updatePlannedTransaction *connect.Client[v1.UpdatePlannedTransactionRequest, v1.UpdatePlannedTransactionResponse]
+	deletePlannedTransaction *connect.Client[v1.DeletePlannedTransactionRequest, v1.DeletePlannedTransactionResponse]
+}
+
+// ListPlannedTransactions calls arian.v1.PlannedTransactionService.ListPlannedTransactions.
+func (c *plannedTransactionServiceClient) ListPlannedTransactions(ctx context.Context, req *connect.Request[v1.ListPlannedTransactionsRequest]) (*connect.Response[v1.ListPlannedTransactionsResponse], error) {
+	return c.listPlannedTransactions.CallUnary(ctx, req)
+}
+
+// GetPlannedTransaction calls arian.v1.PlannedTransactionService.GetPlannedTransaction.
+func (c *plannedTransactionServiceClient) GetPlannedTransaction(ctx context.Context, req *connect.Request[v1.GetPlannedTransactionRequest]) (*connect.Response[v1.GetPlannedTransactionResponse], error) {
+	return c.getPlannedTransaction.CallUnary(ctx, req)
+}
+
+// CreatePlannedTransaction calls arian.v1.PlannedTransactionService.CreatePlannedTransaction.
+func (c *plannedTransactionServiceClient) CreatePlannedTransaction(ctx context.Context, req *connect.Request[v1.CreatePlannedTransactionRequest]) (*connect.Response[v1.CreatePlannedTransactionResponse], error) {
+	return c.createPlannedTransaction.CallUnary(ctx, req)
+}
+
+// UpdatePlannedTransaction calls arian.v1.PlannedTransactionService.UpdatePlannedTransaction.
+func (c *plannedTransactionServiceClient) UpdatePlannedTransaction(ctx context.Context, req *connect.Request[v1.UpdatePlannedTransactionRequest]) (*connect.Response[v1.UpdatePlannedTransactionResponse], error) {
+	return c.updatePlannedTransaction.CallUnary(ctx, req)
+}
+
+// DeletePlannedTransaction calls arian.v1.PlannedTransactionService.DeletePlannedTransaction.
+func (c *plannedTransactionServiceClient) DeletePlannedTransaction(ctx context.Context, req *connect.Request[v1.DeletePlannedTransactionRequest]) (*connect.Response[v1.DeletePlannedTransactionResponse], error) {
+	return c.deletePlannedTransaction.CallUnary(ctx, req)
+}
+
+// PlannedTransactionServiceHandler is an implementation of the arian.v1.PlannedTransactionService
+// service.
+type PlannedTransactionServiceHandler interface {
+	ListPlannedTransactions(context.Context, *connect.Request[v1.ListPlannedTransactionsRequest]) (*connect.Response[v1.ListPlannedTransactionsResponse], error)
+	GetPlannedTransaction(context.Context, *connect.Request[v1.GetPlannedTransactionRequest]) (*connect.Response[v1.GetPlannedTransactionResponse], error)
+	CreatePlannedTransaction(context.Context, *connect.Request[v1.CreatePlannedTransactionRequest]) (*connect.Response[v1.CreatePlannedTransactionResponse], error)
+	UpdatePlannedTransaction(context.Context, *connect.Request[v1.UpdatePlannedTransactionRequest]) (*connect.Response[v1.UpdatePlannedTransactionResponse], error)
+	DeletePlannedTransaction(context.Context, *connect.Request[v1.DeletePlannedTransactionRequest]) (*connect.Response[v1.DeletePlannedTransactionResponse], error)
+}
+
+// NewPlannedTransactionServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPlannedTransactionServiceHandler(svc PlannedTransactionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	plannedTransactionServiceMethods := v1.File_arian_v1_planned_services_proto.Services().ByName("PlannedTransactionService").Methods()
+	plannedTransactionServiceListPlannedTransactionsHandler := connect.NewUnaryHandler(
+		PlannedTransactionServiceListPlannedTransactionsProcedure,
+		svc.ListPlannedTransactions,
+		connect.WithSchema(plannedTransactionServiceMethods.ByName("ListPlannedTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	plannedTransactionServiceGetPlannedTransactionHandler := connect.NewUnaryHandler(
+		PlannedTransactionServiceGetPlannedTransactionProcedure,
+		svc.GetPlannedTransaction,
+		connect.WithSchema(plannedTransactionServiceMethods.ByName("GetPlannedTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	plannedTransactionServiceCreatePlannedTransactionHandler := connect.NewUnaryHandler(
+		PlannedTransactionServiceCreatePlannedTransactionProcedure,
+		svc.CreatePlannedTransaction,
+		connect.WithSchema(plannedTransactionServiceMethods.ByName("CreatePlannedTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	plannedTransactionServiceUpdatePlannedTransactionHandler := connect.NewUnaryHandler(
+		PlannedTransactionServiceUpdatePlannedTransactionProcedure,
+		svc.UpdatePlannedTransaction,
+		connect.WithSchema(plannedTransactionServiceMethods.ByName("UpdatePlannedTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	plannedTransactionServiceDeletePlannedTransactionHandler := connect.NewUnaryHandler(
+		PlannedTransactionServiceDeletePlannedTransactionProcedure,
+		svc.DeletePlannedTransaction,
+		connect.WithSchema(plannedTransactionServiceMethods.ByName("DeletePlannedTransaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/arian.v1.PlannedTransactionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PlannedTransactionServiceListPlannedTransactionsProcedure:
+			plannedTransactionServiceListPlannedTransactionsHandler.ServeHTTP(w, r)
+		case PlannedTransactionServiceGetPlannedTransactionProcedure:
+			plannedTransactionServiceGetPlannedTransactionHandler.ServeHTTP(w, r)
+		case PlannedTransactionServiceCreatePlannedTransactionProcedure:
+			plannedTransactionServiceCreatePlannedTransactionHandler.ServeHTTP(w, r)
+		case PlannedTransactionServiceUpdatePlannedTransactionProcedure:
+			plannedTransactionServiceUpdatePlannedTransactionHandler.ServeHTTP(w, r)
+		case PlannedTransactionServiceDeletePlannedTransactionProcedure:
+			plannedTransactionServiceDeletePlannedTransactionHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPlannedTransactionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPlannedTransactionServiceHandler struct{}
+
+func (UnimplementedPlannedTransactionServiceHandler) ListPlannedTransactions(context.Context, *connect.Request[v1.ListPlannedTransactionsRequest]) (*connect.Response[v1.ListPlannedTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.PlannedTransactionService.ListPlannedTransactions is not implemented"))
+}
+
+func (UnimplementedPlannedTransactionServiceHandler) GetPlannedTransaction(context.Context, *connect.Request[v1.GetPlannedTransactionRequest]) (*connect.Response[v1.GetPlannedTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.PlannedTransactionService.GetPlannedTransaction is not implemented"))
+}
+
+func (UnimplementedPlannedTransactionServiceHandler) CreatePlannedTransaction(context.Context, *connect.Request[v1.CreatePlannedTransactionRequest]) (*connect.Response[v1.CreatePlannedTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.PlannedTransactionService.CreatePlannedTransaction is not implemented"))
+}
+
+func (UnimplementedPlannedTransactionServiceHandler) UpdatePlannedTransaction(context.Context, *connect.Request[v1.UpdatePlannedTransactionRequest]) (*connect.Response[v1.UpdatePlannedTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.PlannedTransactionService.UpdatePlannedTransaction is not implemented"))
+}
+
+func (UnimplementedPlannedTransactionServiceHandler) DeletePlannedTransaction(context.Context, *connect.Request[v1.DeletePlannedTransactionRequest]) (*connect.Response[v1.DeletePlannedTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.PlannedTransactionService.DeletePlannedTransaction is not implemented"))
+}
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: arian/v1/receipt_parser_services.proto
+
+package arianv1connect
+
+import (
+	v1 "ariand/internal/gen/arian/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ReceiptParserServiceName is the fully-qualified name of the ReceiptParserService service.
+	ReceiptParserServiceName = "arian.v1.ReceiptParserService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ReceiptParserServiceParseReceiptProcedure is the fully-qualified name of the
+	// ReceiptParserService's ParseReceipt RPC.
+	ReceiptParserServiceParseReceiptProcedure = "/arian.v1.ReceiptParserService/ParseReceipt"
+)
+
+// ReceiptParserServiceClient is a client for the arian.v1.ReceiptParserService service.
+type ReceiptParserServiceClient interface {
+	ParseReceipt(context.Context, *connect.Request[v1.ParseReceiptRequest]) (*connect.Response[v1.ParseReceiptResponse], error)
+}
+
+// NewReceiptParserServiceClient constructs a client for the arian.v1.ReceiptParserService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReceiptParserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReceiptParserServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	receiptParserServiceMethods := v1.File_arian_v1_receipt_parser_services_proto.Services().ByName("ReceiptParserService").Methods()
+	return &receiptParserServiceClient{
+		parseReceipt: connect.NewClient[v1.ParseReceiptRequest, v1.ParseReceiptResponse](
+			httpClient,
+			baseURL+ReceiptParserServiceParseReceiptProcedure,
+			connect.WithSchema(receiptParserServiceMethods.ByName("ParseReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// receiptParserServiceClient implements ReceiptParserServiceClient.
+type receiptParserServiceClient struct {
+	parseReceipt *connect.Client[v1.ParseReceiptRequest, v1.ParseReceiptResponse]
+}
+
+// ParseReceipt calls arian.v1.ReceiptParserService.ParseReceipt.
+func (c *receiptParserServiceClient) ParseReceipt(ctx context.Context, req *connect.Request[v1.ParseReceiptRequest]) (*connect.Response[v1.ParseReceiptResponse], error) {
+	return c.parseReceipt.CallUnary(ctx, req)
+}
+
+// ReceiptParserServiceHandler is an implementation of the arian.v1.ReceiptParserService service.
+type ReceiptParserServiceHandler interface {
+	ParseReceipt(context.Context, *connect.Request[v1.ParseReceiptRequest]) (*connect.Response[v1.ParseReceiptResponse], error)
+}
+
+// NewReceiptParserServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReceiptParserServiceHandler(svc ReceiptParserServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	receiptParserServiceMethods := v1.File_arian_v1_receipt_parser_services_proto.Services().ByName("ReceiptParserService").Methods()
+	receiptParserServiceParseReceiptHandler := connect.NewUnaryHandler(
+		ReceiptParserServiceParseReceiptProcedure,
+		svc.ParseReceipt,
+		connect.WithSchema(receiptParserServiceMethods.ByName("ParseReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/arian.v1.ReceiptParserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReceiptParserServiceParseReceiptProcedure:
+			receiptParserServiceParseReceiptHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReceiptParserServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReceiptParserServiceHandler struct{}
+
+func (UnimplementedReceiptParserServiceHandler) ParseReceipt(context.Context, *connect.Request[v1.ParseReceiptRequest]) (*connect.Response[v1.ParseReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptParserService.ParseReceipt is not implemented"))
+}
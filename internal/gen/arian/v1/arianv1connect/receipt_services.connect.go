@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: arian/v1/receipt_services.proto
+
+package arianv1connect
+
+import (
+	v1 "ariand/internal/gen/arian/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ReceiptServiceName is the fully-qualified name of the ReceiptService service.
+	ReceiptServiceName = "arian.v1.ReceiptService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ReceiptServiceUploadReceiptProcedure is the fully-qualified name of the ReceiptService's
+	// UploadReceipt RPC.
+	ReceiptServiceUploadReceiptProcedure = "/arian.v1.ReceiptService/UploadReceipt"
+	// ReceiptServiceGetReceiptProcedure is the fully-qualified name of the ReceiptService's GetReceipt
+	// RPC.
+	ReceiptServiceGetReceiptProcedure = "/arian.v1.ReceiptService/GetReceipt"
+	// ReceiptServiceListReceiptsProcedure is the fully-qualified name of the ReceiptService's
+	// ListReceipts RPC.
+	ReceiptServiceListReceiptsProcedure = "/arian.v1.ReceiptService/ListReceipts"
+	// ReceiptServiceConfirmReceiptMatchProcedure is the fully-qualified name of the ReceiptService's
+	// ConfirmReceiptMatch RPC.
+	ReceiptServiceConfirmReceiptMatchProcedure = "/arian.v1.ReceiptService/ConfirmReceiptMatch"
+	// ReceiptServiceRejectReceiptMatchProcedure is the fully-qualified name of the ReceiptService's
+	// RejectReceiptMatch RPC.
+	ReceiptServiceRejectReceiptMatchProcedure = "/arian.v1.ReceiptService/RejectReceiptMatch"
+	// ReceiptServiceDeleteReceiptProcedure is the fully-qualified name of the ReceiptService's
+	// DeleteReceipt RPC.
+	ReceiptServiceDeleteReceiptProcedure = "/arian.v1.ReceiptService/DeleteReceipt"
+)
+
+// ReceiptServiceClient is a client for the arian.v1.ReceiptService service.
+type ReceiptServiceClient interface {
+	UploadReceipt(context.Context, *connect.Request[v1.UploadReceiptRequest]) (*connect.Response[v1.UploadReceiptResponse], error)
+	GetReceipt(context.Context, *connect.Request[v1.GetReceiptRequest]) (*connect.Response[v1.GetReceiptResponse], error)
+	ListReceipts(context.Context, *connect.Request[v1.ListReceiptsRequest]) (*connect.Response[v1.ListReceiptsResponse], error)
+	ConfirmReceiptMatch(context.Context, *connect.Request[v1.ConfirmReceiptMatchRequest]) (*connect.Response[v1.ConfirmReceiptMatchResponse], error)
+	RejectReceiptMatch(context.Context, *connect.Request[v1.RejectReceiptMatchRequest]) (*connect.Response[v1.RejectReceiptMatchResponse], error)
+	DeleteReceipt(context.Context, *connect.Request[v1.DeleteReceiptRequest]) (*connect.Response[v1.DeleteReceiptResponse], error)
+}
+
+// NewReceiptServiceClient constructs a client for the arian.v1.ReceiptService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReceiptServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReceiptServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	receiptServiceMethods := v1.File_arian_v1_receipt_services_proto.Services().ByName("ReceiptService").Methods()
+	return &receiptServiceClient{
+		uploadReceipt: connect.NewClient[v1.UploadReceiptRequest, v1.UploadReceiptResponse](
+			httpClient,
+			baseURL+ReceiptServiceUploadReceiptProcedure,
+			connect.WithSchema(receiptServiceMethods.ByName("UploadReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+		getReceipt: connect.NewClient[v1.GetReceiptRequest, v1.GetReceiptResponse](
+			httpClient,
+			baseURL+ReceiptServiceGetReceiptProcedure,
+			connect.WithSchema(receiptServiceMethods.ByName("GetReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+		listReceipts: connect.NewClient[v1.ListReceiptsRequest, v1.ListReceiptsResponse](
+			httpClient,
+			baseURL+ReceiptServiceListReceiptsProcedure,
+			connect.WithSchema(receiptServiceMethods.ByName("ListReceipts")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmReceiptMatch: connect.NewClient[v1.ConfirmReceiptMatchRequest, v1.ConfirmReceiptMatchResponse](
+			httpClient,
+			baseURL+ReceiptServiceConfirmReceiptMatchProcedure,
+			connect.WithSchema(receiptServiceMethods.ByName("ConfirmReceiptMatch")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectReceiptMatch: connect.NewClient[v1.RejectReceiptMatchRequest, v1.RejectReceiptMatchResponse](
+			httpClient,
+			baseURL+ReceiptServiceRejectReceiptMatchProcedure,
+			connect.WithSchema(receiptServiceMethods.ByName("RejectReceiptMatch")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteReceipt: connect.NewClient[v1.DeleteReceiptRequest, v1.DeleteReceiptResponse](
+			httpClient,
+			baseURL+ReceiptServiceDeleteReceiptProcedure,
+			connect.WithSchema(receiptServiceMethods.ByName("DeleteReceipt")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// receiptServiceClient implements ReceiptServiceClient.
+type receiptServiceClient struct {
+	uploadReceipt       *connect.Client[v1.UploadReceiptRequest, v1.UploadReceiptResponse]
+	getReceipt          *connect.Client[v1.GetReceiptRequest, v1.GetReceiptResponse]
+	listReceipts        *connect.Client[v1.ListReceiptsRequest, v1.ListReceiptsResponse]
+	confirmReceiptMatch *connect.Client[v1.ConfirmReceiptMatchRequest, v1.ConfirmReceiptMatchResponse]
+	rejectReceiptMatch  *connect.Client[v1.RejectReceiptMatchRequest, v1.RejectReceiptMatchResponse]
+	deleteReceipt       *connect.Client[v1.DeleteReceiptRequest, v1.DeleteReceiptResponse]
+}
+
+// UploadReceipt calls arian.v1.ReceiptService.UploadReceipt.
+func (c *receiptServiceClient) UploadReceipt(ctx context.Context, req *connect.Request[v1.UploadReceiptRequest]) (*connect.Response[v1.UploadReceiptResponse], error) {
+	return c.uploadReceipt.CallUnary(ctx, req)
+}
+
+// GetReceipt calls arian.v1.ReceiptService.GetReceipt.
+func (c *receiptServiceClient) GetReceipt(ctx context.Context, req *connect.Request[v1.GetReceiptRequest]) (*connect.Response[v1.GetReceiptResponse], error) {
+	return c.getReceipt.CallUnary(ctx, req)
+}
+
+// ListReceipts calls arian.v1.ReceiptService.ListReceipts.
+func (c *receiptServiceClient) ListReceipts(ctx context.Context, req *connect.Request[v1.ListReceiptsRequest]) (*connect.Response[v1.ListReceiptsResponse], error) {
+	return c.listReceipts.CallUnary(ctx, req)
+}
+
+// ConfirmReceiptMatch calls arian.v1.ReceiptService.ConfirmReceiptMatch.
+func (c *receiptServiceClient) ConfirmReceiptMatch(ctx context.Context, req *connect.Request[v1.ConfirmReceiptMatchRequest]) (*connect.Response[v1.ConfirmReceiptMatchResponse], error) {
+	return c.confirmReceiptMatch.CallUnary(ctx, req)
+}
+
+// RejectReceiptMatch calls arian.v1.ReceiptService.RejectReceiptMatch.
+func (c *receiptServiceClient) RejectReceiptMatch(ctx context.Context, req *connect.Request[v1.RejectReceiptMatchRequest]) (*connect.Response[v1.RejectReceiptMatchResponse], error) {
+	return c.rejectReceiptMatch.CallUnary(ctx, req)
+}
+
+// DeleteReceipt calls arian.v1.ReceiptService.DeleteReceipt.
+func (c *receiptServiceClient) DeleteReceipt(ctx context.Context, req *connect.Request[v1.DeleteReceiptRequest]) (*connect.Response[v1.DeleteReceiptResponse], error) {
+	return c.deleteReceipt.CallUnary(ctx, req)
+}
+
+// ReceiptServiceHandler is an implementation of the arian.v1.ReceiptService service.
+type ReceiptServiceHandler interface {
+	UploadReceipt(context.Context, *connect.Request[v1.UploadReceiptRequest]) (*connect.Response[v1.UploadReceiptResponse], error)
+	GetReceipt(context.Context, *connect.Request[v1.GetReceiptRequest]) (*connect.Response[v1.GetReceiptResponse], error)
+	ListReceipts(context.Context, *connect.Request[v1.ListReceiptsRequest]) (*connect.Response[v1.ListReceiptsResponse], error)
+	ConfirmReceiptMatch(context.Context, *connect.Request[v1.ConfirmReceiptMatchRequest]) (*connect.Response[v1.ConfirmReceiptMatchResponse], error)
+	RejectReceiptMatch(context.Context, *connect.Request[v1.RejectReceiptMatchRequest]) (*connect.Response[v1.RejectReceiptMatchResponse], error)
+	DeleteReceipt(context.Context, *connect.Request[v1.DeleteReceiptRequest]) (*connect.Response[v1.DeleteReceiptResponse], error)
+}
+
+// NewReceiptServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReceiptServiceHandler(svc ReceiptServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	receiptServiceMethods := v1.File_arian_v1_receipt_services_proto.Services().ByName("ReceiptService").Methods()
+	receiptServiceUploadReceiptHandler := connect.NewUnaryHandler(
+		ReceiptServiceUploadReceiptProcedure,
+		svc.UploadReceipt,
+		connect.WithSchema(receiptServiceMethods.ByName("UploadReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptServiceGetReceiptHandler := connect.NewUnaryHandler(
+		ReceiptServiceGetReceiptProcedure,
+		svc.GetReceipt,
+		connect.WithSchema(receiptServiceMethods.ByName("GetReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptServiceListReceiptsHandler := connect.NewUnaryHandler(
+		ReceiptServiceListReceiptsProcedure,
+		svc.ListReceipts,
+		connect.WithSchema(receiptServiceMethods.ByName("ListReceipts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptServiceConfirmReceiptMatchHandler := connect.NewUnaryHandler(
+		ReceiptServiceConfirmReceiptMatchProcedure,
+		svc.ConfirmReceiptMatch,
+		connect.WithSchema(receiptServiceMethods.ByName("ConfirmReceiptMatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptServiceRejectReceiptMatchHandler := connect.NewUnaryHandler(
+		ReceiptServiceRejectReceiptMatchProcedure,
+		svc.RejectReceiptMatch,
+		connect.WithSchema(receiptServiceMethods.ByName("RejectReceiptMatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	receiptServiceDeleteReceiptHandler := connect.NewUnaryHandler(
+		ReceiptServiceDeleteReceiptProcedure,
+		svc.DeleteReceipt,
+		connect.WithSchema(receiptServiceMethods.ByName("DeleteReceipt")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/arian.v1.ReceiptService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReceiptServiceUploadReceiptProcedure:
+			receiptServiceUploadReceiptHandler.ServeHTTP(w, r)
+		case ReceiptServiceGetReceiptProcedure:
+			receiptServiceGetReceiptHandler.ServeHTTP(w, r)
+		case ReceiptServiceListReceiptsProcedure:
+			receiptServiceListReceiptsHandler.ServeHTTP(w, r)
+		case ReceiptServiceConfirmReceiptMatchProcedure:
+			receiptServiceConfirmReceiptMatchHandler.ServeHTTP(w, r)
+		case ReceiptServiceRejectReceiptMatchProcedure:
+			receiptServiceRejectReceiptMatchHandler.ServeHTTP(w, r)
+		case ReceiptServiceDeleteReceiptProcedure:
+			receiptServiceDeleteReceiptHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReceiptServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReceiptServiceHandler struct{}
+
+func (UnimplementedReceiptServiceHandler) UploadReceipt(context.Context, *connect.Request[v1.UploadReceiptRequest]) (*connect.Response[v1.UploadReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptService.UploadReceipt is not implemented"))
+}
+
+func (UnimplementedReceiptServiceHandler) GetReceipt(context.Context, *connect.Request[v1.GetReceiptRequest]) (*connect.Response[v1.GetReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptService.GetReceipt is not implemented"))
+}
+
+func (UnimplementedReceiptServiceHandler) ListReceipts(context.Context, *connect.Request[v1.ListReceiptsRequest]) (*connect.Response[v1.ListReceiptsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptService.ListReceipts is not implemented"))
+}
+
+func (UnimplementedReceiptServiceHandler) ConfirmReceiptMatch(context.Context, *connect.Request[v1.ConfirmReceiptMatchRequest]) (*connect.Response[v1.ConfirmReceiptMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptService.ConfirmReceiptMatch is not implemented"))
+}
+
+func (UnimplementedReceiptServiceHandler) RejectReceiptMatch(context.Context, *connect.Request[v1.RejectReceiptMatchRequest]) (*connect.Response[v1.RejectReceiptMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptService.RejectReceiptMatch is not implemented"))
+}
+
+func (UnimplementedReceiptServiceHandler) DeleteReceipt(context.Context, *connect.Request[v1.DeleteReceiptRequest]) (*connect.Response[v1.DeleteReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.ReceiptService.DeleteReceipt is not implemented"))
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: arian/v1/recurring_services.proto
+
+package arianv1connect
+
+import (
+	v1 "ariand/internal/gen/arian/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RecurringServiceName is the fully-qualified name of the RecurringService service.
+	RecurringServiceName = "arian.v1.RecurringService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RecurringServiceDetectRecurringProcedure is the fully-qualified name of the RecurringService's
+	// DetectRecurring RPC.
+	RecurringServiceDetectRecurringProcedure = "/arian.v1.RecurringService/DetectRecurring"
+	// RecurringServiceListRecurringProcedure is the fully-qualified name of the RecurringService's
+	// ListRecurring RPC.
+	RecurringServiceListRecurringProcedure = "/arian.v1.RecurringService/ListRecurring"
+	// RecurringServiceConfirmRecurringProcedure is the fully-qualified name of the RecurringService's
+	// ConfirmRecurring RPC.
+	RecurringServiceConfirmRecurringProcedure = "/arian.v1.RecurringService/ConfirmRecurring"
+	// RecurringServiceDismissRecurringProcedure is the fully-qualified name of the RecurringService's
+	// DismissRecurring RPC.
+	RecurringServiceDismissRecurringProcedure = "/arian.v1.RecurringService/DismissRecurring"
+)
+
+// RecurringServiceClient is a client for the arian.v1.RecurringService service.
+type RecurringServiceClient interface {
+	DetectRecurring(context.Context, *connect.Request[v1.DetectRecurringRequest]) (*connect.Response[v1.DetectRecurringResponse], error)
+	ListRecurring(context.Context, *connect.Request[v1.ListRecurringRequest]) (*connect.Response[v1.ListRecurringResponse], error)
+	ConfirmRecurring(context.Context, *connect.Request[v1.ConfirmRecurringRequest]) (*connect.Response[v1.ConfirmRecurringResponse], error)
+	DismissRecurring(context.Context, *connect.Request[v1.DismissRecurringRequest]) (*connect.Response[v1.DismissRecurringResponse], error)
+}
+
+// NewRecurringServiceClient constructs a client for the arian.v1.RecurringService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRecurringServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RecurringServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	recurringServiceMethods := v1.File_arian_v1_recurring_services_proto.Services().ByName("RecurringService").Methods()
+	return &recurringServiceClient{
+		detectRecurring: connect.NewClient[v1.DetectRecurringRequest, v1.DetectRecurringResponse](
+			httpClient,
+			baseURL+RecurringServiceDetectRecurringProcedure,
+			connect.WithSchema(recurringServiceMethods.ByName("DetectRecurring")),
+			connect.WithClientOptions(opts...),
+		),
+		listRecurring: connect.NewClient[v1.ListRecurringRequest, v1.ListRecurringResponse](
+			httpClient,
+			baseURL+RecurringServiceListRecurringProcedure,
+			connect.WithSchema(recurringServiceMethods.ByName("ListRecurring")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmRecurring: connect.NewClient[v1.ConfirmRecurringRequest, v1.ConfirmRecurringResponse](
+			httpClient,
+			baseURL+RecurringServiceConfirmRecurringProcedure,
+			connect.WithSchema(recurringServiceMethods.ByName("ConfirmRecurring")),
+			connect.WithClientOptions(opts...),
+		),
+		dismissRecurring: connect.NewClient[v1.DismissRecurringRequest, v1.DismissRecurringResponse](
+			httpClient,
+			baseURL+RecurringServiceDismissRecurringProcedure,
+			connect.WithSchema(recurringServiceMethods.ByName("DismissRecurring")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// recurringServiceClient implements RecurringServiceClient.
+type recurringServiceClient struct {
+	detectRecurring  *connect.Client[v1.DetectRecurringRequest, v1.DetectRecurringResponse]
+	listRecurring    *connect.Client[v1.ListRecurringRequest, v1.ListRecurringResponse]
+	confirmRecurring *connect.Client[v1.ConfirmRecurringRequest, v1.ConfirmRecurringResponse]
+	dismissRecurring *connect.Client[v1.DismissRecurringRequest, v1.DismissRecurringResponse]
+}
+
+// DetectRecurring calls arian.v1.RecurringService.DetectRecurring.
+func (c *recurringServiceClient) DetectRecurring(ctx context.Context, req *connect.Request[v1.DetectRecurringRequest]) (*connect.Response[v1.DetectRecurringResponse], error) {
+	return c.detectRecurring.CallUnary(ctx, req)
+}
+
+// ListRecurring calls arian.v1.RecurringService.ListRecurring.
+func (c *recurringServiceClient) ListRecurring(ctx context.Context, req *connect.Request[v1.ListRecurringRequest]) (*connect.Response[v1.ListRecurringResponse], error) {
+	return c.listRecurring.CallUnary(ctx, req)
+}
+
+// ConfirmRecurring calls arian.v1.RecurringService.ConfirmRecurring.
+func (c *recurringServiceClient) ConfirmRecurring(ctx context.Context, req *connect.Request[v1.ConfirmRecurringRequest]) (*connect.Response[v1.ConfirmRecurringResponse], error) {
+	return c.confirmRecurring.CallUnary(ctx, req)
+}
+
+// DismissRecurring calls arian.v1.RecurringService.DismissRecurring.
+func (c *recurringServiceClient) DismissRecurring(ctx context.Context, req *connect.Request[v1.DismissRecurringRequest]) (*connect.Response[v1.DismissRecurringResponse], error) {
+	return c.dismissRecurring.CallUnary(ctx, req)
+}
+
+// RecurringServiceHandler is an implementation of the arian.v1.RecurringService service.
+type RecurringServiceHandler interface {
+	DetectRecurring(context.Context, *connect.Request[v1.DetectRecurringRequest]) (*connect.Response[v1.DetectRecurringResponse], error)
+	ListRecurring(context.Context, *connect.Request[v1.ListRecurringRequest]) (*connect.Response[v1.ListRecurringResponse], error)
+	ConfirmRecurring(context.Context, *connect.Request[v1.ConfirmRecurringRequest]) (*connect.Response[v1.ConfirmRecurringResponse], error)
+	DismissRecurring(context.Context, *connect.Request[v1.DismissRecurringRequest]) (*connect.Response[v1.DismissRecurringResponse], error)
+}
+
+// NewRecurringServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRecurringServiceHandler(svc RecurringServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	recurringServiceMethods := v1.File_arian_v1_recurring_services_proto.Services().ByName("RecurringService").Methods()
+	recurringServiceDetectRecurringHandler := connect.NewUnaryHandler(
+		RecurringServiceDetectRecurringProcedure,
+		svc.DetectRecurring,
+		connect.WithSchema(recurringServiceMethods.ByName("DetectRecurring")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recurringServiceListRecurringHandler := connect.NewUnaryHandler(
+		RecurringServiceListRecurringProcedure,
+		svc.ListRecurring,
+		connect.WithSchema(recurringServiceMethods.ByName("ListRecurring")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recurringServiceConfirmRecurringHandler := connect.NewUnaryHandler(
+		RecurringServiceConfirmRecurringProcedure,
+		svc.ConfirmRecurring,
+		connect.WithSchema(recurringServiceMethods.ByName("ConfirmRecurring")),
+		connect.WithHandlerOptions(opts...),
+	)
+	recurringServiceDismissRecurringHandler := connect.NewUnaryHandler(
+		RecurringServiceDismissRecurringProcedure,
+		svc.DismissRecurring,
+		connect.WithSchema(recurringServiceMethods.ByName("DismissRecurring")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/arian.v1.RecurringService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RecurringServiceDetectRecurringProcedure:
+			recurringServiceDetectRecurringHandler.ServeHTTP(w, r)
+		case RecurringServiceListRecurringProcedure:
+			recurringServiceListRecurringHandler.ServeHTTP(w, r)
+		case RecurringServiceConfirmRecurringProcedure:
+			recurringServiceConfirmRecurringHandler.ServeHTTP(w, r)
+		case RecurringServiceDismissRecurringProcedure:
+			recurringServiceDismissRecurringHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRecurringServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRecurringServiceHandler struct{}
+
+func (UnimplementedRecurringServiceHandler) DetectRecurring(context.Context, *connect.Request[v1.DetectRecurringRequest]) (*connect.Response[v1.DetectRecurringResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RecurringService.DetectRecurring is not implemented"))
+}
+
+func (UnimplementedRecurringServiceHandler) ListRecurring(context.Context, *connect.Request[v1.ListRecurringRequest]) (*connect.Response[v1.ListRecurringResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RecurringService.ListRecurring is not implemented"))
+}
+
+func (UnimplementedRecurringServiceHandler) ConfirmRecurring(context.Context, *connect.Request[v1.ConfirmRecurringRequest]) (*connect.Response[v1.ConfirmRecurringResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RecurringService.ConfirmRecurring is not implemented"))
+}
+
+func (UnimplementedRecurringServiceHandler) DismissRecurring(context.Context, *connect.Request[v1.DismissRecurringRequest]) (*connect.Response[v1.DismissRecurringResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RecurringService.DismissRecurring is not implemented"))
+}
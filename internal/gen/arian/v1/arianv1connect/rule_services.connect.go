@@ -46,6 +46,17 @@ const (
 	// RuleServiceValidateRuleProcedure is the fully-qualified name of the RuleService's ValidateRule
 	// RPC.
 	RuleServiceValidateRuleProcedure = "/arian.v1.RuleService/ValidateRule"
+	// RuleServiceReorderRulesProcedure is the fully-qualified name of the RuleService's ReorderRules
+	// RPC.
+	RuleServiceReorderRulesProcedure = "/arian.v1.RuleService/ReorderRules"
+	// RuleServiceSetRuleActiveProcedure is the fully-qualified name of the RuleService's SetRuleActive
+	// RPC.
+	RuleServiceSetRuleActiveProcedure = "/arian.v1.RuleService/SetRuleActive"
+	// RuleServiceAnalyzeRulesProcedure is the fully-qualified name of the RuleService's AnalyzeRules
+	// RPC.
+	RuleServiceAnalyzeRulesProcedure = "/arian.v1.RuleService/AnalyzeRules"
+	// RuleServicePreviewRuleProcedure is the fully-qualified name of the RuleService's PreviewRule RPC.
+	RuleServicePreviewRuleProcedure = "/arian.v1.RuleService/PreviewRule"
 )
 
 // RuleServiceClient is a client for the arian.v1.RuleService service.
@@ -56,6 +67,10 @@ type RuleServiceClient interface {
 	UpdateRule(context.Context, *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error)
 	DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error)
 	ValidateRule(context.Context, *connect.Request[v1.ValidateRuleRequest]) (*connect.Response[v1.ValidateRuleResponse], error)
+	ReorderRules(context.Context, *connect.Request[v1.ReorderRulesRequest]) (*connect.Response[v1.ReorderRulesResponse], error)
+	SetRuleActive(context.Context, *connect.Request[v1.SetRuleActiveRequest]) (*connect.Response[v1.SetRuleActiveResponse], error)
+	AnalyzeRules(context.Context, *connect.Request[v1.AnalyzeRulesRequest]) (*connect.Response[v1.AnalyzeRulesResponse], error)
+	PreviewRule(context.Context, *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error)
 }
 
 // NewRuleServiceClient constructs a client for the arian.v1.RuleService service. By default, it
@@ -105,17 +120,45 @@ func NewRuleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(ruleServiceMethods.ByName("ValidateRule")),
 			connect.WithClientOptions(opts...),
 		),
+		reorderRules: connect.NewClient[v1.ReorderRulesRequest, v1.ReorderRulesResponse](
+			httpClient,
+			baseURL+RuleServiceReorderRulesProcedure,
+			connect.WithSchema(ruleServiceMethods.ByName("ReorderRules")),
+			connect.WithClientOptions(opts...),
+		),
+		setRuleActive: connect.NewClient[v1.SetRuleActiveRequest, v1.SetRuleActiveResponse](
+			httpClient,
+			baseURL+RuleServiceSetRuleActiveProcedure,
+			connect.WithSchema(ruleServiceMethods.ByName("SetRuleActive")),
+			connect.WithClientOptions(opts...),
+		),
+		analyzeRules: connect.NewClient[v1.AnalyzeRulesRequest, v1.AnalyzeRulesResponse](
+			httpClient,
+			baseURL+RuleServiceAnalyzeRulesProcedure,
+			connect.WithSchema(ruleServiceMethods.ByName("AnalyzeRules")),
+			connect.WithClientOptions(opts...),
+		),
+		previewRule: connect.NewClient[v1.PreviewRuleRequest, v1.PreviewRuleResponse](
+			httpClient,
+			baseURL+RuleServicePreviewRuleProcedure,
+			connect.WithSchema(ruleServiceMethods.ByName("PreviewRule")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // ruleServiceClient implements RuleServiceClient.
 type ruleServiceClient struct {
-	listRules    *connect.Client[v1.ListRulesRequest, v1.ListRulesResponse]
-	getRule      *connect.Client[v1.GetRuleRequest, v1.GetRuleResponse]
-	createRule   *connect.Client[v1.CreateRuleRequest, v1.CreateRuleResponse]
-	updateRule   *connect.Client[v1.UpdateRuleRequest, v1.UpdateRuleResponse]
-	deleteRule   *connect.Client[v1.DeleteRuleRequest, v1.DeleteRuleResponse]
-	validateRule *connect.Client[v1.ValidateRuleRequest, v1.ValidateRuleResponse]
+	listRules     *connect.Client[v1.ListRulesRequest, v1.ListRulesResponse]
+	getRule       *connect.Client[v1.GetRuleRequest, v1.GetRuleResponse]
+	createRule    *connect.Client[v1.CreateRuleRequest, v1.CreateRuleResponse]
+	updateRule    *connect.Client[v1.UpdateRuleRequest, v1.UpdateRuleResponse]
+	deleteRule    *connect.Client[v1.DeleteRuleRequest, v1.DeleteRuleResponse]
+	validateRule  *connect.Client[v1.ValidateRuleRequest, v1.ValidateRuleResponse]
+	reorderRules  *connect.Client[v1.ReorderRulesRequest, v1.ReorderRulesResponse]
+	setRuleActive *connect.Client[v1.SetRuleActiveRequest, v1.SetRuleActiveResponse]
+	analyzeRules  *connect.Client[v1.AnalyzeRulesRequest, v1.AnalyzeRulesResponse]
+	previewRule   *connect.Client[v1.PreviewRuleRequest, v1.PreviewRuleResponse]
 }
 
 // ListRules calls arian.v1.RuleService.ListRules.
@@ -148,6 +191,26 @@ func (c *ruleServiceClient) ValidateRule(ctx context.Context, req *connect.Reque
 	return c.validateRule.CallUnary(ctx, req)
 }
 
+// ReorderRules calls arian.v1.RuleService.ReorderRules.
+func (c *ruleServiceClient) ReorderRules(ctx context.Context, req *connect.Request[v1.ReorderRulesRequest]) (*connect.Response[v1.ReorderRulesResponse], error) {
+	return c.reorderRules.CallUnary(ctx, req)
+}
+
+// SetRuleActive calls arian.v1.RuleService.SetRuleActive.
+func (c *ruleServiceClient) SetRuleActive(ctx context.Context, req *connect.Request[v1.SetRuleActiveRequest]) (*connect.Response[v1.SetRuleActiveResponse], error) {
+	return c.setRuleActive.CallUnary(ctx, req)
+}
+
+// AnalyzeRules calls arian.v1.RuleService.AnalyzeRules.
+func (c *ruleServiceClient) AnalyzeRules(ctx context.Context, req *connect.Request[v1.AnalyzeRulesRequest]) (*connect.Response[v1.AnalyzeRulesResponse], error) {
+	return c.analyzeRules.CallUnary(ctx, req)
+}
+
+// PreviewRule calls arian.v1.RuleService.PreviewRule.
+func (c *ruleServiceClient) PreviewRule(ctx context.Context, req *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error) {
+	return c.previewRule.CallUnary(ctx, req)
+}
+
 // RuleServiceHandler is an implementation of the arian.v1.RuleService service.
 type RuleServiceHandler interface {
 	ListRules(context.Context, *connect.Request[v1.ListRulesRequest]) (*connect.Response[v1.ListRulesResponse], error)
@@ -156,6 +219,10 @@ type RuleServiceHandler interface {
 	UpdateRule(context.Context, *connect.Request[v1.UpdateRuleRequest]) (*connect.Response[v1.UpdateRuleResponse], error)
 	DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.DeleteRuleResponse], error)
 	ValidateRule(context.Context, *connect.Request[v1.ValidateRuleRequest]) (*connect.Response[v1.ValidateRuleResponse], error)
+	ReorderRules(context.Context, *connect.Request[v1.ReorderRulesRequest]) (*connect.Response[v1.ReorderRulesResponse], error)
+	SetRuleActive(context.Context, *connect.Request[v1.SetRuleActiveRequest]) (*connect.Response[v1.SetRuleActiveResponse], error)
+	AnalyzeRules(context.Context, *connect.Request[v1.AnalyzeRulesRequest]) (*connect.Response[v1.AnalyzeRulesResponse], error)
+	PreviewRule(context.Context, *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error)
 }
 
 // NewRuleServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -201,6 +268,30 @@ func NewRuleServiceHandler(svc RuleServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(ruleServiceMethods.ByName("ValidateRule")),
 		connect.WithHandlerOptions(opts...),
 	)
+	ruleServiceReorderRulesHandler := connect.NewUnaryHandler(
+		RuleServiceReorderRulesProcedure,
+		svc.ReorderRules,
+		connect.WithSchema(ruleServiceMethods.ByName("ReorderRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ruleServiceSetRuleActiveHandler := connect.NewUnaryHandler(
+		RuleServiceSetRuleActiveProcedure,
+		svc.SetRuleActive,
+		connect.WithSchema(ruleServiceMethods.ByName("SetRuleActive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ruleServiceAnalyzeRulesHandler := connect.NewUnaryHandler(
+		RuleServiceAnalyzeRulesProcedure,
+		svc.AnalyzeRules,
+		connect.WithSchema(ruleServiceMethods.ByName("AnalyzeRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	ruleServicePreviewRuleHandler := connect.NewUnaryHandler(
+		RuleServicePreviewRuleProcedure,
+		svc.PreviewRule,
+		connect.WithSchema(ruleServiceMethods.ByName("PreviewRule")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/arian.v1.RuleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RuleServiceListRulesProcedure:
@@ -215,6 +306,14 @@ func NewRuleServiceHandler(svc RuleServiceHandler, opts ...connect.HandlerOption
 			ruleServiceDeleteRuleHandler.ServeHTTP(w, r)
 		case RuleServiceValidateRuleProcedure:
 			ruleServiceValidateRuleHandler.ServeHTTP(w, r)
+		case RuleServiceReorderRulesProcedure:
+			ruleServiceReorderRulesHandler.ServeHTTP(w, r)
+		case RuleServiceSetRuleActiveProcedure:
+			ruleServiceSetRuleActiveHandler.ServeHTTP(w, r)
+		case RuleServiceAnalyzeRulesProcedure:
+			ruleServiceAnalyzeRulesHandler.ServeHTTP(w, r)
+		case RuleServicePreviewRuleProcedure:
+			ruleServicePreviewRuleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -247,3 +346,19 @@ func (UnimplementedRuleServiceHandler) DeleteRule(context.Context, *connect.Requ
 func (UnimplementedRuleServiceHandler) ValidateRule(context.Context, *connect.Request[v1.ValidateRuleRequest]) (*connect.Response[v1.ValidateRuleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RuleService.ValidateRule is not implemented"))
 }
+
+func (UnimplementedRuleServiceHandler) ReorderRules(context.Context, *connect.Request[v1.ReorderRulesRequest]) (*connect.Response[v1.ReorderRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RuleService.ReorderRules is not implemented"))
+}
+
+func (UnimplementedRuleServiceHandler) SetRuleActive(context.Context, *connect.Request[v1.SetRuleActiveRequest]) (*connect.Response[v1.SetRuleActiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RuleService.SetRuleActive is not implemented"))
+}
+
+func (UnimplementedRuleServiceHandler) AnalyzeRules(context.Context, *connect.Request[v1.AnalyzeRulesRequest]) (*connect.Response[v1.AnalyzeRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RuleService.AnalyzeRules is not implemented"))
+}
+
+func (UnimplementedRuleServiceHandler) PreviewRule(context.Context, *connect.Request[v1.PreviewRuleRequest]) (*connect.Response[v1.PreviewRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.RuleService.PreviewRule is not implemented"))
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: arian/v1/shared_expense_services.proto
+
+package arianv1connect
+
+import (
+	v1 "ariand/internal/gen/arian/v1"
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SharedExpenseServiceName is the fully-qualified name of the SharedExpenseService service.
+	SharedExpenseServiceName = "arian.v1.SharedExpenseService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SharedExpenseServiceSetSharedExpenseProcedure is the fully-qualified name of the
+	// SharedExpenseService's SetSharedExpense RPC.
+	SharedExpenseServiceSetSharedExpenseProcedure = "/arian.v1.SharedExpenseService/SetSharedExpense"
+	// SharedExpenseServiceClearSharedExpenseProcedure is the fully-qualified name of the
+	// SharedExpenseService's ClearSharedExpense RPC.
+	SharedExpenseServiceClearSharedExpenseProcedure = "/arian.v1.SharedExpenseService/ClearSharedExpense"
+	// SharedExpenseServiceListSharedBalancesProcedure is the fully-qualified name of the
+	// SharedExpenseService's ListSharedBalances RPC.
+	SharedExpenseServiceListSharedBalancesProcedure = "/arian.v1.SharedExpenseService/ListSharedBalances"
+	// SharedExpenseServiceSettleUpProcedure is the fully-qualified name of the SharedExpenseService's
+	// SettleUp RPC.
+	SharedExpenseServiceSettleUpProcedure = "/arian.v1.SharedExpenseService/SettleUp"
+	// SharedExpenseServiceListSettlementsProcedure is the fully-qualified name of the
+	// SharedExpenseService's ListSettlements RPC.
+	SharedExpenseServiceListSettlementsProcedure = "/arian.v1.SharedExpenseService/ListSettlements"
+)
+
+// SharedExpenseServiceClient is a client for the arian.v1.SharedExpenseService service.
+type SharedExpenseServiceClient interface {
+	SetSharedExpense(context.Context, *connect.Request[v1.SetSharedExpenseRequest]) (*connect.Response[v1.SetSharedExpenseResponse], error)
+	ClearSharedExpense(context.Context, *connect.Request[v1.ClearSharedExpenseRequest]) (*connect.Response[v1.ClearSharedExpenseResponse], error)
+	ListSharedBalances(context.Context, *connect.Request[v1.ListSharedBalancesRequest]) (*connect.Response[v1.ListSharedBalancesResponse], error)
+	SettleUp(context.Context, *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error)
+	ListSettlements(context.Context, *connect.Request[v1.ListSettlementsRequest]) (*connect.Response[v1.ListSettlementsResponse], error)
+}
+
+// NewSharedExpenseServiceClient constructs a client for the arian.v1.SharedExpenseService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSharedExpenseServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SharedExpenseServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	sharedExpenseServiceMethods := v1.File_arian_v1_shared_expense_services_proto.Services().ByName("SharedExpenseService").Methods()
+	return &sharedExpenseServiceClient{
+		setSharedExpense: connect.NewClient[v1.SetSharedExpenseRequest, v1.SetSharedExpenseResponse](
+			httpClient,
+			baseURL+SharedExpenseServiceSetSharedExpenseProcedure,
+			connect.WithSchema(sharedExpenseServiceMethods.ByName("SetSharedExpense")),
+			connect.WithClientOptions(opts...),
+		),
+		clearSharedExpense: connect.NewClient[v1.ClearSharedExpenseRequest, v1.ClearSharedExpenseResponse](
+			httpClient,
+			baseURL+SharedExpenseServiceClearSharedExpenseProcedure,
+			connect.WithSchema(sharedExpenseServiceMethods.ByName("ClearSharedExpense")),
+			connect.WithClientOptions(opts...),
+		),
+		listSharedBalances: connect.NewClient[v1.ListSharedBalancesRequest, v1.ListSharedBalancesResponse](
+			httpClient,
+			baseURL+SharedExpenseServiceListSharedBalancesProcedure,
+			connect.WithSchema(sharedExpenseServiceMethods.ByName("ListSharedBalances")),
+			connect.WithClientOptions(opts...),
+		),
+		settleUp: connect.NewClient[v1.SettleUpRequest, v1.SettleUpResponse](
+			httpClient,
+			baseURL+SharedExpenseServiceSettleUpProcedure,
+			connect.WithSchema(sharedExpenseServiceMethods.ByName("SettleUp")),
+			connect.WithClientOptions(opts...),
+		),
+		listSettlements: connect.NewClient[v1.ListSettlementsRequest, v1.ListSettlementsResponse](
+			httpClient,
+			baseURL+SharedExpenseServiceListSettlementsProcedure,
+			connect.WithSchema(sharedExpenseServiceMethods.ByName("ListSettlements")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// sharedExpenseServiceClient implements SharedExpenseServiceClient.
+type sharedExpenseServiceClient struct {
+	setSharedExpense   *connect.Client[v1.SetSharedExpenseRequest, v1.SetSharedExpenseResponse]
+	clearSharedExpense *connect.Client[v1.ClearSharedExpenseRequest, v1.ClearSharedExpenseResponse]
+	listSharedBalances *connect.Client[v1.ListSharedBalancesRequest, v1.ListSharedBalancesResponse]
+	settleUp           *connect.Client[v1.SettleUpRequest, v1.SettleUpResponse]
+	listSettlements    *connect.Client[v1.ListSettlementsRequest, v1.ListSettlementsResponse]
+}
+
+// SetSharedExpense calls arian.v1.SharedExpenseService.SetSharedExpense.
+func (c *sharedExpenseServiceClient) SetSharedExpense(ctx context.Context, req *connect.Request[v1.SetSharedExpenseRequest]) (*connect.Response[v1.SetSharedExpenseResponse], error) {
+	return c.setSharedExpense.CallUnary(ctx, req)
+}
+
+// ClearSharedExpense calls arian.v1.SharedExpenseService.ClearSharedExpense.
+func (c *sharedExpenseServiceClient) ClearSharedExpense(ctx context.Context, req *connect.Request[v1.ClearSharedExpenseRequest]) (*connect.Response[v1.ClearSharedExpenseResponse], error) {
+	return c.clearSharedExpense.CallUnary(ctx, req)
+}
+
+// ListSharedBalances calls arian.v1.SharedExpenseService.ListSharedBalances.
+func (c *sharedExpenseServiceClient) ListSharedBalances(ctx context.Context, req *connect.Request[v1.ListSharedBalancesRequest]) (*connect.Response[v1.ListSharedBalancesResponse], error) {
+	return c.listSharedBalances.CallUnary(ctx, req)
+}
+
+// SettleUp calls arian.v1.SharedExpenseService.SettleUp.
+func (c *sharedExpenseServiceClient) SettleUp(ctx context.Context, req *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error) {
+	return c.settleUp.CallUnary(ctx, req)
+}
+
+// ListSettlements calls arian.v1.SharedExpenseService.ListSettlements.
+func (c *sharedExpenseServiceClient) ListSettlements(ctx context.Context, req *connect.Request[v1.ListSettlementsRequest]) (*connect.Response[v1.ListSettlementsResponse], error) {
+	return c.listSettlements.CallUnary(ctx, req)
+}
+
+// SharedExpenseServiceHandler is an implementation of the arian.v1.SharedExpenseService service.
+type SharedExpenseServiceHandler interface {
+	SetSharedExpense(context.Context, *connect.Request[v1.SetSharedExpenseRequest]) (*connect.Response[v1.SetSharedExpenseResponse], error)
+	ClearSharedExpense(context.Context, *connect.Request[v1.ClearSharedExpenseRequest]) (*connect.Response[v1.ClearSharedExpenseResponse], error)
+	ListSharedBalances(context.Context, *connect.Request[v1.ListSharedBalancesRequest]) (*connect.Response[v1.ListSharedBalancesResponse], error)
+	SettleUp(context.Context, *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error)
+	ListSettlements(context.Context, *connect.Request[v1.ListSettlementsRequest]) (*connect.Response[v1.ListSettlementsResponse], error)
+}
+
+// NewSharedExpenseServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSharedExpenseServiceHandler(svc SharedExpenseServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	sharedExpenseServiceMethods := v1.File_arian_v1_shared_expense_services_proto.Services().ByName("SharedExpenseService").Methods()
+	sharedExpenseServiceSetSharedExpenseHandler := connect.NewUnaryHandler(
+		SharedExpenseServiceSetSharedExpenseProcedure,
+		svc.SetSharedExpense,
+		connect.WithSchema(sharedExpenseServiceMethods.ByName("SetSharedExpense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sharedExpenseServiceClearSharedExpenseHandler := connect.NewUnaryHandler(
+		SharedExpenseServiceClearSharedExpenseProcedure,
+		svc.ClearSharedExpense,
+		connect.WithSchema(sharedExpenseServiceMethods.ByName("ClearSharedExpense")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sharedExpenseServiceListSharedBalancesHandler := connect.NewUnaryHandler(
+		SharedExpenseServiceListSharedBalancesProcedure,
+		svc.ListSharedBalances,
+		connect.WithSchema(sharedExpenseServiceMethods.ByName("ListSharedBalances")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sharedExpenseServiceSettleUpHandler := connect.NewUnaryHandler(
+		SharedExpenseServiceSettleUpProcedure,
+		svc.SettleUp,
+		connect.WithSchema(sharedExpenseServiceMethods.ByName("SettleUp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sharedExpenseServiceListSettlementsHandler := connect.NewUnaryHandler(
+		SharedExpenseServiceListSettlementsProcedure,
+		svc.ListSettlements,
+		connect.WithSchema(sharedExpenseServiceMethods.ByName("ListSettlements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/arian.v1.SharedExpenseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SharedExpenseServiceSetSharedExpenseProcedure:
+			sharedExpenseServiceSetSharedExpenseHandler.ServeHTTP(w, r)
+		case SharedExpenseServiceClearSharedExpenseProcedure:
+			sharedExpenseServiceClearSharedExpenseHandler.ServeHTTP(w, r)
+		case SharedExpenseServiceListSharedBalancesProcedure:
+			sharedExpenseServiceListSharedBalancesHandler.ServeHTTP(w, r)
+		case SharedExpenseServiceSettleUpProcedure:
+			sharedExpenseServiceSettleUpHandler.ServeHTTP(w, r)
+		case SharedExpenseServiceListSettlementsProcedure:
+			sharedExpenseServiceListSettlementsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSharedExpenseServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSharedExpenseServiceHandler struct{}
+
+func (UnimplementedSharedExpenseServiceHandler) SetSharedExpense(context.Context, *connect.Request[v1.SetSharedExpenseRequest]) (*connect.Response[v1.SetSharedExpenseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.SharedExpenseService.SetSharedExpense is not implemented"))
+}
+
+func (UnimplementedSharedExpenseServiceHandler) ClearSharedExpense(context.Context, *connect.Request[v1.ClearSharedExpenseRequest]) (*connect.Response[v1.ClearSharedExpenseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.SharedExpenseService.ClearSharedExpense is not implemented"))
+}
+
+func (UnimplementedSharedExpenseServiceHandler) ListSharedBalances(context.Context, *connect.Request[v1.ListSharedBalancesRequest]) (*connect.Response[v1.ListSharedBalancesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.SharedExpenseService.ListSharedBalances is not implemented"))
+}
+
+func (UnimplementedSharedExpenseServiceHandler) SettleUp(context.Context, *connect.Request[v1.SettleUpRequest]) (*connect.Response[v1.SettleUpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.SharedExpenseService.SettleUp is not implemented"))
+}
+
+func (UnimplementedSharedExpenseServiceHandler) ListSettlements(context.Context, *connect.Request[v1.ListSettlementsRequest]) (*connect.Response[v1.ListSettlementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.SharedExpenseService.ListSettlements is not implemented"))
+}
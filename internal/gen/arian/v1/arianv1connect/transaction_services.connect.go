@@ -51,6 +51,66 @@ const (
 	// TransactionServiceCategorizeTransactionsProcedure is the fully-qualified name of the
 	// TransactionService's CategorizeTransactions RPC.
 	TransactionServiceCategorizeTransactionsProcedure = "/arian.v1.TransactionService/CategorizeTransactions"
+	// TransactionServiceStreamTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's StreamTransactions RPC.
+	TransactionServiceStreamTransactionsProcedure = "/arian.v1.TransactionService/StreamTransactions"
+	// TransactionServiceAggregateTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's AggregateTransactions RPC.
+	TransactionServiceAggregateTransactionsProcedure = "/arian.v1.TransactionService/AggregateTransactions"
+	// TransactionServiceUpdateTransactionsByFilterProcedure is the fully-qualified name of the
+	// TransactionService's UpdateTransactionsByFilter RPC.
+	TransactionServiceUpdateTransactionsByFilterProcedure = "/arian.v1.TransactionService/UpdateTransactionsByFilter"
+	// TransactionServiceDeleteTransactionsByFilterProcedure is the fully-qualified name of the
+	// TransactionService's DeleteTransactionsByFilter RPC.
+	TransactionServiceDeleteTransactionsByFilterProcedure = "/arian.v1.TransactionService/DeleteTransactionsByFilter"
+	// TransactionServiceImportStatementProcedure is the fully-qualified name of the
+	// TransactionService's ImportStatement RPC.
+	TransactionServiceImportStatementProcedure = "/arian.v1.TransactionService/ImportStatement"
+	// TransactionServiceListImportProfilesProcedure is the fully-qualified name of the
+	// TransactionService's ListImportProfiles RPC.
+	TransactionServiceListImportProfilesProcedure = "/arian.v1.TransactionService/ListImportProfiles"
+	// TransactionServiceSaveImportProfileProcedure is the fully-qualified name of the
+	// TransactionService's SaveImportProfile RPC.
+	TransactionServiceSaveImportProfileProcedure = "/arian.v1.TransactionService/SaveImportProfile"
+	// TransactionServiceDeleteImportProfileProcedure is the fully-qualified name of the
+	// TransactionService's DeleteImportProfile RPC.
+	TransactionServiceDeleteImportProfileProcedure = "/arian.v1.TransactionService/DeleteImportProfile"
+	// TransactionServiceSetTransactionSplitsProcedure is the fully-qualified name of the
+	// TransactionService's SetTransactionSplits RPC.
+	TransactionServiceSetTransactionSplitsProcedure = "/arian.v1.TransactionService/SetTransactionSplits"
+	// TransactionServiceLinkTransferProcedure is the fully-qualified name of the TransactionService's
+	// LinkTransfer RPC.
+	TransactionServiceLinkTransferProcedure = "/arian.v1.TransactionService/LinkTransfer"
+	// TransactionServiceUnlinkTransferProcedure is the fully-qualified name of the TransactionService's
+	// UnlinkTransfer RPC.
+	TransactionServiceUnlinkTransferProcedure = "/arian.v1.TransactionService/UnlinkTransfer"
+	// TransactionServiceDetectTransfersProcedure is the fully-qualified name of the
+	// TransactionService's DetectTransfers RPC.
+	TransactionServiceDetectTransfersProcedure = "/arian.v1.TransactionService/DetectTransfers"
+	// TransactionServiceLinkRefundProcedure is the fully-qualified name of the TransactionService's
+	// LinkRefund RPC.
+	TransactionServiceLinkRefundProcedure = "/arian.v1.TransactionService/LinkRefund"
+	// TransactionServiceUnlinkRefundProcedure is the fully-qualified name of the TransactionService's
+	// UnlinkRefund RPC.
+	TransactionServiceUnlinkRefundProcedure = "/arian.v1.TransactionService/UnlinkRefund"
+	// TransactionServiceDetectRefundsProcedure is the fully-qualified name of the TransactionService's
+	// DetectRefunds RPC.
+	TransactionServiceDetectRefundsProcedure = "/arian.v1.TransactionService/DetectRefunds"
+	// TransactionServiceSearchTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's SearchTransactions RPC.
+	TransactionServiceSearchTransactionsProcedure = "/arian.v1.TransactionService/SearchTransactions"
+	// TransactionServiceGetTransactionHistoryProcedure is the fully-qualified name of the
+	// TransactionService's GetTransactionHistory RPC.
+	TransactionServiceGetTransactionHistoryProcedure = "/arian.v1.TransactionService/GetTransactionHistory"
+	// TransactionServiceListDeletedTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's ListDeletedTransactions RPC.
+	TransactionServiceListDeletedTransactionsProcedure = "/arian.v1.TransactionService/ListDeletedTransactions"
+	// TransactionServiceRestoreTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's RestoreTransactions RPC.
+	TransactionServiceRestoreTransactionsProcedure = "/arian.v1.TransactionService/RestoreTransactions"
+	// TransactionServicePurgeTransactionsProcedure is the fully-qualified name of the
+	// TransactionService's PurgeTransactions RPC.
+	TransactionServicePurgeTransactionsProcedure = "/arian.v1.TransactionService/PurgeTransactions"
 )
 
 // TransactionServiceClient is a client for the arian.v1.TransactionService service.
@@ -61,6 +121,28 @@ type TransactionServiceClient interface {
 	UpdateTransaction(context.Context, *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error)
 	DeleteTransaction(context.Context, *connect.Request[v1.DeleteTransactionRequest]) (*connect.Response[v1.DeleteTransactionResponse], error)
 	CategorizeTransactions(context.Context, *connect.Request[v1.CategorizeTransactionsRequest]) (*connect.Response[v1.CategorizeTransactionsResponse], error)
+	StreamTransactions(context.Context, *connect.Request[v1.StreamTransactionsRequest]) (*connect.ServerStreamForClient[v1.StreamTransactionsResponse], error)
+	AggregateTransactions(context.Context, *connect.Request[v1.AggregateTransactionsRequest]) (*connect.Response[v1.AggregateTransactionsResponse], error)
+	UpdateTransactionsByFilter(context.Context, *connect.Request[v1.UpdateTransactionsByFilterRequest]) (*connect.Response[v1.UpdateTransactionsByFilterResponse], error)
+	// moves the matching transactions to the trash
+	DeleteTransactionsByFilter(context.Context, *connect.Request[v1.DeleteTransactionsByFilterRequest]) (*connect.Response[v1.DeleteTransactionsByFilterResponse], error)
+	ImportStatement(context.Context, *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error)
+	ListImportProfiles(context.Context, *connect.Request[v1.ListImportProfilesRequest]) (*connect.Response[v1.ListImportProfilesResponse], error)
+	SaveImportProfile(context.Context, *connect.Request[v1.SaveImportProfileRequest]) (*connect.Response[v1.SaveImportProfileResponse], error)
+	DeleteImportProfile(context.Context, *connect.Request[v1.DeleteImportProfileRequest]) (*connect.Response[v1.DeleteImportProfileResponse], error)
+	SetTransactionSplits(context.Context, *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error)
+	LinkTransfer(context.Context, *connect.Request[v1.LinkTransferRequest]) (*connect.Response[v1.LinkTransferResponse], error)
+	UnlinkTransfer(context.Context, *connect.Request[v1.UnlinkTransferRequest]) (*connect.Response[v1.UnlinkTransferResponse], error)
+	DetectTransfers(context.Context, *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error)
+	LinkRefund(context.Context, *connect.Request[v1.LinkRefundRequest]) (*connect.Response[v1.LinkRefundResponse], error)
+	UnlinkRefund(context.Context, *connect.Request[v1.UnlinkRefundRequest]) (*connect.Response[v1.UnlinkRefundResponse], error)
+	DetectRefunds(context.Context, *connect.Request[v1.DetectRefundsRequest]) (*connect.Response[v1.DetectRefundsResponse], error)
+	SearchTransactions(context.Context, *connect.Request[v1.SearchTransactionsRequest]) (*connect.Response[v1.SearchTransactionsResponse], error)
+	GetTransactionHistory(context.Context, *connect.Request[v1.GetTransactionHistoryRequest]) (*connect.Response[v1.GetTransactionHistoryResponse], error)
+	ListDeletedTransactions(context.Context, *connect.Request[v1.ListDeletedTransactionsRequest]) (*connect.Response[v1.ListDeletedTransactionsResponse], error)
+	RestoreTransactions(context.Context, *connect.Request[v1.RestoreTransactionsRequest]) (*connect.Response[v1.RestoreTransactionsResponse], error)
+	// permanently removes transactions that are already in the trash
+	PurgeTransactions(context.Context, *connect.Request[v1.PurgeTransactionsRequest]) (*connect.Response[v1.PurgeTransactionsResponse], error)
 }
 
 // NewTransactionServiceClient constructs a client for the arian.v1.TransactionService service. By
@@ -110,17 +192,157 @@ func NewTransactionServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(transactionServiceMethods.ByName("CategorizeTransactions")),
 			connect.WithClientOptions(opts...),
 		),
+		streamTransactions: connect.NewClient[v1.StreamTransactionsRequest, v1.StreamTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceStreamTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("StreamTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		aggregateTransactions: connect.NewClient[v1.AggregateTransactionsRequest, v1.AggregateTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceAggregateTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("AggregateTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTransactionsByFilter: connect.NewClient[v1.UpdateTransactionsByFilterRequest, v1.UpdateTransactionsByFilterResponse](
+			httpClient,
+			baseURL+TransactionServiceUpdateTransactionsByFilterProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UpdateTransactionsByFilter")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTransactionsByFilter: connect.NewClient[v1.DeleteTransactionsByFilterRequest, v1.DeleteTransactionsByFilterResponse](
+			httpClient,
+			baseURL+TransactionServiceDeleteTransactionsByFilterProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DeleteTransactionsByFilter")),
+			connect.WithClientOptions(opts...),
+		),
+		importStatement: connect.NewClient[v1.ImportStatementRequest, v1.ImportStatementResponse](
+			httpClient,
+			baseURL+TransactionServiceImportStatementProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ImportStatement")),
+			connect.WithClientOptions(opts...),
+		),
+		listImportProfiles: connect.NewClient[v1.ListImportProfilesRequest, v1.ListImportProfilesResponse](
+			httpClient,
+			baseURL+TransactionServiceListImportProfilesProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListImportProfiles")),
+			connect.WithClientOptions(opts...),
+		),
+		saveImportProfile: connect.NewClient[v1.SaveImportProfileRequest, v1.SaveImportProfileResponse](
+			httpClient,
+			baseURL+TransactionServiceSaveImportProfileProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SaveImportProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteImportProfile: connect.NewClient[v1.DeleteImportProfileRequest, v1.DeleteImportProfileResponse](
+			httpClient,
+			baseURL+TransactionServiceDeleteImportProfileProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DeleteImportProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		setTransactionSplits: connect.NewClient[v1.SetTransactionSplitsRequest, v1.SetTransactionSplitsResponse](
+			httpClient,
+			baseURL+TransactionServiceSetTransactionSplitsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SetTransactionSplits")),
+			connect.WithClientOptions(opts...),
+		),
+		linkTransfer: connect.NewClient[v1.LinkTransferRequest, v1.LinkTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceLinkTransferProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("LinkTransfer")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkTransfer: connect.NewClient[v1.UnlinkTransferRequest, v1.UnlinkTransferResponse](
+			httpClient,
+			baseURL+TransactionServiceUnlinkTransferProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UnlinkTransfer")),
+			connect.WithClientOptions(opts...),
+		),
+		detectTransfers: connect.NewClient[v1.DetectTransfersRequest, v1.DetectTransfersResponse](
+			httpClient,
+			baseURL+TransactionServiceDetectTransfersProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DetectTransfers")),
+			connect.WithClientOptions(opts...),
+		),
+		linkRefund: connect.NewClient[v1.LinkRefundRequest, v1.LinkRefundResponse](
+			httpClient,
+			baseURL+TransactionServiceLinkRefundProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("LinkRefund")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkRefund: connect.NewClient[v1.UnlinkRefundRequest, v1.UnlinkRefundResponse](
+			httpClient,
+			baseURL+TransactionServiceUnlinkRefundProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("UnlinkRefund")),
+			connect.WithClientOptions(opts...),
+		),
+		detectRefunds: connect.NewClient[v1.DetectRefundsRequest, v1.DetectRefundsResponse](
+			httpClient,
+			baseURL+TransactionServiceDetectRefundsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("DetectRefunds")),
+			connect.WithClientOptions(opts...),
+		),
+		searchTransactions: connect.NewClient[v1.SearchTransactionsRequest, v1.SearchTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceSearchTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("SearchTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		getTransactionHistory: connect.NewClient[v1.GetTransactionHistoryRequest, v1.GetTransactionHistoryResponse](
+			httpClient,
+			baseURL+TransactionServiceGetTransactionHistoryProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("GetTransactionHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedTransactions: connect.NewClient[v1.ListDeletedTransactionsRequest, v1.ListDeletedTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceListDeletedTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("ListDeletedTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreTransactions: connect.NewClient[v1.RestoreTransactionsRequest, v1.RestoreTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServiceRestoreTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("RestoreTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeTransactions: connect.NewClient[v1.PurgeTransactionsRequest, v1.PurgeTransactionsResponse](
+			httpClient,
+			baseURL+TransactionServicePurgeTransactionsProcedure,
+			connect.WithSchema(transactionServiceMethods.ByName("PurgeTransactions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // transactionServiceClient implements TransactionServiceClient.
 type transactionServiceClient struct {
-	listTransactions       *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
-	getTransaction         *connect.Client[v1.GetTransactionRequest, v1.GetTransactionResponse]
-	createTransaction      *connect.Client[v1.CreateTransactionRequest, v1.CreateTransactionResponse]
-	updateTransaction      *connect.Client[v1.UpdateTransactionRequest, v1.UpdateTransactionResponse]
-	deleteTransaction      *connect.Client[v1.DeleteTransactionRequest, v1.DeleteTransactionResponse]
-	categorizeTransactions *connect.Client[v1.CategorizeTransactionsRequest, v1.CategorizeTransactionsResponse]
+	listTransactions           *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
+	getTransaction             *connect.Client[v1.GetTransactionRequest, v1.GetTransactionResponse]
+	createTransaction          *connect.Client[v1.CreateTransactionRequest, v1.CreateTransactionResponse]
+	updateTransaction          *connect.Client[v1.UpdateTransactionRequest, v1.UpdateTransactionResponse]
+	deleteTransaction          *connect.Client[v1.DeleteTransactionRequest, v1.DeleteTransactionResponse]
+	categorizeTransactions     *connect.Client[v1.CategorizeTransactionsRequest, v1.CategorizeTransactionsResponse]
+	streamTransactions         *connect.Client[v1.StreamTransactionsRequest, v1.StreamTransactionsResponse]
+	aggregateTransactions      *connect.Client[v1.AggregateTransactionsRequest, v1.AggregateTransactionsResponse]
+	updateTransactionsByFilter *connect.Client[v1.UpdateTransactionsByFilterRequest, v1.UpdateTransactionsByFilterResponse]
+	deleteTransactionsByFilter *connect.Client[v1.DeleteTransactionsByFilterRequest, v1.DeleteTransactionsByFilterResponse]
+	importStatement            *connect.Client[v1.ImportStatementRequest, v1.ImportStatementResponse]
+	listImportProfiles         *connect.Client[v1.ListImportProfilesRequest, v1.ListImportProfilesResponse]
+	saveImportProfile          *connect.Client[v1.SaveImportProfileRequest, v1.SaveImportProfileResponse]
+	deleteImportProfile        *connect.Client[v1.DeleteImportProfileRequest, v1.DeleteImportProfileResponse]
+	setTransactionSplits       *connect.Client[v1.SetTransactionSplitsRequest, v1.SetTransactionSplitsResponse]
+	linkTransfer               *connect.Client[v1.LinkTransferRequest, v1.LinkTransferResponse]
+	unlinkTransfer             *connect.Client[v1.UnlinkTransferRequest, v1.UnlinkTransferResponse]
+	detectTransfers            *connect.Client[v1.DetectTransfersRequest, v1.DetectTransfersResponse]
+	linkRefund                 *connect.Client[v1.LinkRefundRequest, v1.LinkRefundResponse]
+	unlinkRefund               *connect.Client[v1.UnlinkRefundRequest, v1.UnlinkRefundResponse]
+	detectRefunds              *connect.Client[v1.DetectRefundsRequest, v1.DetectRefundsResponse]
+	searchTransactions         *connect.Client[v1.SearchTransactionsRequest, v1.SearchTransactionsResponse]
+	getTransactionHistory      *connect.Client[v1.GetTransactionHistoryRequest, v1.GetTransactionHistoryResponse]
+	listDeletedTransactions    *connect.Client[v1.ListDeletedTransactionsRequest, v1.ListDeletedTransactionsResponse]
+	restoreTransactions        *connect.Client[v1.RestoreTransactionsRequest, v1.RestoreTransactionsResponse]
+	purgeTransactions          *connect.Client[v1.PurgeTransactionsRequest, v1.PurgeTransactionsResponse]
 }
 
 // ListTransactions calls arian.v1.TransactionService.ListTransactions.
@@ -153,6 +375,106 @@ func (c *transactionServiceClient) CategorizeTransactions(ctx context.Context, r
 	return c.categorizeTransactions.CallUnary(ctx, req)
 }
 
+// StreamTransactions calls arian.v1.TransactionService.StreamTransactions.
+func (c *transactionServiceClient) StreamTransactions(ctx context.Context, req *connect.Request[v1.StreamTransactionsRequest]) (*connect.ServerStreamForClient[v1.StreamTransactionsResponse], error) {
+	return c.streamTransactions.CallServerStream(ctx, req)
+}
+
+// AggregateTransactions calls arian.v1.TransactionService.AggregateTransactions.
+func (c *transactionServiceClient) AggregateTransactions(ctx context.Context, req *connect.Request[v1.AggregateTransactionsRequest]) (*connect.Response[v1.AggregateTransactionsResponse], error) {
+	return c.aggregateTransactions.CallUnary(ctx, req)
+}
+
+// UpdateTransactionsByFilter calls arian.v1.TransactionService.UpdateTransactionsByFilter.
+func (c *transactionServiceClient) UpdateTransactionsByFilter(ctx context.Context, req *connect.Request[v1.UpdateTransactionsByFilterRequest]) (*connect.Response[v1.UpdateTransactionsByFilterResponse], error) {
+	return c.updateTransactionsByFilter.CallUnary(ctx, req)
+}
+
+// DeleteTransactionsByFilter calls arian.v1.TransactionService.DeleteTransactionsByFilter.
+func (c *transactionServiceClient) DeleteTransactionsByFilter(ctx context.Context, req *connect.Request[v1.DeleteTransactionsByFilterRequest]) (*connect.Response[v1.DeleteTransactionsByFilterResponse], error) {
+	return c.deleteTransactionsByFilter.CallUnary(ctx, req)
+}
+
+// ImportStatement calls arian.v1.TransactionService.ImportStatement.
+func (c *transactionServiceClient) ImportStatement(ctx context.Context, req *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error) {
+	return c.importStatement.CallUnary(ctx, req)
+}
+
+// ListImportProfiles calls arian.v1.TransactionService.ListImportProfiles.
+func (c *transactionServiceClient) ListImportProfiles(ctx context.Context, req *connect.Request[v1.ListImportProfilesRequest]) (*connect.Response[v1.ListImportProfilesResponse], error) {
+	return c.listImportProfiles.CallUnary(ctx, req)
+}
+
+// SaveImportProfile calls arian.v1.TransactionService.SaveImportProfile.
+func (c *transactionServiceClient) SaveImportProfile(ctx context.Context, req *connect.Request[v1.SaveImportProfileRequest]) (*connect.Response[v1.SaveImportProfileResponse], error) {
+	return c.saveImportProfile.CallUnary(ctx, req)
+}
+
+// DeleteImportProfile calls arian.v1.TransactionService.DeleteImportProfile.
+func (c *transactionServiceClient) DeleteImportProfile(ctx context.Context, req *connect.Request[v1.DeleteImportProfileRequest]) (*connect.Response[v1.DeleteImportProfileResponse], error) {
+	return c.deleteImportProfile.CallUnary(ctx, req)
+}
+
+// SetTransactionSplits calls arian.v1.TransactionService.SetTransactionSplits.
+func (c *transactionServiceClient) SetTransactionSplits(ctx context.Context, req *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error) {
+	return c.setTransactionSplits.CallUnary(ctx, req)
+}
+
+// LinkTransfer calls arian.v1.TransactionService.LinkTransfer.
+func (c *transactionServiceClient) LinkTransfer(ctx context.Context, req *connect.Request[v1.LinkTransferRequest]) (*connect.Response[v1.LinkTransferResponse], error) {
+	return c.linkTransfer.CallUnary(ctx, req)
+}
+
+// UnlinkTransfer calls arian.v1.TransactionService.UnlinkTransfer.
+func (c *transactionServiceClient) UnlinkTransfer(ctx context.Context, req *connect.Request[v1.UnlinkTransferRequest]) (*connect.Response[v1.UnlinkTransferResponse], error) {
+	return c.unlinkTransfer.CallUnary(ctx, req)
+}
+
+// DetectTransfers calls arian.v1.TransactionService.DetectTransfers.
+func (c *transactionServiceClient) DetectTransfers(ctx context.Context, req *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error) {
+	return c.detectTransfers.CallUnary(ctx, req)
+}
+
+// LinkRefund calls arian.v1.TransactionService.LinkRefund.
+func (c *transactionServiceClient) LinkRefund(ctx context.Context, req *connect.Request[v1.LinkRefundRequest]) (*connect.Response[v1.LinkRefundResponse], error) {
+	return c.linkRefund.CallUnary(ctx, req)
+}
+
+// UnlinkRefund calls arian.v1.TransactionService.UnlinkRefund.
+func (c *transactionServiceClient) UnlinkRefund(ctx context.Context, req *connect.Request[v1.UnlinkRefundRequest]) (*connect.Response[v1.UnlinkRefundResponse], error) {
+	return c.unlinkRefund.CallUnary(ctx, req)
+}
+
+// DetectRefunds calls arian.v1.TransactionService.DetectRefunds.
+func (c *transactionServiceClient) DetectRefunds(ctx context.Context, req *connect.Request[v1.DetectRefundsRequest]) (*connect.Response[v1.DetectRefundsResponse], error) {
+	return c.detectRefunds.CallUnary(ctx, req)
+}
+
+// SearchTransactions calls arian.v1.TransactionService.SearchTransactions.
+func (c *transactionServiceClient) SearchTransactions(ctx context.Context, req *connect.Request[v1.SearchTransactionsRequest]) (*connect.Response[v1.SearchTransactionsResponse], error) {
+	return c.searchTransactions.CallUnary(ctx, req)
+}
+
+// GetTransactionHistory calls arian.v1.TransactionService.GetTransactionHistory.
+func (c *transactionServiceClient) GetTransactionHistory(ctx context.Context, req *connect.Request[v1.GetTransactionHistoryRequest]) (*connect.Response[v1.GetTransactionHistoryResponse], error) {
+	return c.getTransactionHistory.CallUnary(ctx, req)
+}
+
+// ListDeletedTransactions calls arian.v1.TransactionService.ListDeletedTransactions.
+func (c *transactionServiceClient) ListDeletedTransactions(ctx context.Context, req *connect.Request[v1.ListDeletedTransactionsRequest]) (*connect.Response[v1.ListDeletedTransactionsResponse], error) {
+	return c.listDeletedTransactions.CallUnary(ctx, req)
+}
+
+// RestoreTransactions calls arian.v1.TransactionService.RestoreTransactions.
+func (c *transactionServiceClient) RestoreTransactions(ctx context.Context, req *connect.Request[v1.RestoreTransactionsRequest]) (*connect.Response[v1.RestoreTransactionsResponse], error) {
+	return c.restoreTransactions.CallUnary(ctx, req)
+}
+
+// PurgeTransactions calls arian.v1.TransactionService.PurgeTransactions.
+func (c *transactionServiceClient) PurgeTransactions(ctx context.Context, req *connect.Request[v1.PurgeTransactionsRequest]) (*connect.Response[v1.PurgeTransactionsResponse], error) {
+	return c.purgeTransactions.CallUnary(ctx, req)
+}
+
 // TransactionServiceHandler is an implementation of the arian.v1.TransactionService service.
 type TransactionServiceHandler interface {
 	ListTransactions(context.Context, *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error)
@@ -161,6 +483,28 @@ type TransactionServiceHandler interface {
 	UpdateTransaction(context.Context, *connect.Request[v1.UpdateTransactionRequest]) (*connect.Response[v1.UpdateTransactionResponse], error)
 	DeleteTransaction(context.Context, *connect.Request[v1.DeleteTransactionRequest]) (*connect.Response[v1.DeleteTransactionResponse], error)
 	CategorizeTransactions(context.Context, *connect.Request[v1.CategorizeTransactionsRequest]) (*connect.Response[v1.CategorizeTransactionsResponse], error)
+	StreamTransactions(context.Context, *connect.Request[v1.StreamTransactionsRequest], *connect.ServerStream[v1.StreamTransactionsResponse]) error
+	AggregateTransactions(context.Context, *connect.Request[v1.AggregateTransactionsRequest]) (*connect.Response[v1.AggregateTransactionsResponse], error)
+	UpdateTransactionsByFilter(context.Context, *connect.Request[v1.UpdateTransactionsByFilterRequest]) (*connect.Response[v1.UpdateTransactionsByFilterResponse], error)
+	// moves the matching transactions to the trash
+	DeleteTransactionsByFilter(context.Context, *connect.Request[v1.DeleteTransactionsByFilterRequest]) (*connect.Response[v1.DeleteTransactionsByFilterResponse], error)
+	ImportStatement(context.Context, *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error)
+	ListImportProfiles(context.Context, *connect.Request[v1.ListImportProfilesRequest]) (*connect.Response[v1.ListImportProfilesResponse], error)
+	SaveImportProfile(context.Context, *connect.Request[v1.SaveImportProfileRequest]) (*connect.Response[v1.SaveImportProfileResponse], error)
+	DeleteImportProfile(context.Context, *connect.Request[v1.DeleteImportProfileRequest]) (*connect.Response[v1.DeleteImportProfileResponse], error)
+	SetTransactionSplits(context.Context, *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error)
+	LinkTransfer(context.Context, *connect.Request[v1.LinkTransferRequest]) (*connect.Response[v1.LinkTransferResponse], error)
+	UnlinkTransfer(context.Context, *connect.Request[v1.UnlinkTransferRequest]) (*connect.Response[v1.UnlinkTransferResponse], error)
+	DetectTransfers(context.Context, *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error)
+	LinkRefund(context.Context, *connect.Request[v1.LinkRefundRequest]) (*connect.Response[v1.LinkRefundResponse], error)
+	UnlinkRefund(context.Context, *connect.Request[v1.UnlinkRefundRequest]) (*connect.Response[v1.UnlinkRefundResponse], error)
+	DetectRefunds(context.Context, *connect.Request[v1.DetectRefundsRequest]) (*connect.Response[v1.DetectRefundsResponse], error)
+	SearchTransactions(context.Context, *connect.Request[v1.SearchTransactionsRequest]) (*connect.Response[v1.SearchTransactionsResponse], error)
+	GetTransactionHistory(context.Context, *connect.Request[v1.GetTransactionHistoryRequest]) (*connect.Response[v1.GetTransactionHistoryResponse], error)
+	ListDeletedTransactions(context.Context, *connect.Request[v1.ListDeletedTransactionsRequest]) (*connect.Response[v1.ListDeletedTransactionsResponse], error)
+	RestoreTransactions(context.Context, *connect.Request[v1.RestoreTransactionsRequest]) (*connect.Response[v1.RestoreTransactionsResponse], error)
+	// permanently removes transactions that are already in the trash
+	PurgeTransactions(context.Context, *connect.Request[v1.PurgeTransactionsRequest]) (*connect.Response[v1.PurgeTransactionsResponse], error)
 }
 
 // NewTransactionServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -206,6 +550,126 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 		connect.WithSchema(transactionServiceMethods.ByName("CategorizeTransactions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	transactionServiceStreamTransactionsHandler := connect.NewServerStreamHandler(
+		TransactionServiceStreamTransactionsProcedure,
+		svc.StreamTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("StreamTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceAggregateTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServiceAggregateTransactionsProcedure,
+		svc.AggregateTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("AggregateTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUpdateTransactionsByFilterHandler := connect.NewUnaryHandler(
+		TransactionServiceUpdateTransactionsByFilterProcedure,
+		svc.UpdateTransactionsByFilter,
+		connect.WithSchema(transactionServiceMethods.ByName("UpdateTransactionsByFilter")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDeleteTransactionsByFilterHandler := connect.NewUnaryHandler(
+		TransactionServiceDeleteTransactionsByFilterProcedure,
+		svc.DeleteTransactionsByFilter,
+		connect.WithSchema(transactionServiceMethods.ByName("DeleteTransactionsByFilter")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceImportStatementHandler := connect.NewUnaryHandler(
+		TransactionServiceImportStatementProcedure,
+		svc.ImportStatement,
+		connect.WithSchema(transactionServiceMethods.ByName("ImportStatement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListImportProfilesHandler := connect.NewUnaryHandler(
+		TransactionServiceListImportProfilesProcedure,
+		svc.ListImportProfiles,
+		connect.WithSchema(transactionServiceMethods.ByName("ListImportProfiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSaveImportProfileHandler := connect.NewUnaryHandler(
+		TransactionServiceSaveImportProfileProcedure,
+		svc.SaveImportProfile,
+		connect.WithSchema(transactionServiceMethods.ByName("SaveImportProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDeleteImportProfileHandler := connect.NewUnaryHandler(
+		TransactionServiceDeleteImportProfileProcedure,
+		svc.DeleteImportProfile,
+		connect.WithSchema(transactionServiceMethods.ByName("DeleteImportProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSetTransactionSplitsHandler := connect.NewUnaryHandler(
+		TransactionServiceSetTransactionSplitsProcedure,
+		svc.SetTransactionSplits,
+		connect.WithSchema(transactionServiceMethods.ByName("SetTransactionSplits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceLinkTransferHandler := connect.NewUnaryHandler(
+		TransactionServiceLinkTransferProcedure,
+		svc.LinkTransfer,
+		connect.WithSchema(transactionServiceMethods.ByName("LinkTransfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUnlinkTransferHandler := connect.NewUnaryHandler(
+		TransactionServiceUnlinkTransferProcedure,
+		svc.UnlinkTransfer,
+		connect.WithSchema(transactionServiceMethods.ByName("UnlinkTransfer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDetectTransfersHandler := connect.NewUnaryHandler(
+		TransactionServiceDetectTransfersProcedure,
+		svc.DetectTransfers,
+		connect.WithSchema(transactionServiceMethods.ByName("DetectTransfers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceLinkRefundHandler := connect.NewUnaryHandler(
+		TransactionServiceLinkRefundProcedure,
+		svc.LinkRefund,
+		connect.WithSchema(transactionServiceMethods.ByName("LinkRefund")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceUnlinkRefundHandler := connect.NewUnaryHandler(
+		TransactionServiceUnlinkRefundProcedure,
+		svc.UnlinkRefund,
+		connect.WithSchema(transactionServiceMethods.ByName("UnlinkRefund")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceDetectRefundsHandler := connect.NewUnaryHandler(
+		TransactionServiceDetectRefundsProcedure,
+		svc.DetectRefunds,
+		connect.WithSchema(transactionServiceMethods.ByName("DetectRefunds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceSearchTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServiceSearchTransactionsProcedure,
+		svc.SearchTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("SearchTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceGetTransactionHistoryHandler := connect.NewUnaryHandler(
+		TransactionServiceGetTransactionHistoryProcedure,
+		svc.GetTransactionHistory,
+		connect.WithSchema(transactionServiceMethods.ByName("GetTransactionHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceListDeletedTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServiceListDeletedTransactionsProcedure,
+		svc.ListDeletedTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("ListDeletedTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServiceRestoreTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServiceRestoreTransactionsProcedure,
+		svc.RestoreTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("RestoreTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	transactionServicePurgeTransactionsHandler := connect.NewUnaryHandler(
+		TransactionServicePurgeTransactionsProcedure,
+		svc.PurgeTransactions,
+		connect.WithSchema(transactionServiceMethods.ByName("PurgeTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/arian.v1.TransactionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TransactionServiceListTransactionsProcedure:
@@ -220,6 +684,46 @@ func NewTransactionServiceHandler(svc TransactionServiceHandler, opts ...connect
 			transactionServiceDeleteTransactionHandler.ServeHTTP(w, r)
 		case TransactionServiceCategorizeTransactionsProcedure:
 			transactionServiceCategorizeTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceStreamTransactionsProcedure:
+			transactionServiceStreamTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceAggregateTransactionsProcedure:
+			transactionServiceAggregateTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceUpdateTransactionsByFilterProcedure:
+			transactionServiceUpdateTransactionsByFilterHandler.ServeHTTP(w, r)
+		case TransactionServiceDeleteTransactionsByFilterProcedure:
+			transactionServiceDeleteTransactionsByFilterHandler.ServeHTTP(w, r)
+		case TransactionServiceImportStatementProcedure:
+			transactionServiceImportStatementHandler.ServeHTTP(w, r)
+		case TransactionServiceListImportProfilesProcedure:
+			transactionServiceListImportProfilesHandler.ServeHTTP(w, r)
+		case TransactionServiceSaveImportProfileProcedure:
+			transactionServiceSaveImportProfileHandler.ServeHTTP(w, r)
+		case TransactionServiceDeleteImportProfileProcedure:
+			transactionServiceDeleteImportProfileHandler.ServeHTTP(w, r)
+		case TransactionServiceSetTransactionSplitsProcedure:
+			transactionServiceSetTransactionSplitsHandler.ServeHTTP(w, r)
+		case TransactionServiceLinkTransferProcedure:
+			transactionServiceLinkTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceUnlinkTransferProcedure:
+			transactionServiceUnlinkTransferHandler.ServeHTTP(w, r)
+		case TransactionServiceDetectTransfersProcedure:
+			transactionServiceDetectTransfersHandler.ServeHTTP(w, r)
+		case TransactionServiceLinkRefundProcedure:
+			transactionServiceLinkRefundHandler.ServeHTTP(w, r)
+		case TransactionServiceUnlinkRefundProcedure:
+			transactionServiceUnlinkRefundHandler.ServeHTTP(w, r)
+		case TransactionServiceDetectRefundsProcedure:
+			transactionServiceDetectRefundsHandler.ServeHTTP(w, r)
+		case TransactionServiceSearchTransactionsProcedure:
+			transactionServiceSearchTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceGetTransactionHistoryProcedure:
+			transactionServiceGetTransactionHistoryHandler.ServeHTTP(w, r)
+		case TransactionServiceListDeletedTransactionsProcedure:
+			transactionServiceListDeletedTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServiceRestoreTransactionsProcedure:
+			transactionServiceRestoreTransactionsHandler.ServeHTTP(w, r)
+		case TransactionServicePurgeTransactionsProcedure:
+			transactionServicePurgeTransactionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -252,3 +756,83 @@ func (UnimplementedTransactionServiceHandler) DeleteTransaction(context.Context,
 func (UnimplementedTransactionServiceHandler) CategorizeTransactions(context.Context, *connect.Request[v1.CategorizeTransactionsRequest]) (*connect.Response[v1.CategorizeTransactionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.CategorizeTransactions is not implemented"))
 }
+
+func (UnimplementedTransactionServiceHandler) StreamTransactions(context.Context, *connect.Request[v1.StreamTransactionsRequest], *connect.ServerStream[v1.StreamTransactionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.StreamTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) AggregateTransactions(context.Context, *connect.Request[v1.AggregateTransactionsRequest]) (*connect.Response[v1.AggregateTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.AggregateTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UpdateTransactionsByFilter(context.Context, *connect.Request[v1.UpdateTransactionsByFilterRequest]) (*connect.Response[v1.UpdateTransactionsByFilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.UpdateTransactionsByFilter is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DeleteTransactionsByFilter(context.Context, *connect.Request[v1.DeleteTransactionsByFilterRequest]) (*connect.Response[v1.DeleteTransactionsByFilterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.DeleteTransactionsByFilter is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ImportStatement(context.Context, *connect.Request[v1.ImportStatementRequest]) (*connect.Response[v1.ImportStatementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.ImportStatement is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListImportProfiles(context.Context, *connect.Request[v1.ListImportProfilesRequest]) (*connect.Response[v1.ListImportProfilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.ListImportProfiles is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SaveImportProfile(context.Context, *connect.Request[v1.SaveImportProfileRequest]) (*connect.Response[v1.SaveImportProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.SaveImportProfile is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DeleteImportProfile(context.Context, *connect.Request[v1.DeleteImportProfileRequest]) (*connect.Response[v1.DeleteImportProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.DeleteImportProfile is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SetTransactionSplits(context.Context, *connect.Request[v1.SetTransactionSplitsRequest]) (*connect.Response[v1.SetTransactionSplitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.SetTransactionSplits is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) LinkTransfer(context.Context, *connect.Request[v1.LinkTransferRequest]) (*connect.Response[v1.LinkTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.LinkTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UnlinkTransfer(context.Context, *connect.Request[v1.UnlinkTransferRequest]) (*connect.Response[v1.UnlinkTransferResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.UnlinkTransfer is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DetectTransfers(context.Context, *connect.Request[v1.DetectTransfersRequest]) (*connect.Response[v1.DetectTransfersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.DetectTransfers is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) LinkRefund(context.Context, *connect.Request[v1.LinkRefundRequest]) (*connect.Response[v1.LinkRefundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.LinkRefund is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) UnlinkRefund(context.Context, *connect.Request[v1.UnlinkRefundRequest]) (*connect.Response[v1.UnlinkRefundResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.UnlinkRefund is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) DetectRefunds(context.Context, *connect.Request[v1.DetectRefundsRequest]) (*connect.Response[v1.DetectRefundsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.DetectRefunds is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) SearchTransactions(context.Context, *connect.Request[v1.SearchTransactionsRequest]) (*connect.Response[v1.SearchTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.SearchTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) GetTransactionHistory(context.Context, *connect.Request[v1.GetTransactionHistoryRequest]) (*connect.Response[v1.GetTransactionHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.GetTransactionHistory is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) ListDeletedTransactions(context.Context, *connect.Request[v1.ListDeletedTransactionsRequest]) (*connect.Response[v1.ListDeletedTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.ListDeletedTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) RestoreTransactions(context.Context, *connect.Request[v1.RestoreTransactionsRequest]) (*connect.Response[v1.RestoreTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.RestoreTransactions is not implemented"))
+}
+
+func (UnimplementedTransactionServiceHandler) PurgeTransactions(context.Context, *connect.Request[v1.PurgeTransactionsRequest]) (*connect.Response[v1.PurgeTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("arian.v1.TransactionService.PurgeTransactions is not implemented"))
+}
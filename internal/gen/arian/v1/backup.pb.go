@@ -273,6 +273,7 @@ type TransactionData struct {
 	UserNotes     *string                `protobuf:"bytes,9,opt,name=user_notes,json=userNotes,proto3,oneof" json:"user_notes,omitempty"`
 	ForeignAmount *money.Money           `protobuf:"bytes,10,opt,name=foreign_amount,json=foreignAmount,proto3,oneof" json:"foreign_amount,omitempty"`
 	ExchangeRate  *float64               `protobuf:"fixed64,11,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
+	Status        *string                `protobuf:"bytes,12,opt,name=status,proto3,oneof" json:"status,omitempty"` // PENDING or VOIDED, omitted for posted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -384,6 +385,13 @@ func (x *TransactionData) GetExchangeRate() float64 {
 	return 0
 }
 
+func (x *TransactionData) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
 type RuleData struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	RuleName       string                 `protobuf:"bytes,1,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
@@ -505,7 +513,7 @@ const file_arian_v1_backup_proto_rawDesc = "" +
 	"\rmain_currency\x18\a \x01(\tB\a\xbaH\x04r\x02\x10\x03R\fmainCurrency\x12\x16\n" +
 	"\x06colors\x18\b \x03(\tR\x06colorsB\b\n" +
 	"\x06_aliasB\x0e\n" +
-	"\f_anchor_date\"\x85\x05\n" +
+	"\f_anchor_date\"\xad\x05\n" +
 	"\x0fTransactionData\x12*\n" +
 	"\faccount_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vaccountName\x12;\n" +
 	"\atx_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x06txDate\x127\n" +
@@ -519,7 +527,8 @@ const file_arian_v1_backup_proto_rawDesc = "" +
 	"user_notes\x18\t \x01(\tH\x04R\tuserNotes\x88\x01\x01\x12>\n" +
 	"\x0eforeign_amount\x18\n" +
 	" \x01(\v2\x12.google.type.MoneyH\x05R\rforeignAmount\x88\x01\x01\x12(\n" +
-	"\rexchange_rate\x18\v \x01(\x01H\x06R\fexchangeRate\x88\x01\x01B\n" +
+	"\rexchange_rate\x18\v \x01(\x01H\x06R\fexchangeRate\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\f \x01(\tH\aR\x06status\x88\x01\x01B\n" +
 	"\n" +
 	"\b_tx_descB\x10\n" +
 	"\x0e_balance_afterB\v\n" +
@@ -527,7 +536,8 @@ const file_arian_v1_backup_proto_rawDesc = "" +
 	"\x0e_category_slugB\r\n" +
 	"\v_user_notesB\x11\n" +
 	"\x0f_foreign_amountB\x10\n" +
-	"\x0e_exchange_rate\"\xf1\x02\n" +
+	"\x0e_exchange_rateB\t\n" +
+	"\a_status\"\xf1\x02\n" +
 	"\bRuleData\x12$\n" +
 	"\trule_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bruleName\x12(\n" +
 	"\rcategory_slug\x18\x02 \x01(\tH\x00R\fcategorySlug\x88\x01\x01\x12\x1f\n" +
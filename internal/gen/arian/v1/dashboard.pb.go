@@ -311,19 +311,89 @@ func (x *TopCategory) GetTotalAmount() *money.Money {
 	return nil
 }
 
+type TopTag struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Slug             string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Color            string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	TotalAmount      *money.Money           `protobuf:"bytes,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TopTag) Reset() {
+	*x = TopTag{}
+	mi := &file_arian_v1_dashboard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopTag) ProtoMessage() {}
+
+func (x *TopTag) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_dashboard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopTag.ProtoReflect.Descriptor instead.
+func (*TopTag) Descriptor() ([]byte, []int) {
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TopTag) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *TopTag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TopTag) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *TopTag) GetTotalAmount() *money.Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
 type TopMerchant struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Merchant         string                 `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
 	TransactionCount int64                  `protobuf:"varint,2,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
 	TotalAmount      *money.Money           `protobuf:"bytes,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
 	AvgAmount        *money.Money           `protobuf:"bytes,4,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// set when the row groups transactions resolved to a merchant
+	MerchantId    *int64 `protobuf:"varint,5,opt,name=merchant_id,json=merchantId,proto3,oneof" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TopMerchant) Reset() {
 	*x = TopMerchant{}
-	mi := &file_arian_v1_dashboard_proto_msgTypes[4]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -335,7 +405,7 @@ func (x *TopMerchant) String() string {
 func (*TopMerchant) ProtoMessage() {}
 
 func (x *TopMerchant) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_proto_msgTypes[4]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +418,7 @@ func (x *TopMerchant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopMerchant.ProtoReflect.Descriptor instead.
 func (*TopMerchant) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{4}
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TopMerchant) GetMerchant() string {
@@ -379,6 +449,13 @@ func (x *TopMerchant) GetAvgAmount() *money.Money {
 	return nil
 }
 
+func (x *TopMerchant) GetMerchantId() int64 {
+	if x != nil && x.MerchantId != nil {
+		return *x.MerchantId
+	}
+	return 0
+}
+
 type PeriodInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StartDate     *date.Date             `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
@@ -390,7 +467,7 @@ type PeriodInfo struct {
 
 func (x *PeriodInfo) Reset() {
 	*x = PeriodInfo{}
-	mi := &file_arian_v1_dashboard_proto_msgTypes[5]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -402,7 +479,7 @@ func (x *PeriodInfo) String() string {
 func (*PeriodInfo) ProtoMessage() {}
 
 func (x *PeriodInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_proto_msgTypes[5]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +492,7 @@ func (x *PeriodInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeriodInfo.ProtoReflect.Descriptor instead.
 func (*PeriodInfo) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{5}
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PeriodInfo) GetStartDate() *date.Date {
@@ -449,7 +526,7 @@ type PeriodSpending struct {
 
 func (x *PeriodSpending) Reset() {
 	*x = PeriodSpending{}
-	mi := &file_arian_v1_dashboard_proto_msgTypes[6]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +538,7 @@ func (x *PeriodSpending) String() string {
 func (*PeriodSpending) ProtoMessage() {}
 
 func (x *PeriodSpending) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_proto_msgTypes[6]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +551,7 @@ func (x *PeriodSpending) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeriodSpending.ProtoReflect.Descriptor instead.
 func (*PeriodSpending) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{6}
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PeriodSpending) GetAmount() *money.Money {
@@ -502,7 +579,7 @@ type CategorySpendingComparison struct {
 
 func (x *CategorySpendingComparison) Reset() {
 	*x = CategorySpendingComparison{}
-	mi := &file_arian_v1_dashboard_proto_msgTypes[7]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -514,7 +591,7 @@ func (x *CategorySpendingComparison) String() string {
 func (*CategorySpendingComparison) ProtoMessage() {}
 
 func (x *CategorySpendingComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_proto_msgTypes[7]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +604,7 @@ func (x *CategorySpendingComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategorySpendingComparison.ProtoReflect.Descriptor instead.
 func (*CategorySpendingComparison) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{7}
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CategorySpendingComparison) GetCategoryId() int64 {
@@ -561,7 +638,7 @@ type CategorySpendingTotals struct {
 
 func (x *CategorySpendingTotals) Reset() {
 	*x = CategorySpendingTotals{}
-	mi := &file_arian_v1_dashboard_proto_msgTypes[8]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +650,7 @@ func (x *CategorySpendingTotals) String() string {
 func (*CategorySpendingTotals) ProtoMessage() {}
 
 func (x *CategorySpendingTotals) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_proto_msgTypes[8]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +663,7 @@ func (x *CategorySpendingTotals) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategorySpendingTotals.ProtoReflect.Descriptor instead.
 func (*CategorySpendingTotals) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{8}
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CategorySpendingTotals) GetCurrentPeriodTotal() *money.Money {
@@ -613,7 +690,7 @@ type NetWorthPoint struct {
 
 func (x *NetWorthPoint) Reset() {
 	*x = NetWorthPoint{}
-	mi := &file_arian_v1_dashboard_proto_msgTypes[9]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -625,7 +702,7 @@ func (x *NetWorthPoint) String() string {
 func (*NetWorthPoint) ProtoMessage() {}
 
 func (x *NetWorthPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_proto_msgTypes[9]
+	mi := &file_arian_v1_dashboard_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,7 +715,7 @@ func (x *NetWorthPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetWorthPoint.ProtoReflect.Descriptor instead.
 func (*NetWorthPoint) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{9}
+	return file_arian_v1_dashboard_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NetWorthPoint) GetDate() *date.Date {
@@ -682,13 +759,21 @@ const file_arian_v1_dashboard_proto_rawDesc = "" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
 	"\x05color\x18\x03 \x01(\tR\x05color\x12+\n" +
 	"\x11transaction_count\x18\x04 \x01(\x03R\x10transactionCount\x125\n" +
-	"\ftotal_amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\vtotalAmount\"\xc0\x01\n" +
+	"\ftotal_amount\x18\x05 \x01(\v2\x12.google.type.MoneyR\vtotalAmount\"\x96\x01\n" +
+	"\x06TopTag\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\x12+\n" +
+	"\x11transaction_count\x18\x03 \x01(\x03R\x10transactionCount\x125\n" +
+	"\ftotal_amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\vtotalAmount\"\xf6\x01\n" +
 	"\vTopMerchant\x12\x1a\n" +
 	"\bmerchant\x18\x01 \x01(\tR\bmerchant\x12+\n" +
 	"\x11transaction_count\x18\x02 \x01(\x03R\x10transactionCount\x125\n" +
 	"\ftotal_amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\vtotalAmount\x121\n" +
 	"\n" +
-	"avg_amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\tavgAmount\"\x82\x01\n" +
+	"avg_amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\tavgAmount\x12$\n" +
+	"\vmerchant_id\x18\x05 \x01(\x03H\x00R\n" +
+	"merchantId\x88\x01\x01B\x0e\n" +
+	"\f_merchant_id\"\x82\x01\n" +
 	"\n" +
 	"PeriodInfo\x120\n" +
 	"\n" +
@@ -724,47 +809,49 @@ func file_arian_v1_dashboard_proto_rawDescGZIP() []byte {
 	return file_arian_v1_dashboard_proto_rawDescData
 }
 
-var file_arian_v1_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_arian_v1_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_arian_v1_dashboard_proto_goTypes = []any{
 	(*TrendPoint)(nil),                 // 0: arian.v1.TrendPoint
 	(*MonthlyComparison)(nil),          // 1: arian.v1.MonthlyComparison
 	(*DashboardSummary)(nil),           // 2: arian.v1.DashboardSummary
 	(*TopCategory)(nil),                // 3: arian.v1.TopCategory
-	(*TopMerchant)(nil),                // 4: arian.v1.TopMerchant
-	(*PeriodInfo)(nil),                 // 5: arian.v1.PeriodInfo
-	(*PeriodSpending)(nil),             // 6: arian.v1.PeriodSpending
-	(*CategorySpendingComparison)(nil), // 7: arian.v1.CategorySpendingComparison
-	(*CategorySpendingTotals)(nil),     // 8: arian.v1.CategorySpendingTotals
-	(*NetWorthPoint)(nil),              // 9: arian.v1.NetWorthPoint
-	(*date.Date)(nil),                  // 10: google.type.Date
-	(*money.Money)(nil),                // 11: google.type.Money
+	(*TopTag)(nil),                     // 4: arian.v1.TopTag
+	(*TopMerchant)(nil),                // 5: arian.v1.TopMerchant
+	(*PeriodInfo)(nil),                 // 6: arian.v1.PeriodInfo
+	(*PeriodSpending)(nil),             // 7: arian.v1.PeriodSpending
+	(*CategorySpendingComparison)(nil), // 8: arian.v1.CategorySpendingComparison
+	(*CategorySpendingTotals)(nil),     // 9: arian.v1.CategorySpendingTotals
+	(*NetWorthPoint)(nil),              // 10: arian.v1.NetWorthPoint
+	(*date.Date)(nil),                  // 11: google.type.Date
+	(*money.Money)(nil),                // 12: google.type.Money
 }
 var file_arian_v1_dashboard_proto_depIdxs = []int32{
-	10, // 0: arian.v1.TrendPoint.date:type_name -> google.type.Date
-	11, // 1: arian.v1.TrendPoint.income:type_name -> google.type.Money
-	11, // 2: arian.v1.TrendPoint.expenses:type_name -> google.type.Money
-	11, // 3: arian.v1.MonthlyComparison.income:type_name -> google.type.Money
-	11, // 4: arian.v1.MonthlyComparison.expenses:type_name -> google.type.Money
-	11, // 5: arian.v1.MonthlyComparison.net:type_name -> google.type.Money
-	11, // 6: arian.v1.DashboardSummary.total_income:type_name -> google.type.Money
-	11, // 7: arian.v1.DashboardSummary.total_expenses:type_name -> google.type.Money
-	11, // 8: arian.v1.TopCategory.total_amount:type_name -> google.type.Money
-	11, // 9: arian.v1.TopMerchant.total_amount:type_name -> google.type.Money
-	11, // 10: arian.v1.TopMerchant.avg_amount:type_name -> google.type.Money
-	10, // 11: arian.v1.PeriodInfo.start_date:type_name -> google.type.Date
-	10, // 12: arian.v1.PeriodInfo.end_date:type_name -> google.type.Date
-	11, // 13: arian.v1.PeriodSpending.amount:type_name -> google.type.Money
-	6,  // 14: arian.v1.CategorySpendingComparison.current_period:type_name -> arian.v1.PeriodSpending
-	6,  // 15: arian.v1.CategorySpendingComparison.previous_period:type_name -> arian.v1.PeriodSpending
-	11, // 16: arian.v1.CategorySpendingTotals.current_period_total:type_name -> google.type.Money
-	11, // 17: arian.v1.CategorySpendingTotals.previous_period_total:type_name -> google.type.Money
-	10, // 18: arian.v1.NetWorthPoint.date:type_name -> google.type.Date
-	11, // 19: arian.v1.NetWorthPoint.net_worth:type_name -> google.type.Money
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	11, // 0: arian.v1.TrendPoint.date:type_name -> google.type.Date
+	12, // 1: arian.v1.TrendPoint.income:type_name -> google.type.Money
+	12, // 2: arian.v1.TrendPoint.expenses:type_name -> google.type.Money
+	12, // 3: arian.v1.MonthlyComparison.income:type_name -> google.type.Money
+	12, // 4: arian.v1.MonthlyComparison.expenses:type_name -> google.type.Money
+	12, // 5: arian.v1.MonthlyComparison.net:type_name -> google.type.Money
+	12, // 6: arian.v1.DashboardSummary.total_income:type_name -> google.type.Money
+	12, // 7: arian.v1.DashboardSummary.total_expenses:type_name -> google.type.Money
+	12, // 8: arian.v1.TopCategory.total_amount:type_name -> google.type.Money
+	12, // 9: arian.v1.TopTag.total_amount:type_name -> google.type.Money
+	12, // 10: arian.v1.TopMerchant.total_amount:type_name -> google.type.Money
+	12, // 11: arian.v1.TopMerchant.avg_amount:type_name -> google.type.Money
+	11, // 12: arian.v1.PeriodInfo.start_date:type_name -> google.type.Date
+	11, // 13: arian.v1.PeriodInfo.end_date:type_name -> google.type.Date
+	12, // 14: arian.v1.PeriodSpending.amount:type_name -> google.type.Money
+	7,  // 15: arian.v1.CategorySpendingComparison.current_period:type_name -> arian.v1.PeriodSpending
+	7,  // 16: arian.v1.CategorySpendingComparison.previous_period:type_name -> arian.v1.PeriodSpending
+	12, // 17: arian.v1.CategorySpendingTotals.current_period_total:type_name -> google.type.Money
+	12, // 18: arian.v1.CategorySpendingTotals.previous_period_total:type_name -> google.type.Money
+	11, // 19: arian.v1.NetWorthPoint.date:type_name -> google.type.Date
+	12, // 20: arian.v1.NetWorthPoint.net_worth:type_name -> google.type.Money
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_dashboard_proto_init() }
@@ -773,14 +860,15 @@ func file_arian_v1_dashboard_proto_init() {
 		return
 	}
 	file_arian_v1_category_proto_init()
-	file_arian_v1_dashboard_proto_msgTypes[7].OneofWrappers = []any{}
+	file_arian_v1_dashboard_proto_msgTypes[5].OneofWrappers = []any{}
+	file_arian_v1_dashboard_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_dashboard_proto_rawDesc), len(file_arian_v1_dashboard_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,6 +7,7 @@
 package arianv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	date "google.golang.org/genproto/googleapis/type/date"
 	money "google.golang.org/genproto/googleapis/type/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -343,6 +344,118 @@ func (x *GetTopCategoriesResponse) GetCategories() []*TopCategory {
 	return nil
 }
 
+type GetTopTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate     *date.Date             `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate       *date.Date             `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopTagsRequest) Reset() {
+	*x = GetTopTagsRequest{}
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopTagsRequest) ProtoMessage() {}
+
+func (x *GetTopTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopTagsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTopTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTopTagsRequest) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetTopTagsRequest) GetEndDate() *date.Date {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetTopTagsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetTopTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TopTag              `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopTagsResponse) Reset() {
+	*x = GetTopTagsResponse{}
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopTagsResponse) ProtoMessage() {}
+
+func (x *GetTopTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopTagsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTopTagsResponse) GetTags() []*TopTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type GetTopMerchantsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -355,7 +468,7 @@ type GetTopMerchantsRequest struct {
 
 func (x *GetTopMerchantsRequest) Reset() {
 	*x = GetTopMerchantsRequest{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[6]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -367,7 +480,7 @@ func (x *GetTopMerchantsRequest) String() string {
 func (*GetTopMerchantsRequest) ProtoMessage() {}
 
 func (x *GetTopMerchantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[6]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +493,7 @@ func (x *GetTopMerchantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopMerchantsRequest.ProtoReflect.Descriptor instead.
 func (*GetTopMerchantsRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{6}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTopMerchantsRequest) GetUserId() string {
@@ -420,7 +533,7 @@ type GetTopMerchantsResponse struct {
 
 func (x *GetTopMerchantsResponse) Reset() {
 	*x = GetTopMerchantsResponse{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[7]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +545,7 @@ func (x *GetTopMerchantsResponse) String() string {
 func (*GetTopMerchantsResponse) ProtoMessage() {}
 
 func (x *GetTopMerchantsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[7]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +558,7 @@ func (x *GetTopMerchantsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopMerchantsResponse.ProtoReflect.Descriptor instead.
 func (*GetTopMerchantsResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{7}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetTopMerchantsResponse) GetMerchants() []*TopMerchant {
@@ -468,7 +581,7 @@ type GetSpendingTrendsRequest struct {
 
 func (x *GetSpendingTrendsRequest) Reset() {
 	*x = GetSpendingTrendsRequest{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[8]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +593,7 @@ func (x *GetSpendingTrendsRequest) String() string {
 func (*GetSpendingTrendsRequest) ProtoMessage() {}
 
 func (x *GetSpendingTrendsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[8]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +606,7 @@ func (x *GetSpendingTrendsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpendingTrendsRequest.ProtoReflect.Descriptor instead.
 func (*GetSpendingTrendsRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{8}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetSpendingTrendsRequest) GetUserId() string {
@@ -540,7 +653,7 @@ type GetSpendingTrendsResponse struct {
 
 func (x *GetSpendingTrendsResponse) Reset() {
 	*x = GetSpendingTrendsResponse{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[9]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +665,7 @@ func (x *GetSpendingTrendsResponse) String() string {
 func (*GetSpendingTrendsResponse) ProtoMessage() {}
 
 func (x *GetSpendingTrendsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[9]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +678,7 @@ func (x *GetSpendingTrendsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpendingTrendsResponse.ProtoReflect.Descriptor instead.
 func (*GetSpendingTrendsResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{9}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetSpendingTrendsResponse) GetTrends() []*TrendPoint {
@@ -584,7 +697,7 @@ type GetFinancialSummaryRequest struct {
 
 func (x *GetFinancialSummaryRequest) Reset() {
 	*x = GetFinancialSummaryRequest{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[10]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -596,7 +709,7 @@ func (x *GetFinancialSummaryRequest) String() string {
 func (*GetFinancialSummaryRequest) ProtoMessage() {}
 
 func (x *GetFinancialSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[10]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +722,7 @@ func (x *GetFinancialSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFinancialSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetFinancialSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{10}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetFinancialSummaryRequest) GetUserId() string {
@@ -630,7 +743,7 @@ type GetFinancialSummaryResponse struct {
 
 func (x *GetFinancialSummaryResponse) Reset() {
 	*x = GetFinancialSummaryResponse{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[11]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +755,7 @@ func (x *GetFinancialSummaryResponse) String() string {
 func (*GetFinancialSummaryResponse) ProtoMessage() {}
 
 func (x *GetFinancialSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[11]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +768,7 @@ func (x *GetFinancialSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFinancialSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetFinancialSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{11}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetFinancialSummaryResponse) GetTotalBalance() *money.Money {
@@ -691,7 +804,7 @@ type GetCategorySpendingComparisonRequest struct {
 
 func (x *GetCategorySpendingComparisonRequest) Reset() {
 	*x = GetCategorySpendingComparisonRequest{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[12]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +816,7 @@ func (x *GetCategorySpendingComparisonRequest) String() string {
 func (*GetCategorySpendingComparisonRequest) ProtoMessage() {}
 
 func (x *GetCategorySpendingComparisonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[12]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +829,7 @@ func (x *GetCategorySpendingComparisonRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetCategorySpendingComparisonRequest.ProtoReflect.Descriptor instead.
 func (*GetCategorySpendingComparisonRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{12}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetCategorySpendingComparisonRequest) GetUserId() string {
@@ -757,7 +870,7 @@ type CategorySpendingItem struct {
 
 func (x *CategorySpendingItem) Reset() {
 	*x = CategorySpendingItem{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[13]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +882,7 @@ func (x *CategorySpendingItem) String() string {
 func (*CategorySpendingItem) ProtoMessage() {}
 
 func (x *CategorySpendingItem) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[13]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +895,7 @@ func (x *CategorySpendingItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategorySpendingItem.ProtoReflect.Descriptor instead.
 func (*CategorySpendingItem) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{13}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CategorySpendingItem) GetCategory() *Category {
@@ -812,7 +925,7 @@ type GetCategorySpendingComparisonResponse struct {
 
 func (x *GetCategorySpendingComparisonResponse) Reset() {
 	*x = GetCategorySpendingComparisonResponse{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[14]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -824,7 +937,7 @@ func (x *GetCategorySpendingComparisonResponse) String() string {
 func (*GetCategorySpendingComparisonResponse) ProtoMessage() {}
 
 func (x *GetCategorySpendingComparisonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[14]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +950,7 @@ func (x *GetCategorySpendingComparisonResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetCategorySpendingComparisonResponse.ProtoReflect.Descriptor instead.
 func (*GetCategorySpendingComparisonResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{14}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetCategorySpendingComparisonResponse) GetCurrentPeriod() *PeriodInfo {
@@ -887,7 +1000,7 @@ type GetNetWorthHistoryRequest struct {
 
 func (x *GetNetWorthHistoryRequest) Reset() {
 	*x = GetNetWorthHistoryRequest{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[15]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +1012,7 @@ func (x *GetNetWorthHistoryRequest) String() string {
 func (*GetNetWorthHistoryRequest) ProtoMessage() {}
 
 func (x *GetNetWorthHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[15]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1025,7 @@ func (x *GetNetWorthHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetWorthHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetNetWorthHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{15}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetNetWorthHistoryRequest) GetUserId() string {
@@ -952,7 +1065,7 @@ type GetNetWorthHistoryResponse struct {
 
 func (x *GetNetWorthHistoryResponse) Reset() {
 	*x = GetNetWorthHistoryResponse{}
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[16]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -964,7 +1077,7 @@ func (x *GetNetWorthHistoryResponse) String() string {
 func (*GetNetWorthHistoryResponse) ProtoMessage() {}
 
 func (x *GetNetWorthHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_dashboard_services_proto_msgTypes[16]
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,7 +1090,7 @@ func (x *GetNetWorthHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNetWorthHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetNetWorthHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{16}
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetNetWorthHistoryResponse) GetDataPoints() []*NetWorthPoint {
@@ -987,11 +1100,108 @@ func (x *GetNetWorthHistoryResponse) GetDataPoints() []*NetWorthPoint {
 	return nil
 }
 
+type GetProjectedBalancesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// defaults to 30
+	Days          *int32 `protobuf:"varint,2,opt,name=days,proto3,oneof" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectedBalancesRequest) Reset() {
+	*x = GetProjectedBalancesRequest{}
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectedBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectedBalancesRequest) ProtoMessage() {}
+
+func (x *GetProjectedBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectedBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectedBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetProjectedBalancesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetProjectedBalancesRequest) GetDays() int32 {
+	if x != nil && x.Days != nil {
+		return *x.Days
+	}
+	return 0
+}
+
+type GetProjectedBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*AccountProjection   `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectedBalancesResponse) Reset() {
+	*x = GetProjectedBalancesResponse{}
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectedBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectedBalancesResponse) ProtoMessage() {}
+
+func (x *GetProjectedBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_dashboard_services_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectedBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectedBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_dashboard_services_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetProjectedBalancesResponse) GetAccounts() []*AccountProjection {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
 var File_arian_v1_dashboard_services_proto protoreflect.FileDescriptor
 
 const file_arian_v1_dashboard_services_proto_rawDesc = "" +
 	"\n" +
-	"!arian/v1/dashboard_services.proto\x12\barian.v1\x1a\x16arian/v1/account.proto\x1a\x17arian/v1/category.proto\x1a\x18arian/v1/dashboard.proto\x1a\x14arian/v1/enums.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\xbb\x01\n" +
+	"!arian/v1/dashboard_services.proto\x12\barian.v1\x1a\x16arian/v1/account.proto\x1a\x17arian/v1/category.proto\x1a\x18arian/v1/dashboard.proto\x1a\x14arian/v1/enums.proto\x1a\x16arian/v1/planned.proto\x1a\x1bbuf/validate/validate.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\xbb\x01\n" +
 	"\x1aGetDashboardSummaryRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
 	"\n" +
@@ -1022,7 +1232,18 @@ const file_arian_v1_dashboard_services_proto_rawDesc = "" +
 	"\x18GetTopCategoriesResponse\x125\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x15.arian.v1.TopCategoryR\n" +
-	"categories\"\xdc\x01\n" +
+	"categories\"\xd7\x01\n" +
+	"\x11GetTopTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x11.google.type.DateH\x00R\tstartDate\x88\x01\x01\x121\n" +
+	"\bend_date\x18\x03 \x01(\v2\x11.google.type.DateH\x01R\aendDate\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x05H\x02R\x05limit\x88\x01\x01B\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\b\n" +
+	"\x06_limit\":\n" +
+	"\x12GetTopTagsResponse\x12$\n" +
+	"\x04tags\x18\x01 \x03(\v2\x10.arian.v1.TopTagR\x04tags\"\xdc\x01\n" +
 	"\x16GetTopMerchantsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
 	"\n" +
@@ -1084,16 +1305,26 @@ const file_arian_v1_dashboard_services_proto_rawDesc = "" +
 	"\vgranularity\x18\x04 \x01(\x0e2\x15.arian.v1.GranularityR\vgranularity\"V\n" +
 	"\x1aGetNetWorthHistoryResponse\x128\n" +
 	"\vdata_points\x18\x01 \x03(\v2\x17.arian.v1.NetWorthPointR\n" +
-	"dataPoints2\xb6\x06\n" +
+	"dataPoints\"d\n" +
+	"\x1bGetProjectedBalancesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\x04days\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xee\x02(\x01H\x00R\x04days\x88\x01\x01B\a\n" +
+	"\x05_days\"W\n" +
+	"\x1cGetProjectedBalancesResponse\x127\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1b.arian.v1.AccountProjectionR\baccounts2\xe6\a\n" +
 	"\x10DashboardService\x12b\n" +
 	"\x13GetDashboardSummary\x12$.arian.v1.GetDashboardSummaryRequest\x1a%.arian.v1.GetDashboardSummaryResponse\x12e\n" +
 	"\x14GetMonthlyComparison\x12%.arian.v1.GetMonthlyComparisonRequest\x1a&.arian.v1.GetMonthlyComparisonResponse\x12Y\n" +
-	"\x10GetTopCategories\x12!.arian.v1.GetTopCategoriesRequest\x1a\".arian.v1.GetTopCategoriesResponse\x12V\n" +
+	"\x10GetTopCategories\x12!.arian.v1.GetTopCategoriesRequest\x1a\".arian.v1.GetTopCategoriesResponse\x12G\n" +
+	"\n" +
+	"GetTopTags\x12\x1b.arian.v1.GetTopTagsRequest\x1a\x1c.arian.v1.GetTopTagsResponse\x12V\n" +
 	"\x0fGetTopMerchants\x12 .arian.v1.GetTopMerchantsRequest\x1a!.arian.v1.GetTopMerchantsResponse\x12\\\n" +
 	"\x11GetSpendingTrends\x12\".arian.v1.GetSpendingTrendsRequest\x1a#.arian.v1.GetSpendingTrendsResponse\x12b\n" +
 	"\x13GetFinancialSummary\x12$.arian.v1.GetFinancialSummaryRequest\x1a%.arian.v1.GetFinancialSummaryResponse\x12\x80\x01\n" +
 	"\x1dGetCategorySpendingComparison\x12..arian.v1.GetCategorySpendingComparisonRequest\x1a/.arian.v1.GetCategorySpendingComparisonResponse\x12_\n" +
-	"\x12GetNetWorthHistory\x12#.arian.v1.GetNetWorthHistoryRequest\x1a$.arian.v1.GetNetWorthHistoryResponseB\x8d\x01\n" +
+	"\x12GetNetWorthHistory\x12#.arian.v1.GetNetWorthHistoryRequest\x1a$.arian.v1.GetNetWorthHistoryResponse\x12e\n" +
+	"\x14GetProjectedBalances\x12%.arian.v1.GetProjectedBalancesRequest\x1a&.arian.v1.GetProjectedBalancesResponseB\x8d\x01\n" +
 	"\fcom.arian.v1B\x16DashboardServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
 
 var (
@@ -1108,7 +1339,7 @@ func file_arian_v1_dashboard_services_proto_rawDescGZIP() []byte {
 	return file_arian_v1_dashboard_services_proto_rawDescData
 }
 
-var file_arian_v1_dashboard_services_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_arian_v1_dashboard_services_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_arian_v1_dashboard_services_proto_goTypes = []any{
 	(*GetDashboardSummaryRequest)(nil),            // 0: arian.v1.GetDashboardSummaryRequest
 	(*GetDashboardSummaryResponse)(nil),           // 1: arian.v1.GetDashboardSummaryResponse
@@ -1116,84 +1347,98 @@ var file_arian_v1_dashboard_services_proto_goTypes = []any{
 	(*GetMonthlyComparisonResponse)(nil),          // 3: arian.v1.GetMonthlyComparisonResponse
 	(*GetTopCategoriesRequest)(nil),               // 4: arian.v1.GetTopCategoriesRequest
 	(*GetTopCategoriesResponse)(nil),              // 5: arian.v1.GetTopCategoriesResponse
-	(*GetTopMerchantsRequest)(nil),                // 6: arian.v1.GetTopMerchantsRequest
-	(*GetTopMerchantsResponse)(nil),               // 7: arian.v1.GetTopMerchantsResponse
-	(*GetSpendingTrendsRequest)(nil),              // 8: arian.v1.GetSpendingTrendsRequest
-	(*GetSpendingTrendsResponse)(nil),             // 9: arian.v1.GetSpendingTrendsResponse
-	(*GetFinancialSummaryRequest)(nil),            // 10: arian.v1.GetFinancialSummaryRequest
-	(*GetFinancialSummaryResponse)(nil),           // 11: arian.v1.GetFinancialSummaryResponse
-	(*GetCategorySpendingComparisonRequest)(nil),  // 12: arian.v1.GetCategorySpendingComparisonRequest
-	(*CategorySpendingItem)(nil),                  // 13: arian.v1.CategorySpendingItem
-	(*GetCategorySpendingComparisonResponse)(nil), // 14: arian.v1.GetCategorySpendingComparisonResponse
-	(*GetNetWorthHistoryRequest)(nil),             // 15: arian.v1.GetNetWorthHistoryRequest
-	(*GetNetWorthHistoryResponse)(nil),            // 16: arian.v1.GetNetWorthHistoryResponse
-	(*date.Date)(nil),                             // 17: google.type.Date
-	(*DashboardSummary)(nil),                      // 18: arian.v1.DashboardSummary
-	(*MonthlyComparison)(nil),                     // 19: arian.v1.MonthlyComparison
-	(*TopCategory)(nil),                           // 20: arian.v1.TopCategory
-	(*TopMerchant)(nil),                           // 21: arian.v1.TopMerchant
-	(*TrendPoint)(nil),                            // 22: arian.v1.TrendPoint
-	(*money.Money)(nil),                           // 23: google.type.Money
-	(PeriodType)(0),                               // 24: arian.v1.PeriodType
-	(*Category)(nil),                              // 25: arian.v1.Category
-	(*CategorySpendingComparison)(nil),            // 26: arian.v1.CategorySpendingComparison
-	(*PeriodInfo)(nil),                            // 27: arian.v1.PeriodInfo
-	(*CategorySpendingTotals)(nil),                // 28: arian.v1.CategorySpendingTotals
-	(Granularity)(0),                              // 29: arian.v1.Granularity
-	(*NetWorthPoint)(nil),                         // 30: arian.v1.NetWorthPoint
+	(*GetTopTagsRequest)(nil),                     // 6: arian.v1.GetTopTagsRequest
+	(*GetTopTagsResponse)(nil),                    // 7: arian.v1.GetTopTagsResponse
+	(*GetTopMerchantsRequest)(nil),                // 8: arian.v1.GetTopMerchantsRequest
+	(*GetTopMerchantsResponse)(nil),               // 9: arian.v1.GetTopMerchantsResponse
+	(*GetSpendingTrendsRequest)(nil),              // 10: arian.v1.GetSpendingTrendsRequest
+	(*GetSpendingTrendsResponse)(nil),             // 11: arian.v1.GetSpendingTrendsResponse
+	(*GetFinancialSummaryRequest)(nil),            // 12: arian.v1.GetFinancialSummaryRequest
+	(*GetFinancialSummaryResponse)(nil),           // 13: arian.v1.GetFinancialSummaryResponse
+	(*GetCategorySpendingComparisonRequest)(nil),  // 14: arian.v1.GetCategorySpendingComparisonRequest
+	(*CategorySpendingItem)(nil),                  // 15: arian.v1.CategorySpendingItem
+	(*GetCategorySpendingComparisonResponse)(nil), // 16: arian.v1.GetCategorySpendingComparisonResponse
+	(*GetNetWorthHistoryRequest)(nil),             // 17: arian.v1.GetNetWorthHistoryRequest
+	(*GetNetWorthHistoryResponse)(nil),            // 18: arian.v1.GetNetWorthHistoryResponse
+	(*GetProjectedBalancesRequest)(nil),           // 19: arian.v1.GetProjectedBalancesRequest
+	(*GetProjectedBalancesResponse)(nil),          // 20: arian.v1.GetProjectedBalancesResponse
+	(*date.Date)(nil),                             // 21: google.type.Date
+	(*DashboardSummary)(nil),                      // 22: arian.v1.DashboardSummary
+	(*MonthlyComparison)(nil),                     // 23: arian.v1.MonthlyComparison
+	(*TopCategory)(nil),                           // 24: arian.v1.TopCategory
+	(*TopTag)(nil),                                // 25: arian.v1.TopTag
+	(*TopMerchant)(nil),                           // 26: arian.v1.TopMerchant
+	(*TrendPoint)(nil),                            // 27: arian.v1.TrendPoint
+	(*money.Money)(nil),                           // 28: google.type.Money
+	(PeriodType)(0),                               // 29: arian.v1.PeriodType
+	(*Category)(nil),                              // 30: arian.v1.Category
+	(*CategorySpendingComparison)(nil),            // 31: arian.v1.CategorySpendingComparison
+	(*PeriodInfo)(nil),                            // 32: arian.v1.PeriodInfo
+	(*CategorySpendingTotals)(nil),                // 33: arian.v1.CategorySpendingTotals
+	(Granularity)(0),                              // 34: arian.v1.Granularity
+	(*NetWorthPoint)(nil),                         // 35: arian.v1.NetWorthPoint
+	(*AccountProjection)(nil),                     // 36: arian.v1.AccountProjection
 }
 var file_arian_v1_dashboard_services_proto_depIdxs = []int32{
-	17, // 0: arian.v1.GetDashboardSummaryRequest.start_date:type_name -> google.type.Date
-	17, // 1: arian.v1.GetDashboardSummaryRequest.end_date:type_name -> google.type.Date
-	18, // 2: arian.v1.GetDashboardSummaryResponse.summary:type_name -> arian.v1.DashboardSummary
-	19, // 3: arian.v1.GetMonthlyComparisonResponse.comparisons:type_name -> arian.v1.MonthlyComparison
-	17, // 4: arian.v1.GetTopCategoriesRequest.start_date:type_name -> google.type.Date
-	17, // 5: arian.v1.GetTopCategoriesRequest.end_date:type_name -> google.type.Date
-	20, // 6: arian.v1.GetTopCategoriesResponse.categories:type_name -> arian.v1.TopCategory
-	17, // 7: arian.v1.GetTopMerchantsRequest.start_date:type_name -> google.type.Date
-	17, // 8: arian.v1.GetTopMerchantsRequest.end_date:type_name -> google.type.Date
-	21, // 9: arian.v1.GetTopMerchantsResponse.merchants:type_name -> arian.v1.TopMerchant
-	17, // 10: arian.v1.GetSpendingTrendsRequest.start_date:type_name -> google.type.Date
-	17, // 11: arian.v1.GetSpendingTrendsRequest.end_date:type_name -> google.type.Date
-	22, // 12: arian.v1.GetSpendingTrendsResponse.trends:type_name -> arian.v1.TrendPoint
-	23, // 13: arian.v1.GetFinancialSummaryResponse.total_balance:type_name -> google.type.Money
-	23, // 14: arian.v1.GetFinancialSummaryResponse.total_debt:type_name -> google.type.Money
-	23, // 15: arian.v1.GetFinancialSummaryResponse.net_balance:type_name -> google.type.Money
-	24, // 16: arian.v1.GetCategorySpendingComparisonRequest.period_type:type_name -> arian.v1.PeriodType
-	17, // 17: arian.v1.GetCategorySpendingComparisonRequest.custom_start_date:type_name -> google.type.Date
-	17, // 18: arian.v1.GetCategorySpendingComparisonRequest.custom_end_date:type_name -> google.type.Date
-	25, // 19: arian.v1.CategorySpendingItem.category:type_name -> arian.v1.Category
-	26, // 20: arian.v1.CategorySpendingItem.spending:type_name -> arian.v1.CategorySpendingComparison
-	27, // 21: arian.v1.GetCategorySpendingComparisonResponse.current_period:type_name -> arian.v1.PeriodInfo
-	27, // 22: arian.v1.GetCategorySpendingComparisonResponse.previous_period:type_name -> arian.v1.PeriodInfo
-	13, // 23: arian.v1.GetCategorySpendingComparisonResponse.categories:type_name -> arian.v1.CategorySpendingItem
-	26, // 24: arian.v1.GetCategorySpendingComparisonResponse.uncategorized:type_name -> arian.v1.CategorySpendingComparison
-	28, // 25: arian.v1.GetCategorySpendingComparisonResponse.totals:type_name -> arian.v1.CategorySpendingTotals
-	17, // 26: arian.v1.GetNetWorthHistoryRequest.start_date:type_name -> google.type.Date
-	17, // 27: arian.v1.GetNetWorthHistoryRequest.end_date:type_name -> google.type.Date
-	29, // 28: arian.v1.GetNetWorthHistoryRequest.granularity:type_name -> arian.v1.Granularity
-	30, // 29: arian.v1.GetNetWorthHistoryResponse.data_points:type_name -> arian.v1.NetWorthPoint
-	0,  // 30: arian.v1.DashboardService.GetDashboardSummary:input_type -> arian.v1.GetDashboardSummaryRequest
-	2,  // 31: arian.v1.DashboardService.GetMonthlyComparison:input_type -> arian.v1.GetMonthlyComparisonRequest
-	4,  // 32: arian.v1.DashboardService.GetTopCategories:input_type -> arian.v1.GetTopCategoriesRequest
-	6,  // 33: arian.v1.DashboardService.GetTopMerchants:input_type -> arian.v1.GetTopMerchantsRequest
-	8,  // 34: arian.v1.DashboardService.GetSpendingTrends:input_type -> arian.v1.GetSpendingTrendsRequest
-	10, // 35: arian.v1.DashboardService.GetFinancialSummary:input_type -> arian.v1.GetFinancialSummaryRequest
-	12, // 36: arian.v1.DashboardService.GetCategorySpendingComparison:input_type -> arian.v1.GetCategorySpendingComparisonRequest
-	15, // 37: arian.v1.DashboardService.GetNetWorthHistory:input_type -> arian.v1.GetNetWorthHistoryRequest
-	1,  // 38: arian.v1.DashboardService.GetDashboardSummary:output_type -> arian.v1.GetDashboardSummaryResponse
-	3,  // 39: arian.v1.DashboardService.GetMonthlyComparison:output_type -> arian.v1.GetMonthlyComparisonResponse
-	5,  // 40: arian.v1.DashboardService.GetTopCategories:output_type -> arian.v1.GetTopCategoriesResponse
-	7,  // 41: arian.v1.DashboardService.GetTopMerchants:output_type -> arian.v1.GetTopMerchantsResponse
-	9,  // 42: arian.v1.DashboardService.GetSpendingTrends:output_type -> arian.v1.GetSpendingTrendsResponse
-	11, // 43: arian.v1.DashboardService.GetFinancialSummary:output_type -> arian.v1.GetFinancialSummaryResponse
-	14, // 44: arian.v1.DashboardService.GetCategorySpendingComparison:output_type -> arian.v1.GetCategorySpendingComparisonResponse
-	16, // 45: arian.v1.DashboardService.GetNetWorthHistory:output_type -> arian.v1.GetNetWorthHistoryResponse
-	38, // [38:46] is the sub-list for method output_type
-	30, // [30:38] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	21, // 0: arian.v1.GetDashboardSummaryRequest.start_date:type_name -> google.type.Date
+	21, // 1: arian.v1.GetDashboardSummaryRequest.end_date:type_name -> google.type.Date
+	22, // 2: arian.v1.GetDashboardSummaryResponse.summary:type_name -> arian.v1.DashboardSummary
+	23, // 3: arian.v1.GetMonthlyComparisonResponse.comparisons:type_name -> arian.v1.MonthlyComparison
+	21, // 4: arian.v1.GetTopCategoriesRequest.start_date:type_name -> google.type.Date
+	21, // 5: arian.v1.GetTopCategoriesRequest.end_date:type_name -> google.type.Date
+	24, // 6: arian.v1.GetTopCategoriesResponse.categories:type_name -> arian.v1.TopCategory
+	21, // 7: arian.v1.GetTopTagsRequest.start_date:type_name -> google.type.Date
+	21, // 8: arian.v1.GetTopTagsRequest.end_date:type_name -> google.type.Date
+	25, // 9: arian.v1.GetTopTagsResponse.tags:type_name -> arian.v1.TopTag
+	21, // 10: arian.v1.GetTopMerchantsRequest.start_date:type_name -> google.type.Date
+	21, // 11: arian.v1.GetTopMerchantsRequest.end_date:type_name -> google.type.Date
+	26, // 12: arian.v1.GetTopMerchantsResponse.merchants:type_name -> arian.v1.TopMerchant
+	21, // 13: arian.v1.GetSpendingTrendsRequest.start_date:type_name -> google.type.Date
+	21, // 14: arian.v1.GetSpendingTrendsRequest.end_date:type_name -> google.type.Date
+	27, // 15: arian.v1.GetSpendingTrendsResponse.trends:type_name -> arian.v1.TrendPoint
+	28, // 16: arian.v1.GetFinancialSummaryResponse.total_balance:type_name -> google.type.Money
+	28, // 17: arian.v1.GetFinancialSummaryResponse.total_debt:type_name -> google.type.Money
+	28, // 18: arian.v1.GetFinancialSummaryResponse.net_balance:type_name -> google.type.Money
+	29, // 19: arian.v1.GetCategorySpendingComparisonRequest.period_type:type_name -> arian.v1.PeriodType
+	21, // 20: arian.v1.GetCategorySpendingComparisonRequest.custom_start_date:type_name -> google.type.Date
+	21, // 21: arian.v1.GetCategorySpendingComparisonRequest.custom_end_date:type_name -> google.type.Date
+	30, // 22: arian.v1.CategorySpendingItem.category:type_name -> arian.v1.Category
+	31, // 23: arian.v1.CategorySpendingItem.spending:type_name -> arian.v1.CategorySpendingComparison
+	32, // 24: arian.v1.GetCategorySpendingComparisonResponse.current_period:type_name -> arian.v1.PeriodInfo
+	32, // 25: arian.v1.GetCategorySpendingComparisonResponse.previous_period:type_name -> arian.v1.PeriodInfo
+	15, // 26: arian.v1.GetCategorySpendingComparisonResponse.categories:type_name -> arian.v1.CategorySpendingItem
+	31, // 27: arian.v1.GetCategorySpendingComparisonResponse.uncategorized:type_name -> arian.v1.CategorySpendingComparison
+	33, // 28: arian.v1.GetCategorySpendingComparisonResponse.totals:type_name -> arian.v1.CategorySpendingTotals
+	21, // 29: arian.v1.GetNetWorthHistoryRequest.start_date:type_name -> google.type.Date
+	21, // 30: arian.v1.GetNetWorthHistoryRequest.end_date:type_name -> google.type.Date
+	34, // 31: arian.v1.GetNetWorthHistoryRequest.granularity:type_name -> arian.v1.Granularity
+	35, // 32: arian.v1.GetNetWorthHistoryResponse.data_points:type_name -> arian.v1.NetWorthPoint
+	36, // 33: arian.v1.GetProjectedBalancesResponse.accounts:type_name -> arian.v1.AccountProjection
+	0,  // 34: arian.v1.DashboardService.GetDashboardSummary:input_type -> arian.v1.GetDashboardSummaryRequest
+	2,  // 35: arian.v1.DashboardService.GetMonthlyComparison:input_type -> arian.v1.GetMonthlyComparisonRequest
+	4,  // 36: arian.v1.DashboardService.GetTopCategories:input_type -> arian.v1.GetTopCategoriesRequest
+	6,  // 37: arian.v1.DashboardService.GetTopTags:input_type -> arian.v1.GetTopTagsRequest
+	8,  // 38: arian.v1.DashboardService.GetTopMerchants:input_type -> arian.v1.GetTopMerchantsRequest
+	10, // 39: arian.v1.DashboardService.GetSpendingTrends:input_type -> arian.v1.GetSpendingTrendsRequest
+	12, // 40: arian.v1.DashboardService.GetFinancialSummary:input_type -> arian.v1.GetFinancialSummaryRequest
+	14, // 41: arian.v1.DashboardService.GetCategorySpendingComparison:input_type -> arian.v1.GetCategorySpendingComparisonRequest
+	17, // 42: arian.v1.DashboardService.GetNetWorthHistory:input_type -> arian.v1.GetNetWorthHistoryRequest
+	19, // 43: arian.v1.DashboardService.GetProjectedBalances:input_type -> arian.v1.GetProjectedBalancesRequest
+	1,  // 44: arian.v1.DashboardService.GetDashboardSummary:output_type -> arian.v1.GetDashboardSummaryResponse
+	3,  // 45: arian.v1.DashboardService.GetMonthlyComparison:output_type -> arian.v1.GetMonthlyComparisonResponse
+	5,  // 46: arian.v1.DashboardService.GetTopCategories:output_type -> arian.v1.GetTopCategoriesResponse
+	7,  // 47: arian.v1.DashboardService.GetTopTags:output_type -> arian.v1.GetTopTagsResponse
+	9,  // 48: arian.v1.DashboardService.GetTopMerchants:output_type -> arian.v1.GetTopMerchantsResponse
+	11, // 49: arian.v1.DashboardService.GetSpendingTrends:output_type -> arian.v1.GetSpendingTrendsResponse
+	13, // 50: arian.v1.DashboardService.GetFinancialSummary:output_type -> arian.v1.GetFinancialSummaryResponse
+	16, // 51: arian.v1.DashboardService.GetCategorySpendingComparison:output_type -> arian.v1.GetCategorySpendingComparisonResponse
+	18, // 52: arian.v1.DashboardService.GetNetWorthHistory:output_type -> arian.v1.GetNetWorthHistoryResponse
+	20, // 53: arian.v1.DashboardService.GetProjectedBalances:output_type -> arian.v1.GetProjectedBalancesResponse
+	44, // [44:54] is the sub-list for method output_type
+	34, // [34:44] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_dashboard_services_proto_init() }
@@ -1205,21 +1450,24 @@ func file_arian_v1_dashboard_services_proto_init() {
 	file_arian_v1_category_proto_init()
 	file_arian_v1_dashboard_proto_init()
 	file_arian_v1_enums_proto_init()
+	file_arian_v1_planned_proto_init()
 	file_arian_v1_dashboard_services_proto_msgTypes[0].OneofWrappers = []any{}
 	file_arian_v1_dashboard_services_proto_msgTypes[2].OneofWrappers = []any{}
 	file_arian_v1_dashboard_services_proto_msgTypes[4].OneofWrappers = []any{}
 	file_arian_v1_dashboard_services_proto_msgTypes[6].OneofWrappers = []any{}
 	file_arian_v1_dashboard_services_proto_msgTypes[8].OneofWrappers = []any{}
-	file_arian_v1_dashboard_services_proto_msgTypes[12].OneofWrappers = []any{}
-	file_arian_v1_dashboard_services_proto_msgTypes[13].OneofWrappers = []any{}
+	file_arian_v1_dashboard_services_proto_msgTypes[10].OneofWrappers = []any{}
 	file_arian_v1_dashboard_services_proto_msgTypes[14].OneofWrappers = []any{}
+	file_arian_v1_dashboard_services_proto_msgTypes[15].OneofWrappers = []any{}
+	file_arian_v1_dashboard_services_proto_msgTypes[16].OneofWrappers = []any{}
+	file_arian_v1_dashboard_services_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_dashboard_services_proto_rawDesc), len(file_arian_v1_dashboard_services_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
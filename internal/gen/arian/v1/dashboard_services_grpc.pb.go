@@ -22,11 +22,13 @@ const (
 	DashboardService_GetDashboardSummary_FullMethodName           = "/arian.v1.DashboardService/GetDashboardSummary"
 	DashboardService_GetMonthlyComparison_FullMethodName          = "/arian.v1.DashboardService/GetMonthlyComparison"
 	DashboardService_GetTopCategories_FullMethodName              = "/arian.v1.DashboardService/GetTopCategories"
+	DashboardService_GetTopTags_FullMethodName                    = "/arian.v1.DashboardService/GetTopTags"
 	DashboardService_GetTopMerchants_FullMethodName               = "/arian.v1.DashboardService/GetTopMerchants"
 	DashboardService_GetSpendingTrends_FullMethodName             = "/arian.v1.DashboardService/GetSpendingTrends"
 	DashboardService_GetFinancialSummary_FullMethodName           = "/arian.v1.DashboardService/GetFinancialSummary"
 	DashboardService_GetCategorySpendingComparison_FullMethodName = "/arian.v1.DashboardService/GetCategorySpendingComparison"
 	DashboardService_GetNetWorthHistory_FullMethodName            = "/arian.v1.DashboardService/GetNetWorthHistory"
+	DashboardService_GetProjectedBalances_FullMethodName          = "/arian.v1.DashboardService/GetProjectedBalances"
 )
 
 // DashboardServiceClient is the client API for DashboardService service.
@@ -38,6 +40,7 @@ type DashboardServiceClient interface {
 	GetDashboardSummary(ctx context.Context, in *GetDashboardSummaryRequest, opts ...grpc.CallOption) (*GetDashboardSummaryResponse, error)
 	GetMonthlyComparison(ctx context.Context, in *GetMonthlyComparisonRequest, opts ...grpc.CallOption) (*GetMonthlyComparisonResponse, error)
 	GetTopCategories(ctx context.Context, in *GetTopCategoriesRequest, opts ...grpc.CallOption) (*GetTopCategoriesResponse, error)
+	GetTopTags(ctx context.Context, in *GetTopTagsRequest, opts ...grpc.CallOption) (*GetTopTagsResponse, error)
 	GetTopMerchants(ctx context.Context, in *GetTopMerchantsRequest, opts ...grpc.CallOption) (*GetTopMerchantsResponse, error)
 	GetSpendingTrends(ctx context.Context, in *GetSpendingTrendsRequest, opts ...grpc.CallOption) (*GetSpendingTrendsResponse, error)
 	// combines total balance, debt, and net worth across all accounts
@@ -45,6 +48,8 @@ type DashboardServiceClient interface {
 	// compares category spending between current and previous period
 	GetCategorySpendingComparison(ctx context.Context, in *GetCategorySpendingComparisonRequest, opts ...grpc.CallOption) (*GetCategorySpendingComparisonResponse, error)
 	GetNetWorthHistory(ctx context.Context, in *GetNetWorthHistoryRequest, opts ...grpc.CallOption) (*GetNetWorthHistoryResponse, error)
+	// projected end-of-day balance per account including planned transactions
+	GetProjectedBalances(ctx context.Context, in *GetProjectedBalancesRequest, opts ...grpc.CallOption) (*GetProjectedBalancesResponse, error)
 }
 
 type dashboardServiceClient struct {
@@ -85,6 +90,16 @@ func (c *dashboardServiceClient) GetTopCategories(ctx context.Context, in *GetTo
 	return out, nil
 }
 
+func (c *dashboardServiceClient) GetTopTags(ctx context.Context, in *GetTopTagsRequest, opts ...grpc.CallOption) (*GetTopTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopTagsResponse)
+	err := c.cc.Invoke(ctx, DashboardService_GetTopTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dashboardServiceClient) GetTopMerchants(ctx context.Context, in *GetTopMerchantsRequest, opts ...grpc.CallOption) (*GetTopMerchantsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetTopMerchantsResponse)
@@ -135,6 +150,16 @@ func (c *dashboardServiceClient) GetNetWorthHistory(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *dashboardServiceClient) GetProjectedBalances(ctx context.Context, in *GetProjectedBalancesRequest, opts ...grpc.CallOption) (*GetProjectedBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectedBalancesResponse)
+	err := c.cc.Invoke(ctx, DashboardService_GetProjectedBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DashboardServiceServer is the server API for DashboardService service.
 // All implementations must embed UnimplementedDashboardServiceServer
 // for forward compatibility.
@@ -144,6 +169,7 @@ type DashboardServiceServer interface {
 	GetDashboardSummary(context.Context, *GetDashboardSummaryRequest) (*GetDashboardSummaryResponse, error)
 	GetMonthlyComparison(context.Context, *GetMonthlyComparisonRequest) (*GetMonthlyComparisonResponse, error)
 	GetTopCategories(context.Context, *GetTopCategoriesRequest) (*GetTopCategoriesResponse, error)
+	GetTopTags(context.Context, *GetTopTagsRequest) (*GetTopTagsResponse, error)
 	GetTopMerchants(context.Context, *GetTopMerchantsRequest) (*GetTopMerchantsResponse, error)
 	GetSpendingTrends(context.Context, *GetSpendingTrendsRequest) (*GetSpendingTrendsResponse, error)
 	// combines total balance, debt, and net worth across all accounts
@@ -151,6 +177,8 @@ type DashboardServiceServer interface {
 	// compares category spending between current and previous period
 	GetCategorySpendingComparison(context.Context, *GetCategorySpendingComparisonRequest) (*GetCategorySpendingComparisonResponse, error)
 	GetNetWorthHistory(context.Context, *GetNetWorthHistoryRequest) (*GetNetWorthHistoryResponse, error)
+	// projected end-of-day balance per account including planned transactions
+	GetProjectedBalances(context.Context, *GetProjectedBalancesRequest) (*GetProjectedBalancesResponse, error)
 	mustEmbedUnimplementedDashboardServiceServer()
 }
 
@@ -170,6 +198,9 @@ func (UnimplementedDashboardServiceServer) GetMonthlyComparison(context.Context,
 func (UnimplementedDashboardServiceServer) GetTopCategories(context.Context, *GetTopCategoriesRequest) (*GetTopCategoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopCategories not implemented")
 }
+func (UnimplementedDashboardServiceServer) GetTopTags(context.Context, *GetTopTagsRequest) (*GetTopTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopTags not implemented")
+}
 func (UnimplementedDashboardServiceServer) GetTopMerchants(context.Context, *GetTopMerchantsRequest) (*GetTopMerchantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopMerchants not implemented")
 }
@@ -185,6 +216,9 @@ func (UnimplementedDashboardServiceServer) GetCategorySpendingComparison(context
 func (UnimplementedDashboardServiceServer) GetNetWorthHistory(context.Context, *GetNetWorthHistoryRequest) (*GetNetWorthHistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetWorthHistory not implemented")
 }
+func (UnimplementedDashboardServiceServer) GetProjectedBalances(context.Context, *GetProjectedBalancesRequest) (*GetProjectedBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectedBalances not implemented")
+}
 func (UnimplementedDashboardServiceServer) mustEmbedUnimplementedDashboardServiceServer() {}
 func (UnimplementedDashboardServiceServer) testEmbeddedByValue()                          {}
 
@@ -260,6 +294,24 @@ func _DashboardService_GetTopCategories_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DashboardService_GetTopTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServiceServer).GetTopTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardService_GetTopTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServiceServer).GetTopTags(ctx, req.(*GetTopTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DashboardService_GetTopMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTopMerchantsRequest)
 	if err := dec(in); err != nil {
@@ -350,6 +402,24 @@ func _DashboardService_GetNetWorthHistory_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DashboardService_GetProjectedBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectedBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServiceServer).GetProjectedBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DashboardService_GetProjectedBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServiceServer).GetProjectedBalances(ctx, req.(*GetProjectedBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DashboardService_ServiceDesc is the grpc.ServiceDesc for DashboardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +439,10 @@ var DashboardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopCategories",
 			Handler:    _DashboardService_GetTopCategories_Handler,
 		},
+		{
+			MethodName: "GetTopTags",
+			Handler:    _DashboardService_GetTopTags_Handler,
+		},
 		{
 			MethodName: "GetTopMerchants",
 			Handler:    _DashboardService_GetTopMerchants_Handler,
@@ -389,6 +463,10 @@ var DashboardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetWorthHistory",
 			Handler:    _DashboardService_GetNetWorthHistory_Handler,
 		},
+		{
+			MethodName: "GetProjectedBalances",
+			Handler:    _DashboardService_GetProjectedBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arian/v1/dashboard_services.proto",
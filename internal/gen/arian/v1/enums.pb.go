@@ -247,6 +247,685 @@ func (Granularity) EnumDescriptor() ([]byte, []int) {
 	return file_arian_v1_enums_proto_rawDescGZIP(), []int{3}
 }
 
+type StatementFormat int32
+
+const (
+	StatementFormat_STATEMENT_FORMAT_UNSPECIFIED StatementFormat = 0
+	StatementFormat_STATEMENT_FORMAT_CSV         StatementFormat = 1
+	StatementFormat_STATEMENT_FORMAT_OFX         StatementFormat = 2
+	StatementFormat_STATEMENT_FORMAT_QFX         StatementFormat = 3
+	StatementFormat_STATEMENT_FORMAT_QIF         StatementFormat = 4
+)
+
+// Enum value maps for StatementFormat.
+var (
+	StatementFormat_name = map[int32]string{
+		0: "STATEMENT_FORMAT_UNSPECIFIED",
+		1: "STATEMENT_FORMAT_CSV",
+		2: "STATEMENT_FORMAT_OFX",
+		3: "STATEMENT_FORMAT_QFX",
+		4: "STATEMENT_FORMAT_QIF",
+	}
+	StatementFormat_value = map[string]int32{
+		"STATEMENT_FORMAT_UNSPECIFIED": 0,
+		"STATEMENT_FORMAT_CSV":         1,
+		"STATEMENT_FORMAT_OFX":         2,
+		"STATEMENT_FORMAT_QFX":         3,
+		"STATEMENT_FORMAT_QIF":         4,
+	}
+)
+
+func (x StatementFormat) Enum() *StatementFormat {
+	p := new(StatementFormat)
+	*p = x
+	return p
+}
+
+func (x StatementFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[4].Descriptor()
+}
+
+func (StatementFormat) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[4]
+}
+
+func (x StatementFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementFormat.Descriptor instead.
+func (StatementFormat) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{4}
+}
+
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_STATUS_NEW         ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE   ImportRowStatus = 2
+	ImportRowStatus_IMPORT_ROW_STATUS_INVALID     ImportRowStatus = 3
+	ImportRowStatus_IMPORT_ROW_STATUS_CREATED     ImportRowStatus = 4
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_NEW",
+		2: "IMPORT_ROW_STATUS_DUPLICATE",
+		3: "IMPORT_ROW_STATUS_INVALID",
+		4: "IMPORT_ROW_STATUS_CREATED",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_NEW":         1,
+		"IMPORT_ROW_STATUS_DUPLICATE":   2,
+		"IMPORT_ROW_STATUS_INVALID":     3,
+		"IMPORT_ROW_STATUS_CREATED":     4,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[5].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[5]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{5}
+}
+
+type CreateTransactionStatus int32
+
+const (
+	CreateTransactionStatus_CREATE_TRANSACTION_STATUS_UNSPECIFIED CreateTransactionStatus = 0
+	CreateTransactionStatus_CREATE_TRANSACTION_STATUS_CREATED     CreateTransactionStatus = 1
+	CreateTransactionStatus_CREATE_TRANSACTION_STATUS_DUPLICATE   CreateTransactionStatus = 2
+	CreateTransactionStatus_CREATE_TRANSACTION_STATUS_INVALID     CreateTransactionStatus = 3
+	// a pending transaction was settled with the posted details
+	CreateTransactionStatus_CREATE_TRANSACTION_STATUS_REPLACED_PENDING CreateTransactionStatus = 4
+	// the exchange rate could not be looked up, retrying later may succeed
+	CreateTransactionStatus_CREATE_TRANSACTION_STATUS_RATE_UNAVAILABLE CreateTransactionStatus = 5
+)
+
+// Enum value maps for CreateTransactionStatus.
+var (
+	CreateTransactionStatus_name = map[int32]string{
+		0: "CREATE_TRANSACTION_STATUS_UNSPECIFIED",
+		1: "CREATE_TRANSACTION_STATUS_CREATED",
+		2: "CREATE_TRANSACTION_STATUS_DUPLICATE",
+		3: "CREATE_TRANSACTION_STATUS_INVALID",
+		4: "CREATE_TRANSACTION_STATUS_REPLACED_PENDING",
+		5: "CREATE_TRANSACTION_STATUS_RATE_UNAVAILABLE",
+	}
+	CreateTransactionStatus_value = map[string]int32{
+		"CREATE_TRANSACTION_STATUS_UNSPECIFIED":      0,
+		"CREATE_TRANSACTION_STATUS_CREATED":          1,
+		"CREATE_TRANSACTION_STATUS_DUPLICATE":        2,
+		"CREATE_TRANSACTION_STATUS_INVALID":          3,
+		"CREATE_TRANSACTION_STATUS_REPLACED_PENDING": 4,
+		"CREATE_TRANSACTION_STATUS_RATE_UNAVAILABLE": 5,
+	}
+)
+
+func (x CreateTransactionStatus) Enum() *CreateTransactionStatus {
+	p := new(CreateTransactionStatus)
+	*p = x
+	return p
+}
+
+func (x CreateTransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CreateTransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[6].Descriptor()
+}
+
+func (CreateTransactionStatus) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[6]
+}
+
+func (x CreateTransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CreateTransactionStatus.Descriptor instead.
+func (CreateTransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{6}
+}
+
+type ReceiptMatchStatus int32
+
+const (
+	ReceiptMatchStatus_RECEIPT_MATCH_STATUS_UNSPECIFIED ReceiptMatchStatus = 0
+	ReceiptMatchStatus_RECEIPT_MATCH_STATUS_NONE        ReceiptMatchStatus = 1
+	ReceiptMatchStatus_RECEIPT_MATCH_STATUS_SUGGESTED   ReceiptMatchStatus = 2
+	ReceiptMatchStatus_RECEIPT_MATCH_STATUS_CONFIRMED   ReceiptMatchStatus = 3
+	ReceiptMatchStatus_RECEIPT_MATCH_STATUS_REJECTED    ReceiptMatchStatus = 4
+)
+
+// Enum value maps for ReceiptMatchStatus.
+var (
+	ReceiptMatchStatus_name = map[int32]string{
+		0: "RECEIPT_MATCH_STATUS_UNSPECIFIED",
+		1: "RECEIPT_MATCH_STATUS_NONE",
+		2: "RECEIPT_MATCH_STATUS_SUGGESTED",
+		3: "RECEIPT_MATCH_STATUS_CONFIRMED",
+		4: "RECEIPT_MATCH_STATUS_REJECTED",
+	}
+	ReceiptMatchStatus_value = map[string]int32{
+		"RECEIPT_MATCH_STATUS_UNSPECIFIED": 0,
+		"RECEIPT_MATCH_STATUS_NONE":        1,
+		"RECEIPT_MATCH_STATUS_SUGGESTED":   2,
+		"RECEIPT_MATCH_STATUS_CONFIRMED":   3,
+		"RECEIPT_MATCH_STATUS_REJECTED":    4,
+	}
+)
+
+func (x ReceiptMatchStatus) Enum() *ReceiptMatchStatus {
+	p := new(ReceiptMatchStatus)
+	*p = x
+	return p
+}
+
+func (x ReceiptMatchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReceiptMatchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[7].Descriptor()
+}
+
+func (ReceiptMatchStatus) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[7]
+}
+
+func (x ReceiptMatchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptMatchStatus.Descriptor instead.
+func (ReceiptMatchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{7}
+}
+
+type ChangeActorType int32
+
+const (
+	ChangeActorType_CHANGE_ACTOR_TYPE_UNSPECIFIED      ChangeActorType = 0
+	ChangeActorType_CHANGE_ACTOR_TYPE_USER             ChangeActorType = 1
+	ChangeActorType_CHANGE_ACTOR_TYPE_INTERNAL_SERVICE ChangeActorType = 2 // requests authenticated with the internal key, e.g. the email parser
+	ChangeActorType_CHANGE_ACTOR_TYPE_RULE             ChangeActorType = 3
+)
+
+// Enum value maps for ChangeActorType.
+var (
+	ChangeActorType_name = map[int32]string{
+		0: "CHANGE_ACTOR_TYPE_UNSPECIFIED",
+		1: "CHANGE_ACTOR_TYPE_USER",
+		2: "CHANGE_ACTOR_TYPE_INTERNAL_SERVICE",
+		3: "CHANGE_ACTOR_TYPE_RULE",
+	}
+	ChangeActorType_value = map[string]int32{
+		"CHANGE_ACTOR_TYPE_UNSPECIFIED":      0,
+		"CHANGE_ACTOR_TYPE_USER":             1,
+		"CHANGE_ACTOR_TYPE_INTERNAL_SERVICE": 2,
+		"CHANGE_ACTOR_TYPE_RULE":             3,
+	}
+)
+
+func (x ChangeActorType) Enum() *ChangeActorType {
+	p := new(ChangeActorType)
+	*p = x
+	return p
+}
+
+func (x ChangeActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[8].Descriptor()
+}
+
+func (ChangeActorType) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[8]
+}
+
+func (x ChangeActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeActorType.Descriptor instead.
+func (ChangeActorType) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{8}
+}
+
+type RecurringCadence int32
+
+const (
+	RecurringCadence_RECURRING_CADENCE_UNSPECIFIED RecurringCadence = 0
+	RecurringCadence_RECURRING_CADENCE_WEEKLY      RecurringCadence = 1
+	RecurringCadence_RECURRING_CADENCE_BIWEEKLY    RecurringCadence = 2
+	RecurringCadence_RECURRING_CADENCE_MONTHLY     RecurringCadence = 3
+	RecurringCadence_RECURRING_CADENCE_QUARTERLY   RecurringCadence = 4
+	RecurringCadence_RECURRING_CADENCE_ANNUAL      RecurringCadence = 5
+)
+
+// Enum value maps for RecurringCadence.
+var (
+	RecurringCadence_name = map[int32]string{
+		0: "RECURRING_CADENCE_UNSPECIFIED",
+		1: "RECURRING_CADENCE_WEEKLY",
+		2: "RECURRING_CADENCE_BIWEEKLY",
+		3: "RECURRING_CADENCE_MONTHLY",
+		4: "RECURRING_CADENCE_QUARTERLY",
+		5: "RECURRING_CADENCE_ANNUAL",
+	}
+	RecurringCadence_value = map[string]int32{
+		"RECURRING_CADENCE_UNSPECIFIED": 0,
+		"RECURRING_CADENCE_WEEKLY":      1,
+		"RECURRING_CADENCE_BIWEEKLY":    2,
+		"RECURRING_CADENCE_MONTHLY":     3,
+		"RECURRING_CADENCE_QUARTERLY":   4,
+		"RECURRING_CADENCE_ANNUAL":      5,
+	}
+)
+
+func (x RecurringCadence) Enum() *RecurringCadence {
+	p := new(RecurringCadence)
+	*p = x
+	return p
+}
+
+func (x RecurringCadence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurringCadence) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[9].Descriptor()
+}
+
+func (RecurringCadence) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[9]
+}
+
+func (x RecurringCadence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurringCadence.Descriptor instead.
+func (RecurringCadence) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{9}
+}
+
+type RecurringStatus int32
+
+const (
+	RecurringStatus_RECURRING_STATUS_UNSPECIFIED RecurringStatus = 0
+	RecurringStatus_RECURRING_STATUS_SUGGESTED   RecurringStatus = 1
+	RecurringStatus_RECURRING_STATUS_CONFIRMED   RecurringStatus = 2
+	RecurringStatus_RECURRING_STATUS_DISMISSED   RecurringStatus = 3
+)
+
+// Enum value maps for RecurringStatus.
+var (
+	RecurringStatus_name = map[int32]string{
+		0: "RECURRING_STATUS_UNSPECIFIED",
+		1: "RECURRING_STATUS_SUGGESTED",
+		2: "RECURRING_STATUS_CONFIRMED",
+		3: "RECURRING_STATUS_DISMISSED",
+	}
+	RecurringStatus_value = map[string]int32{
+		"RECURRING_STATUS_UNSPECIFIED": 0,
+		"RECURRING_STATUS_SUGGESTED":   1,
+		"RECURRING_STATUS_CONFIRMED":   2,
+		"RECURRING_STATUS_DISMISSED":   3,
+	}
+)
+
+func (x RecurringStatus) Enum() *RecurringStatus {
+	p := new(RecurringStatus)
+	*p = x
+	return p
+}
+
+func (x RecurringStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurringStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[10].Descriptor()
+}
+
+func (RecurringStatus) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[10]
+}
+
+func (x RecurringStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurringStatus.Descriptor instead.
+func (RecurringStatus) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{10}
+}
+
+type TransactionStatus int32
+
+const (
+	TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED TransactionStatus = 0
+	TransactionStatus_TRANSACTION_STATUS_PENDING     TransactionStatus = 1
+	TransactionStatus_TRANSACTION_STATUS_POSTED      TransactionStatus = 2
+	TransactionStatus_TRANSACTION_STATUS_VOIDED      TransactionStatus = 3
+)
+
+// Enum value maps for TransactionStatus.
+var (
+	TransactionStatus_name = map[int32]string{
+		0: "TRANSACTION_STATUS_UNSPECIFIED",
+		1: "TRANSACTION_STATUS_PENDING",
+		2: "TRANSACTION_STATUS_POSTED",
+		3: "TRANSACTION_STATUS_VOIDED",
+	}
+	TransactionStatus_value = map[string]int32{
+		"TRANSACTION_STATUS_UNSPECIFIED": 0,
+		"TRANSACTION_STATUS_PENDING":     1,
+		"TRANSACTION_STATUS_POSTED":      2,
+		"TRANSACTION_STATUS_VOIDED":      3,
+	}
+)
+
+func (x TransactionStatus) Enum() *TransactionStatus {
+	p := new(TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[11].Descriptor()
+}
+
+func (TransactionStatus) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[11]
+}
+
+func (x TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionStatus.Descriptor instead.
+func (TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{11}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_PROTOBUF    ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_NDJSON      ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_PROTOBUF",
+		2: "EXPORT_FORMAT_NDJSON",
+		3: "EXPORT_FORMAT_CSV",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_PROTOBUF":    1,
+		"EXPORT_FORMAT_NDJSON":      2,
+		"EXPORT_FORMAT_CSV":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[12].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[12]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{12}
+}
+
+// dates are bucketed in the user's timezone, weeks start on Monday
+type AggregateDimension int32
+
+const (
+	AggregateDimension_AGGREGATE_DIMENSION_UNSPECIFIED AggregateDimension = 0
+	AggregateDimension_AGGREGATE_DIMENSION_CATEGORY    AggregateDimension = 1
+	// the category one level up, top-level categories are their own parent
+	AggregateDimension_AGGREGATE_DIMENSION_PARENT_CATEGORY AggregateDimension = 2
+	AggregateDimension_AGGREGATE_DIMENSION_MERCHANT        AggregateDimension = 3
+	AggregateDimension_AGGREGATE_DIMENSION_ACCOUNT         AggregateDimension = 4
+	AggregateDimension_AGGREGATE_DIMENSION_TAG             AggregateDimension = 5
+	AggregateDimension_AGGREGATE_DIMENSION_DIRECTION       AggregateDimension = 6
+	AggregateDimension_AGGREGATE_DIMENSION_DAY             AggregateDimension = 7
+	AggregateDimension_AGGREGATE_DIMENSION_WEEK            AggregateDimension = 8
+	AggregateDimension_AGGREGATE_DIMENSION_MONTH           AggregateDimension = 9
+	AggregateDimension_AGGREGATE_DIMENSION_YEAR            AggregateDimension = 10
+)
+
+// Enum value maps for AggregateDimension.
+var (
+	AggregateDimension_name = map[int32]string{
+		0:  "AGGREGATE_DIMENSION_UNSPECIFIED",
+		1:  "AGGREGATE_DIMENSION_CATEGORY",
+		2:  "AGGREGATE_DIMENSION_PARENT_CATEGORY",
+		3:  "AGGREGATE_DIMENSION_MERCHANT",
+		4:  "AGGREGATE_DIMENSION_ACCOUNT",
+		5:  "AGGREGATE_DIMENSION_TAG",
+		6:  "AGGREGATE_DIMENSION_DIRECTION",
+		7:  "AGGREGATE_DIMENSION_DAY",
+		8:  "AGGREGATE_DIMENSION_WEEK",
+		9:  "AGGREGATE_DIMENSION_MONTH",
+		10: "AGGREGATE_DIMENSION_YEAR",
+	}
+	AggregateDimension_value = map[string]int32{
+		"AGGREGATE_DIMENSION_UNSPECIFIED":     0,
+		"AGGREGATE_DIMENSION_CATEGORY":        1,
+		"AGGREGATE_DIMENSION_PARENT_CATEGORY": 2,
+		"AGGREGATE_DIMENSION_MERCHANT":        3,
+		"AGGREGATE_DIMENSION_ACCOUNT":         4,
+		"AGGREGATE_DIMENSION_TAG":             5,
+		"AGGREGATE_DIMENSION_DIRECTION":       6,
+		"AGGREGATE_DIMENSION_DAY":             7,
+		"AGGREGATE_DIMENSION_WEEK":            8,
+		"AGGREGATE_DIMENSION_MONTH":           9,
+		"AGGREGATE_DIMENSION_YEAR":            10,
+	}
+)
+
+func (x AggregateDimension) Enum() *AggregateDimension {
+	p := new(AggregateDimension)
+	*p = x
+	return p
+}
+
+func (x AggregateDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[13].Descriptor()
+}
+
+func (AggregateDimension) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[13]
+}
+
+func (x AggregateDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateDimension.Descriptor instead.
+func (AggregateDimension) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{13}
+}
+
+type AggregateMeasure int32
+
+const (
+	AggregateMeasure_AGGREGATE_MEASURE_UNSPECIFIED AggregateMeasure = 0
+	AggregateMeasure_AGGREGATE_MEASURE_SUM         AggregateMeasure = 1
+	AggregateMeasure_AGGREGATE_MEASURE_COUNT       AggregateMeasure = 2
+	AggregateMeasure_AGGREGATE_MEASURE_AVG         AggregateMeasure = 3
+	AggregateMeasure_AGGREGATE_MEASURE_MIN         AggregateMeasure = 4
+	AggregateMeasure_AGGREGATE_MEASURE_MAX         AggregateMeasure = 5
+	AggregateMeasure_AGGREGATE_MEASURE_MEDIAN      AggregateMeasure = 6
+)
+
+// Enum value maps for AggregateMeasure.
+var (
+	AggregateMeasure_name = map[int32]string{
+		0: "AGGREGATE_MEASURE_UNSPECIFIED",
+		1: "AGGREGATE_MEASURE_SUM",
+		2: "AGGREGATE_MEASURE_COUNT",
+		3: "AGGREGATE_MEASURE_AVG",
+		4: "AGGREGATE_MEASURE_MIN",
+		5: "AGGREGATE_MEASURE_MAX",
+		6: "AGGREGATE_MEASURE_MEDIAN",
+	}
+	AggregateMeasure_value = map[string]int32{
+		"AGGREGATE_MEASURE_UNSPECIFIED": 0,
+		"AGGREGATE_MEASURE_SUM":         1,
+		"AGGREGATE_MEASURE_COUNT":       2,
+		"AGGREGATE_MEASURE_AVG":         3,
+		"AGGREGATE_MEASURE_MIN":         4,
+		"AGGREGATE_MEASURE_MAX":         5,
+		"AGGREGATE_MEASURE_MEDIAN":      6,
+	}
+)
+
+func (x AggregateMeasure) Enum() *AggregateMeasure {
+	p := new(AggregateMeasure)
+	*p = x
+	return p
+}
+
+func (x AggregateMeasure) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateMeasure) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[14].Descriptor()
+}
+
+func (AggregateMeasure) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[14]
+}
+
+func (x AggregateMeasure) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateMeasure.Descriptor instead.
+func (AggregateMeasure) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{14}
+}
+
+type SharedSplitMethod int32
+
+const (
+	SharedSplitMethod_SHARED_SPLIT_METHOD_UNSPECIFIED SharedSplitMethod = 0
+	SharedSplitMethod_SHARED_SPLIT_METHOD_PERCENTAGE  SharedSplitMethod = 1
+	SharedSplitMethod_SHARED_SPLIT_METHOD_SHARES      SharedSplitMethod = 2
+	SharedSplitMethod_SHARED_SPLIT_METHOD_EXACT       SharedSplitMethod = 3
+)
+
+// Enum value maps for SharedSplitMethod.
+var (
+	SharedSplitMethod_name = map[int32]string{
+		0: "SHARED_SPLIT_METHOD_UNSPECIFIED",
+		1: "SHARED_SPLIT_METHOD_PERCENTAGE",
+		2: "SHARED_SPLIT_METHOD_SHARES",
+		3: "SHARED_SPLIT_METHOD_EXACT",
+	}
+	SharedSplitMethod_value = map[string]int32{
+		"SHARED_SPLIT_METHOD_UNSPECIFIED": 0,
+		"SHARED_SPLIT_METHOD_PERCENTAGE":  1,
+		"SHARED_SPLIT_METHOD_SHARES":      2,
+		"SHARED_SPLIT_METHOD_EXACT":       3,
+	}
+)
+
+func (x SharedSplitMethod) Enum() *SharedSplitMethod {
+	p := new(SharedSplitMethod)
+	*p = x
+	return p
+}
+
+func (x SharedSplitMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SharedSplitMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_arian_v1_enums_proto_enumTypes[15].Descriptor()
+}
+
+func (SharedSplitMethod) Type() protoreflect.EnumType {
+	return &file_arian_v1_enums_proto_enumTypes[15]
+}
+
+func (x SharedSplitMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SharedSplitMethod.Descriptor instead.
+func (SharedSplitMethod) EnumDescriptor() ([]byte, []int) {
+	return file_arian_v1_enums_proto_rawDescGZIP(), []int{15}
+}
+
 var File_arian_v1_enums_proto protoreflect.FileDescriptor
 
 const file_arian_v1_enums_proto_rawDesc = "" +
@@ -278,7 +957,85 @@ const file_arian_v1_enums_proto_rawDesc = "" +
 	"\x17GRANULARITY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fGRANULARITY_DAY\x10\x01\x12\x14\n" +
 	"\x10GRANULARITY_WEEK\x10\x02\x12\x15\n" +
-	"\x11GRANULARITY_MONTH\x10\x03B\x81\x01\n" +
+	"\x11GRANULARITY_MONTH\x10\x03*\x9b\x01\n" +
+	"\x0fStatementFormat\x12 \n" +
+	"\x1cSTATEMENT_FORMAT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_OFX\x10\x02\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_QFX\x10\x03\x12\x18\n" +
+	"\x14STATEMENT_FORMAT_QIF\x10\x04*\xae\x01\n" +
+	"\x0fImportRowStatus\x12!\n" +
+	"\x1dIMPORT_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15IMPORT_ROW_STATUS_NEW\x10\x01\x12\x1f\n" +
+	"\x1bIMPORT_ROW_STATUS_DUPLICATE\x10\x02\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_INVALID\x10\x03\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_CREATED\x10\x04*\x9b\x02\n" +
+	"\x17CreateTransactionStatus\x12)\n" +
+	"%CREATE_TRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12%\n" +
+	"!CREATE_TRANSACTION_STATUS_CREATED\x10\x01\x12'\n" +
+	"#CREATE_TRANSACTION_STATUS_DUPLICATE\x10\x02\x12%\n" +
+	"!CREATE_TRANSACTION_STATUS_INVALID\x10\x03\x12.\n" +
+	"*CREATE_TRANSACTION_STATUS_REPLACED_PENDING\x10\x04\x12.\n" +
+	"*CREATE_TRANSACTION_STATUS_RATE_UNAVAILABLE\x10\x05*\xc4\x01\n" +
+	"\x12ReceiptMatchStatus\x12$\n" +
+	" RECEIPT_MATCH_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19RECEIPT_MATCH_STATUS_NONE\x10\x01\x12\"\n" +
+	"\x1eRECEIPT_MATCH_STATUS_SUGGESTED\x10\x02\x12\"\n" +
+	"\x1eRECEIPT_MATCH_STATUS_CONFIRMED\x10\x03\x12!\n" +
+	"\x1dRECEIPT_MATCH_STATUS_REJECTED\x10\x04*\x94\x01\n" +
+	"\x0fChangeActorType\x12!\n" +
+	"\x1dCHANGE_ACTOR_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CHANGE_ACTOR_TYPE_USER\x10\x01\x12&\n" +
+	"\"CHANGE_ACTOR_TYPE_INTERNAL_SERVICE\x10\x02\x12\x1a\n" +
+	"\x16CHANGE_ACTOR_TYPE_RULE\x10\x03*\xd1\x01\n" +
+	"\x10RecurringCadence\x12!\n" +
+	"\x1dRECURRING_CADENCE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RECURRING_CADENCE_WEEKLY\x10\x01\x12\x1e\n" +
+	"\x1aRECURRING_CADENCE_BIWEEKLY\x10\x02\x12\x1d\n" +
+	"\x19RECURRING_CADENCE_MONTHLY\x10\x03\x12\x1f\n" +
+	"\x1bRECURRING_CADENCE_QUARTERLY\x10\x04\x12\x1c\n" +
+	"\x18RECURRING_CADENCE_ANNUAL\x10\x05*\x93\x01\n" +
+	"\x0fRecurringStatus\x12 \n" +
+	"\x1cRECURRING_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRING_STATUS_SUGGESTED\x10\x01\x12\x1e\n" +
+	"\x1aRECURRING_STATUS_CONFIRMED\x10\x02\x12\x1e\n" +
+	"\x1aRECURRING_STATUS_DISMISSED\x10\x03*\x95\x01\n" +
+	"\x11TransactionStatus\x12\"\n" +
+	"\x1eTRANSACTION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTRANSACTION_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_POSTED\x10\x02\x12\x1d\n" +
+	"\x19TRANSACTION_STATUS_VOIDED\x10\x03*z\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16EXPORT_FORMAT_PROTOBUF\x10\x01\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x02\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x03*\xff\x02\n" +
+	"\x12AggregateDimension\x12#\n" +
+	"\x1fAGGREGATE_DIMENSION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cAGGREGATE_DIMENSION_CATEGORY\x10\x01\x12'\n" +
+	"#AGGREGATE_DIMENSION_PARENT_CATEGORY\x10\x02\x12 \n" +
+	"\x1cAGGREGATE_DIMENSION_MERCHANT\x10\x03\x12\x1f\n" +
+	"\x1bAGGREGATE_DIMENSION_ACCOUNT\x10\x04\x12\x1b\n" +
+	"\x17AGGREGATE_DIMENSION_TAG\x10\x05\x12!\n" +
+	"\x1dAGGREGATE_DIMENSION_DIRECTION\x10\x06\x12\x1b\n" +
+	"\x17AGGREGATE_DIMENSION_DAY\x10\a\x12\x1c\n" +
+	"\x18AGGREGATE_DIMENSION_WEEK\x10\b\x12\x1d\n" +
+	"\x19AGGREGATE_DIMENSION_MONTH\x10\t\x12\x1c\n" +
+	"\x18AGGREGATE_DIMENSION_YEAR\x10\n" +
+	"*\xdc\x01\n" +
+	"\x10AggregateMeasure\x12!\n" +
+	"\x1dAGGREGATE_MEASURE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15AGGREGATE_MEASURE_SUM\x10\x01\x12\x1b\n" +
+	"\x17AGGREGATE_MEASURE_COUNT\x10\x02\x12\x19\n" +
+	"\x15AGGREGATE_MEASURE_AVG\x10\x03\x12\x19\n" +
+	"\x15AGGREGATE_MEASURE_MIN\x10\x04\x12\x19\n" +
+	"\x15AGGREGATE_MEASURE_MAX\x10\x05\x12\x1c\n" +
+	"\x18AGGREGATE_MEASURE_MEDIAN\x10\x06*\x9b\x01\n" +
+	"\x11SharedSplitMethod\x12#\n" +
+	"\x1fSHARED_SPLIT_METHOD_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eSHARED_SPLIT_METHOD_PERCENTAGE\x10\x01\x12\x1e\n" +
+	"\x1aSHARED_SPLIT_METHOD_SHARES\x10\x02\x12\x1d\n" +
+	"\x19SHARED_SPLIT_METHOD_EXACT\x10\x03B\x81\x01\n" +
 	"\fcom.arian.v1B\n" +
 	"EnumsProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
 
@@ -294,12 +1051,24 @@ func file_arian_v1_enums_proto_rawDescGZIP() []byte {
 	return file_arian_v1_enums_proto_rawDescData
 }
 
-var file_arian_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_arian_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 16)
 var file_arian_v1_enums_proto_goTypes = []any{
-	(AccountType)(0),          // 0: arian.v1.AccountType
-	(TransactionDirection)(0), // 1: arian.v1.TransactionDirection
-	(PeriodType)(0),           // 2: arian.v1.PeriodType
-	(Granularity)(0),          // 3: arian.v1.Granularity
+	(AccountType)(0),             // 0: arian.v1.AccountType
+	(TransactionDirection)(0),    // 1: arian.v1.TransactionDirection
+	(PeriodType)(0),              // 2: arian.v1.PeriodType
+	(Granularity)(0),             // 3: arian.v1.Granularity
+	(StatementFormat)(0),         // 4: arian.v1.StatementFormat
+	(ImportRowStatus)(0),         // 5: arian.v1.ImportRowStatus
+	(CreateTransactionStatus)(0), // 6: arian.v1.CreateTransactionStatus
+	(ReceiptMatchStatus)(0),      // 7: arian.v1.ReceiptMatchStatus
+	(ChangeActorType)(0),         // 8: arian.v1.ChangeActorType
+	(RecurringCadence)(0),        // 9: arian.v1.RecurringCadence
+	(RecurringStatus)(0),         // 10: arian.v1.RecurringStatus
+	(TransactionStatus)(0),       // 11: arian.v1.TransactionStatus
+	(ExportFormat)(0),            // 12: arian.v1.ExportFormat
+	(AggregateDimension)(0),      // 13: arian.v1.AggregateDimension
+	(AggregateMeasure)(0),        // 14: arian.v1.AggregateMeasure
+	(SharedSplitMethod)(0),       // 15: arian.v1.SharedSplitMethod
 }
 var file_arian_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -319,7 +1088,7 @@ func file_arian_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_enums_proto_rawDesc), len(file_arian_v1_enums_proto_rawDesc)),
-			NumEnums:      4,
+			NumEnums:      16,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
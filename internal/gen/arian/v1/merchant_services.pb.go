@@ -0,0 +1,996 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/merchant_services.proto
+
+package arianv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListMerchantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantsRequest) Reset() {
+	*x = ListMerchantsRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantsRequest) ProtoMessage() {}
+
+func (x *ListMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*ListMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListMerchantsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListMerchantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchants     []*Merchant            `protobuf:"bytes,1,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantsResponse) Reset() {
+	*x = ListMerchantsResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantsResponse) ProtoMessage() {}
+
+func (x *ListMerchantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantsResponse.ProtoReflect.Descriptor instead.
+func (*ListMerchantsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMerchantsResponse) GetMerchants() []*Merchant {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+type GetMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantRequest) Reset() {
+	*x = GetMerchantRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantRequest) ProtoMessage() {}
+
+func (x *GetMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantRequest.ProtoReflect.Descriptor instead.
+func (*GetMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMerchantRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetMerchantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *Merchant              `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMerchantResponse) Reset() {
+	*x = GetMerchantResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMerchantResponse) ProtoMessage() {}
+
+func (x *GetMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMerchantResponse.ProtoReflect.Descriptor instead.
+func (*GetMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMerchantResponse) GetMerchant() *Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+// the name is added as an alias too
+type CreateMerchantRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases           []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	DefaultCategoryId *int64                 `protobuf:"varint,4,opt,name=default_category_id,json=defaultCategoryId,proto3,oneof" json:"default_category_id,omitempty"`
+	WebsiteUrl        *string                `protobuf:"bytes,5,opt,name=website_url,json=websiteUrl,proto3,oneof" json:"website_url,omitempty"`
+	LogoUrl           *string                `protobuf:"bytes,6,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateMerchantRequest) Reset() {
+	*x = CreateMerchantRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMerchantRequest) ProtoMessage() {}
+
+func (x *CreateMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMerchantRequest.ProtoReflect.Descriptor instead.
+func (*CreateMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateMerchantRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateMerchantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMerchantRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *CreateMerchantRequest) GetDefaultCategoryId() int64 {
+	if x != nil && x.DefaultCategoryId != nil {
+		return *x.DefaultCategoryId
+	}
+	return 0
+}
+
+func (x *CreateMerchantRequest) GetWebsiteUrl() string {
+	if x != nil && x.WebsiteUrl != nil {
+		return *x.WebsiteUrl
+	}
+	return ""
+}
+
+func (x *CreateMerchantRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+type CreateMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *Merchant              `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMerchantResponse) Reset() {
+	*x = CreateMerchantResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMerchantResponse) ProtoMessage() {}
+
+func (x *CreateMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMerchantResponse.ProtoReflect.Descriptor instead.
+func (*CreateMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateMerchantResponse) GetMerchant() *Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+// empty urls and a zero default_category_id clear the field
+type UpdateMerchantRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id                int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	UpdateMask        *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Name              *string                `protobuf:"bytes,4,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	DefaultCategoryId *int64                 `protobuf:"varint,5,opt,name=default_category_id,json=defaultCategoryId,proto3,oneof" json:"default_category_id,omitempty"`
+	WebsiteUrl        *string                `protobuf:"bytes,6,opt,name=website_url,json=websiteUrl,proto3,oneof" json:"website_url,omitempty"`
+	LogoUrl           *string                `protobuf:"bytes,7,opt,name=logo_url,json=logoUrl,proto3,oneof" json:"logo_url,omitempty"`
+	AddAliases        []string               `protobuf:"bytes,8,rep,name=add_aliases,json=addAliases,proto3" json:"add_aliases,omitempty"`
+	RemoveAliases     []string               `protobuf:"bytes,9,rep,name=remove_aliases,json=removeAliases,proto3" json:"remove_aliases,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateMerchantRequest) Reset() {
+	*x = UpdateMerchantRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMerchantRequest) ProtoMessage() {}
+
+func (x *UpdateMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMerchantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateMerchantRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMerchantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMerchantRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateMerchantRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMerchantRequest) GetDefaultCategoryId() int64 {
+	if x != nil && x.DefaultCategoryId != nil {
+		return *x.DefaultCategoryId
+	}
+	return 0
+}
+
+func (x *UpdateMerchantRequest) GetWebsiteUrl() string {
+	if x != nil && x.WebsiteUrl != nil {
+		return *x.WebsiteUrl
+	}
+	return ""
+}
+
+func (x *UpdateMerchantRequest) GetLogoUrl() string {
+	if x != nil && x.LogoUrl != nil {
+		return *x.LogoUrl
+	}
+	return ""
+}
+
+func (x *UpdateMerchantRequest) GetAddAliases() []string {
+	if x != nil {
+		return x.AddAliases
+	}
+	return nil
+}
+
+func (x *UpdateMerchantRequest) GetRemoveAliases() []string {
+	if x != nil {
+		return x.RemoveAliases
+	}
+	return nil
+}
+
+type UpdateMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *Merchant              `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMerchantResponse) Reset() {
+	*x = UpdateMerchantResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMerchantResponse) ProtoMessage() {}
+
+func (x *UpdateMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMerchantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateMerchantResponse) GetMerchant() *Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+// transactions of a deleted merchant keep their merchant text
+type DeleteMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMerchantRequest) Reset() {
+	*x = DeleteMerchantRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMerchantRequest) ProtoMessage() {}
+
+func (x *DeleteMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMerchantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteMerchantRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteMerchantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMerchantResponse) Reset() {
+	*x = DeleteMerchantResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMerchantResponse) ProtoMessage() {}
+
+func (x *DeleteMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMerchantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteMerchantResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+// moves the aliases and transactions of the sources to the target and
+// deletes the sources
+type MergeMerchantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	SourceIds     []int64                `protobuf:"varint,3,rep,packed,name=source_ids,json=sourceIds,proto3" json:"source_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeMerchantsRequest) Reset() {
+	*x = MergeMerchantsRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMerchantsRequest) ProtoMessage() {}
+
+func (x *MergeMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*MergeMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MergeMerchantsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MergeMerchantsRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *MergeMerchantsRequest) GetSourceIds() []int64 {
+	if x != nil {
+		return x.SourceIds
+	}
+	return nil
+}
+
+type MergeMerchantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *Merchant              `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeMerchantsResponse) Reset() {
+	*x = MergeMerchantsResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeMerchantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMerchantsResponse) ProtoMessage() {}
+
+func (x *MergeMerchantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMerchantsResponse.ProtoReflect.Descriptor instead.
+func (*MergeMerchantsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MergeMerchantsResponse) GetMerchant() *Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+// moves the given aliases of a merchant to a new merchant and re-resolves
+// the transactions they match
+type SplitMerchantRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId        int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Aliases           []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	DefaultCategoryId *int64                 `protobuf:"varint,5,opt,name=default_category_id,json=defaultCategoryId,proto3,oneof" json:"default_category_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SplitMerchantRequest) Reset() {
+	*x = SplitMerchantRequest{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitMerchantRequest) ProtoMessage() {}
+
+func (x *SplitMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitMerchantRequest.ProtoReflect.Descriptor instead.
+func (*SplitMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SplitMerchantRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SplitMerchantRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *SplitMerchantRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *SplitMerchantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SplitMerchantRequest) GetDefaultCategoryId() int64 {
+	if x != nil && x.DefaultCategoryId != nil {
+		return *x.DefaultCategoryId
+	}
+	return 0
+}
+
+type SplitMerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchant      *Merchant              `protobuf:"bytes,1,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	NewMerchant   *Merchant              `protobuf:"bytes,2,opt,name=new_merchant,json=newMerchant,proto3" json:"new_merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitMerchantResponse) Reset() {
+	*x = SplitMerchantResponse{}
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitMerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitMerchantResponse) ProtoMessage() {}
+
+func (x *SplitMerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_merchant_services_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitMerchantResponse.ProtoReflect.Descriptor instead.
+func (*SplitMerchantResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_merchant_services_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SplitMerchantResponse) GetMerchant() *Merchant {
+	if x != nil {
+		return x.Merchant
+	}
+	return nil
+}
+
+func (x *SplitMerchantResponse) GetNewMerchant() *Merchant {
+	if x != nil {
+		return x.NewMerchant
+	}
+	return nil
+}
+
+var File_arian_v1_merchant_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_merchant_services_proto_rawDesc = "" +
+	"\n" +
+	" arian/v1/merchant_services.proto\x12\barian.v1\x1a\x17arian/v1/merchant.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\"9\n" +
+	"\x14ListMerchantsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"I\n" +
+	"\x15ListMerchantsResponse\x120\n" +
+	"\tmerchants\x18\x01 \x03(\v2\x12.arian.v1.MerchantR\tmerchants\"P\n" +
+	"\x12GetMerchantRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"E\n" +
+	"\x13GetMerchantResponse\x12.\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x12.arian.v1.MerchantR\bmerchant\"\xcb\x02\n" +
+	"\x15CreateMerchantRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x04name\x12\"\n" +
+	"\aaliases\x18\x03 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10dR\aaliases\x12<\n" +
+	"\x13default_category_id\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x11defaultCategoryId\x88\x01\x01\x12.\n" +
+	"\vwebsite_url\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01H\x01R\n" +
+	"websiteUrl\x88\x01\x01\x12(\n" +
+	"\blogo_url\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x88\x01\x01H\x02R\alogoUrl\x88\x01\x01B\x16\n" +
+	"\x14_default_category_idB\x0e\n" +
+	"\f_website_urlB\v\n" +
+	"\t_logo_url\"H\n" +
+	"\x16CreateMerchantResponse\x12.\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x12.arian.v1.MerchantR\bmerchant\"\xca\x03\n" +
+	"\x15UpdateMerchantRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12#\n" +
+	"\x04name\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01H\x00R\x04name\x88\x01\x01\x123\n" +
+	"\x13default_category_id\x18\x05 \x01(\x03H\x01R\x11defaultCategoryId\x88\x01\x01\x12$\n" +
+	"\vwebsite_url\x18\x06 \x01(\tH\x02R\n" +
+	"websiteUrl\x88\x01\x01\x12\x1e\n" +
+	"\blogo_url\x18\a \x01(\tH\x03R\alogoUrl\x88\x01\x01\x12)\n" +
+	"\vadd_aliases\x18\b \x03(\tB\b\xbaH\x05\x92\x01\x02\x10dR\n" +
+	"addAliases\x12/\n" +
+	"\x0eremove_aliases\x18\t \x03(\tB\b\xbaH\x05\x92\x01\x02\x10dR\rremoveAliasesB\a\n" +
+	"\x05_nameB\x16\n" +
+	"\x14_default_category_idB\x0e\n" +
+	"\f_website_urlB\v\n" +
+	"\t_logo_url\"H\n" +
+	"\x16UpdateMerchantResponse\x12.\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x12.arian.v1.MerchantR\bmerchant\"S\n" +
+	"\x15DeleteMerchantRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"=\n" +
+	"\x16DeleteMerchantResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x8b\x01\n" +
+	"\x15MergeMerchantsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12$\n" +
+	"\ttarget_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\btargetId\x12)\n" +
+	"\n" +
+	"source_ids\x18\x03 \x03(\x03B\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\tsourceIds\"H\n" +
+	"\x16MergeMerchantsResponse\x12.\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x12.arian.v1.MerchantR\bmerchant\"\xff\x01\n" +
+	"\x14SplitMerchantRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12(\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\n" +
+	"merchantId\x12$\n" +
+	"\aaliases\x18\x03 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\aaliases\x12\x1e\n" +
+	"\x04name\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x04name\x12<\n" +
+	"\x13default_category_id\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x11defaultCategoryId\x88\x01\x01B\x16\n" +
+	"\x14_default_category_id\"~\n" +
+	"\x15SplitMerchantResponse\x12.\n" +
+	"\bmerchant\x18\x01 \x01(\v2\x12.arian.v1.MerchantR\bmerchant\x125\n" +
+	"\fnew_merchant\x18\x02 \x01(\v2\x12.arian.v1.MerchantR\vnewMerchant2\xd5\x04\n" +
+	"\x0fMerchantService\x12P\n" +
+	"\rListMerchants\x12\x1e.arian.v1.ListMerchantsRequest\x1a\x1f.arian.v1.ListMerchantsResponse\x12J\n" +
+	"\vGetMerchant\x12\x1c.arian.v1.GetMerchantRequest\x1a\x1d.arian.v1.GetMerchantResponse\x12S\n" +
+	"\x0eCreateMerchant\x12\x1f.arian.v1.CreateMerchantRequest\x1a .arian.v1.CreateMerchantResponse\x12S\n" +
+	"\x0eUpdateMerchant\x12\x1f.arian.v1.UpdateMerchantRequest\x1a .arian.v1.UpdateMerchantResponse\x12S\n" +
+	"\x0eDeleteMerchant\x12\x1f.arian.v1.DeleteMerchantRequest\x1a .arian.v1.DeleteMerchantResponse\x12S\n" +
+	"\x0eMergeMerchants\x12\x1f.arian.v1.MergeMerchantsRequest\x1a .arian.v1.MergeMerchantsResponse\x12P\n" +
+	"\rSplitMerchant\x12\x1e.arian.v1.SplitMerchantRequest\x1a\x1f.arian.v1.SplitMerchantResponseB\x8c\x01\n" +
+	"\fcom.arian.v1B\x15MerchantServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_merchant_services_proto_rawDescOnce sync.Once
+	file_arian_v1_merchant_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_merchant_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_merchant_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_merchant_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_merchant_services_proto_rawDesc), len(file_arian_v1_merchant_services_proto_rawDesc)))
+	})
+	return file_arian_v1_merchant_services_proto_rawDescData
+}
+
+var file_arian_v1_merchant_services_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_arian_v1_merchant_services_proto_goTypes = []any{
+	(*ListMerchantsRequest)(nil),   // 0: arian.v1.ListMerchantsRequest
+	(*ListMerchantsResponse)(nil),  // 1: arian.v1.ListMerchantsResponse
+	(*GetMerchantRequest)(nil),     // 2: arian.v1.GetMerchantRequest
+	(*GetMerchantResponse)(nil),    // 3: arian.v1.GetMerchantResponse
+	(*CreateMerchantRequest)(nil),  // 4: arian.v1.CreateMerchantRequest
+	(*CreateMerchantResponse)(nil), // 5: arian.v1.CreateMerchantResponse
+	(*UpdateMerchantRequest)(nil),  // 6: arian.v1.UpdateMerchantRequest
+	(*UpdateMerchantResponse)(nil), // 7: arian.v1.UpdateMerchantResponse
+	(*DeleteMerchantRequest)(nil),  // 8: arian.v1.DeleteMerchantRequest
+	(*DeleteMerchantResponse)(nil), // 9: arian.v1.DeleteMerchantResponse
+	(*MergeMerchantsRequest)(nil),  // 10: arian.v1.MergeMerchantsRequest
+	(*MergeMerchantsResponse)(nil), // 11: arian.v1.MergeMerchantsResponse
+	(*SplitMerchantRequest)(nil),   // 12: arian.v1.SplitMerchantRequest
+	(*SplitMerchantResponse)(nil),  // 13: arian.v1.SplitMerchantResponse
+	(*Merchant)(nil),               // 14: arian.v1.Merchant
+	(*fieldmaskpb.FieldMask)(nil),  // 15: google.protobuf.FieldMask
+}
+var file_arian_v1_merchant_services_proto_depIdxs = []int32{
+	14, // 0: arian.v1.ListMerchantsResponse.merchants:type_name -> arian.v1.Merchant
+	14, // 1: arian.v1.GetMerchantResponse.merchant:type_name -> arian.v1.Merchant
+	14, // 2: arian.v1.CreateMerchantResponse.merchant:type_name -> arian.v1.Merchant
+	15, // 3: arian.v1.UpdateMerchantRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 4: arian.v1.UpdateMerchantResponse.merchant:type_name -> arian.v1.Merchant
+	14, // 5: arian.v1.MergeMerchantsResponse.merchant:type_name -> arian.v1.Merchant
+	14, // 6: arian.v1.SplitMerchantResponse.merchant:type_name -> arian.v1.Merchant
+	14, // 7: arian.v1.SplitMerchantResponse.new_merchant:type_name -> arian.v1.Merchant
+	0,  // 8: arian.v1.MerchantService.ListMerchants:input_type -> arian.v1.ListMerchantsRequest
+	2,  // 9: arian.v1.MerchantService.GetMerchant:input_type -> arian.v1.GetMerchantRequest
+	4,  // 10: arian.v1.MerchantService.CreateMerchant:input_type -> arian.v1.CreateMerchantRequest
+	6,  // 11: arian.v1.MerchantService.UpdateMerchant:input_type -> arian.v1.UpdateMerchantRequest
+	8,  // 12: arian.v1.MerchantService.DeleteMerchant:input_type -> arian.v1.DeleteMerchantRequest
+	10, // 13: arian.v1.MerchantService.MergeMerchants:input_type -> arian.v1.MergeMerchantsRequest
+	12, // 14: arian.v1.MerchantService.SplitMerchant:input_type -> arian.v1.SplitMerchantRequest
+	1,  // 15: arian.v1.MerchantService.ListMerchants:output_type -> arian.v1.ListMerchantsResponse
+	3,  // 16: arian.v1.MerchantService.GetMerchant:output_type -> arian.v1.GetMerchantResponse
+	5,  // 17: arian.v1.MerchantService.CreateMerchant:output_type -> arian.v1.CreateMerchantResponse
+	7,  // 18: arian.v1.MerchantService.UpdateMerchant:output_type -> arian.v1.UpdateMerchantResponse
+	9,  // 19: arian.v1.MerchantService.DeleteMerchant:output_type -> arian.v1.DeleteMerchantResponse
+	11, // 20: arian.v1.MerchantService.MergeMerchants:output_type -> arian.v1.MergeMerchantsResponse
+	13, // 21: arian.v1.MerchantService.SplitMerchant:output_type -> arian.v1.SplitMerchantResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_merchant_services_proto_init() }
+func file_arian_v1_merchant_services_proto_init() {
+	if File_arian_v1_merchant_services_proto != nil {
+		return
+	}
+	file_arian_v1_merchant_proto_init()
+	file_arian_v1_merchant_services_proto_msgTypes[4].OneofWrappers = []any{}
+	file_arian_v1_merchant_services_proto_msgTypes[6].OneofWrappers = []any{}
+	file_arian_v1_merchant_services_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_merchant_services_proto_rawDesc), len(file_arian_v1_merchant_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_merchant_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_merchant_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_merchant_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_merchant_services_proto = out.File
+	file_arian_v1_merchant_services_proto_goTypes = nil
+	file_arian_v1_merchant_services_proto_depIdxs = nil
+}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: arian/v1/merchant_services.proto
+
+package arianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MerchantService_ListMerchants_FullMethodName  = "/arian.v1.MerchantService/ListMerchants"
+	MerchantService_GetMerchant_FullMethodName    = "/arian.v1.MerchantService/GetMerchant"
+	MerchantService_CreateMerchant_FullMethodName = "/arian.v1.MerchantService/CreateMerchant"
+	MerchantService_UpdateMerchant_FullMethodName = "/arian.v1.MerchantService/UpdateMerchant"
+	MerchantService_DeleteMerchant_FullMethodName = "/arian.v1.MerchantService/DeleteMerchant"
+	MerchantService_MergeMerchants_FullMethodName = "/arian.v1.MerchantService/MergeMerchants"
+	MerchantService_SplitMerchant_FullMethodName  = "/arian.v1.MerchantService/SplitMerchant"
+)
+
+// MerchantServiceClient is the client API for MerchantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MerchantServiceClient interface {
+	ListMerchants(ctx context.Context, in *ListMerchantsRequest, opts ...grpc.CallOption) (*ListMerchantsResponse, error)
+	GetMerchant(ctx context.Context, in *GetMerchantRequest, opts ...grpc.CallOption) (*GetMerchantResponse, error)
+	CreateMerchant(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*CreateMerchantResponse, error)
+	UpdateMerchant(ctx context.Context, in *UpdateMerchantRequest, opts ...grpc.CallOption) (*UpdateMerchantResponse, error)
+	DeleteMerchant(ctx context.Context, in *DeleteMerchantRequest, opts ...grpc.CallOption) (*DeleteMerchantResponse, error)
+	MergeMerchants(ctx context.Context, in *MergeMerchantsRequest, opts ...grpc.CallOption) (*MergeMerchantsResponse, error)
+	SplitMerchant(ctx context.Context, in *SplitMerchantRequest, opts ...grpc.CallOption) (*SplitMerchantResponse, error)
+}
+
+type merchantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMerchantServiceClient(cc grpc.ClientConnInterface) MerchantServiceClient {
+	return &merchantServiceClient{cc}
+}
+
+func (c *merchantServiceClient) ListMerchants(ctx context.Context, in *ListMerchantsRequest, opts ...grpc.CallOption) (*ListMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMerchantsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_ListMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) GetMerchant(ctx context.Context, in *GetMerchantRequest, opts ...grpc.CallOption) (*GetMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantService_GetMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) CreateMerchant(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*CreateMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantService_CreateMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) UpdateMerchant(ctx context.Context, in *UpdateMerchantRequest, opts ...grpc.CallOption) (*UpdateMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantService_UpdateMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) DeleteMerchant(ctx context.Context, in *DeleteMerchantRequest, opts ...grpc.CallOption) (*DeleteMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantService_DeleteMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) MergeMerchants(ctx context.Context, in *MergeMerchantsRequest, opts ...grpc.CallOption) (*MergeMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeMerchantsResponse)
+	err := c.cc.Invoke(ctx, MerchantService_MergeMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantServiceClient) SplitMerchant(ctx context.Context, in *SplitMerchantRequest, opts ...grpc.CallOption) (*SplitMerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitMerchantResponse)
+	err := c.cc.Invoke(ctx, MerchantService_SplitMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MerchantServiceServer is the server API for MerchantService service.
+// All implementations must embed UnimplementedMerchantServiceServer
+// for forward compatibility.
+type MerchantServiceServer interface {
+	ListMerchants(context.Context, *ListMerchantsRequest) (*ListMerchantsResponse, error)
+	GetMerchant(context.Context, *GetMerchantRequest) (*GetMerchantResponse, error)
+	CreateMerchant(context.Context, *CreateMerchantRequest) (*CreateMerchantResponse, error)
+	UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error)
+	DeleteMerchant(context.Context, *DeleteMerchantRequest) (*DeleteMerchantResponse, error)
+	MergeMerchants(context.Context, *MergeMerchantsRequest) (*MergeMerchantsResponse, error)
+	SplitMerchant(context.Context, *SplitMerchantRequest) (*SplitMerchantResponse, error)
+	mustEmbedUnimplementedMerchantServiceServer()
+}
+
+// UnimplementedMerchantServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMerchantServiceServer struct{}
+
+func (UnimplementedMerchantServiceServer) ListMerchants(context.Context, *ListMerchantsRequest) (*ListMerchantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMerchants not implemented")
+}
+func (UnimplementedMerchantServiceServer) GetMerchant(context.Context, *GetMerchantRequest) (*GetMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) CreateMerchant(context.Context, *CreateMerchantRequest) (*CreateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) UpdateMerchant(context.Context, *UpdateMerchantRequest) (*UpdateMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) DeleteMerchant(context.Context, *DeleteMerchantRequest) (*DeleteMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) MergeMerchants(context.Context, *MergeMerchantsRequest) (*MergeMerchantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeMerchants not implemented")
+}
+func (UnimplementedMerchantServiceServer) SplitMerchant(context.Context, *SplitMerchantRequest) (*SplitMerchantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitMerchant not implemented")
+}
+func (UnimplementedMerchantServiceServer) mustEmbedUnimplementedMerchantServiceServer() {}
+func (UnimplementedMerchantServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeMerchantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MerchantServiceServer will
+// result in compilation errors.
+type UnsafeMerchantServiceServer interface {
+	mustEmbedUnimplementedMerchantServiceServer()
+}
+
+func RegisterMerchantServiceServer(s grpc.ServiceRegistrar, srv MerchantServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMerchantServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MerchantService_ServiceDesc, srv)
+}
+
+func _MerchantService_ListMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).ListMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_ListMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).ListMerchants(ctx, req.(*ListMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_GetMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).GetMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_GetMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).GetMerchant(ctx, req.(*GetMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_CreateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).CreateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_CreateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).CreateMerchant(ctx, req.(*CreateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_UpdateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).UpdateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_UpdateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).UpdateMerchant(ctx, req.(*UpdateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_DeleteMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).DeleteMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_DeleteMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).DeleteMerchant(ctx, req.(*DeleteMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_MergeMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).MergeMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_MergeMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).MergeMerchants(ctx, req.(*MergeMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantService_SplitMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantServiceServer).SplitMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantService_SplitMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantServiceServer).SplitMerchant(ctx, req.(*SplitMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MerchantService_ServiceDesc is the grpc.ServiceDesc for MerchantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MerchantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "arian.v1.MerchantService",
+	HandlerType: (*MerchantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMerchants",
+			Handler:    _MerchantService_ListMerchants_Handler,
+		},
+		{
+			MethodName: "GetMerchant",
+			Handler:    _MerchantService_GetMerchant_Handler,
+		},
+		{
+			MethodName: "CreateMerchant",
+			Handler:    _MerchantService_CreateMerchant_Handler,
+		},
+		{
+			MethodName: "UpdateMerchant",
+			Handler:    _MerchantService_UpdateMerchant_Handler,
+		},
+		{
+			MethodName: "DeleteMerchant",
+			Handler:    _MerchantService_DeleteMerchant_Handler,
+		},
+		{
+			MethodName: "MergeMerchants",
+			Handler:    _MerchantService_MergeMerchants_Handler,
+		},
+		{
+			MethodName: "SplitMerchant",
+			Handler:    _MerchantService_SplitMerchant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arian/v1/merchant_services.proto",
+}
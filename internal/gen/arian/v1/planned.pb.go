@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/planned.proto
+
+package arianv1
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// an expected future transaction, never part of actual balances
+type PlannedTransaction struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId   int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount      *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction   TransactionDirection   `protobuf:"varint,4,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
+	Description *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Merchant    *string                `protobuf:"bytes,6,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	CategoryId  *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	StartDate   *date.Date             `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// RFC 5545 recurrence rule, e.g. "FREQ=MONTHLY;BYMONTHDAY=1", unset for a one-off
+	Rrule *string `protobuf:"bytes,9,opt,name=rrule,proto3,oneof" json:"rrule,omitempty"`
+	// first occurrence from today on that has not been fulfilled yet
+	NextOccurrence *date.Date             `protobuf:"bytes,10,opt,name=next_occurrence,json=nextOccurrence,proto3,oneof" json:"next_occurrence,omitempty"`
+	Fulfillments   []*PlannedFulfillment  `protobuf:"bytes,11,rep,name=fulfillments,proto3" json:"fulfillments,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlannedTransaction) Reset() {
+	*x = PlannedTransaction{}
+	mi := &file_arian_v1_planned_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedTransaction) ProtoMessage() {}
+
+func (x *PlannedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedTransaction.ProtoReflect.Descriptor instead.
+func (*PlannedTransaction) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlannedTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlannedTransaction) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *PlannedTransaction) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PlannedTransaction) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *PlannedTransaction) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *PlannedTransaction) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *PlannedTransaction) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *PlannedTransaction) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *PlannedTransaction) GetRrule() string {
+	if x != nil && x.Rrule != nil {
+		return *x.Rrule
+	}
+	return ""
+}
+
+func (x *PlannedTransaction) GetNextOccurrence() *date.Date {
+	if x != nil {
+		return x.NextOccurrence
+	}
+	return nil
+}
+
+func (x *PlannedTransaction) GetFulfillments() []*PlannedFulfillment {
+	if x != nil {
+		return x.Fulfillments
+	}
+	return nil
+}
+
+func (x *PlannedTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PlannedTransaction) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// a real transaction that took care of one occurrence
+type PlannedFulfillment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OccurrenceDate *date.Date             `protobuf:"bytes,1,opt,name=occurrence_date,json=occurrenceDate,proto3" json:"occurrence_date,omitempty"`
+	TransactionId  int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlannedFulfillment) Reset() {
+	*x = PlannedFulfillment{}
+	mi := &file_arian_v1_planned_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedFulfillment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedFulfillment) ProtoMessage() {}
+
+func (x *PlannedFulfillment) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedFulfillment.ProtoReflect.Descriptor instead.
+func (*PlannedFulfillment) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlannedFulfillment) GetOccurrenceDate() *date.Date {
+	if x != nil {
+		return x.OccurrenceDate
+	}
+	return nil
+}
+
+func (x *PlannedFulfillment) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type PlannedOccurrence struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PlannedTransactionId int64                  `protobuf:"varint,1,opt,name=planned_transaction_id,json=plannedTransactionId,proto3" json:"planned_transaction_id,omitempty"`
+	Date                 *date.Date             `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Amount               *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction            TransactionDirection   `protobuf:"varint,4,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
+	Description          *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PlannedOccurrence) Reset() {
+	*x = PlannedOccurrence{}
+	mi := &file_arian_v1_planned_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedOccurrence) ProtoMessage() {}
+
+func (x *PlannedOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedOccurrence.ProtoReflect.Descriptor instead.
+func (*PlannedOccurrence) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlannedOccurrence) GetPlannedTransactionId() int64 {
+	if x != nil {
+		return x.PlannedTransactionId
+	}
+	return 0
+}
+
+func (x *PlannedOccurrence) GetDate() *date.Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *PlannedOccurrence) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PlannedOccurrence) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *PlannedOccurrence) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type ProjectedBalancePoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  *date.Date             `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// balance at the end of the day
+	Balance       *money.Money         `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Occurrences   []*PlannedOccurrence `protobuf:"bytes,3,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectedBalancePoint) Reset() {
+	*x = ProjectedBalancePoint{}
+	mi := &file_arian_v1_planned_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectedBalancePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectedBalancePoint) ProtoMessage() {}
+
+func (x *ProjectedBalancePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectedBalancePoint.ProtoReflect.Descriptor instead.
+func (*ProjectedBalancePoint) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectedBalancePoint) GetDate() *date.Date {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ProjectedBalancePoint) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *ProjectedBalancePoint) GetOccurrences() []*PlannedOccurrence {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+type AccountProjection struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName    string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	CurrentBalance *money.Money           `protobuf:"bytes,3,opt,name=current_balance,json=currentBalance,proto3" json:"current_balance,omitempty"`
+	// one point per day, starting today
+	Points        []*ProjectedBalancePoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountProjection) Reset() {
+	*x = AccountProjection{}
+	mi := &file_arian_v1_planned_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountProjection) ProtoMessage() {}
+
+func (x *AccountProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountProjection.ProtoReflect.Descriptor instead.
+func (*AccountProjection) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccountProjection) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *AccountProjection) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountProjection) GetCurrentBalance() *money.Money {
+	if x != nil {
+		return x.CurrentBalance
+	}
+	return nil
+}
+
+func (x *AccountProjection) GetPoints() []*ProjectedBalancePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+var File_arian_v1_planned_proto protoreflect.FileDescriptor
+
+const file_arian_v1_planned_proto_rawDesc = "" +
+	"\n" +
+	"\x16arian/v1/planned.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\xac\x05\n" +
+	"\x12PlannedTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12<\n" +
+	"\tdirection\x18\x04 \x01(\x0e2\x1e.arian.v1.TransactionDirectionR\tdirection\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bmerchant\x18\x06 \x01(\tH\x01R\bmerchant\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x120\n" +
+	"\n" +
+	"start_date\x18\b \x01(\v2\x11.google.type.DateR\tstartDate\x12\x19\n" +
+	"\x05rrule\x18\t \x01(\tH\x03R\x05rrule\x88\x01\x01\x12?\n" +
+	"\x0fnext_occurrence\x18\n" +
+	" \x01(\v2\x11.google.type.DateH\x04R\x0enextOccurrence\x88\x01\x01\x12@\n" +
+	"\ffulfillments\x18\v \x03(\v2\x1c.arian.v1.PlannedFulfillmentR\ffulfillments\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_merchantB\x0e\n" +
+	"\f_category_idB\b\n" +
+	"\x06_rruleB\x12\n" +
+	"\x10_next_occurrence\"w\n" +
+	"\x12PlannedFulfillment\x12:\n" +
+	"\x0foccurrence_date\x18\x01 \x01(\v2\x11.google.type.DateR\x0eoccurrenceDate\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\"\x91\x02\n" +
+	"\x11PlannedOccurrence\x124\n" +
+	"\x16planned_transaction_id\x18\x01 \x01(\x03R\x14plannedTransactionId\x12%\n" +
+	"\x04date\x18\x02 \x01(\v2\x11.google.type.DateR\x04date\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12<\n" +
+	"\tdirection\x18\x04 \x01(\x0e2\x1e.arian.v1.TransactionDirectionR\tdirection\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01B\x0e\n" +
+	"\f_description\"\xab\x01\n" +
+	"\x15ProjectedBalancePoint\x12%\n" +
+	"\x04date\x18\x01 \x01(\v2\x11.google.type.DateR\x04date\x12,\n" +
+	"\abalance\x18\x02 \x01(\v2\x12.google.type.MoneyR\abalance\x12=\n" +
+	"\voccurrences\x18\x03 \x03(\v2\x1b.arian.v1.PlannedOccurrenceR\voccurrences\"\xcb\x01\n" +
+	"\x11AccountProjection\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12;\n" +
+	"\x0fcurrent_balance\x18\x03 \x01(\v2\x12.google.type.MoneyR\x0ecurrentBalance\x127\n" +
+	"\x06points\x18\x04 \x03(\v2\x1f.arian.v1.ProjectedBalancePointR\x06pointsB\x83\x01\n" +
+	"\fcom.arian.v1B\fPlannedProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_planned_proto_rawDescOnce sync.Once
+	file_arian_v1_planned_proto_rawDescData []byte
+)
+
+func file_arian_v1_planned_proto_rawDescGZIP() []byte {
+	file_arian_v1_planned_proto_rawDescOnce.Do(func() {
+		file_arian_v1_planned_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_planned_proto_rawDesc), len(file_arian_v1_planned_proto_rawDesc)))
+	})
+	return file_arian_v1_planned_proto_rawDescData
+}
+
+var file_arian_v1_planned_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_arian_v1_planned_proto_goTypes = []any{
+	(*PlannedTransaction)(nil),    // 0: arian.v1.PlannedTransaction
+	(*PlannedFulfillment)(nil),    // 1: arian.v1.PlannedFulfillment
+	(*PlannedOccurrence)(nil),     // 2: arian.v1.PlannedOccurrence
+	(*ProjectedBalancePoint)(nil), // 3: arian.v1.ProjectedBalancePoint
+	(*AccountProjection)(nil),     // 4: arian.v1.AccountProjection
+	(*money.Money)(nil),           // 5: google.type.Money
+	(TransactionDirection)(0),     // 6: arian.v1.TransactionDirection
+	(*date.Date)(nil),             // 7: google.type.Date
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_arian_v1_planned_proto_depIdxs = []int32{
+	5,  // 0: arian.v1.PlannedTransaction.amount:type_name -> google.type.Money
+	6,  // 1: arian.v1.PlannedTransaction.direction:type_name -> arian.v1.TransactionDirection
+	7,  // 2: arian.v1.PlannedTransaction.start_date:type_name -> google.type.Date
+	7,  // 3: arian.v1.PlannedTransaction.next_occurrence:type_name -> google.type.Date
+	1,  // 4: arian.v1.PlannedTransaction.fulfillments:type_name -> arian.v1.PlannedFulfillment
+	8,  // 5: arian.v1.PlannedTransaction.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: arian.v1.PlannedTransaction.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: arian.v1.PlannedFulfillment.occurrence_date:type_name -> google.type.Date
+	7,  // 8: arian.v1.PlannedOccurrence.date:type_name -> google.type.Date
+	5,  // 9: arian.v1.PlannedOccurrence.amount:type_name -> google.type.Money
+	6,  // 10: arian.v1.PlannedOccurrence.direction:type_name -> arian.v1.TransactionDirection
+	7,  // 11: arian.v1.ProjectedBalancePoint.date:type_name -> google.type.Date
+	5,  // 12: arian.v1.ProjectedBalancePoint.balance:type_name -> google.type.Money
+	2,  // 13: arian.v1.ProjectedBalancePoint.occurrences:type_name -> arian.v1.PlannedOccurrence
+	5,  // 14: arian.v1.AccountProjection.current_balance:type_name -> google.type.Money
+	3,  // 15: arian.v1.AccountProjection.points:type_name -> arian.v1.ProjectedBalancePoint
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_planned_proto_init() }
+func file_arian_v1_planned_proto_init() {
+	if File_arian_v1_planned_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_planned_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_planned_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_planned_proto_rawDesc), len(file_arian_v1_planned_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_arian_v1_planned_proto_goTypes,
+		DependencyIndexes: file_arian_v1_planned_proto_depIdxs,
+		MessageInfos:      file_arian_v1_planned_proto_msgTypes,
+	}.Build()
+	File_arian_v1_planned_proto = out.File
+	file_arian_v1_planned_proto_goTypes = nil
+	file_arian_v1_planned_proto_depIdxs = nil
+}
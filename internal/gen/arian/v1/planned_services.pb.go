@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/planned_services.proto
+
+package arianv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPlannedTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlannedTransactionsRequest) Reset() {
+	*x = ListPlannedTransactionsRequest{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlannedTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlannedTransactionsRequest) ProtoMessage() {}
+
+func (x *ListPlannedTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlannedTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlannedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPlannedTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPlannedTransactionsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+type ListPlannedTransactionsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PlannedTransactions []*PlannedTransaction  `protobuf:"bytes,1,rep,name=planned_transactions,json=plannedTransactions,proto3" json:"planned_transactions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListPlannedTransactionsResponse) Reset() {
+	*x = ListPlannedTransactionsResponse{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlannedTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlannedTransactionsResponse) ProtoMessage() {}
+
+func (x *ListPlannedTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlannedTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlannedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPlannedTransactionsResponse) GetPlannedTransactions() []*PlannedTransaction {
+	if x != nil {
+		return x.PlannedTransactions
+	}
+	return nil
+}
+
+type GetPlannedTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlannedTransactionRequest) Reset() {
+	*x = GetPlannedTransactionRequest{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlannedTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlannedTransactionRequest) ProtoMessage() {}
+
+func (x *GetPlannedTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlannedTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetPlannedTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPlannedTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPlannedTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPlannedTransactionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PlannedTransaction *PlannedTransaction    `protobuf:"bytes,1,opt,name=planned_transaction,json=plannedTransaction,proto3" json:"planned_transaction,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetPlannedTransactionResponse) Reset() {
+	*x = GetPlannedTransactionResponse{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlannedTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlannedTransactionResponse) ProtoMessage() {}
+
+func (x *GetPlannedTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlannedTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetPlannedTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPlannedTransactionResponse) GetPlannedTransaction() *PlannedTransaction {
+	if x != nil {
+		return x.PlannedTransaction
+	}
+	return nil
+}
+
+// the amount's currency defaults to, and has to match, the account's main currency
+type CreatePlannedTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Direction     TransactionDirection   `protobuf:"varint,4,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
+	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Merchant      *string                `protobuf:"bytes,6,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	StartDate     *date.Date             `protobuf:"bytes,8,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	Rrule         *string                `protobuf:"bytes,9,opt,name=rrule,proto3,oneof" json:"rrule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePlannedTransactionRequest) Reset() {
+	*x = CreatePlannedTransactionRequest{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlannedTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlannedTransactionRequest) ProtoMessage() {}
+
+func (x *CreatePlannedTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlannedTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlannedTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePlannedTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePlannedTransactionRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreatePlannedTransactionRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreatePlannedTransactionRequest) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *CreatePlannedTransactionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreatePlannedTransactionRequest) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *CreatePlannedTransactionRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreatePlannedTransactionRequest) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreatePlannedTransactionRequest) GetRrule() string {
+	if x != nil && x.Rrule != nil {
+		return *x.Rrule
+	}
+	return ""
+}
+
+type CreatePlannedTransactionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PlannedTransaction *PlannedTransaction    `protobuf:"bytes,1,opt,name=planned_transaction,json=plannedTransaction,proto3" json:"planned_transaction,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePlannedTransactionResponse) Reset() {
+	*x = CreatePlannedTransactionResponse{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlannedTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlannedTransactionResponse) ProtoMessage() {}
+
+func (x *CreatePlannedTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlannedTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlannedTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePlannedTransactionResponse) GetPlannedTransaction() *PlannedTransaction {
+	if x != nil {
+		return x.PlannedTransaction
+	}
+	return nil
+}
+
+// empty strings and a zero category_id clear the field, an empty rrule makes it a one-off
+type UpdatePlannedTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Direction     *TransactionDirection  `protobuf:"varint,5,opt,name=direction,proto3,enum=arian.v1.TransactionDirection,oneof" json:"direction,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Merchant      *string                `protobuf:"bytes,7,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	StartDate     *date.Date             `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	Rrule         *string                `protobuf:"bytes,10,opt,name=rrule,proto3,oneof" json:"rrule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePlannedTransactionRequest) Reset() {
+	*x = UpdatePlannedTransactionRequest{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlannedTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlannedTransactionRequest) ProtoMessage() {}
+
+func (x *UpdatePlannedTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlannedTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlannedTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdatePlannedTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePlannedTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePlannedTransactionRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePlannedTransactionRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *UpdatePlannedTransactionRequest) GetDirection() TransactionDirection {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *UpdatePlannedTransactionRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePlannedTransactionRequest) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *UpdatePlannedTransactionRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdatePlannedTransactionRequest) GetStartDate() *date.Date {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdatePlannedTransactionRequest) GetRrule() string {
+	if x != nil && x.Rrule != nil {
+		return *x.Rrule
+	}
+	return ""
+}
+
+type UpdatePlannedTransactionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PlannedTransaction *PlannedTransaction    `protobuf:"bytes,1,opt,name=planned_transaction,json=plannedTransaction,proto3" json:"planned_transaction,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdatePlannedTransactionResponse) Reset() {
+	*x = UpdatePlannedTransactionResponse{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePlannedTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlannedTransactionResponse) ProtoMessage() {}
+
+func (x *UpdatePlannedTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlannedTransactionResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlannedTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePlannedTransactionResponse) GetPlannedTransaction() *PlannedTransaction {
+	if x != nil {
+		return x.PlannedTransaction
+	}
+	return nil
+}
+
+type DeletePlannedTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlannedTransactionRequest) Reset() {
+	*x = DeletePlannedTransactionRequest{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlannedTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlannedTransactionRequest) ProtoMessage() {}
+
+func (x *DeletePlannedTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlannedTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlannedTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeletePlannedTransactionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeletePlannedTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePlannedTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePlannedTransactionResponse) Reset() {
+	*x = DeletePlannedTransactionResponse{}
+	mi := &file_arian_v1_planned_services_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePlannedTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlannedTransactionResponse) ProtoMessage() {}
+
+func (x *DeletePlannedTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_planned_services_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlannedTransactionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlannedTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_planned_services_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePlannedTransactionResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+var File_arian_v1_planned_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_planned_services_proto_rawDesc = "" +
+	"\n" +
+	"\x1farian/v1/planned_services.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x16arian/v1/planned.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\x7f\n" +
+	"\x1eListPlannedTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\taccountId\x88\x01\x01B\r\n" +
+	"\v_account_id\"r\n" +
+	"\x1fListPlannedTransactionsResponse\x12O\n" +
+	"\x14planned_transactions\x18\x01 \x03(\v2\x1c.arian.v1.PlannedTransactionR\x13plannedTransactions\"Z\n" +
+	"\x1cGetPlannedTransactionRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"n\n" +
+	"\x1dGetPlannedTransactionResponse\x12M\n" +
+	"\x13planned_transaction\x18\x01 \x01(\v2\x1c.arian.v1.PlannedTransactionR\x12plannedTransaction\"\xec\x03\n" +
+	"\x1fCreatePlannedTransactionRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\taccountId\x122\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\x12F\n" +
+	"\tdirection\x18\x04 \x01(\x0e2\x1e.arian.v1.TransactionDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01R\tdirection\x12%\n" +
+	"\vdescription\x18\x05 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bmerchant\x18\x06 \x01(\tH\x01R\bmerchant\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\a \x01(\x03H\x02R\n" +
+	"categoryId\x88\x01\x01\x128\n" +
+	"\n" +
+	"start_date\x18\b \x01(\v2\x11.google.type.DateB\x06\xbaH\x03\xc8\x01\x01R\tstartDate\x12#\n" +
+	"\x05rrule\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\x03R\x05rrule\x88\x01\x01B\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_merchantB\x0e\n" +
+	"\f_category_idB\b\n" +
+	"\x06_rrule\"q\n" +
+	" CreatePlannedTransactionResponse\x12M\n" +
+	"\x13planned_transaction\x18\x01 \x01(\v2\x1c.arian.v1.PlannedTransactionR\x12plannedTransaction\"\xc1\x04\n" +
+	"\x1fUpdatePlannedTransactionRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12/\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyH\x00R\x06amount\x88\x01\x01\x12K\n" +
+	"\tdirection\x18\x05 \x01(\x0e2\x1e.arian.v1.TransactionDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\tdirection\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bmerchant\x18\a \x01(\tH\x03R\bmerchant\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\b \x01(\x03H\x04R\n" +
+	"categoryId\x88\x01\x01\x125\n" +
+	"\n" +
+	"start_date\x18\t \x01(\v2\x11.google.type.DateH\x05R\tstartDate\x88\x01\x01\x12#\n" +
+	"\x05rrule\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\x06R\x05rrule\x88\x01\x01B\t\n" +
+	"\a_amountB\f\n" +
+	"\n" +
+	"_directionB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_merchantB\x0e\n" +
+	"\f_category_idB\r\n" +
+	"\v_start_dateB\b\n" +
+	"\x06_rrule\"q\n" +
+	" UpdatePlannedTransactionResponse\x12M\n" +
+	"\x13planned_transaction\x18\x01 \x01(\v2\x1c.arian.v1.PlannedTransactionR\x12plannedTransaction\"]\n" +
+	"\x1fDeletePlannedTransactionRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"G\n" +
+	" DeletePlannedTransactionResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows2\xce\x04\n" +
+	"\x19PlannedTransactionService\x12n\n" +
+	"\x17ListPlannedTransactions\x12(.arian.v1.ListPlannedTransactionsRequest\x1a).arian.v1.ListPlannedTransactionsResponse\x12h\n" +
+	"\x15GetPlannedTransaction\x12&.arian.v1.GetPlannedTransactionRequest\x1a'.arian.v1.GetPlannedTransactionResponse\x12q\n" +
+	"\x18CreatePlannedTransaction\x12).arian.v1.CreatePlannedTransactionRequest\x1a*.arian.v1.CreatePlannedTransactionResponse\x12q\n" +
+	"\x18UpdatePlannedTransaction\x12).arian.v1.UpdatePlannedTransactionRequest\x1a*.arian.v1.UpdatePlannedTransactionResponse\x12q\n" +
+	"\x18DeletePlannedTransaction\x12).arian.v1.DeletePlannedTransactionRequest\x1a*.arian.v1.DeletePlannedTransactionResponseB\x8b\x01\n" +
+	"\fcom.arian.v1B\x14PlannedServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_planned_services_proto_rawDescOnce sync.Once
+	file_arian_v1_planned_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_planned_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_planned_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_planned_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_planned_services_proto_rawDesc), len(file_arian_v1_planned_services_proto_rawDesc)))
+	})
+	return file_arian_v1_planned_services_proto_rawDescData
+}
+
+var file_arian_v1_planned_services_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_arian_v1_planned_services_proto_goTypes = []any{
+	(*ListPlannedTransactionsRequest)(nil),   // 0: arian.v1.ListPlannedTransactionsRequest
+	(*ListPlannedTransactionsResponse)(nil),  // 1: arian.v1.ListPlannedTransactionsResponse
+	(*GetPlannedTransactionRequest)(nil),     // 2: arian.v1.GetPlannedTransactionRequest
+	(*GetPlannedTransactionResponse)(nil),    // 3: arian.v1.GetPlannedTransactionResponse
+	(*CreatePlannedTransactionRequest)(nil),  // 4: arian.v1.CreatePlannedTransactionRequest
+	(*CreatePlannedTransactionResponse)(nil), // 5: arian.v1.CreatePlannedTransactionResponse
+	(*UpdatePlannedTransactionRequest)(nil),  // 6: arian.v1.UpdatePlannedTransactionRequest
+	(*UpdatePlannedTransactionResponse)(nil), // 7: arian.v1.UpdatePlannedTransactionResponse
+	(*DeletePlannedTransactionRequest)(nil),  // 8: arian.v1.DeletePlannedTransactionRequest
+	(*DeletePlannedTransactionResponse)(nil), // 9: arian.v1.DeletePlannedTransactionResponse
+	(*PlannedTransaction)(nil),               // 10: arian.v1.PlannedTransaction
+	(*money.Money)(nil),                      // 11: google.type.Money
+	(TransactionDirection)(0),                // 12: arian.v1.TransactionDirection
+	(*date.Date)(nil),                        // 13: google.type.Date
+	(*fieldmaskpb.FieldMask)(nil),            // 14: google.protobuf.FieldMask
+}
+var file_arian_v1_planned_services_proto_depIdxs = []int32{
+	10, // 0: arian.v1.ListPlannedTransactionsResponse.planned_transactions:type_name -> arian.v1.PlannedTransaction
+	10, // 1: arian.v1.GetPlannedTransactionResponse.planned_transaction:type_name -> arian.v1.PlannedTransaction
+	11, // 2: arian.v1.CreatePlannedTransactionRequest.amount:type_name -> google.type.Money
+	12, // 3: arian.v1.CreatePlannedTransactionRequest.direction:type_name -> arian.v1.TransactionDirection
+	13, // 4: arian.v1.CreatePlannedTransactionRequest.start_date:type_name -> google.type.Date
+	10, // 5: arian.v1.CreatePlannedTransactionResponse.planned_transaction:type_name -> arian.v1.PlannedTransaction
+	14, // 6: arian.v1.UpdatePlannedTransactionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	11, // 7: arian.v1.UpdatePlannedTransactionRequest.amount:type_name -> google.type.Money
+	12, // 8: arian.v1.UpdatePlannedTransactionRequest.direction:type_name -> arian.v1.TransactionDirection
+	13, // 9: arian.v1.UpdatePlannedTransactionRequest.start_date:type_name -> google.type.Date
+	10, // 10: arian.v1.UpdatePlannedTransactionResponse.planned_transaction:type_name -> arian.v1.PlannedTransaction
+	0,  // 11: arian.v1.PlannedTransactionService.ListPlannedTransactions:input_type -> arian.v1.ListPlannedTransactionsRequest
+	2,  // 12: arian.v1.PlannedTransactionService.GetPlannedTransaction:input_type -> arian.v1.GetPlannedTransactionRequest
+	4,  // 13: arian.v1.PlannedTransactionService.CreatePlannedTransaction:input_type -> arian.v1.CreatePlannedTransactionRequest
+	6,  // 14: arian.v1.PlannedTransactionService.UpdatePlannedTransaction:input_type -> arian.v1.UpdatePlannedTransactionRequest
+	8,  // 15: arian.v1.PlannedTransactionService.DeletePlannedTransaction:input_type -> arian.v1.DeletePlannedTransactionRequest
+	1,  // 16: arian.v1.PlannedTransactionService.ListPlannedTransactions:output_type -> arian.v1.ListPlannedTransactionsResponse
+	3,  // 17: arian.v1.PlannedTransactionService.GetPlannedTransaction:output_type -> arian.v1.GetPlannedTransactionResponse
+	5,  // 18: arian.v1.PlannedTransactionService.CreatePlannedTransaction:output_type -> arian.v1.CreatePlannedTransactionResponse
+	7,  // 19: arian.v1.PlannedTransactionService.UpdatePlannedTransaction:output_type -> arian.v1.UpdatePlannedTransactionResponse
+	9,  // 20: arian.v1.PlannedTransactionService.DeletePlannedTransaction:output_type -> arian.v1.DeletePlannedTransactionResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_planned_services_proto_init() }
+func file_arian_v1_planned_services_proto_init() {
+	if File_arian_v1_planned_services_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_planned_proto_init()
+	file_arian_v1_planned_services_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_planned_services_proto_msgTypes[4].OneofWrappers = []any{}
+	file_arian_v1_planned_services_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_planned_services_proto_rawDesc), len(file_arian_v1_planned_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_planned_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_planned_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_planned_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_planned_services_proto = out.File
+	file_arian_v1_planned_services_proto_goTypes = nil
+	file_arian_v1_planned_services_proto_depIdxs = nil
+}
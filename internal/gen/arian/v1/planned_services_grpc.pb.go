@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: arian/v1/planned_services.proto
+
+package arianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PlannedTransactionService_ListPlannedTransactions_FullMethodName  = "/arian.v1.PlannedTransactionService/ListPlannedTransactions"
+	PlannedTransactionService_GetPlannedTransaction_FullMethodName    = "/arian.v1.PlannedTransactionService/GetPlannedTransaction"
+	PlannedTransactionService_CreatePlannedTransaction_FullMethodName = "/arian.v1.PlannedTransactionService/CreatePlannedTransaction"
+	PlannedTransactionService_UpdatePlannedTransaction_FullMethodName = "/arian.v1.PlannedTransactionService/UpdatePlannedTransaction"
+	PlannedTransactionService_DeletePlannedTransaction_FullMethodName = "/arian.v1.PlannedTransactionService/DeletePlannedTransaction"
+)
+
+// PlannedTransactionServiceClient is the client API for PlannedTransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlannedTransactionServiceClient interface {
+	ListPlannedTransactions(ctx context.Context, in *ListPlannedTransactionsRequest, opts ...grpc.CallOption) (*ListPlannedTransactionsResponse, error)
+	GetPlannedTransaction(ctx context.Context, in *GetPlannedTransactionRequest, opts ...grpc.CallOption) (*GetPlannedTransactionResponse, error)
+	CreatePlannedTransaction(ctx context.Context, in *CreatePlannedTransactionRequest, opts ...grpc.CallOption) (*CreatePlannedTransactionResponse, error)
+	UpdatePlannedTransaction(ctx context.Context, in *UpdatePlannedTransactionRequest, opts ...grpc.CallOption) (*UpdatePlannedTransactionResponse, error)
+	DeletePlannedTransaction(ctx context.Context, in *DeletePlannedTransactionRequest, opts ...grpc.CallOption) (*DeletePlannedTransactionResponse, error)
+}
+
+type plannedTransactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlannedTransactionServiceClient(cc grpc.ClientConnInterface) PlannedTransactionServiceClient {
+	return &plannedTransactionServiceClient{cc}
+}
+
+func (c *plannedTransactionServiceClient) ListPlannedTransactions(ctx context.Context, in *ListPlannedTransactionsRequest, opts ...grpc.CallOption) (*ListPlannedTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlannedTransactionsResponse)
+	err := c.cc.Invoke(ctx, PlannedTransactionService_ListPlannedTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannedTransactionServiceClient) GetPlannedTransaction(ctx context.Context, in *GetPlannedTransactionRequest, opts ...grpc.CallOption) (*GetPlannedTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPlannedTransactionResponse)
+	err := c.cc.Invoke(ctx, PlannedTransactionService_GetPlannedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannedTransactionServiceClient) CreatePlannedTransaction(ctx context.Context, in *CreatePlannedTransactionRequest, opts ...grpc.CallOption) (*CreatePlannedTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePlannedTransactionResponse)
+	err := c.cc.Invoke(ctx, PlannedTransactionService_CreatePlannedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannedTransactionServiceClient) UpdatePlannedTransaction(ctx context.Context, in *UpdatePlannedTransactionRequest, opts ...grpc.CallOption) (*UpdatePlannedTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePlannedTransactionResponse)
+	err := c.cc.Invoke(ctx, PlannedTransactionService_UpdatePlannedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *plannedTransactionServiceClient) DeletePlannedTransaction(ctx context.Context, in *DeletePlannedTransactionRequest, opts ...grpc.CallOption) (*DeletePlannedTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlannedTransactionResponse)
+	err := c.cc.Invoke(ctx, PlannedTransactionService_DeletePlannedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlannedTransactionServiceServer is the server API for PlannedTransactionService service.
+// All implementations must embed UnimplementedPlannedTransactionServiceServer
+// for forward compatibility.
+type PlannedTransactionServiceServer interface {
+	ListPlannedTransactions(context.Context, *ListPlannedTransactionsRequest) (*ListPlannedTransactionsResponse, error)
+	GetPlannedTransaction(context.Context, *GetPlannedTransactionRequest) (*GetPlannedTransactionResponse, error)
+	CreatePlannedTransaction(context.Context, *CreatePlannedTransactionRequest) (*CreatePlannedTransactionResponse, error)
+	UpdatePlannedTransaction(context.Context, *UpdatePlannedTransactionRequest) (*UpdatePlannedTransactionResponse, error)
+	DeletePlannedTransaction(context.Context, *DeletePlannedTransactionRequest) (*DeletePlannedTransactionResponse, error)
+	mustEmbedUnimplementedPlannedTransactionServiceServer()
+}
+
+// UnimplementedPlannedTransactionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlannedTransactionServiceServer struct{}
+
+func (UnimplementedPlannedTransactionServiceServer) ListPlannedTransactions(context.Context, *ListPlannedTransactionsRequest) (*ListPlannedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlannedTransactions not implemented")
+}
+func (UnimplementedPlannedTransactionServiceServer) GetPlannedTransaction(context.Context, *GetPlannedTransactionRequest) (*GetPlannedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlannedTransaction not implemented")
+}
+func (UnimplementedPlannedTransactionServiceServer) CreatePlannedTransaction(context.Context, *CreatePlannedTransactionRequest) (*CreatePlannedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlannedTransaction not implemented")
+}
+func (UnimplementedPlannedTransactionServiceServer) UpdatePlannedTransaction(context.Context, *UpdatePlannedTransactionRequest) (*UpdatePlannedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlannedTransaction not implemented")
+}
+func (UnimplementedPlannedTransactionServiceServer) DeletePlannedTransaction(context.Context, *DeletePlannedTransactionRequest) (*DeletePlannedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlannedTransaction not implemented")
+}
+func (UnimplementedPlannedTransactionServiceServer) mustEmbedUnimplementedPlannedTransactionServiceServer() {
+}
+func (UnimplementedPlannedTransactionServiceServer) testEmbeddedByValue() {}
+
+// UnsafePlannedTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlannedTransactionServiceServer will
+// result in compilation errors.
+type UnsafePlannedTransactionServiceServer interface {
+	mustEmbedUnimplementedPlannedTransactionServiceServer()
+}
+
+func RegisterPlannedTransactionServiceServer(s grpc.ServiceRegistrar, srv PlannedTransactionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPlannedTransactionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlannedTransactionService_ServiceDesc, srv)
+}
+
+func _PlannedTransactionService_ListPlannedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlannedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannedTransactionServiceServer).ListPlannedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlannedTransactionService_ListPlannedTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannedTransactionServiceServer).ListPlannedTransactions(ctx, req.(*ListPlannedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannedTransactionService_GetPlannedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlannedTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannedTransactionServiceServer).GetPlannedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlannedTransactionService_GetPlannedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannedTransactionServiceServer).GetPlannedTransaction(ctx, req.(*GetPlannedTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannedTransactionService_CreatePlannedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlannedTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannedTransactionServiceServer).CreatePlannedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlannedTransactionService_CreatePlannedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannedTransactionServiceServer).CreatePlannedTransaction(ctx, req.(*CreatePlannedTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannedTransactionService_UpdatePlannedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlannedTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannedTransactionServiceServer).UpdatePlannedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlannedTransactionService_UpdatePlannedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannedTransactionServiceServer).UpdatePlannedTransaction(ctx, req.(*UpdatePlannedTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlannedTransactionService_DeletePlannedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlannedTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlannedTransactionServiceServer).DeletePlannedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlannedTransactionService_DeletePlannedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlannedTransactionServiceServer).DeletePlannedTransaction(ctx, req.(*DeletePlannedTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlannedTransactionService_ServiceDesc is the grpc.ServiceDesc for PlannedTransactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlannedTransactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "arian.v1.PlannedTransactionService",
+	HandlerType: (*PlannedTransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPlannedTransactions",
+			Handler:    _PlannedTransactionService_ListPlannedTransactions_Handler,
+		},
+		{
+			MethodName: "GetPlannedTransaction",
+			Handler:    _PlannedTransactionService_GetPlannedTransaction_Handler,
+		},
+		{
+			MethodName: "CreatePlannedTransaction",
+			Handler:    _PlannedTransactionService_CreatePlannedTransaction_Handler,
+		},
+		{
+			MethodName: "UpdatePlannedTransaction",
+			Handler:    _PlannedTransactionService_UpdatePlannedTransaction_Handler,
+		},
+		{
+			MethodName: "DeletePlannedTransaction",
+			Handler:    _PlannedTransactionService_DeletePlannedTransaction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arian/v1/planned_services.proto",
+}
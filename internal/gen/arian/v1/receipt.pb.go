@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/receipt.proto
+
+package arianv1
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReceiptItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *money.Money           `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3,oneof" json:"unit_price,omitempty"`
+	Total         *money.Money           `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptItem) Reset() {
+	*x = ReceiptItem{}
+	mi := &file_arian_v1_receipt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptItem) ProtoMessage() {}
+
+func (x *ReceiptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptItem.ProtoReflect.Descriptor instead.
+func (*ReceiptItem) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReceiptItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetUnitPrice() *money.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *ReceiptItem) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type Receipt struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Merchant    *string                `protobuf:"bytes,2,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	Total       *money.Money           `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	ReceiptDate *date.Date             `protobuf:"bytes,4,opt,name=receipt_date,json=receiptDate,proto3,oneof" json:"receipt_date,omitempty"`
+	Items       []*ReceiptItem         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	MatchStatus ReceiptMatchStatus     `protobuf:"varint,6,opt,name=match_status,json=matchStatus,proto3,enum=arian.v1.ReceiptMatchStatus" json:"match_status,omitempty"`
+	// the suggested or confirmed transaction
+	TransactionId *int64                 `protobuf:"varint,7,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	MatchScore    *float64               `protobuf:"fixed64,8,opt,name=match_score,json=matchScore,proto3,oneof" json:"match_score,omitempty"`
+	ContentType   string                 `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_arian_v1_receipt_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Receipt) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Receipt) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *Receipt) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Receipt) GetReceiptDate() *date.Date {
+	if x != nil {
+		return x.ReceiptDate
+	}
+	return nil
+}
+
+func (x *Receipt) GetItems() []*ReceiptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Receipt) GetMatchStatus() ReceiptMatchStatus {
+	if x != nil {
+		return x.MatchStatus
+	}
+	return ReceiptMatchStatus_RECEIPT_MATCH_STATUS_UNSPECIFIED
+}
+
+func (x *Receipt) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *Receipt) GetMatchScore() float64 {
+	if x != nil && x.MatchScore != nil {
+		return *x.MatchScore
+	}
+	return 0
+}
+
+func (x *Receipt) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Receipt) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Receipt) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_arian_v1_receipt_proto protoreflect.FileDescriptor
+
+const file_arian_v1_receipt_proto_rawDesc = "" +
+	"\n" +
+	"\x16arian/v1/receipt.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\xbe\x01\n" +
+	"\vReceiptItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x126\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x12.google.type.MoneyH\x00R\tunitPrice\x88\x01\x01\x12(\n" +
+	"\x05total\x18\x05 \x01(\v2\x12.google.type.MoneyR\x05totalB\r\n" +
+	"\v_unit_price\"\xb9\x04\n" +
+	"\aReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\bmerchant\x18\x02 \x01(\tH\x00R\bmerchant\x88\x01\x01\x12(\n" +
+	"\x05total\x18\x03 \x01(\v2\x12.google.type.MoneyR\x05total\x129\n" +
+	"\freceipt_date\x18\x04 \x01(\v2\x11.google.type.DateH\x01R\vreceiptDate\x88\x01\x01\x12+\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.arian.v1.ReceiptItemR\x05items\x12?\n" +
+	"\fmatch_status\x18\x06 \x01(\x0e2\x1c.arian.v1.ReceiptMatchStatusR\vmatchStatus\x12*\n" +
+	"\x0etransaction_id\x18\a \x01(\x03H\x02R\rtransactionId\x88\x01\x01\x12$\n" +
+	"\vmatch_score\x18\b \x01(\x01H\x03R\n" +
+	"matchScore\x88\x01\x01\x12!\n" +
+	"\fcontent_type\x18\t \x01(\tR\vcontentType\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"\t_merchantB\x0f\n" +
+	"\r_receipt_dateB\x11\n" +
+	"\x0f_transaction_idB\x0e\n" +
+	"\f_match_scoreB\x83\x01\n" +
+	"\fcom.arian.v1B\fReceiptProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_receipt_proto_rawDescOnce sync.Once
+	file_arian_v1_receipt_proto_rawDescData []byte
+)
+
+func file_arian_v1_receipt_proto_rawDescGZIP() []byte {
+	file_arian_v1_receipt_proto_rawDescOnce.Do(func() {
+		file_arian_v1_receipt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_receipt_proto_rawDesc), len(file_arian_v1_receipt_proto_rawDesc)))
+	})
+	return file_arian_v1_receipt_proto_rawDescData
+}
+
+var file_arian_v1_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_arian_v1_receipt_proto_goTypes = []any{
+	(*ReceiptItem)(nil),           // 0: arian.v1.ReceiptItem
+	(*Receipt)(nil),               // 1: arian.v1.Receipt
+	(*money.Money)(nil),           // 2: google.type.Money
+	(*date.Date)(nil),             // 3: google.type.Date
+	(ReceiptMatchStatus)(0),       // 4: arian.v1.ReceiptMatchStatus
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_arian_v1_receipt_proto_depIdxs = []int32{
+	2, // 0: arian.v1.ReceiptItem.unit_price:type_name -> google.type.Money
+	2, // 1: arian.v1.ReceiptItem.total:type_name -> google.type.Money
+	2, // 2: arian.v1.Receipt.total:type_name -> google.type.Money
+	3, // 3: arian.v1.Receipt.receipt_date:type_name -> google.type.Date
+	0, // 4: arian.v1.Receipt.items:type_name -> arian.v1.ReceiptItem
+	4, // 5: arian.v1.Receipt.match_status:type_name -> arian.v1.ReceiptMatchStatus
+	5, // 6: arian.v1.Receipt.created_at:type_name -> google.protobuf.Timestamp
+	5, // 7: arian.v1.Receipt.updated_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_receipt_proto_init() }
+func file_arian_v1_receipt_proto_init() {
+	if File_arian_v1_receipt_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_receipt_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_receipt_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_receipt_proto_rawDesc), len(file_arian_v1_receipt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_arian_v1_receipt_proto_goTypes,
+		DependencyIndexes: file_arian_v1_receipt_proto_depIdxs,
+		MessageInfos:      file_arian_v1_receipt_proto_msgTypes,
+	}.Build()
+	File_arian_v1_receipt_proto = out.File
+	file_arian_v1_receipt_proto_goTypes = nil
+	file_arian_v1_receipt_proto_depIdxs = nil
+}
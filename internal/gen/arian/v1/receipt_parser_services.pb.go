@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/receipt_parser_services.proto
+
+package arianv1
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ParseReceiptRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Content []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// mime type of content, e.g. image/png or application/pdf
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseReceiptRequest) Reset() {
+	*x = ParseReceiptRequest{}
+	mi := &file_arian_v1_receipt_parser_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseReceiptRequest) ProtoMessage() {}
+
+func (x *ParseReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_parser_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ParseReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_parser_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParseReceiptRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ParseReceiptRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type ParsedReceiptItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *money.Money           `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3,oneof" json:"unit_price,omitempty"`
+	Total         *money.Money           `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParsedReceiptItem) Reset() {
+	*x = ParsedReceiptItem{}
+	mi := &file_arian_v1_receipt_parser_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsedReceiptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedReceiptItem) ProtoMessage() {}
+
+func (x *ParsedReceiptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_parser_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedReceiptItem.ProtoReflect.Descriptor instead.
+func (*ParsedReceiptItem) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_parser_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParsedReceiptItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParsedReceiptItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ParsedReceiptItem) GetUnitPrice() *money.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *ParsedReceiptItem) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+type ParseReceiptResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Merchant *string                `protobuf:"bytes,1,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	// currency_code is empty when the receipt doesn't say
+	Total         *money.Money         `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	ReceiptDate   *date.Date           `protobuf:"bytes,3,opt,name=receipt_date,json=receiptDate,proto3,oneof" json:"receipt_date,omitempty"`
+	Items         []*ParsedReceiptItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseReceiptResponse) Reset() {
+	*x = ParseReceiptResponse{}
+	mi := &file_arian_v1_receipt_parser_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseReceiptResponse) ProtoMessage() {}
+
+func (x *ParseReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_parser_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseReceiptResponse.ProtoReflect.Descriptor instead.
+func (*ParseReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_parser_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParseReceiptResponse) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *ParseReceiptResponse) GetTotal() *money.Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *ParseReceiptResponse) GetReceiptDate() *date.Date {
+	if x != nil {
+		return x.ReceiptDate
+	}
+	return nil
+}
+
+func (x *ParseReceiptResponse) GetItems() []*ParsedReceiptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_arian_v1_receipt_parser_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_receipt_parser_services_proto_rawDesc = "" +
+	"\n" +
+	"&arian/v1/receipt_parser_services.proto\x12\barian.v1\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"R\n" +
+	"\x13ParseReceiptRequest\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"\xb4\x01\n" +
+	"\x11ParsedReceiptItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x126\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\v2\x12.google.type.MoneyH\x00R\tunitPrice\x88\x01\x01\x12(\n" +
+	"\x05total\x18\x04 \x01(\v2\x12.google.type.MoneyR\x05totalB\r\n" +
+	"\v_unit_price\"\xed\x01\n" +
+	"\x14ParseReceiptResponse\x12\x1f\n" +
+	"\bmerchant\x18\x01 \x01(\tH\x00R\bmerchant\x88\x01\x01\x12(\n" +
+	"\x05total\x18\x02 \x01(\v2\x12.google.type.MoneyR\x05total\x129\n" +
+	"\freceipt_date\x18\x03 \x01(\v2\x11.google.type.DateH\x01R\vreceiptDate\x88\x01\x01\x121\n" +
+	"\x05items\x18\x04 \x03(\v2\x1b.arian.v1.ParsedReceiptItemR\x05itemsB\v\n" +
+	"\t_merchantB\x0f\n" +
+	"\r_receipt_date2e\n" +
+	"\x14ReceiptParserService\x12M\n" +
+	"\fParseReceipt\x12\x1d.arian.v1.ParseReceiptRequest\x1a\x1e.arian.v1.ParseReceiptResponseB\x91\x01\n" +
+	"\fcom.arian.v1B\x1aReceiptParserServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_receipt_parser_services_proto_rawDescOnce sync.Once
+	file_arian_v1_receipt_parser_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_receipt_parser_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_receipt_parser_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_receipt_parser_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_receipt_parser_services_proto_rawDesc), len(file_arian_v1_receipt_parser_services_proto_rawDesc)))
+	})
+	return file_arian_v1_receipt_parser_services_proto_rawDescData
+}
+
+var file_arian_v1_receipt_parser_services_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_arian_v1_receipt_parser_services_proto_goTypes = []any{
+	(*ParseReceiptRequest)(nil),  // 0: arian.v1.ParseReceiptRequest
+	(*ParsedReceiptItem)(nil),    // 1: arian.v1.ParsedReceiptItem
+	(*ParseReceiptResponse)(nil), // 2: arian.v1.ParseReceiptResponse
+	(*money.Money)(nil),          // 3: google.type.Money
+	(*date.Date)(nil),            // 4: google.type.Date
+}
+var file_arian_v1_receipt_parser_services_proto_depIdxs = []int32{
+	3, // 0: arian.v1.ParsedReceiptItem.unit_price:type_name -> google.type.Money
+	3, // 1: arian.v1.ParsedReceiptItem.total:type_name -> google.type.Money
+	3, // 2: arian.v1.ParseReceiptResponse.total:type_name -> google.type.Money
+	4, // 3: arian.v1.ParseReceiptResponse.receipt_date:type_name -> google.type.Date
+	1, // 4: arian.v1.ParseReceiptResponse.items:type_name -> arian.v1.ParsedReceiptItem
+	0, // 5: arian.v1.ReceiptParserService.ParseReceipt:input_type -> arian.v1.ParseReceiptRequest
+	2, // 6: arian.v1.ReceiptParserService.ParseReceipt:output_type -> arian.v1.ParseReceiptResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_receipt_parser_services_proto_init() }
+func file_arian_v1_receipt_parser_services_proto_init() {
+	if File_arian_v1_receipt_parser_services_proto != nil {
+		return
+	}
+	file_arian_v1_receipt_parser_services_proto_msgTypes[1].OneofWrappers = []any{}
+	file_arian_v1_receipt_parser_services_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_receipt_parser_services_proto_rawDesc), len(file_arian_v1_receipt_parser_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_receipt_parser_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_receipt_parser_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_receipt_parser_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_receipt_parser_services_proto = out.File
+	file_arian_v1_receipt_parser_services_proto_goTypes = nil
+	file_arian_v1_receipt_parser_services_proto_depIdxs = nil
+}
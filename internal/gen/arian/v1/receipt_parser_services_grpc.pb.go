@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: arian/v1/receipt_parser_services.proto
+
+package arianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReceiptParserService_ParseReceipt_FullMethodName = "/arian.v1.ReceiptParserService/ParseReceipt"
+)
+
+// ReceiptParserServiceClient is the client API for ReceiptParserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReceiptParserService is served by arian-receipts. It answers
+// INVALID_ARGUMENT when the upload can't be read as a receipt.
+type ReceiptParserServiceClient interface {
+	ParseReceipt(ctx context.Context, in *ParseReceiptRequest, opts ...grpc.CallOption) (*ParseReceiptResponse, error)
+}
+
+type receiptParserServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReceiptParserServiceClient(cc grpc.ClientConnInterface) ReceiptParserServiceClient {
+	return &receiptParserServiceClient{cc}
+}
+
+func (c *receiptParserServiceClient) ParseReceipt(ctx context.Context, in *ParseReceiptRequest, opts ...grpc.CallOption) (*ParseReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseReceiptResponse)
+	err := c.cc.Invoke(ctx, ReceiptParserService_ParseReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReceiptParserServiceServer is the server API for ReceiptParserService service.
+// All implementations must embed UnimplementedReceiptParserServiceServer
+// for forward compatibility.
+//
+// ReceiptParserService is served by arian-receipts. It answers
+// INVALID_ARGUMENT when the upload can't be read as a receipt.
+type ReceiptParserServiceServer interface {
+	ParseReceipt(context.Context, *ParseReceiptRequest) (*ParseReceiptResponse, error)
+	mustEmbedUnimplementedReceiptParserServiceServer()
+}
+
+// UnimplementedReceiptParserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReceiptParserServiceServer struct{}
+
+func (UnimplementedReceiptParserServiceServer) ParseReceipt(context.Context, *ParseReceiptRequest) (*ParseReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseReceipt not implemented")
+}
+func (UnimplementedReceiptParserServiceServer) mustEmbedUnimplementedReceiptParserServiceServer() {}
+func (UnimplementedReceiptParserServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeReceiptParserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReceiptParserServiceServer will
+// result in compilation errors.
+type UnsafeReceiptParserServiceServer interface {
+	mustEmbedUnimplementedReceiptParserServiceServer()
+}
+
+func RegisterReceiptParserServiceServer(s grpc.ServiceRegistrar, srv ReceiptParserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReceiptParserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReceiptParserService_ServiceDesc, srv)
+}
+
+func _ReceiptParserService_ParseReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReceiptParserServiceServer).ParseReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReceiptParserService_ParseReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReceiptParserServiceServer).ParseReceipt(ctx, req.(*ParseReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReceiptParserService_ServiceDesc is the grpc.ServiceDesc for ReceiptParserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReceiptParserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "arian.v1.ReceiptParserService",
+	HandlerType: (*ReceiptParserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ParseReceipt",
+			Handler:    _ReceiptParserService_ParseReceipt_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arian/v1/receipt_parser_services.proto",
+}
@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/receipt_services.proto
+
+package arianv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UploadReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadReceiptRequest) Reset() {
+	*x = UploadReceiptRequest{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadReceiptRequest) ProtoMessage() {}
+
+func (x *UploadReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadReceiptRequest.ProtoReflect.Descriptor instead.
+func (*UploadReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadReceiptRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UploadReceiptRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UploadReceiptRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type UploadReceiptResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Receipt *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// every transaction that could be the receipt, best first
+	Candidates    []*TransactionWithScore `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadReceiptResponse) Reset() {
+	*x = UploadReceiptResponse{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadReceiptResponse) ProtoMessage() {}
+
+func (x *UploadReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadReceiptResponse.ProtoReflect.Descriptor instead.
+func (*UploadReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+func (x *UploadReceiptResponse) GetCandidates() []*TransactionWithScore {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetReceiptRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReceiptRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type ListReceiptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MatchStatus   *ReceiptMatchStatus    `protobuf:"varint,2,opt,name=match_status,json=matchStatus,proto3,enum=arian.v1.ReceiptMatchStatus,oneof" json:"match_status,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceiptsRequest) Reset() {
+	*x = ListReceiptsRequest{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceiptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceiptsRequest) ProtoMessage() {}
+
+func (x *ListReceiptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceiptsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceiptsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReceiptsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListReceiptsRequest) GetMatchStatus() ReceiptMatchStatus {
+	if x != nil && x.MatchStatus != nil {
+		return *x.MatchStatus
+	}
+	return ReceiptMatchStatus_RECEIPT_MATCH_STATUS_UNSPECIFIED
+}
+
+func (x *ListReceiptsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListReceiptsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListReceiptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipts      []*Receipt             `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceiptsResponse) Reset() {
+	*x = ListReceiptsResponse{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceiptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceiptsResponse) ProtoMessage() {}
+
+func (x *ListReceiptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceiptsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceiptsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReceiptsResponse) GetReceipts() []*Receipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type ConfirmReceiptMatchRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReceiptId int64                  `protobuf:"varint,2,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	// pick a different transaction than the suggested one
+	TransactionId *int64 `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReceiptMatchRequest) Reset() {
+	*x = ConfirmReceiptMatchRequest{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReceiptMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReceiptMatchRequest) ProtoMessage() {}
+
+func (x *ConfirmReceiptMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReceiptMatchRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReceiptMatchRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConfirmReceiptMatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmReceiptMatchRequest) GetReceiptId() int64 {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return 0
+}
+
+func (x *ConfirmReceiptMatchRequest) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type ConfirmReceiptMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReceiptMatchResponse) Reset() {
+	*x = ConfirmReceiptMatchResponse{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReceiptMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReceiptMatchResponse) ProtoMessage() {}
+
+func (x *ConfirmReceiptMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReceiptMatchResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmReceiptMatchResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConfirmReceiptMatchResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type RejectReceiptMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReceiptId     int64                  `protobuf:"varint,2,opt,name=receipt_id,json=receiptId,proto3" json:"receipt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectReceiptMatchRequest) Reset() {
+	*x = RejectReceiptMatchRequest{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectReceiptMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReceiptMatchRequest) ProtoMessage() {}
+
+func (x *RejectReceiptMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReceiptMatchRequest.ProtoReflect.Descriptor instead.
+func (*RejectReceiptMatchRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectReceiptMatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RejectReceiptMatchRequest) GetReceiptId() int64 {
+	if x != nil {
+		return x.ReceiptId
+	}
+	return 0
+}
+
+type RejectReceiptMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receipt       *Receipt               `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectReceiptMatchResponse) Reset() {
+	*x = RejectReceiptMatchResponse{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectReceiptMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectReceiptMatchResponse) ProtoMessage() {}
+
+func (x *RejectReceiptMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectReceiptMatchResponse.ProtoReflect.Descriptor instead.
+func (*RejectReceiptMatchResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectReceiptMatchResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type DeleteReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReceiptRequest) Reset() {
+	*x = DeleteReceiptRequest{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReceiptRequest) ProtoMessage() {}
+
+func (x *DeleteReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReceiptRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteReceiptRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteReceiptRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReceiptResponse) Reset() {
+	*x = DeleteReceiptResponse{}
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReceiptResponse) ProtoMessage() {}
+
+func (x *DeleteReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_receipt_services_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReceiptResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_receipt_services_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteReceiptResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+var File_arian_v1_receipt_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_receipt_services_proto_rawDesc = "" +
+	"\n" +
+	"\x1farian/v1/receipt_services.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x16arian/v1/receipt.proto\x1a\x1aarian/v1/transaction.proto\x1a\x1bbuf/validate/validate.proto\"\xcb\x01\n" +
+	"\x14UploadReceiptRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\acontent\x18\x02 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\n" +
+	"R\acontent\x12h\n" +
+	"\fcontent_type\x18\x03 \x01(\tBE\xbaHBr@R\n" +
+	"image/jpegR\timage/pngR\n" +
+	"image/webpR\n" +
+	"image/heicR\x0fapplication/pdfR\vcontentType\"\x84\x01\n" +
+	"\x15UploadReceiptResponse\x12+\n" +
+	"\areceipt\x18\x01 \x01(\v2\x11.arian.v1.ReceiptR\areceipt\x12>\n" +
+	"\n" +
+	"candidates\x18\x02 \x03(\v2\x1e.arian.v1.TransactionWithScoreR\n" +
+	"candidates\"O\n" +
+	"\x11GetReceiptRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"A\n" +
+	"\x12GetReceiptResponse\x12+\n" +
+	"\areceipt\x18\x01 \x01(\v2\x11.arian.v1.ReceiptR\areceipt\"\xfb\x01\n" +
+	"\x13ListReceiptsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12N\n" +
+	"\fmatch_status\x18\x02 \x01(\x0e2\x1c.arian.v1.ReceiptMatchStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\vmatchStatus\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x01H\x01R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x02R\x06offset\x88\x01\x01B\x0f\n" +
+	"\r_match_statusB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"E\n" +
+	"\x14ListReceiptsResponse\x12-\n" +
+	"\breceipts\x18\x01 \x03(\v2\x11.arian.v1.ReceiptR\breceipts\"\xaf\x01\n" +
+	"\x1aConfirmReceiptMatchRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"receipt_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\treceiptId\x123\n" +
+	"\x0etransaction_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\rtransactionId\x88\x01\x01B\x11\n" +
+	"\x0f_transaction_id\"J\n" +
+	"\x1bConfirmReceiptMatchResponse\x12+\n" +
+	"\areceipt\x18\x01 \x01(\v2\x11.arian.v1.ReceiptR\areceipt\"f\n" +
+	"\x19RejectReceiptMatchRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"receipt_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\treceiptId\"I\n" +
+	"\x1aRejectReceiptMatchResponse\x12+\n" +
+	"\areceipt\x18\x01 \x01(\v2\x11.arian.v1.ReceiptR\areceipt\"R\n" +
+	"\x14DeleteReceiptRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"<\n" +
+	"\x15DeleteReceiptResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows2\x91\x04\n" +
+	"\x0eReceiptService\x12P\n" +
+	"\rUploadReceipt\x12\x1e.arian.v1.UploadReceiptRequest\x1a\x1f.arian.v1.UploadReceiptResponse\x12G\n" +
+	"\n" +
+	"GetReceipt\x12\x1b.arian.v1.GetReceiptRequest\x1a\x1c.arian.v1.GetReceiptResponse\x12M\n" +
+	"\fListReceipts\x12\x1d.arian.v1.ListReceiptsRequest\x1a\x1e.arian.v1.ListReceiptsResponse\x12b\n" +
+	"\x13ConfirmReceiptMatch\x12$.arian.v1.ConfirmReceiptMatchRequest\x1a%.arian.v1.ConfirmReceiptMatchResponse\x12_\n" +
+	"\x12RejectReceiptMatch\x12#.arian.v1.RejectReceiptMatchRequest\x1a$.arian.v1.RejectReceiptMatchResponse\x12P\n" +
+	"\rDeleteReceipt\x12\x1e.arian.v1.DeleteReceiptRequest\x1a\x1f.arian.v1.DeleteReceiptResponseB\x8b\x01\n" +
+	"\fcom.arian.v1B\x14ReceiptServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_receipt_services_proto_rawDescOnce sync.Once
+	file_arian_v1_receipt_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_receipt_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_receipt_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_receipt_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_receipt_services_proto_rawDesc), len(file_arian_v1_receipt_services_proto_rawDesc)))
+	})
+	return file_arian_v1_receipt_services_proto_rawDescData
+}
+
+var file_arian_v1_receipt_services_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_arian_v1_receipt_services_proto_goTypes = []any{
+	(*UploadReceiptRequest)(nil),        // 0: arian.v1.UploadReceiptRequest
+	(*UploadReceiptResponse)(nil),       // 1: arian.v1.UploadReceiptResponse
+	(*GetReceiptRequest)(nil),           // 2: arian.v1.GetReceiptRequest
+	(*GetReceiptResponse)(nil),          // 3: arian.v1.GetReceiptResponse
+	(*ListReceiptsRequest)(nil),         // 4: arian.v1.ListReceiptsRequest
+	(*ListReceiptsResponse)(nil),        // 5: arian.v1.ListReceiptsResponse
+	(*ConfirmReceiptMatchRequest)(nil),  // 6: arian.v1.ConfirmReceiptMatchRequest
+	(*ConfirmReceiptMatchResponse)(nil), // 7: arian.v1.ConfirmReceiptMatchResponse
+	(*RejectReceiptMatchRequest)(nil),   // 8: arian.v1.RejectReceiptMatchRequest
+	(*RejectReceiptMatchResponse)(nil),  // 9: arian.v1.RejectReceiptMatchResponse
+	(*DeleteReceiptRequest)(nil),        // 10: arian.v1.DeleteReceiptRequest
+	(*DeleteReceiptResponse)(nil),       // 11: arian.v1.DeleteReceiptResponse
+	(*Receipt)(nil),                     // 12: arian.v1.Receipt
+	(*TransactionWithScore)(nil),        // 13: arian.v1.TransactionWithScore
+	(ReceiptMatchStatus)(0),             // 14: arian.v1.ReceiptMatchStatus
+}
+var file_arian_v1_receipt_services_proto_depIdxs = []int32{
+	12, // 0: arian.v1.UploadReceiptResponse.receipt:type_name -> arian.v1.Receipt
+	13, // 1: arian.v1.UploadReceiptResponse.candidates:type_name -> arian.v1.TransactionWithScore
+	12, // 2: arian.v1.GetReceiptResponse.receipt:type_name -> arian.v1.Receipt
+	14, // 3: arian.v1.ListReceiptsRequest.match_status:type_name -> arian.v1.ReceiptMatchStatus
+	12, // 4: arian.v1.ListReceiptsResponse.receipts:type_name -> arian.v1.Receipt
+	12, // 5: arian.v1.ConfirmReceiptMatchResponse.receipt:type_name -> arian.v1.Receipt
+	12, // 6: arian.v1.RejectReceiptMatchResponse.receipt:type_name -> arian.v1.Receipt
+	0,  // 7: arian.v1.ReceiptService.UploadReceipt:input_type -> arian.v1.UploadReceiptRequest
+	2,  // 8: arian.v1.ReceiptService.GetReceipt:input_type -> arian.v1.GetReceiptRequest
+	4,  // 9: arian.v1.ReceiptService.ListReceipts:input_type -> arian.v1.ListReceiptsRequest
+	6,  // 10: arian.v1.ReceiptService.ConfirmReceiptMatch:input_type -> arian.v1.ConfirmReceiptMatchRequest
+	8,  // 11: arian.v1.ReceiptService.RejectReceiptMatch:input_type -> arian.v1.RejectReceiptMatchRequest
+	10, // 12: arian.v1.ReceiptService.DeleteReceipt:input_type -> arian.v1.DeleteReceiptRequest
+	1,  // 13: arian.v1.ReceiptService.UploadReceipt:output_type -> arian.v1.UploadReceiptResponse
+	3,  // 14: arian.v1.ReceiptService.GetReceipt:output_type -> arian.v1.GetReceiptResponse
+	5,  // 15: arian.v1.ReceiptService.ListReceipts:output_type -> arian.v1.ListReceiptsResponse
+	7,  // 16: arian.v1.ReceiptService.ConfirmReceiptMatch:output_type -> arian.v1.ConfirmReceiptMatchResponse
+	9,  // 17: arian.v1.ReceiptService.RejectReceiptMatch:output_type -> arian.v1.RejectReceiptMatchResponse
+	11, // 18: arian.v1.ReceiptService.DeleteReceipt:output_type -> arian.v1.DeleteReceiptResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_receipt_services_proto_init() }
+func file_arian_v1_receipt_services_proto_init() {
+	if File_arian_v1_receipt_services_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_receipt_proto_init()
+	file_arian_v1_transaction_proto_init()
+	file_arian_v1_receipt_services_proto_msgTypes[4].OneofWrappers = []any{}
+	file_arian_v1_receipt_services_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_receipt_services_proto_rawDesc), len(file_arian_v1_receipt_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_receipt_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_receipt_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_receipt_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_receipt_services_proto = out.File
+	file_arian_v1_receipt_services_proto_goTypes = nil
+	file_arian_v1_receipt_services_proto_depIdxs = nil
+}
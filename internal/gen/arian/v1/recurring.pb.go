@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/recurring.proto
+
+package arianv1
+
+import (
+	date "google.golang.org/genproto/googleapis/type/date"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// a subscription or bill found by the recurring detector
+type RecurringSeries struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountId      *int64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	Cadence        RecurringCadence       `protobuf:"varint,4,opt,name=cadence,proto3,enum=arian.v1.RecurringCadence" json:"cadence,omitempty"`
+	Direction      TransactionDirection   `protobuf:"varint,5,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
+	ExpectedAmount *money.Money           `protobuf:"bytes,6,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	LastAmount     *money.Money           `protobuf:"bytes,7,opt,name=last_amount,json=lastAmount,proto3" json:"last_amount,omitempty"`
+	LastDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_date,json=lastDate,proto3" json:"last_date,omitempty"`
+	NextDueDate    *date.Date             `protobuf:"bytes,9,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"`
+	Occurrences    int32                  `protobuf:"varint,10,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	Status         RecurringStatus        `protobuf:"varint,11,opt,name=status,proto3,enum=arian.v1.RecurringStatus" json:"status,omitempty"`
+	// set when a confirmed series got more expensive, cleared by confirming it again
+	PriceIncreaseFrom *money.Money           `protobuf:"bytes,12,opt,name=price_increase_from,json=priceIncreaseFrom,proto3,oneof" json:"price_increase_from,omitempty"`
+	TransactionIds    []int64                `protobuf:"varint,13,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecurringSeries) Reset() {
+	*x = RecurringSeries{}
+	mi := &file_arian_v1_recurring_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringSeries) ProtoMessage() {}
+
+func (x *RecurringSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringSeries.ProtoReflect.Descriptor instead.
+func (*RecurringSeries) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecurringSeries) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecurringSeries) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetCadence() RecurringCadence {
+	if x != nil {
+		return x.Cadence
+	}
+	return RecurringCadence_RECURRING_CADENCE_UNSPECIFIED
+}
+
+func (x *RecurringSeries) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *RecurringSeries) GetExpectedAmount() *money.Money {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetLastAmount() *money.Money {
+	if x != nil {
+		return x.LastAmount
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetLastDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastDate
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetNextDueDate() *date.Date {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *RecurringSeries) GetStatus() RecurringStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RecurringStatus_RECURRING_STATUS_UNSPECIFIED
+}
+
+func (x *RecurringSeries) GetPriceIncreaseFrom() *money.Money {
+	if x != nil {
+		return x.PriceIncreaseFrom
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecurringSeries) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_arian_v1_recurring_proto protoreflect.FileDescriptor
+
+const file_arian_v1_recurring_proto_rawDesc = "" +
+	"\n" +
+	"\x18arian/v1/recurring.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16google/type/date.proto\x1a\x17google/type/money.proto\"\x93\x06\n" +
+	"\x0fRecurringSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03H\x00R\taccountId\x88\x01\x01\x124\n" +
+	"\acadence\x18\x04 \x01(\x0e2\x1a.arian.v1.RecurringCadenceR\acadence\x12<\n" +
+	"\tdirection\x18\x05 \x01(\x0e2\x1e.arian.v1.TransactionDirectionR\tdirection\x12;\n" +
+	"\x0fexpected_amount\x18\x06 \x01(\v2\x12.google.type.MoneyR\x0eexpectedAmount\x123\n" +
+	"\vlast_amount\x18\a \x01(\v2\x12.google.type.MoneyR\n" +
+	"lastAmount\x127\n" +
+	"\tlast_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\blastDate\x125\n" +
+	"\rnext_due_date\x18\t \x01(\v2\x11.google.type.DateR\vnextDueDate\x12 \n" +
+	"\voccurrences\x18\n" +
+	" \x01(\x05R\voccurrences\x121\n" +
+	"\x06status\x18\v \x01(\x0e2\x19.arian.v1.RecurringStatusR\x06status\x12G\n" +
+	"\x13price_increase_from\x18\f \x01(\v2\x12.google.type.MoneyH\x01R\x11priceIncreaseFrom\x88\x01\x01\x12'\n" +
+	"\x0ftransaction_ids\x18\r \x03(\x03R\x0etransactionIds\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\r\n" +
+	"\v_account_idB\x16\n" +
+	"\x14_price_increase_fromB\x85\x01\n" +
+	"\fcom.arian.v1B\x0eRecurringProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_recurring_proto_rawDescOnce sync.Once
+	file_arian_v1_recurring_proto_rawDescData []byte
+)
+
+func file_arian_v1_recurring_proto_rawDescGZIP() []byte {
+	file_arian_v1_recurring_proto_rawDescOnce.Do(func() {
+		file_arian_v1_recurring_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_recurring_proto_rawDesc), len(file_arian_v1_recurring_proto_rawDesc)))
+	})
+	return file_arian_v1_recurring_proto_rawDescData
+}
+
+var file_arian_v1_recurring_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_arian_v1_recurring_proto_goTypes = []any{
+	(*RecurringSeries)(nil),       // 0: arian.v1.RecurringSeries
+	(RecurringCadence)(0),         // 1: arian.v1.RecurringCadence
+	(TransactionDirection)(0),     // 2: arian.v1.TransactionDirection
+	(*money.Money)(nil),           // 3: google.type.Money
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*date.Date)(nil),             // 5: google.type.Date
+	(RecurringStatus)(0),          // 6: arian.v1.RecurringStatus
+}
+var file_arian_v1_recurring_proto_depIdxs = []int32{
+	1,  // 0: arian.v1.RecurringSeries.cadence:type_name -> arian.v1.RecurringCadence
+	2,  // 1: arian.v1.RecurringSeries.direction:type_name -> arian.v1.TransactionDirection
+	3,  // 2: arian.v1.RecurringSeries.expected_amount:type_name -> google.type.Money
+	3,  // 3: arian.v1.RecurringSeries.last_amount:type_name -> google.type.Money
+	4,  // 4: arian.v1.RecurringSeries.last_date:type_name -> google.protobuf.Timestamp
+	5,  // 5: arian.v1.RecurringSeries.next_due_date:type_name -> google.type.Date
+	6,  // 6: arian.v1.RecurringSeries.status:type_name -> arian.v1.RecurringStatus
+	3,  // 7: arian.v1.RecurringSeries.price_increase_from:type_name -> google.type.Money
+	4,  // 8: arian.v1.RecurringSeries.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 9: arian.v1.RecurringSeries.updated_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_recurring_proto_init() }
+func file_arian_v1_recurring_proto_init() {
+	if File_arian_v1_recurring_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_recurring_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_recurring_proto_rawDesc), len(file_arian_v1_recurring_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_arian_v1_recurring_proto_goTypes,
+		DependencyIndexes: file_arian_v1_recurring_proto_depIdxs,
+		MessageInfos:      file_arian_v1_recurring_proto_msgTypes,
+	}.Build()
+	File_arian_v1_recurring_proto = out.File
+	file_arian_v1_recurring_proto_goTypes = nil
+	file_arian_v1_recurring_proto_depIdxs = nil
+}
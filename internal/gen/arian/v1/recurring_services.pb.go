@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/recurring_services.proto
+
+package arianv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// scans the last lookback_days (default 400) of history and upserts series
+type DetectRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LookbackDays  *int32                 `protobuf:"varint,2,opt,name=lookback_days,json=lookbackDays,proto3,oneof" json:"lookback_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectRecurringRequest) Reset() {
+	*x = DetectRecurringRequest{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectRecurringRequest) ProtoMessage() {}
+
+func (x *DetectRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRecurringRequest.ProtoReflect.Descriptor instead.
+func (*DetectRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DetectRecurringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DetectRecurringRequest) GetLookbackDays() int32 {
+	if x != nil && x.LookbackDays != nil {
+		return *x.LookbackDays
+	}
+	return 0
+}
+
+type DetectRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*RecurringSeries     `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectRecurringResponse) Reset() {
+	*x = DetectRecurringResponse{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectRecurringResponse) ProtoMessage() {}
+
+func (x *DetectRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRecurringResponse.ProtoReflect.Descriptor instead.
+func (*DetectRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DetectRecurringResponse) GetSeries() []*RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type ListRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        *RecurringStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=arian.v1.RecurringStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringRequest) Reset() {
+	*x = ListRecurringRequest{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringRequest) ProtoMessage() {}
+
+func (x *ListRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringRequest.ProtoReflect.Descriptor instead.
+func (*ListRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRecurringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRecurringRequest) GetStatus() RecurringStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return RecurringStatus_RECURRING_STATUS_UNSPECIFIED
+}
+
+type ListRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*RecurringSeries     `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecurringResponse) Reset() {
+	*x = ListRecurringResponse{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecurringResponse) ProtoMessage() {}
+
+func (x *ListRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecurringResponse.ProtoReflect.Descriptor instead.
+func (*ListRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRecurringResponse) GetSeries() []*RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type ConfirmRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRecurringRequest) Reset() {
+	*x = ConfirmRecurringRequest{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRecurringRequest) ProtoMessage() {}
+
+func (x *ConfirmRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRecurringRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfirmRecurringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConfirmRecurringRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ConfirmRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *RecurringSeries       `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmRecurringResponse) Reset() {
+	*x = ConfirmRecurringResponse{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmRecurringResponse) ProtoMessage() {}
+
+func (x *ConfirmRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmRecurringResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConfirmRecurringResponse) GetSeries() *RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type DismissRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissRecurringRequest) Reset() {
+	*x = DismissRecurringRequest{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissRecurringRequest) ProtoMessage() {}
+
+func (x *DismissRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissRecurringRequest.ProtoReflect.Descriptor instead.
+func (*DismissRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DismissRecurringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DismissRecurringRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DismissRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *RecurringSeries       `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DismissRecurringResponse) Reset() {
+	*x = DismissRecurringResponse{}
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DismissRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DismissRecurringResponse) ProtoMessage() {}
+
+func (x *DismissRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_recurring_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DismissRecurringResponse.ProtoReflect.Descriptor instead.
+func (*DismissRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_recurring_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DismissRecurringResponse) GetSeries() *RecurringSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+var File_arian_v1_recurring_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_recurring_services_proto_rawDesc = "" +
+	"\n" +
+	"!arian/v1/recurring_services.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x18arian/v1/recurring.proto\x1a\x1bbuf/validate/validate.proto\"\x83\x01\n" +
+	"\x16DetectRecurringRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x124\n" +
+	"\rlookback_days\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xa6\x0e(\x1eH\x00R\flookbackDays\x88\x01\x01B\x10\n" +
+	"\x0e_lookback_days\"L\n" +
+	"\x17DetectRecurringResponse\x121\n" +
+	"\x06series\x18\x01 \x03(\v2\x19.arian.v1.RecurringSeriesR\x06series\"\x86\x01\n" +
+	"\x14ListRecurringRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12@\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.arian.v1.RecurringStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"J\n" +
+	"\x15ListRecurringResponse\x121\n" +
+	"\x06series\x18\x01 \x03(\v2\x19.arian.v1.RecurringSeriesR\x06series\"U\n" +
+	"\x17ConfirmRecurringRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"M\n" +
+	"\x18ConfirmRecurringResponse\x121\n" +
+	"\x06series\x18\x01 \x01(\v2\x19.arian.v1.RecurringSeriesR\x06series\"U\n" +
+	"\x17DismissRecurringRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"M\n" +
+	"\x18DismissRecurringResponse\x121\n" +
+	"\x06series\x18\x01 \x01(\v2\x19.arian.v1.RecurringSeriesR\x06series2\xf2\x02\n" +
+	"\x10RecurringService\x12V\n" +
+	"\x0fDetectRecurring\x12 .arian.v1.DetectRecurringRequest\x1a!.arian.v1.DetectRecurringResponse\x12P\n" +
+	"\rListRecurring\x12\x1e.arian.v1.ListRecurringRequest\x1a\x1f.arian.v1.ListRecurringResponse\x12Y\n" +
+	"\x10ConfirmRecurring\x12!.arian.v1.ConfirmRecurringRequest\x1a\".arian.v1.ConfirmRecurringResponse\x12Y\n" +
+	"\x10DismissRecurring\x12!.arian.v1.DismissRecurringRequest\x1a\".arian.v1.DismissRecurringResponseB\x8d\x01\n" +
+	"\fcom.arian.v1B\x16RecurringServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_recurring_services_proto_rawDescOnce sync.Once
+	file_arian_v1_recurring_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_recurring_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_recurring_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_recurring_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_recurring_services_proto_rawDesc), len(file_arian_v1_recurring_services_proto_rawDesc)))
+	})
+	return file_arian_v1_recurring_services_proto_rawDescData
+}
+
+var file_arian_v1_recurring_services_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_arian_v1_recurring_services_proto_goTypes = []any{
+	(*DetectRecurringRequest)(nil),   // 0: arian.v1.DetectRecurringRequest
+	(*DetectRecurringResponse)(nil),  // 1: arian.v1.DetectRecurringResponse
+	(*ListRecurringRequest)(nil),     // 2: arian.v1.ListRecurringRequest
+	(*ListRecurringResponse)(nil),    // 3: arian.v1.ListRecurringResponse
+	(*ConfirmRecurringRequest)(nil),  // 4: arian.v1.ConfirmRecurringRequest
+	(*ConfirmRecurringResponse)(nil), // 5: arian.v1.ConfirmRecurringResponse
+	(*DismissRecurringRequest)(nil),  // 6: arian.v1.DismissRecurringRequest
+	(*DismissRecurringResponse)(nil), // 7: arian.v1.DismissRecurringResponse
+	(*RecurringSeries)(nil),          // 8: arian.v1.RecurringSeries
+	(RecurringStatus)(0),             // 9: arian.v1.RecurringStatus
+}
+var file_arian_v1_recurring_services_proto_depIdxs = []int32{
+	8, // 0: arian.v1.DetectRecurringResponse.series:type_name -> arian.v1.RecurringSeries
+	9, // 1: arian.v1.ListRecurringRequest.status:type_name -> arian.v1.RecurringStatus
+	8, // 2: arian.v1.ListRecurringResponse.series:type_name -> arian.v1.RecurringSeries
+	8, // 3: arian.v1.ConfirmRecurringResponse.series:type_name -> arian.v1.RecurringSeries
+	8, // 4: arian.v1.DismissRecurringResponse.series:type_name -> arian.v1.RecurringSeries
+	0, // 5: arian.v1.RecurringService.DetectRecurring:input_type -> arian.v1.DetectRecurringRequest
+	2, // 6: arian.v1.RecurringService.ListRecurring:input_type -> arian.v1.ListRecurringRequest
+	4, // 7: arian.v1.RecurringService.ConfirmRecurring:input_type -> arian.v1.ConfirmRecurringRequest
+	6, // 8: arian.v1.RecurringService.DismissRecurring:input_type -> arian.v1.DismissRecurringRequest
+	1, // 9: arian.v1.RecurringService.DetectRecurring:output_type -> arian.v1.DetectRecurringResponse
+	3, // 10: arian.v1.RecurringService.ListRecurring:output_type -> arian.v1.ListRecurringResponse
+	5, // 11: arian.v1.RecurringService.ConfirmRecurring:output_type -> arian.v1.ConfirmRecurringResponse
+	7, // 12: arian.v1.RecurringService.DismissRecurring:output_type -> arian.v1.DismissRecurringResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_recurring_services_proto_init() }
+func file_arian_v1_recurring_services_proto_init() {
+	if File_arian_v1_recurring_services_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_recurring_proto_init()
+	file_arian_v1_recurring_services_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_recurring_services_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_recurring_services_proto_rawDesc), len(file_arian_v1_recurring_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_recurring_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_recurring_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_recurring_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_recurring_services_proto = out.File
+	file_arian_v1_recurring_services_proto_goTypes = nil
+	file_arian_v1_recurring_services_proto_depIdxs = nil
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: arian/v1/recurring_services.proto
+
+package arianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecurringService_DetectRecurring_FullMethodName  = "/arian.v1.RecurringService/DetectRecurring"
+	RecurringService_ListRecurring_FullMethodName    = "/arian.v1.RecurringService/ListRecurring"
+	RecurringService_ConfirmRecurring_FullMethodName = "/arian.v1.RecurringService/ConfirmRecurring"
+	RecurringService_DismissRecurring_FullMethodName = "/arian.v1.RecurringService/DismissRecurring"
+)
+
+// RecurringServiceClient is the client API for RecurringService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecurringServiceClient interface {
+	DetectRecurring(ctx context.Context, in *DetectRecurringRequest, opts ...grpc.CallOption) (*DetectRecurringResponse, error)
+	ListRecurring(ctx context.Context, in *ListRecurringRequest, opts ...grpc.CallOption) (*ListRecurringResponse, error)
+	ConfirmRecurring(ctx context.Context, in *ConfirmRecurringRequest, opts ...grpc.CallOption) (*ConfirmRecurringResponse, error)
+	DismissRecurring(ctx context.Context, in *DismissRecurringRequest, opts ...grpc.CallOption) (*DismissRecurringResponse, error)
+}
+
+type recurringServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecurringServiceClient(cc grpc.ClientConnInterface) RecurringServiceClient {
+	return &recurringServiceClient{cc}
+}
+
+func (c *recurringServiceClient) DetectRecurring(ctx context.Context, in *DetectRecurringRequest, opts ...grpc.CallOption) (*DetectRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectRecurringResponse)
+	err := c.cc.Invoke(ctx, RecurringService_DetectRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringServiceClient) ListRecurring(ctx context.Context, in *ListRecurringRequest, opts ...grpc.CallOption) (*ListRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringResponse)
+	err := c.cc.Invoke(ctx, RecurringService_ListRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringServiceClient) ConfirmRecurring(ctx context.Context, in *ConfirmRecurringRequest, opts ...grpc.CallOption) (*ConfirmRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmRecurringResponse)
+	err := c.cc.Invoke(ctx, RecurringService_ConfirmRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringServiceClient) DismissRecurring(ctx context.Context, in *DismissRecurringRequest, opts ...grpc.CallOption) (*DismissRecurringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DismissRecurringResponse)
+	err := c.cc.Invoke(ctx, RecurringService_DismissRecurring_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecurringServiceServer is the server API for RecurringService service.
+// All implementations must embed UnimplementedRecurringServiceServer
+// for forward compatibility.
+type RecurringServiceServer interface {
+	DetectRecurring(context.Context, *DetectRecurringRequest) (*DetectRecurringResponse, error)
+	ListRecurring(context.Context, *ListRecurringRequest) (*ListRecurringResponse, error)
+	ConfirmRecurring(context.Context, *ConfirmRecurringRequest) (*ConfirmRecurringResponse, error)
+	DismissRecurring(context.Context, *DismissRecurringRequest) (*DismissRecurringResponse, error)
+	mustEmbedUnimplementedRecurringServiceServer()
+}
+
+// UnimplementedRecurringServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecurringServiceServer struct{}
+
+func (UnimplementedRecurringServiceServer) DetectRecurring(context.Context, *DetectRecurringRequest) (*DetectRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectRecurring not implemented")
+}
+func (UnimplementedRecurringServiceServer) ListRecurring(context.Context, *ListRecurringRequest) (*ListRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurring not implemented")
+}
+func (UnimplementedRecurringServiceServer) ConfirmRecurring(context.Context, *ConfirmRecurringRequest) (*ConfirmRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRecurring not implemented")
+}
+func (UnimplementedRecurringServiceServer) DismissRecurring(context.Context, *DismissRecurringRequest) (*DismissRecurringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DismissRecurring not implemented")
+}
+func (UnimplementedRecurringServiceServer) mustEmbedUnimplementedRecurringServiceServer() {}
+func (UnimplementedRecurringServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeRecurringServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecurringServiceServer will
+// result in compilation errors.
+type UnsafeRecurringServiceServer interface {
+	mustEmbedUnimplementedRecurringServiceServer()
+}
+
+func RegisterRecurringServiceServer(s grpc.ServiceRegistrar, srv RecurringServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecurringServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecurringService_ServiceDesc, srv)
+}
+
+func _RecurringService_DetectRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringServiceServer).DetectRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringService_DetectRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringServiceServer).DetectRecurring(ctx, req.(*DetectRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringService_ListRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringServiceServer).ListRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringService_ListRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringServiceServer).ListRecurring(ctx, req.(*ListRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringService_ConfirmRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringServiceServer).ConfirmRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringService_ConfirmRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringServiceServer).ConfirmRecurring(ctx, req.(*ConfirmRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringService_DismissRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DismissRecurringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringServiceServer).DismissRecurring(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringService_DismissRecurring_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringServiceServer).DismissRecurring(ctx, req.(*DismissRecurringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecurringService_ServiceDesc is the grpc.ServiceDesc for RecurringService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecurringService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "arian.v1.RecurringService",
+	HandlerType: (*RecurringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DetectRecurring",
+			Handler:    _RecurringService_DetectRecurring_Handler,
+		},
+		{
+			MethodName: "ListRecurring",
+			Handler:    _RecurringService_ListRecurring_Handler,
+		},
+		{
+			MethodName: "ConfirmRecurring",
+			Handler:    _RecurringService_ConfirmRecurring_Handler,
+		},
+		{
+			MethodName: "DismissRecurring",
+			Handler:    _RecurringService_DismissRecurring_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arian/v1/recurring_services.proto",
+}
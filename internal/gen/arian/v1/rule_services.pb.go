@@ -748,11 +748,846 @@ func (x *ValidateRuleResponse) GetNormalizedConditions() *structpb.Struct {
 	return nil
 }
 
+// rule_ids lists every one of the user's rules in their new order; the
+// first one is tried first
+type ReorderRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleIds       []string               `protobuf:"bytes,2,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRulesRequest) Reset() {
+	*x = ReorderRulesRequest{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRulesRequest) ProtoMessage() {}
+
+func (x *ReorderRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRulesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRulesRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderRulesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReorderRulesRequest) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type ReorderRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderRulesResponse) Reset() {
+	*x = ReorderRulesResponse{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderRulesResponse) ProtoMessage() {}
+
+func (x *ReorderRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderRulesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderRulesResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReorderRulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetRuleActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRuleActiveRequest) Reset() {
+	*x = SetRuleActiveRequest{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRuleActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRuleActiveRequest) ProtoMessage() {}
+
+func (x *SetRuleActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRuleActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetRuleActiveRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetRuleActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetRuleActiveRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *SetRuleActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetRuleActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRuleActiveResponse) Reset() {
+	*x = SetRuleActiveResponse{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRuleActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRuleActiveResponse) ProtoMessage() {}
+
+func (x *SetRuleActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRuleActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetRuleActiveResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetRuleActiveResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// replays every transaction the user can see through their active rules
+type AnalyzeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeRulesRequest) Reset() {
+	*x = AnalyzeRulesRequest{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRulesRequest) ProtoMessage() {}
+
+func (x *AnalyzeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRulesRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AnalyzeRulesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RuleReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	PriorityOrder int32                  `protobuf:"varint,3,opt,name=priority_order,json=priorityOrder,proto3" json:"priority_order,omitempty"`
+	MatchCount    int64                  `protobuf:"varint,4,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
+	// transactions this rule set the category or merchant of
+	CategoryWins int64 `protobuf:"varint,5,opt,name=category_wins,json=categoryWins,proto3" json:"category_wins,omitempty"`
+	MerchantWins int64 `protobuf:"varint,6,opt,name=merchant_wins,json=merchantWins,proto3" json:"merchant_wins,omitempty"`
+	// matched transactions but always lost them to a higher priority rule
+	NeverWins bool `protobuf:"varint,7,opt,name=never_wins,json=neverWins,proto3" json:"never_wins,omitempty"`
+	// a higher priority rule matches whenever this one does and takes all of
+	// its actions first, so it can't win on any transaction
+	Unreachable      bool    `protobuf:"varint,8,opt,name=unreachable,proto3" json:"unreachable,omitempty"`
+	ShadowedByRuleId *string `protobuf:"bytes,9,opt,name=shadowed_by_rule_id,json=shadowedByRuleId,proto3,oneof" json:"shadowed_by_rule_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RuleReport) Reset() {
+	*x = RuleReport{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleReport) ProtoMessage() {}
+
+func (x *RuleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleReport.ProtoReflect.Descriptor instead.
+func (*RuleReport) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RuleReport) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleReport) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleReport) GetPriorityOrder() int32 {
+	if x != nil {
+		return x.PriorityOrder
+	}
+	return 0
+}
+
+func (x *RuleReport) GetMatchCount() int64 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+func (x *RuleReport) GetCategoryWins() int64 {
+	if x != nil {
+		return x.CategoryWins
+	}
+	return 0
+}
+
+func (x *RuleReport) GetMerchantWins() int64 {
+	if x != nil {
+		return x.MerchantWins
+	}
+	return 0
+}
+
+func (x *RuleReport) GetNeverWins() bool {
+	if x != nil {
+		return x.NeverWins
+	}
+	return false
+}
+
+func (x *RuleReport) GetUnreachable() bool {
+	if x != nil {
+		return x.Unreachable
+	}
+	return false
+}
+
+func (x *RuleReport) GetShadowedByRuleId() string {
+	if x != nil && x.ShadowedByRuleId != nil {
+		return *x.ShadowedByRuleId
+	}
+	return ""
+}
+
+// two rules that matched the same transactions but assign different
+// categories; rule_id comes first in priority order
+type RuleConflict struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RuleId               string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	OtherRuleId          string                 `protobuf:"bytes,2,opt,name=other_rule_id,json=otherRuleId,proto3" json:"other_rule_id,omitempty"`
+	CategoryId           int64                  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	OtherCategoryId      int64                  `protobuf:"varint,4,opt,name=other_category_id,json=otherCategoryId,proto3" json:"other_category_id,omitempty"`
+	TransactionCount     int64                  `protobuf:"varint,5,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	SampleTransactionIds []int64                `protobuf:"varint,6,rep,packed,name=sample_transaction_ids,json=sampleTransactionIds,proto3" json:"sample_transaction_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RuleConflict) Reset() {
+	*x = RuleConflict{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleConflict) ProtoMessage() {}
+
+func (x *RuleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleConflict.ProtoReflect.Descriptor instead.
+func (*RuleConflict) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RuleConflict) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleConflict) GetOtherRuleId() string {
+	if x != nil {
+		return x.OtherRuleId
+	}
+	return ""
+}
+
+func (x *RuleConflict) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *RuleConflict) GetOtherCategoryId() int64 {
+	if x != nil {
+		return x.OtherCategoryId
+	}
+	return 0
+}
+
+func (x *RuleConflict) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *RuleConflict) GetSampleTransactionIds() []int64 {
+	if x != nil {
+		return x.SampleTransactionIds
+	}
+	return nil
+}
+
+type AnalyzeRulesResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TransactionsAnalyzed int64                  `protobuf:"varint,1,opt,name=transactions_analyzed,json=transactionsAnalyzed,proto3" json:"transactions_analyzed,omitempty"`
+	Rules                []*RuleReport          `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Conflicts            []*RuleConflict        `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AnalyzeRulesResponse) Reset() {
+	*x = AnalyzeRulesResponse{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeRulesResponse) ProtoMessage() {}
+
+func (x *AnalyzeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeRulesResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AnalyzeRulesResponse) GetTransactionsAnalyzed() int64 {
+	if x != nil {
+		return x.TransactionsAnalyzed
+	}
+	return 0
+}
+
+func (x *AnalyzeRulesResponse) GetRules() []*RuleReport {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *AnalyzeRulesResponse) GetConflicts() []*RuleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// dry-runs a rule over every transaction the user can see without saving
+// anything. The rule is evaluated on its own, ignoring the user's other rules.
+type PreviewRuleRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Conditions *structpb.Struct       `protobuf:"bytes,2,opt,name=conditions,proto3" json:"conditions,omitempty"`
+	CategoryId *int64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Merchant   *string                `protobuf:"bytes,4,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	// applies to would_change and blocked_by_manual separately
+	Limit         *int32 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32 `protobuf:"varint,6,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewRuleRequest) Reset() {
+	*x = PreviewRuleRequest{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRuleRequest) ProtoMessage() {}
+
+func (x *PreviewRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRuleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRuleRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PreviewRuleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PreviewRuleRequest) GetConditions() *structpb.Struct {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *PreviewRuleRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *PreviewRuleRequest) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *PreviewRuleRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *PreviewRuleRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type RulePreviewMatch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// with its current category and merchant
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// set when the rule would change them
+	ProposedCategoryId *int64  `protobuf:"varint,2,opt,name=proposed_category_id,json=proposedCategoryId,proto3,oneof" json:"proposed_category_id,omitempty"`
+	ProposedMerchant   *string `protobuf:"bytes,3,opt,name=proposed_merchant,json=proposedMerchant,proto3,oneof" json:"proposed_merchant,omitempty"`
+	// the rule would set a different value but the field was set manually
+	CategoryBlocked bool `protobuf:"varint,4,opt,name=category_blocked,json=categoryBlocked,proto3" json:"category_blocked,omitempty"`
+	MerchantBlocked bool `protobuf:"varint,5,opt,name=merchant_blocked,json=merchantBlocked,proto3" json:"merchant_blocked,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RulePreviewMatch) Reset() {
+	*x = RulePreviewMatch{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulePreviewMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulePreviewMatch) ProtoMessage() {}
+
+func (x *RulePreviewMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulePreviewMatch.ProtoReflect.Descriptor instead.
+func (*RulePreviewMatch) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RulePreviewMatch) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *RulePreviewMatch) GetProposedCategoryId() int64 {
+	if x != nil && x.ProposedCategoryId != nil {
+		return *x.ProposedCategoryId
+	}
+	return 0
+}
+
+func (x *RulePreviewMatch) GetProposedMerchant() string {
+	if x != nil && x.ProposedMerchant != nil {
+		return *x.ProposedMerchant
+	}
+	return ""
+}
+
+func (x *RulePreviewMatch) GetCategoryBlocked() bool {
+	if x != nil {
+		return x.CategoryBlocked
+	}
+	return false
+}
+
+func (x *RulePreviewMatch) GetMerchantBlocked() bool {
+	if x != nil {
+		return x.MerchantBlocked
+	}
+	return false
+}
+
+type RulePreviewSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TransactionsScanned int64                  `protobuf:"varint,1,opt,name=transactions_scanned,json=transactionsScanned,proto3" json:"transactions_scanned,omitempty"`
+	Matched             int64                  `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	WouldChange         int64                  `protobuf:"varint,3,opt,name=would_change,json=wouldChange,proto3" json:"would_change,omitempty"`
+	BlockedByManual     int64                  `protobuf:"varint,4,opt,name=blocked_by_manual,json=blockedByManual,proto3" json:"blocked_by_manual,omitempty"`
+	// matched but already categorized the way the rule would
+	Unchanged       int64 `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	CategoryChanges int64 `protobuf:"varint,6,opt,name=category_changes,json=categoryChanges,proto3" json:"category_changes,omitempty"`
+	MerchantChanges int64 `protobuf:"varint,7,opt,name=merchant_changes,json=merchantChanges,proto3" json:"merchant_changes,omitempty"`
+	// false when the scan stopped before the oldest transaction, because both
+	// pages were full or the scan cap was reached. The counts then cover the
+	// scanned transactions only.
+	Complete      bool `protobuf:"varint,8,opt,name=complete,proto3" json:"complete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RulePreviewSummary) Reset() {
+	*x = RulePreviewSummary{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulePreviewSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulePreviewSummary) ProtoMessage() {}
+
+func (x *RulePreviewSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulePreviewSummary.ProtoReflect.Descriptor instead.
+func (*RulePreviewSummary) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RulePreviewSummary) GetTransactionsScanned() int64 {
+	if x != nil {
+		return x.TransactionsScanned
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetWouldChange() int64 {
+	if x != nil {
+		return x.WouldChange
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetBlockedByManual() int64 {
+	if x != nil {
+		return x.BlockedByManual
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetUnchanged() int64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetCategoryChanges() int64 {
+	if x != nil {
+		return x.CategoryChanges
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetMerchantChanges() int64 {
+	if x != nil {
+		return x.MerchantChanges
+	}
+	return 0
+}
+
+func (x *RulePreviewSummary) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type PreviewRuleResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WouldChange     []*RulePreviewMatch    `protobuf:"bytes,1,rep,name=would_change,json=wouldChange,proto3" json:"would_change,omitempty"`
+	BlockedByManual []*RulePreviewMatch    `protobuf:"bytes,2,rep,name=blocked_by_manual,json=blockedByManual,proto3" json:"blocked_by_manual,omitempty"`
+	Summary         *RulePreviewSummary    `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreviewRuleResponse) Reset() {
+	*x = PreviewRuleResponse{}
+	mi := &file_arian_v1_rule_services_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRuleResponse) ProtoMessage() {}
+
+func (x *PreviewRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_rule_services_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRuleResponse.ProtoReflect.Descriptor instead.
+func (*PreviewRuleResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_rule_services_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PreviewRuleResponse) GetWouldChange() []*RulePreviewMatch {
+	if x != nil {
+		return x.WouldChange
+	}
+	return nil
+}
+
+func (x *PreviewRuleResponse) GetBlockedByManual() []*RulePreviewMatch {
+	if x != nil {
+		return x.BlockedByManual
+	}
+	return nil
+}
+
+func (x *PreviewRuleResponse) GetSummary() *RulePreviewSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
 var File_arian_v1_rule_services_proto protoreflect.FileDescriptor
 
 const file_arian_v1_rule_services_proto_rawDesc = "" +
 	"\n" +
-	"\x1carian/v1/rule_services.proto\x12\barian.v1\x1a\x13arian/v1/rule.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\"5\n" +
+	"\x1carian/v1/rule_services.proto\x12\barian.v1\x1a\x13arian/v1/rule.proto\x1a\x1aarian/v1/transaction.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\"5\n" +
 	"\x10ListRulesRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"9\n" +
 	"\x11ListRulesResponse\x12$\n" +
@@ -820,7 +1655,82 @@ const file_arian_v1_rule_services_proto_rawDesc = "" +
 	"\x14ValidateRuleResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x121\n" +
 	"\x06errors\x18\x02 \x03(\v2\x19.arian.v1.ValidationErrorR\x06errors\x12L\n" +
-	"\x15normalized_conditions\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x14normalizedConditions2\xbd\x03\n" +
+	"\x15normalized_conditions\x18\x03 \x01(\v2\x17.google.protobuf.StructR\x14normalizedConditions\"f\n" +
+	"\x13ReorderRulesRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12,\n" +
+	"\brule_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\b\x01\x18\x01\"\x05r\x03\xb0\x01\x01R\aruleIds\"<\n" +
+	"\x14ReorderRulesResponse\x12$\n" +
+	"\x05rules\x18\x01 \x03(\v2\x0e.arian.v1.RuleR\x05rules\"y\n" +
+	"\x14SetRuleActiveRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\arule_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06ruleId\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\";\n" +
+	"\x15SetRuleActiveResponse\x12\"\n" +
+	"\x04rule\x18\x01 \x01(\v2\x0e.arian.v1.RuleR\x04rule\"8\n" +
+	"\x13AnalyzeRulesRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"\xe1\x02\n" +
+	"\n" +
+	"RuleReport\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12%\n" +
+	"\x0epriority_order\x18\x03 \x01(\x05R\rpriorityOrder\x12\x1f\n" +
+	"\vmatch_count\x18\x04 \x01(\x03R\n" +
+	"matchCount\x12#\n" +
+	"\rcategory_wins\x18\x05 \x01(\x03R\fcategoryWins\x12#\n" +
+	"\rmerchant_wins\x18\x06 \x01(\x03R\fmerchantWins\x12\x1d\n" +
+	"\n" +
+	"never_wins\x18\a \x01(\bR\tneverWins\x12 \n" +
+	"\vunreachable\x18\b \x01(\bR\vunreachable\x122\n" +
+	"\x13shadowed_by_rule_id\x18\t \x01(\tH\x00R\x10shadowedByRuleId\x88\x01\x01B\x16\n" +
+	"\x14_shadowed_by_rule_id\"\xfb\x01\n" +
+	"\fRuleConflict\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\"\n" +
+	"\rother_rule_id\x18\x02 \x01(\tR\votherRuleId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\x03R\n" +
+	"categoryId\x12*\n" +
+	"\x11other_category_id\x18\x04 \x01(\x03R\x0fotherCategoryId\x12+\n" +
+	"\x11transaction_count\x18\x05 \x01(\x03R\x10transactionCount\x124\n" +
+	"\x16sample_transaction_ids\x18\x06 \x03(\x03R\x14sampleTransactionIds\"\xad\x01\n" +
+	"\x14AnalyzeRulesResponse\x123\n" +
+	"\x15transactions_analyzed\x18\x01 \x01(\x03R\x14transactionsAnalyzed\x12*\n" +
+	"\x05rules\x18\x02 \x03(\v2\x14.arian.v1.RuleReportR\x05rules\x124\n" +
+	"\tconflicts\x18\x03 \x03(\v2\x16.arian.v1.RuleConflictR\tconflicts\"\xb6\x02\n" +
+	"\x12PreviewRuleRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x127\n" +
+	"\n" +
+	"conditions\x18\x02 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"conditions\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x1f\n" +
+	"\bmerchant\x18\x04 \x01(\tH\x01R\bmerchant\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x05 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x01H\x02R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x06 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x03R\x06offset\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\v\n" +
+	"\t_merchantB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\xb9\x02\n" +
+	"\x10RulePreviewMatch\x127\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x15.arian.v1.TransactionR\vtransaction\x125\n" +
+	"\x14proposed_category_id\x18\x02 \x01(\x03H\x00R\x12proposedCategoryId\x88\x01\x01\x120\n" +
+	"\x11proposed_merchant\x18\x03 \x01(\tH\x01R\x10proposedMerchant\x88\x01\x01\x12)\n" +
+	"\x10category_blocked\x18\x04 \x01(\bR\x0fcategoryBlocked\x12)\n" +
+	"\x10merchant_blocked\x18\x05 \x01(\bR\x0fmerchantBlockedB\x17\n" +
+	"\x15_proposed_category_idB\x14\n" +
+	"\x12_proposed_merchant\"\xc0\x02\n" +
+	"\x12RulePreviewSummary\x121\n" +
+	"\x14transactions_scanned\x18\x01 \x01(\x03R\x13transactionsScanned\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x03R\amatched\x12!\n" +
+	"\fwould_change\x18\x03 \x01(\x03R\vwouldChange\x12*\n" +
+	"\x11blocked_by_manual\x18\x04 \x01(\x03R\x0fblockedByManual\x12\x1c\n" +
+	"\tunchanged\x18\x05 \x01(\x03R\tunchanged\x12)\n" +
+	"\x10category_changes\x18\x06 \x01(\x03R\x0fcategoryChanges\x12)\n" +
+	"\x10merchant_changes\x18\a \x01(\x03R\x0fmerchantChanges\x12\x1a\n" +
+	"\bcomplete\x18\b \x01(\bR\bcomplete\"\xd4\x01\n" +
+	"\x13PreviewRuleResponse\x12=\n" +
+	"\fwould_change\x18\x01 \x03(\v2\x1a.arian.v1.RulePreviewMatchR\vwouldChange\x12F\n" +
+	"\x11blocked_by_manual\x18\x02 \x03(\v2\x1a.arian.v1.RulePreviewMatchR\x0fblockedByManual\x126\n" +
+	"\asummary\x18\x03 \x01(\v2\x1c.arian.v1.RulePreviewSummaryR\asummary2\xf9\x05\n" +
 	"\vRuleService\x12D\n" +
 	"\tListRules\x12\x1a.arian.v1.ListRulesRequest\x1a\x1b.arian.v1.ListRulesResponse\x12>\n" +
 	"\aGetRule\x12\x18.arian.v1.GetRuleRequest\x1a\x19.arian.v1.GetRuleResponse\x12G\n" +
@@ -830,7 +1740,11 @@ const file_arian_v1_rule_services_proto_rawDesc = "" +
 	"UpdateRule\x12\x1b.arian.v1.UpdateRuleRequest\x1a\x1c.arian.v1.UpdateRuleResponse\x12G\n" +
 	"\n" +
 	"DeleteRule\x12\x1b.arian.v1.DeleteRuleRequest\x1a\x1c.arian.v1.DeleteRuleResponse\x12M\n" +
-	"\fValidateRule\x12\x1d.arian.v1.ValidateRuleRequest\x1a\x1e.arian.v1.ValidateRuleResponseB\x88\x01\n" +
+	"\fValidateRule\x12\x1d.arian.v1.ValidateRuleRequest\x1a\x1e.arian.v1.ValidateRuleResponse\x12M\n" +
+	"\fReorderRules\x12\x1d.arian.v1.ReorderRulesRequest\x1a\x1e.arian.v1.ReorderRulesResponse\x12P\n" +
+	"\rSetRuleActive\x12\x1e.arian.v1.SetRuleActiveRequest\x1a\x1f.arian.v1.SetRuleActiveResponse\x12M\n" +
+	"\fAnalyzeRules\x12\x1d.arian.v1.AnalyzeRulesRequest\x1a\x1e.arian.v1.AnalyzeRulesResponse\x12J\n" +
+	"\vPreviewRule\x12\x1c.arian.v1.PreviewRuleRequest\x1a\x1d.arian.v1.PreviewRuleResponseB\x88\x01\n" +
 	"\fcom.arian.v1B\x11RuleServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
 
 var (
@@ -845,7 +1759,7 @@ func file_arian_v1_rule_services_proto_rawDescGZIP() []byte {
 	return file_arian_v1_rule_services_proto_rawDescData
 }
 
-var file_arian_v1_rule_services_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_arian_v1_rule_services_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_arian_v1_rule_services_proto_goTypes = []any{
 	(*ListRulesRequest)(nil),      // 0: arian.v1.ListRulesRequest
 	(*ListRulesResponse)(nil),     // 1: arian.v1.ListRulesResponse
@@ -860,37 +1774,67 @@ var file_arian_v1_rule_services_proto_goTypes = []any{
 	(*ValidateRuleRequest)(nil),   // 10: arian.v1.ValidateRuleRequest
 	(*ValidationError)(nil),       // 11: arian.v1.ValidationError
 	(*ValidateRuleResponse)(nil),  // 12: arian.v1.ValidateRuleResponse
-	(*Rule)(nil),                  // 13: arian.v1.Rule
-	(*structpb.Struct)(nil),       // 14: google.protobuf.Struct
-	(*fieldmaskpb.FieldMask)(nil), // 15: google.protobuf.FieldMask
+	(*ReorderRulesRequest)(nil),   // 13: arian.v1.ReorderRulesRequest
+	(*ReorderRulesResponse)(nil),  // 14: arian.v1.ReorderRulesResponse
+	(*SetRuleActiveRequest)(nil),  // 15: arian.v1.SetRuleActiveRequest
+	(*SetRuleActiveResponse)(nil), // 16: arian.v1.SetRuleActiveResponse
+	(*AnalyzeRulesRequest)(nil),   // 17: arian.v1.AnalyzeRulesRequest
+	(*RuleReport)(nil),            // 18: arian.v1.RuleReport
+	(*RuleConflict)(nil),          // 19: arian.v1.RuleConflict
+	(*AnalyzeRulesResponse)(nil),  // 20: arian.v1.AnalyzeRulesResponse
+	(*PreviewRuleRequest)(nil),    // 21: arian.v1.PreviewRuleRequest
+	(*RulePreviewMatch)(nil),      // 22: arian.v1.RulePreviewMatch
+	(*RulePreviewSummary)(nil),    // 23: arian.v1.RulePreviewSummary
+	(*PreviewRuleResponse)(nil),   // 24: arian.v1.PreviewRuleResponse
+	(*Rule)(nil),                  // 25: arian.v1.Rule
+	(*structpb.Struct)(nil),       // 26: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil), // 27: google.protobuf.FieldMask
+	(*Transaction)(nil),           // 28: arian.v1.Transaction
 }
 var file_arian_v1_rule_services_proto_depIdxs = []int32{
-	13, // 0: arian.v1.ListRulesResponse.rules:type_name -> arian.v1.Rule
-	13, // 1: arian.v1.GetRuleResponse.rule:type_name -> arian.v1.Rule
-	14, // 2: arian.v1.CreateRuleRequest.conditions:type_name -> google.protobuf.Struct
-	13, // 3: arian.v1.CreateRuleResponse.rule:type_name -> arian.v1.Rule
-	15, // 4: arian.v1.UpdateRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	14, // 5: arian.v1.UpdateRuleRequest.conditions:type_name -> google.protobuf.Struct
-	14, // 6: arian.v1.ValidateRuleRequest.conditions:type_name -> google.protobuf.Struct
+	25, // 0: arian.v1.ListRulesResponse.rules:type_name -> arian.v1.Rule
+	25, // 1: arian.v1.GetRuleResponse.rule:type_name -> arian.v1.Rule
+	26, // 2: arian.v1.CreateRuleRequest.conditions:type_name -> google.protobuf.Struct
+	25, // 3: arian.v1.CreateRuleResponse.rule:type_name -> arian.v1.Rule
+	27, // 4: arian.v1.UpdateRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	26, // 5: arian.v1.UpdateRuleRequest.conditions:type_name -> google.protobuf.Struct
+	26, // 6: arian.v1.ValidateRuleRequest.conditions:type_name -> google.protobuf.Struct
 	11, // 7: arian.v1.ValidateRuleResponse.errors:type_name -> arian.v1.ValidationError
-	14, // 8: arian.v1.ValidateRuleResponse.normalized_conditions:type_name -> google.protobuf.Struct
-	0,  // 9: arian.v1.RuleService.ListRules:input_type -> arian.v1.ListRulesRequest
-	2,  // 10: arian.v1.RuleService.GetRule:input_type -> arian.v1.GetRuleRequest
-	4,  // 11: arian.v1.RuleService.CreateRule:input_type -> arian.v1.CreateRuleRequest
-	6,  // 12: arian.v1.RuleService.UpdateRule:input_type -> arian.v1.UpdateRuleRequest
-	8,  // 13: arian.v1.RuleService.DeleteRule:input_type -> arian.v1.DeleteRuleRequest
-	10, // 14: arian.v1.RuleService.ValidateRule:input_type -> arian.v1.ValidateRuleRequest
-	1,  // 15: arian.v1.RuleService.ListRules:output_type -> arian.v1.ListRulesResponse
-	3,  // 16: arian.v1.RuleService.GetRule:output_type -> arian.v1.GetRuleResponse
-	5,  // 17: arian.v1.RuleService.CreateRule:output_type -> arian.v1.CreateRuleResponse
-	7,  // 18: arian.v1.RuleService.UpdateRule:output_type -> arian.v1.UpdateRuleResponse
-	9,  // 19: arian.v1.RuleService.DeleteRule:output_type -> arian.v1.DeleteRuleResponse
-	12, // 20: arian.v1.RuleService.ValidateRule:output_type -> arian.v1.ValidateRuleResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	26, // 8: arian.v1.ValidateRuleResponse.normalized_conditions:type_name -> google.protobuf.Struct
+	25, // 9: arian.v1.ReorderRulesResponse.rules:type_name -> arian.v1.Rule
+	25, // 10: arian.v1.SetRuleActiveResponse.rule:type_name -> arian.v1.Rule
+	18, // 11: arian.v1.AnalyzeRulesResponse.rules:type_name -> arian.v1.RuleReport
+	19, // 12: arian.v1.AnalyzeRulesResponse.conflicts:type_name -> arian.v1.RuleConflict
+	26, // 13: arian.v1.PreviewRuleRequest.conditions:type_name -> google.protobuf.Struct
+	28, // 14: arian.v1.RulePreviewMatch.transaction:type_name -> arian.v1.Transaction
+	22, // 15: arian.v1.PreviewRuleResponse.would_change:type_name -> arian.v1.RulePreviewMatch
+	22, // 16: arian.v1.PreviewRuleResponse.blocked_by_manual:type_name -> arian.v1.RulePreviewMatch
+	23, // 17: arian.v1.PreviewRuleResponse.summary:type_name -> arian.v1.RulePreviewSummary
+	0,  // 18: arian.v1.RuleService.ListRules:input_type -> arian.v1.ListRulesRequest
+	2,  // 19: arian.v1.RuleService.GetRule:input_type -> arian.v1.GetRuleRequest
+	4,  // 20: arian.v1.RuleService.CreateRule:input_type -> arian.v1.CreateRuleRequest
+	6,  // 21: arian.v1.RuleService.UpdateRule:input_type -> arian.v1.UpdateRuleRequest
+	8,  // 22: arian.v1.RuleService.DeleteRule:input_type -> arian.v1.DeleteRuleRequest
+	10, // 23: arian.v1.RuleService.ValidateRule:input_type -> arian.v1.ValidateRuleRequest
+	13, // 24: arian.v1.RuleService.ReorderRules:input_type -> arian.v1.ReorderRulesRequest
+	15, // 25: arian.v1.RuleService.SetRuleActive:input_type -> arian.v1.SetRuleActiveRequest
+	17, // 26: arian.v1.RuleService.AnalyzeRules:input_type -> arian.v1.AnalyzeRulesRequest
+	21, // 27: arian.v1.RuleService.PreviewRule:input_type -> arian.v1.PreviewRuleRequest
+	1,  // 28: arian.v1.RuleService.ListRules:output_type -> arian.v1.ListRulesResponse
+	3,  // 29: arian.v1.RuleService.GetRule:output_type -> arian.v1.GetRuleResponse
+	5,  // 30: arian.v1.RuleService.CreateRule:output_type -> arian.v1.CreateRuleResponse
+	7,  // 31: arian.v1.RuleService.UpdateRule:output_type -> arian.v1.UpdateRuleResponse
+	9,  // 32: arian.v1.RuleService.DeleteRule:output_type -> arian.v1.DeleteRuleResponse
+	12, // 33: arian.v1.RuleService.ValidateRule:output_type -> arian.v1.ValidateRuleResponse
+	14, // 34: arian.v1.RuleService.ReorderRules:output_type -> arian.v1.ReorderRulesResponse
+	16, // 35: arian.v1.RuleService.SetRuleActive:output_type -> arian.v1.SetRuleActiveResponse
+	20, // 36: arian.v1.RuleService.AnalyzeRules:output_type -> arian.v1.AnalyzeRulesResponse
+	24, // 37: arian.v1.RuleService.PreviewRule:output_type -> arian.v1.PreviewRuleResponse
+	28, // [28:38] is the sub-list for method output_type
+	18, // [18:28] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_rule_services_proto_init() }
@@ -899,15 +1843,19 @@ func file_arian_v1_rule_services_proto_init() {
 		return
 	}
 	file_arian_v1_rule_proto_init()
+	file_arian_v1_transaction_proto_init()
 	file_arian_v1_rule_services_proto_msgTypes[4].OneofWrappers = []any{}
 	file_arian_v1_rule_services_proto_msgTypes[6].OneofWrappers = []any{}
+	file_arian_v1_rule_services_proto_msgTypes[18].OneofWrappers = []any{}
+	file_arian_v1_rule_services_proto_msgTypes[21].OneofWrappers = []any{}
+	file_arian_v1_rule_services_proto_msgTypes[22].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_rule_services_proto_rawDesc), len(file_arian_v1_rule_services_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
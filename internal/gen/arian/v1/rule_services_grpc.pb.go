@@ -19,12 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RuleService_ListRules_FullMethodName    = "/arian.v1.RuleService/ListRules"
-	RuleService_GetRule_FullMethodName      = "/arian.v1.RuleService/GetRule"
-	RuleService_CreateRule_FullMethodName   = "/arian.v1.RuleService/CreateRule"
-	RuleService_UpdateRule_FullMethodName   = "/arian.v1.RuleService/UpdateRule"
-	RuleService_DeleteRule_FullMethodName   = "/arian.v1.RuleService/DeleteRule"
-	RuleService_ValidateRule_FullMethodName = "/arian.v1.RuleService/ValidateRule"
+	RuleService_ListRules_FullMethodName     = "/arian.v1.RuleService/ListRules"
+	RuleService_GetRule_FullMethodName       = "/arian.v1.RuleService/GetRule"
+	RuleService_CreateRule_FullMethodName    = "/arian.v1.RuleService/CreateRule"
+	RuleService_UpdateRule_FullMethodName    = "/arian.v1.RuleService/UpdateRule"
+	RuleService_DeleteRule_FullMethodName    = "/arian.v1.RuleService/DeleteRule"
+	RuleService_ValidateRule_FullMethodName  = "/arian.v1.RuleService/ValidateRule"
+	RuleService_ReorderRules_FullMethodName  = "/arian.v1.RuleService/ReorderRules"
+	RuleService_SetRuleActive_FullMethodName = "/arian.v1.RuleService/SetRuleActive"
+	RuleService_AnalyzeRules_FullMethodName  = "/arian.v1.RuleService/AnalyzeRules"
+	RuleService_PreviewRule_FullMethodName   = "/arian.v1.RuleService/PreviewRule"
 )
 
 // RuleServiceClient is the client API for RuleService service.
@@ -37,6 +41,10 @@ type RuleServiceClient interface {
 	UpdateRule(ctx context.Context, in *UpdateRuleRequest, opts ...grpc.CallOption) (*UpdateRuleResponse, error)
 	DeleteRule(ctx context.Context, in *DeleteRuleRequest, opts ...grpc.CallOption) (*DeleteRuleResponse, error)
 	ValidateRule(ctx context.Context, in *ValidateRuleRequest, opts ...grpc.CallOption) (*ValidateRuleResponse, error)
+	ReorderRules(ctx context.Context, in *ReorderRulesRequest, opts ...grpc.CallOption) (*ReorderRulesResponse, error)
+	SetRuleActive(ctx context.Context, in *SetRuleActiveRequest, opts ...grpc.CallOption) (*SetRuleActiveResponse, error)
+	AnalyzeRules(ctx context.Context, in *AnalyzeRulesRequest, opts ...grpc.CallOption) (*AnalyzeRulesResponse, error)
+	PreviewRule(ctx context.Context, in *PreviewRuleRequest, opts ...grpc.CallOption) (*PreviewRuleResponse, error)
 }
 
 type ruleServiceClient struct {
@@ -107,6 +115,46 @@ func (c *ruleServiceClient) ValidateRule(ctx context.Context, in *ValidateRuleRe
 	return out, nil
 }
 
+func (c *ruleServiceClient) ReorderRules(ctx context.Context, in *ReorderRulesRequest, opts ...grpc.CallOption) (*ReorderRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderRulesResponse)
+	err := c.cc.Invoke(ctx, RuleService_ReorderRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleServiceClient) SetRuleActive(ctx context.Context, in *SetRuleActiveRequest, opts ...grpc.CallOption) (*SetRuleActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRuleActiveResponse)
+	err := c.cc.Invoke(ctx, RuleService_SetRuleActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleServiceClient) AnalyzeRules(ctx context.Context, in *AnalyzeRulesRequest, opts ...grpc.CallOption) (*AnalyzeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeRulesResponse)
+	err := c.cc.Invoke(ctx, RuleService_AnalyzeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleServiceClient) PreviewRule(ctx context.Context, in *PreviewRuleRequest, opts ...grpc.CallOption) (*PreviewRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewRuleResponse)
+	err := c.cc.Invoke(ctx, RuleService_PreviewRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RuleServiceServer is the server API for RuleService service.
 // All implementations must embed UnimplementedRuleServiceServer
 // for forward compatibility.
@@ -117,6 +165,10 @@ type RuleServiceServer interface {
 	UpdateRule(context.Context, *UpdateRuleRequest) (*UpdateRuleResponse, error)
 	DeleteRule(context.Context, *DeleteRuleRequest) (*DeleteRuleResponse, error)
 	ValidateRule(context.Context, *ValidateRuleRequest) (*ValidateRuleResponse, error)
+	ReorderRules(context.Context, *ReorderRulesRequest) (*ReorderRulesResponse, error)
+	SetRuleActive(context.Context, *SetRuleActiveRequest) (*SetRuleActiveResponse, error)
+	AnalyzeRules(context.Context, *AnalyzeRulesRequest) (*AnalyzeRulesResponse, error)
+	PreviewRule(context.Context, *PreviewRuleRequest) (*PreviewRuleResponse, error)
 	mustEmbedUnimplementedRuleServiceServer()
 }
 
@@ -145,6 +197,18 @@ func (UnimplementedRuleServiceServer) DeleteRule(context.Context, *DeleteRuleReq
 func (UnimplementedRuleServiceServer) ValidateRule(context.Context, *ValidateRuleRequest) (*ValidateRuleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateRule not implemented")
 }
+func (UnimplementedRuleServiceServer) ReorderRules(context.Context, *ReorderRulesRequest) (*ReorderRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderRules not implemented")
+}
+func (UnimplementedRuleServiceServer) SetRuleActive(context.Context, *SetRuleActiveRequest) (*SetRuleActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRuleActive not implemented")
+}
+func (UnimplementedRuleServiceServer) AnalyzeRules(context.Context, *AnalyzeRulesRequest) (*AnalyzeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeRules not implemented")
+}
+func (UnimplementedRuleServiceServer) PreviewRule(context.Context, *PreviewRuleRequest) (*PreviewRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRule not implemented")
+}
 func (UnimplementedRuleServiceServer) mustEmbedUnimplementedRuleServiceServer() {}
 func (UnimplementedRuleServiceServer) testEmbeddedByValue()                     {}
 
@@ -274,6 +338,78 @@ func _RuleService_ValidateRule_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuleService_ReorderRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleServiceServer).ReorderRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleService_ReorderRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleServiceServer).ReorderRules(ctx, req.(*ReorderRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleService_SetRuleActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRuleActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleServiceServer).SetRuleActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleService_SetRuleActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleServiceServer).SetRuleActive(ctx, req.(*SetRuleActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleService_AnalyzeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleServiceServer).AnalyzeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleService_AnalyzeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleServiceServer).AnalyzeRules(ctx, req.(*AnalyzeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleService_PreviewRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleServiceServer).PreviewRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleService_PreviewRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleServiceServer).PreviewRule(ctx, req.(*PreviewRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RuleService_ServiceDesc is the grpc.ServiceDesc for RuleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +441,22 @@ var RuleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateRule",
 			Handler:    _RuleService_ValidateRule_Handler,
 		},
+		{
+			MethodName: "ReorderRules",
+			Handler:    _RuleService_ReorderRules_Handler,
+		},
+		{
+			MethodName: "SetRuleActive",
+			Handler:    _RuleService_SetRuleActive_Handler,
+		},
+		{
+			MethodName: "AnalyzeRules",
+			Handler:    _RuleService_AnalyzeRules_Handler,
+		},
+		{
+			MethodName: "PreviewRule",
+			Handler:    _RuleService_PreviewRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "arian/v1/rule_services.proto",
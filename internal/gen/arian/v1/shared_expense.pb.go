@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/shared_expense.proto
+
+package arianv1
+
+import (
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// one member's part of a shared expense
+type SharedExpensePart struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount *money.Money           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the percentage or share count the amount was computed from, unset for
+	// exact splits
+	Weight        *float64 `protobuf:"fixed64,3,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedExpensePart) Reset() {
+	*x = SharedExpensePart{}
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedExpensePart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedExpensePart) ProtoMessage() {}
+
+func (x *SharedExpensePart) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedExpensePart.ProtoReflect.Descriptor instead.
+func (*SharedExpensePart) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedExpensePart) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SharedExpensePart) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SharedExpensePart) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+// who paid for a transaction and how its cost is divided
+type SharedExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PayerId       string                 `protobuf:"bytes,2,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	SplitMethod   SharedSplitMethod      `protobuf:"varint,3,opt,name=split_method,json=splitMethod,proto3,enum=arian.v1.SharedSplitMethod" json:"split_method,omitempty"`
+	Parts         []*SharedExpensePart   `protobuf:"bytes,4,rep,name=parts,proto3" json:"parts,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedExpense) Reset() {
+	*x = SharedExpense{}
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedExpense) ProtoMessage() {}
+
+func (x *SharedExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedExpense.ProtoReflect.Descriptor instead.
+func (*SharedExpense) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SharedExpense) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SharedExpense) GetPayerId() string {
+	if x != nil {
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *SharedExpense) GetSplitMethod() SharedSplitMethod {
+	if x != nil {
+		return x.SplitMethod
+	}
+	return SharedSplitMethod_SHARED_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *SharedExpense) GetParts() []*SharedExpensePart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *SharedExpense) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SharedExpense) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// what another user owes you in one currency, negative when you owe them
+type SharedBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   *string                `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	Balance       *money.Money           `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedBalance) Reset() {
+	*x = SharedBalance{}
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedBalance) ProtoMessage() {}
+
+func (x *SharedBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedBalance.ProtoReflect.Descriptor instead.
+func (*SharedBalance) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SharedBalance) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SharedBalance) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SharedBalance) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
+	}
+	return ""
+}
+
+func (x *SharedBalance) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// a reimbursement from one user to another
+type Settlement struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromUserId string                 `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId   string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Amount     *money.Money           `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the transaction that moved the money, if recorded
+	TransactionId *int64                 `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Note          *string                `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Settlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Settlement) ProtoMessage() {}
+
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Settlement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Settlement) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *Settlement) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *Settlement) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Settlement) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *Settlement) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *Settlement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_arian_v1_shared_expense_proto protoreflect.FileDescriptor
+
+const file_arian_v1_shared_expense_proto_rawDesc = "" +
+	"\n" +
+	"\x1darian/v1/shared_expense.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\x80\x01\n" +
+	"\x11SharedExpensePart\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyR\x06amount\x12\x1b\n" +
+	"\x06weight\x18\x03 \x01(\x01H\x00R\x06weight\x88\x01\x01B\t\n" +
+	"\a_weight\"\xba\x02\n" +
+	"\rSharedExpense\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\bpayer_id\x18\x02 \x01(\tR\apayerId\x12>\n" +
+	"\fsplit_method\x18\x03 \x01(\x0e2\x1b.arian.v1.SharedSplitMethodR\vsplitMethod\x121\n" +
+	"\x05parts\x18\x04 \x03(\v2\x1b.arian.v1.SharedExpensePartR\x05parts\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa5\x01\n" +
+	"\rSharedBalance\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12&\n" +
+	"\fdisplay_name\x18\x03 \x01(\tH\x00R\vdisplayName\x88\x01\x01\x12,\n" +
+	"\abalance\x18\x04 \x01(\v2\x12.google.type.MoneyR\abalanceB\x0f\n" +
+	"\r_display_name\"\xa4\x02\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\ffrom_user_id\x18\x02 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x03 \x01(\tR\btoUserId\x12*\n" +
+	"\x06amount\x18\x04 \x01(\v2\x12.google.type.MoneyR\x06amount\x12*\n" +
+	"\x0etransaction_id\x18\x05 \x01(\x03H\x00R\rtransactionId\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x06 \x01(\tH\x01R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x11\n" +
+	"\x0f_transaction_idB\a\n" +
+	"\x05_noteB\x89\x01\n" +
+	"\fcom.arian.v1B\x12SharedExpenseProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_shared_expense_proto_rawDescOnce sync.Once
+	file_arian_v1_shared_expense_proto_rawDescData []byte
+)
+
+func file_arian_v1_shared_expense_proto_rawDescGZIP() []byte {
+	file_arian_v1_shared_expense_proto_rawDescOnce.Do(func() {
+		file_arian_v1_shared_expense_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_shared_expense_proto_rawDesc), len(file_arian_v1_shared_expense_proto_rawDesc)))
+	})
+	return file_arian_v1_shared_expense_proto_rawDescData
+}
+
+var file_arian_v1_shared_expense_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_arian_v1_shared_expense_proto_goTypes = []any{
+	(*SharedExpensePart)(nil),     // 0: arian.v1.SharedExpensePart
+	(*SharedExpense)(nil),         // 1: arian.v1.SharedExpense
+	(*SharedBalance)(nil),         // 2: arian.v1.SharedBalance
+	(*Settlement)(nil),            // 3: arian.v1.Settlement
+	(*money.Money)(nil),           // 4: google.type.Money
+	(SharedSplitMethod)(0),        // 5: arian.v1.SharedSplitMethod
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_arian_v1_shared_expense_proto_depIdxs = []int32{
+	4, // 0: arian.v1.SharedExpensePart.amount:type_name -> google.type.Money
+	5, // 1: arian.v1.SharedExpense.split_method:type_name -> arian.v1.SharedSplitMethod
+	0, // 2: arian.v1.SharedExpense.parts:type_name -> arian.v1.SharedExpensePart
+	6, // 3: arian.v1.SharedExpense.created_at:type_name -> google.protobuf.Timestamp
+	6, // 4: arian.v1.SharedExpense.updated_at:type_name -> google.protobuf.Timestamp
+	4, // 5: arian.v1.SharedBalance.balance:type_name -> google.type.Money
+	4, // 6: arian.v1.Settlement.amount:type_name -> google.type.Money
+	6, // 7: arian.v1.Settlement.created_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_shared_expense_proto_init() }
+func file_arian_v1_shared_expense_proto_init() {
+	if File_arian_v1_shared_expense_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_shared_expense_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_shared_expense_proto_msgTypes[2].OneofWrappers = []any{}
+	file_arian_v1_shared_expense_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_shared_expense_proto_rawDesc), len(file_arian_v1_shared_expense_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_arian_v1_shared_expense_proto_goTypes,
+		DependencyIndexes: file_arian_v1_shared_expense_proto_depIdxs,
+		MessageInfos:      file_arian_v1_shared_expense_proto_msgTypes,
+	}.Build()
+	File_arian_v1_shared_expense_proto = out.File
+	file_arian_v1_shared_expense_proto_goTypes = nil
+	file_arian_v1_shared_expense_proto_depIdxs = nil
+}
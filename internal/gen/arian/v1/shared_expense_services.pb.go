@@ -0,0 +1,784 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/shared_expense_services.proto
+
+package arianv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	money "google.golang.org/genproto/googleapis/type/money"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SharedExpensePartInput struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// percentage or share count, for those split methods. A member with 0 is
+	// part of the expense but owes nothing.
+	Weight *float64 `protobuf:"fixed64,2,opt,name=weight,proto3,oneof" json:"weight,omitempty"`
+	// for exact splits, in the transaction's currency
+	Amount        *money.Money `protobuf:"bytes,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedExpensePartInput) Reset() {
+	*x = SharedExpensePartInput{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedExpensePartInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedExpensePartInput) ProtoMessage() {}
+
+func (x *SharedExpensePartInput) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedExpensePartInput.ProtoReflect.Descriptor instead.
+func (*SharedExpensePartInput) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedExpensePartInput) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SharedExpensePartInput) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *SharedExpensePartInput) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// replaces the transaction's allocation. Payer and members need access to
+// the transaction's account. Parts have to add up to the transaction amount.
+type SetSharedExpenseRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	UserId        string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                     `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PayerId       string                    `protobuf:"bytes,3,opt,name=payer_id,json=payerId,proto3" json:"payer_id,omitempty"`
+	SplitMethod   SharedSplitMethod         `protobuf:"varint,4,opt,name=split_method,json=splitMethod,proto3,enum=arian.v1.SharedSplitMethod" json:"split_method,omitempty"`
+	Parts         []*SharedExpensePartInput `protobuf:"bytes,5,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharedExpenseRequest) Reset() {
+	*x = SetSharedExpenseRequest{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharedExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharedExpenseRequest) ProtoMessage() {}
+
+func (x *SetSharedExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharedExpenseRequest.ProtoReflect.Descriptor instead.
+func (*SetSharedExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetSharedExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetSharedExpenseRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SetSharedExpenseRequest) GetPayerId() string {
+	if x != nil {
+		return x.PayerId
+	}
+	return ""
+}
+
+func (x *SetSharedExpenseRequest) GetSplitMethod() SharedSplitMethod {
+	if x != nil {
+		return x.SplitMethod
+	}
+	return SharedSplitMethod_SHARED_SPLIT_METHOD_UNSPECIFIED
+}
+
+func (x *SetSharedExpenseRequest) GetParts() []*SharedExpensePartInput {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type SetSharedExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SharedExpense *SharedExpense         `protobuf:"bytes,1,opt,name=shared_expense,json=sharedExpense,proto3" json:"shared_expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSharedExpenseResponse) Reset() {
+	*x = SetSharedExpenseResponse{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSharedExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSharedExpenseResponse) ProtoMessage() {}
+
+func (x *SetSharedExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSharedExpenseResponse.ProtoReflect.Descriptor instead.
+func (*SetSharedExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetSharedExpenseResponse) GetSharedExpense() *SharedExpense {
+	if x != nil {
+		return x.SharedExpense
+	}
+	return nil
+}
+
+type ClearSharedExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSharedExpenseRequest) Reset() {
+	*x = ClearSharedExpenseRequest{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSharedExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSharedExpenseRequest) ProtoMessage() {}
+
+func (x *ClearSharedExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSharedExpenseRequest.ProtoReflect.Descriptor instead.
+func (*ClearSharedExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClearSharedExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearSharedExpenseRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type ClearSharedExpenseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearSharedExpenseResponse) Reset() {
+	*x = ClearSharedExpenseResponse{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearSharedExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearSharedExpenseResponse) ProtoMessage() {}
+
+func (x *ClearSharedExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearSharedExpenseResponse.ProtoReflect.Descriptor instead.
+func (*ClearSharedExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClearSharedExpenseResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type ListSharedBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedBalancesRequest) Reset() {
+	*x = ListSharedBalancesRequest{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedBalancesRequest) ProtoMessage() {}
+
+func (x *ListSharedBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedBalancesRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListSharedBalancesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSharedBalancesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// users you are even with are left out
+	Balances      []*SharedBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedBalancesResponse) Reset() {
+	*x = ListSharedBalancesResponse{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedBalancesResponse) ProtoMessage() {}
+
+func (x *ListSharedBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedBalancesResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSharedBalancesResponse) GetBalances() []*SharedBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// records a reimbursement that moves the balance with other_user_id towards
+// zero: you paying them when you owe them, them paying you otherwise
+type SettleUpRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	// defaults to the whole balance in currency
+	Amount *money.Money `protobuf:"bytes,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	// needed without amount when the balance spans several currencies, and
+	// has to match amount's currency_code when both are set
+	Currency *string `protobuf:"bytes,4,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	// the transaction that already moved the money. Its currency, direction
+	// and amount have to match the settlement.
+	TransactionId *int64  `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Note          *string `protobuf:"bytes,6,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// without transaction_id the reimbursement is booked in this account of the
+	// user, outgoing when they pay and incoming when they are paid back. The
+	// account's anchor currency must match.
+	AccountId     *int64 `protobuf:"varint,7,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleUpRequest) Reset() {
+	*x = SettleUpRequest{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleUpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleUpRequest) ProtoMessage() {}
+
+func (x *SettleUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleUpRequest.ProtoReflect.Descriptor instead.
+func (*SettleUpRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SettleUpRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SettleUpRequest) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+func (x *SettleUpRequest) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SettleUpRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *SettleUpRequest) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *SettleUpRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *SettleUpRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+type SettleUpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleUpResponse) Reset() {
+	*x = SettleUpResponse{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleUpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleUpResponse) ProtoMessage() {}
+
+func (x *SettleUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleUpResponse.ProtoReflect.Descriptor instead.
+func (*SettleUpResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SettleUpResponse) GetSettlement() *Settlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type ListSettlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId   *string                `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3,oneof" json:"other_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettlementsRequest) Reset() {
+	*x = ListSettlementsRequest{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementsRequest) ProtoMessage() {}
+
+func (x *ListSettlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementsRequest.ProtoReflect.Descriptor instead.
+func (*ListSettlementsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSettlementsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListSettlementsRequest) GetOtherUserId() string {
+	if x != nil && x.OtherUserId != nil {
+		return *x.OtherUserId
+	}
+	return ""
+}
+
+type ListSettlementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlements   []*Settlement          `protobuf:"bytes,1,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettlementsResponse) Reset() {
+	*x = ListSettlementsResponse{}
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettlementsResponse) ProtoMessage() {}
+
+func (x *ListSettlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_shared_expense_services_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettlementsResponse.ProtoReflect.Descriptor instead.
+func (*ListSettlementsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_shared_expense_services_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSettlementsResponse) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+var File_arian_v1_shared_expense_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_shared_expense_services_proto_rawDesc = "" +
+	"\n" +
+	"&arian/v1/shared_expense_services.proto\x12\barian.v1\x1a\x14arian/v1/enums.proto\x1a\x1darian/v1/shared_expense.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17google/type/money.proto\"\xaf\x01\n" +
+	"\x16SharedExpensePartInput\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
+	"\x06weight\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x06weight\x88\x01\x01\x12/\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyH\x01R\x06amount\x88\x01\x01B\t\n" +
+	"\a_weightB\t\n" +
+	"\a_amount\"\xa1\x02\n" +
+	"\x17SetSharedExpenseRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rtransactionId\x12#\n" +
+	"\bpayer_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\apayerId\x12J\n" +
+	"\fsplit_method\x18\x04 \x01(\x0e2\x1b.arian.v1.SharedSplitMethodB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\vsplitMethod\x12B\n" +
+	"\x05parts\x18\x05 \x03(\v2 .arian.v1.SharedExpensePartInputB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x102R\x05parts\"Z\n" +
+	"\x18SetSharedExpenseResponse\x12>\n" +
+	"\x0eshared_expense\x18\x01 \x01(\v2\x17.arian.v1.SharedExpenseR\rsharedExpense\"n\n" +
+	"\x19ClearSharedExpenseRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rtransactionId\"A\n" +
+	"\x1aClearSharedExpenseResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\">\n" +
+	"\x19ListSharedBalancesRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"Q\n" +
+	"\x1aListSharedBalancesResponse\x123\n" +
+	"\bbalances\x18\x01 \x03(\v2\x17.arian.v1.SharedBalanceR\bbalances\"\x86\x03\n" +
+	"\x0fSettleUpRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12,\n" +
+	"\rother_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\votherUserId\x12/\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyH\x00R\x06amount\x88\x01\x01\x12)\n" +
+	"\bcurrency\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x98\x01\x03H\x01R\bcurrency\x88\x01\x01\x123\n" +
+	"\x0etransaction_id\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\rtransactionId\x88\x01\x01\x12!\n" +
+	"\x04note\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\x03R\x04note\x88\x01\x01\x12+\n" +
+	"\n" +
+	"account_id\x18\a \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x04R\taccountId\x88\x01\x01B\t\n" +
+	"\a_amountB\v\n" +
+	"\t_currencyB\x11\n" +
+	"\x0f_transaction_idB\a\n" +
+	"\x05_noteB\r\n" +
+	"\v_account_id\"H\n" +
+	"\x10SettleUpResponse\x124\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x14.arian.v1.SettlementR\n" +
+	"settlement\"\x80\x01\n" +
+	"\x16ListSettlementsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x121\n" +
+	"\rother_user_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\votherUserId\x88\x01\x01B\x10\n" +
+	"\x0e_other_user_id\"Q\n" +
+	"\x17ListSettlementsResponse\x126\n" +
+	"\vsettlements\x18\x01 \x03(\v2\x14.arian.v1.SettlementR\vsettlements2\xce\x03\n" +
+	"\x14SharedExpenseService\x12Y\n" +
+	"\x10SetSharedExpense\x12!.arian.v1.SetSharedExpenseRequest\x1a\".arian.v1.SetSharedExpenseResponse\x12_\n" +
+	"\x12ClearSharedExpense\x12#.arian.v1.ClearSharedExpenseRequest\x1a$.arian.v1.ClearSharedExpenseResponse\x12_\n" +
+	"\x12ListSharedBalances\x12#.arian.v1.ListSharedBalancesRequest\x1a$.arian.v1.ListSharedBalancesResponse\x12A\n" +
+	"\bSettleUp\x12\x19.arian.v1.SettleUpRequest\x1a\x1a.arian.v1.SettleUpResponse\x12V\n" +
+	"\x0fListSettlements\x12 .arian.v1.ListSettlementsRequest\x1a!.arian.v1.ListSettlementsResponseB\x91\x01\n" +
+	"\fcom.arian.v1B\x1aSharedExpenseServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_shared_expense_services_proto_rawDescOnce sync.Once
+	file_arian_v1_shared_expense_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_shared_expense_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_shared_expense_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_shared_expense_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_shared_expense_services_proto_rawDesc), len(file_arian_v1_shared_expense_services_proto_rawDesc)))
+	})
+	return file_arian_v1_shared_expense_services_proto_rawDescData
+}
+
+var file_arian_v1_shared_expense_services_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_arian_v1_shared_expense_services_proto_goTypes = []any{
+	(*SharedExpensePartInput)(nil),     // 0: arian.v1.SharedExpensePartInput
+	(*SetSharedExpenseRequest)(nil),    // 1: arian.v1.SetSharedExpenseRequest
+	(*SetSharedExpenseResponse)(nil),   // 2: arian.v1.SetSharedExpenseResponse
+	(*ClearSharedExpenseRequest)(nil),  // 3: arian.v1.ClearSharedExpenseRequest
+	(*ClearSharedExpenseResponse)(nil), // 4: arian.v1.ClearSharedExpenseResponse
+	(*ListSharedBalancesRequest)(nil),  // 5: arian.v1.ListSharedBalancesRequest
+	(*ListSharedBalancesResponse)(nil), // 6: arian.v1.ListSharedBalancesResponse
+	(*SettleUpRequest)(nil),            // 7: arian.v1.SettleUpRequest
+	(*SettleUpResponse)(nil),           // 8: arian.v1.SettleUpResponse
+	(*ListSettlementsRequest)(nil),     // 9: arian.v1.ListSettlementsRequest
+	(*ListSettlementsResponse)(nil),    // 10: arian.v1.ListSettlementsResponse
+	(*money.Money)(nil),                // 11: google.type.Money
+	(SharedSplitMethod)(0),             // 12: arian.v1.SharedSplitMethod
+	(*SharedExpense)(nil),              // 13: arian.v1.SharedExpense
+	(*SharedBalance)(nil),              // 14: arian.v1.SharedBalance
+	(*Settlement)(nil),                 // 15: arian.v1.Settlement
+}
+var file_arian_v1_shared_expense_services_proto_depIdxs = []int32{
+	11, // 0: arian.v1.SharedExpensePartInput.amount:type_name -> google.type.Money
+	12, // 1: arian.v1.SetSharedExpenseRequest.split_method:type_name -> arian.v1.SharedSplitMethod
+	0,  // 2: arian.v1.SetSharedExpenseRequest.parts:type_name -> arian.v1.SharedExpensePartInput
+	13, // 3: arian.v1.SetSharedExpenseResponse.shared_expense:type_name -> arian.v1.SharedExpense
+	14, // 4: arian.v1.ListSharedBalancesResponse.balances:type_name -> arian.v1.SharedBalance
+	11, // 5: arian.v1.SettleUpRequest.amount:type_name -> google.type.Money
+	15, // 6: arian.v1.SettleUpResponse.settlement:type_name -> arian.v1.Settlement
+	15, // 7: arian.v1.ListSettlementsResponse.settlements:type_name -> arian.v1.Settlement
+	1,  // 8: arian.v1.SharedExpenseService.SetSharedExpense:input_type -> arian.v1.SetSharedExpenseRequest
+	3,  // 9: arian.v1.SharedExpenseService.ClearSharedExpense:input_type -> arian.v1.ClearSharedExpenseRequest
+	5,  // 10: arian.v1.SharedExpenseService.ListSharedBalances:input_type -> arian.v1.ListSharedBalancesRequest
+	7,  // 11: arian.v1.SharedExpenseService.SettleUp:input_type -> arian.v1.SettleUpRequest
+	9,  // 12: arian.v1.SharedExpenseService.ListSettlements:input_type -> arian.v1.ListSettlementsRequest
+	2,  // 13: arian.v1.SharedExpenseService.SetSharedExpense:output_type -> arian.v1.SetSharedExpenseResponse
+	4,  // 14: arian.v1.SharedExpenseService.ClearSharedExpense:output_type -> arian.v1.ClearSharedExpenseResponse
+	6,  // 15: arian.v1.SharedExpenseService.ListSharedBalances:output_type -> arian.v1.ListSharedBalancesResponse
+	8,  // 16: arian.v1.SharedExpenseService.SettleUp:output_type -> arian.v1.SettleUpResponse
+	10, // 17: arian.v1.SharedExpenseService.ListSettlements:output_type -> arian.v1.ListSettlementsResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_shared_expense_services_proto_init() }
+func file_arian_v1_shared_expense_services_proto_init() {
+	if File_arian_v1_shared_expense_services_proto != nil {
+		return
+	}
+	file_arian_v1_enums_proto_init()
+	file_arian_v1_shared_expense_proto_init()
+	file_arian_v1_shared_expense_services_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_shared_expense_services_proto_msgTypes[7].OneofWrappers = []any{}
+	file_arian_v1_shared_expense_services_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_shared_expense_services_proto_rawDesc), len(file_arian_v1_shared_expense_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_shared_expense_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_shared_expense_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_shared_expense_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_shared_expense_services_proto = out.File
+	file_arian_v1_shared_expense_services_proto_goTypes = nil
+	file_arian_v1_shared_expense_services_proto_depIdxs = nil
+}
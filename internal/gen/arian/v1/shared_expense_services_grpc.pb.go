@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: arian/v1/shared_expense_services.proto
+
+package arianv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SharedExpenseService_SetSharedExpense_FullMethodName   = "/arian.v1.SharedExpenseService/SetSharedExpense"
+	SharedExpenseService_ClearSharedExpense_FullMethodName = "/arian.v1.SharedExpenseService/ClearSharedExpense"
+	SharedExpenseService_ListSharedBalances_FullMethodName = "/arian.v1.SharedExpenseService/ListSharedBalances"
+	SharedExpenseService_SettleUp_FullMethodName           = "/arian.v1.SharedExpenseService/SettleUp"
+	SharedExpenseService_ListSettlements_FullMethodName    = "/arian.v1.SharedExpenseService/ListSettlements"
+)
+
+// SharedExpenseServiceClient is the client API for SharedExpenseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SharedExpenseServiceClient interface {
+	SetSharedExpense(ctx context.Context, in *SetSharedExpenseRequest, opts ...grpc.CallOption) (*SetSharedExpenseResponse, error)
+	ClearSharedExpense(ctx context.Context, in *ClearSharedExpenseRequest, opts ...grpc.CallOption) (*ClearSharedExpenseResponse, error)
+	ListSharedBalances(ctx context.Context, in *ListSharedBalancesRequest, opts ...grpc.CallOption) (*ListSharedBalancesResponse, error)
+	SettleUp(ctx context.Context, in *SettleUpRequest, opts ...grpc.CallOption) (*SettleUpResponse, error)
+	ListSettlements(ctx context.Context, in *ListSettlementsRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error)
+}
+
+type sharedExpenseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSharedExpenseServiceClient(cc grpc.ClientConnInterface) SharedExpenseServiceClient {
+	return &sharedExpenseServiceClient{cc}
+}
+
+func (c *sharedExpenseServiceClient) SetSharedExpense(ctx context.Context, in *SetSharedExpenseRequest, opts ...grpc.CallOption) (*SetSharedExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSharedExpenseResponse)
+	err := c.cc.Invoke(ctx, SharedExpenseService_SetSharedExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharedExpenseServiceClient) ClearSharedExpense(ctx context.Context, in *ClearSharedExpenseRequest, opts ...grpc.CallOption) (*ClearSharedExpenseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearSharedExpenseResponse)
+	err := c.cc.Invoke(ctx, SharedExpenseService_ClearSharedExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharedExpenseServiceClient) ListSharedBalances(ctx context.Context, in *ListSharedBalancesRequest, opts ...grpc.CallOption) (*ListSharedBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedBalancesResponse)
+	err := c.cc.Invoke(ctx, SharedExpenseService_ListSharedBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharedExpenseServiceClient) SettleUp(ctx context.Context, in *SettleUpRequest, opts ...grpc.CallOption) (*SettleUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleUpResponse)
+	err := c.cc.Invoke(ctx, SharedExpenseService_SettleUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharedExpenseServiceClient) ListSettlements(ctx context.Context, in *ListSettlementsRequest, opts ...grpc.CallOption) (*ListSettlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettlementsResponse)
+	err := c.cc.Invoke(ctx, SharedExpenseService_ListSettlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SharedExpenseServiceServer is the server API for SharedExpenseService service.
+// All implementations must embed UnimplementedSharedExpenseServiceServer
+// for forward compatibility.
+type SharedExpenseServiceServer interface {
+	SetSharedExpense(context.Context, *SetSharedExpenseRequest) (*SetSharedExpenseResponse, error)
+	ClearSharedExpense(context.Context, *ClearSharedExpenseRequest) (*ClearSharedExpenseResponse, error)
+	ListSharedBalances(context.Context, *ListSharedBalancesRequest) (*ListSharedBalancesResponse, error)
+	SettleUp(context.Context, *SettleUpRequest) (*SettleUpResponse, error)
+	ListSettlements(context.Context, *ListSettlementsRequest) (*ListSettlementsResponse, error)
+	mustEmbedUnimplementedSharedExpenseServiceServer()
+}
+
+// UnimplementedSharedExpenseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSharedExpenseServiceServer struct{}
+
+func (UnimplementedSharedExpenseServiceServer) SetSharedExpense(context.Context, *SetSharedExpenseRequest) (*SetSharedExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSharedExpense not implemented")
+}
+func (UnimplementedSharedExpenseServiceServer) ClearSharedExpense(context.Context, *ClearSharedExpenseRequest) (*ClearSharedExpenseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearSharedExpense not implemented")
+}
+func (UnimplementedSharedExpenseServiceServer) ListSharedBalances(context.Context, *ListSharedBalancesRequest) (*ListSharedBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedBalances not implemented")
+}
+func (UnimplementedSharedExpenseServiceServer) SettleUp(context.Context, *SettleUpRequest) (*SettleUpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleUp not implemented")
+}
+func (UnimplementedSharedExpenseServiceServer) ListSettlements(context.Context, *ListSettlementsRequest) (*ListSettlementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlements not implemented")
+}
+func (UnimplementedSharedExpenseServiceServer) mustEmbedUnimplementedSharedExpenseServiceServer() {}
+func (UnimplementedSharedExpenseServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeSharedExpenseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SharedExpenseServiceServer will
+// result in compilation errors.
+type UnsafeSharedExpenseServiceServer interface {
+	mustEmbedUnimplementedSharedExpenseServiceServer()
+}
+
+func RegisterSharedExpenseServiceServer(s grpc.ServiceRegistrar, srv SharedExpenseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSharedExpenseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SharedExpenseService_ServiceDesc, srv)
+}
+
+func _SharedExpenseService_SetSharedExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSharedExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharedExpenseServiceServer).SetSharedExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharedExpenseService_SetSharedExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharedExpenseServiceServer).SetSharedExpense(ctx, req.(*SetSharedExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharedExpenseService_ClearSharedExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearSharedExpenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharedExpenseServiceServer).ClearSharedExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharedExpenseService_ClearSharedExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharedExpenseServiceServer).ClearSharedExpense(ctx, req.(*ClearSharedExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharedExpenseService_ListSharedBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharedExpenseServiceServer).ListSharedBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharedExpenseService_ListSharedBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharedExpenseServiceServer).ListSharedBalances(ctx, req.(*ListSharedBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharedExpenseService_SettleUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleUpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharedExpenseServiceServer).SettleUp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharedExpenseService_SettleUp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharedExpenseServiceServer).SettleUp(ctx, req.(*SettleUpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SharedExpenseService_ListSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettlementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharedExpenseServiceServer).ListSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SharedExpenseService_ListSettlements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharedExpenseServiceServer).ListSettlements(ctx, req.(*ListSettlementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SharedExpenseService_ServiceDesc is the grpc.ServiceDesc for SharedExpenseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SharedExpenseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "arian.v1.SharedExpenseService",
+	HandlerType: (*SharedExpenseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSharedExpense",
+			Handler:    _SharedExpenseService_SetSharedExpense_Handler,
+		},
+		{
+			MethodName: "ClearSharedExpense",
+			Handler:    _SharedExpenseService_ClearSharedExpense_Handler,
+		},
+		{
+			MethodName: "ListSharedBalances",
+			Handler:    _SharedExpenseService_ListSharedBalances_Handler,
+		},
+		{
+			MethodName: "SettleUp",
+			Handler:    _SharedExpenseService_SettleUp_Handler,
+		},
+		{
+			MethodName: "ListSettlements",
+			Handler:    _SharedExpenseService_ListSettlements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "arian/v1/shared_expense_services.proto",
+}
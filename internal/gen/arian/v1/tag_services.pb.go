@@ -0,0 +1,882 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: arian/v1/tag_services.proto
+
+package arianv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagRequest) Reset() {
+	*x = GetTagRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagRequest) ProtoMessage() {}
+
+func (x *GetTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagRequest.ProtoReflect.Descriptor instead.
+func (*GetTagRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTagRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagResponse) Reset() {
+	*x = GetTagResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagResponse) ProtoMessage() {}
+
+func (x *GetTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagResponse.ProtoReflect.Descriptor instead.
+func (*GetTagResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// a leading "#" is accepted and stripped, slugs are stored lowercase
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateTagRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type UpdateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Slug          *string                `protobuf:"bytes,4,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Color         *string                `protobuf:"bytes,5,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagRequest) Reset() {
+	*x = UpdateTagRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagRequest) ProtoMessage() {}
+
+func (x *UpdateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTagRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateTagRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *UpdateTagRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+type UpdateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagResponse) Reset() {
+	*x = UpdateTagResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagResponse) ProtoMessage() {}
+
+func (x *UpdateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTagResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteTagRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteTagRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteTagResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type TagTransactionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionIds []int64                `protobuf:"varint,2,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	TagIds         []int64                `protobuf:"varint,3,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TagTransactionsRequest) Reset() {
+	*x = TagTransactionsRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTransactionsRequest) ProtoMessage() {}
+
+func (x *TagTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*TagTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TagTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TagTransactionsRequest) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *TagTransactionsRequest) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type TagTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagTransactionsResponse) Reset() {
+	*x = TagTransactionsResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTransactionsResponse) ProtoMessage() {}
+
+func (x *TagTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*TagTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TagTransactionsResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type UntagTransactionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionIds []int64                `protobuf:"varint,2,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	TagIds         []int64                `protobuf:"varint,3,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UntagTransactionsRequest) Reset() {
+	*x = UntagTransactionsRequest{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UntagTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntagTransactionsRequest) ProtoMessage() {}
+
+func (x *UntagTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntagTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*UntagTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UntagTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UntagTransactionsRequest) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *UntagTransactionsRequest) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type UntagTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UntagTransactionsResponse) Reset() {
+	*x = UntagTransactionsResponse{}
+	mi := &file_arian_v1_tag_services_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UntagTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UntagTransactionsResponse) ProtoMessage() {}
+
+func (x *UntagTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_tag_services_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UntagTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*UntagTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_tag_services_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UntagTransactionsResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+var File_arian_v1_tag_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_tag_services_proto_rawDesc = "" +
+	"\n" +
+	"\x1barian/v1/tag_services.proto\x12\barian.v1\x1a\x12arian/v1/tag.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\"4\n" +
+	"\x0fListTagsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"5\n" +
+	"\x10ListTagsResponse\x12!\n" +
+	"\x04tags\x18\x01 \x03(\v2\r.arian.v1.TagR\x04tags\"K\n" +
+	"\rGetTagRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"1\n" +
+	"\x0eGetTagResponse\x12\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\r.arian.v1.TagR\x03tag\"j\n" +
+	"\x10CreateTagRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04slug\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18AR\x04slug\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"4\n" +
+	"\x11CreateTagResponse\x12\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\r.arian.v1.TagR\x03tag\"\xdd\x01\n" +
+	"\x10UpdateTagRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\"\n" +
+	"\x04slug\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18AH\x00R\x04slug\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x05 \x01(\tH\x01R\x05color\x88\x01\x01B\a\n" +
+	"\x05_slugB\b\n" +
+	"\x06_color\"4\n" +
+	"\x11UpdateTagResponse\x12\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\r.arian.v1.TagR\x03tag\"N\n" +
+	"\x10DeleteTagRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
+	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"8\n" +
+	"\x11DeleteTagResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x96\x01\n" +
+	"\x16TagTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x124\n" +
+	"\x0ftransaction_ids\x18\x02 \x03(\x03B\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\x0etransactionIds\x12#\n" +
+	"\atag_ids\x18\x03 \x03(\x03B\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x102R\x06tagIds\">\n" +
+	"\x17TagTransactionsResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x98\x01\n" +
+	"\x18UntagTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x124\n" +
+	"\x0ftransaction_ids\x18\x02 \x03(\x03B\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\x0etransactionIds\x12#\n" +
+	"\atag_ids\x18\x03 \x03(\x03B\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x102R\x06tagIds\"@\n" +
+	"\x19UntagTransactionsResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows2\x94\x04\n" +
+	"\n" +
+	"TagService\x12A\n" +
+	"\bListTags\x12\x19.arian.v1.ListTagsRequest\x1a\x1a.arian.v1.ListTagsResponse\x12;\n" +
+	"\x06GetTag\x12\x17.arian.v1.GetTagRequest\x1a\x18.arian.v1.GetTagResponse\x12D\n" +
+	"\tCreateTag\x12\x1a.arian.v1.CreateTagRequest\x1a\x1b.arian.v1.CreateTagResponse\x12D\n" +
+	"\tUpdateTag\x12\x1a.arian.v1.UpdateTagRequest\x1a\x1b.arian.v1.UpdateTagResponse\x12D\n" +
+	"\tDeleteTag\x12\x1a.arian.v1.DeleteTagRequest\x1a\x1b.arian.v1.DeleteTagResponse\x12V\n" +
+	"\x0fTagTransactions\x12 .arian.v1.TagTransactionsRequest\x1a!.arian.v1.TagTransactionsResponse\x12\\\n" +
+	"\x11UntagTransactions\x12\".arian.v1.UntagTransactionsRequest\x1a#.arian.v1.UntagTransactionsResponseB\x87\x01\n" +
+	"\fcom.arian.v1B\x10TagServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
+
+var (
+	file_arian_v1_tag_services_proto_rawDescOnce sync.Once
+	file_arian_v1_tag_services_proto_rawDescData []byte
+)
+
+func file_arian_v1_tag_services_proto_rawDescGZIP() []byte {
+	file_arian_v1_tag_services_proto_rawDescOnce.Do(func() {
+		file_arian_v1_tag_services_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_arian_v1_tag_services_proto_rawDesc), len(file_arian_v1_tag_services_proto_rawDesc)))
+	})
+	return file_arian_v1_tag_services_proto_rawDescData
+}
+
+var file_arian_v1_tag_services_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_arian_v1_tag_services_proto_goTypes = []any{
+	(*ListTagsRequest)(nil),           // 0: arian.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),          // 1: arian.v1.ListTagsResponse
+	(*GetTagRequest)(nil),             // 2: arian.v1.GetTagRequest
+	(*GetTagResponse)(nil),            // 3: arian.v1.GetTagResponse
+	(*CreateTagRequest)(nil),          // 4: arian.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),         // 5: arian.v1.CreateTagResponse
+	(*UpdateTagRequest)(nil),          // 6: arian.v1.UpdateTagRequest
+	(*UpdateTagResponse)(nil),         // 7: arian.v1.UpdateTagResponse
+	(*DeleteTagRequest)(nil),          // 8: arian.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),         // 9: arian.v1.DeleteTagResponse
+	(*TagTransactionsRequest)(nil),    // 10: arian.v1.TagTransactionsRequest
+	(*TagTransactionsResponse)(nil),   // 11: arian.v1.TagTransactionsResponse
+	(*UntagTransactionsRequest)(nil),  // 12: arian.v1.UntagTransactionsRequest
+	(*UntagTransactionsResponse)(nil), // 13: arian.v1.UntagTransactionsResponse
+	(*Tag)(nil),                       // 14: arian.v1.Tag
+	(*fieldmaskpb.FieldMask)(nil),     // 15: google.protobuf.FieldMask
+}
+var file_arian_v1_tag_services_proto_depIdxs = []int32{
+	14, // 0: arian.v1.ListTagsResponse.tags:type_name -> arian.v1.Tag
+	14, // 1: arian.v1.GetTagResponse.tag:type_name -> arian.v1.Tag
+	14, // 2: arian.v1.CreateTagResponse.tag:type_name -> arian.v1.Tag
+	15, // 3: arian.v1.UpdateTagRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 4: arian.v1.UpdateTagResponse.tag:type_name -> arian.v1.Tag
+	0,  // 5: arian.v1.TagService.ListTags:input_type -> arian.v1.ListTagsRequest
+	2,  // 6: arian.v1.TagService.GetTag:input_type -> arian.v1.GetTagRequest
+	4,  // 7: arian.v1.TagService.CreateTag:input_type -> arian.v1.CreateTagRequest
+	6,  // 8: arian.v1.TagService.UpdateTag:input_type -> arian.v1.UpdateTagRequest
+	8,  // 9: arian.v1.TagService.DeleteTag:input_type -> arian.v1.DeleteTagRequest
+	10, // 10: arian.v1.TagService.TagTransactions:input_type -> arian.v1.TagTransactionsRequest
+	12, // 11: arian.v1.TagService.UntagTransactions:input_type -> arian.v1.UntagTransactionsRequest
+	1,  // 12: arian.v1.TagService.ListTags:output_type -> arian.v1.ListTagsResponse
+	3,  // 13: arian.v1.TagService.GetTag:output_type -> arian.v1.GetTagResponse
+	5,  // 14: arian.v1.TagService.CreateTag:output_type -> arian.v1.CreateTagResponse
+	7,  // 15: arian.v1.TagService.UpdateTag:output_type -> arian.v1.UpdateTagResponse
+	9,  // 16: arian.v1.TagService.DeleteTag:output_type -> arian.v1.DeleteTagResponse
+	11, // 17: arian.v1.TagService.TagTransactions:output_type -> arian.v1.TagTransactionsResponse
+	13, // 18: arian.v1.TagService.UntagTransactions:output_type -> arian.v1.UntagTransactionsResponse
+	12, // [12:19] is the sub-list for method output_type
+	5,  // [5:12] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_arian_v1_tag_services_proto_init() }
+func file_arian_v1_tag_services_proto_init() {
+	if File_arian_v1_tag_services_proto != nil {
+		return
+	}
+	file_arian_v1_tag_proto_init()
+	file_arian_v1_tag_services_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_tag_services_proto_rawDesc), len(file_arian_v1_tag_services_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_arian_v1_tag_services_proto_goTypes,
+		DependencyIndexes: file_arian_v1_tag_services_proto_depIdxs,
+		MessageInfos:      file_arian_v1_tag_services_proto_msgTypes,
+	}.Build()
+	File_arian_v1_tag_services_proto = out.File
+	file_arian_v1_tag_services_proto_goTypes = nil
+	file_arian_v1_tag_services_proto_depIdxs = nil
+}
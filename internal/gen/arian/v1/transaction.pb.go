@@ -49,8 +49,26 @@ type Transaction struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	// additional fields for API responses
-	Category      *Category `protobuf:"bytes,18,opt,name=category,proto3,oneof" json:"category,omitempty"`
-	AccountName   *string   `protobuf:"bytes,19,opt,name=account_name,json=accountName,proto3,oneof" json:"account_name,omitempty"`
+	Category    *Category `protobuf:"bytes,18,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	AccountName *string   `protobuf:"bytes,19,opt,name=account_name,json=accountName,proto3,oneof" json:"account_name,omitempty"`
+	// when present, the parts replace category_id in reporting
+	Splits []*TransactionSplit `protobuf:"bytes,20,rep,name=splits,proto3" json:"splits,omitempty"`
+	// set when this is one half of a transfer between the user's accounts
+	Transfer *Transfer `protobuf:"bytes,21,opt,name=transfer,proto3,oneof" json:"transfer,omitempty"`
+	Tags     []*Tag    `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	// set while the transaction is in the trash
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`
+	// pending authorizations are replaced by the posted transaction, voided
+	// ones are kept for reference but left out of balances and reporting
+	Status TransactionStatus `protobuf:"varint,24,opt,name=status,proto3,enum=arian.v1.TransactionStatus" json:"status,omitempty"`
+	// set when this transaction pays back (part of) an earlier purchase
+	RefundOf *Refund `protobuf:"bytes,25,opt,name=refund_of,json=refundOf,proto3,oneof" json:"refund_of,omitempty"`
+	// refunds linked to this purchase
+	Refunds []*Refund `protobuf:"bytes,26,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	// the merchant the description or merchant text resolved to
+	MerchantId *int64 `protobuf:"varint,27,opt,name=merchant_id,json=merchantId,proto3,oneof" json:"merchant_id,omitempty"`
+	// who paid and how the cost is divided between the account's members
+	SharedExpense *SharedExpense `protobuf:"bytes,28,opt,name=shared_expense,json=sharedExpense,proto3,oneof" json:"shared_expense,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -218,29 +236,677 @@ func (x *Transaction) GetAccountName() string {
 	return ""
 }
 
+func (x *Transaction) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *Transaction) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *Transaction) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Transaction) GetStatus() TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *Transaction) GetRefundOf() *Refund {
+	if x != nil {
+		return x.RefundOf
+	}
+	return nil
+}
+
+func (x *Transaction) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *Transaction) GetMerchantId() int64 {
+	if x != nil && x.MerchantId != nil {
+		return *x.MerchantId
+	}
+	return 0
+}
+
+func (x *Transaction) GetSharedExpense() *SharedExpense {
+	if x != nil {
+		return x.SharedExpense
+	}
+	return nil
+}
+
+type Transfer struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OutgoingTransactionId int64                  `protobuf:"varint,2,opt,name=outgoing_transaction_id,json=outgoingTransactionId,proto3" json:"outgoing_transaction_id,omitempty"`
+	IncomingTransactionId int64                  `protobuf:"varint,3,opt,name=incoming_transaction_id,json=incomingTransactionId,proto3" json:"incoming_transaction_id,omitempty"`
+	AutoDetected          bool                   `protobuf:"varint,4,opt,name=auto_detected,json=autoDetected,proto3" json:"auto_detected,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Similarity            *float64               `protobuf:"fixed64,6,opt,name=similarity,proto3,oneof" json:"similarity,omitempty"` // description similarity, only set by detection
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Transfer) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transfer) GetOutgoingTransactionId() int64 {
+	if x != nil {
+		return x.OutgoingTransactionId
+	}
+	return 0
+}
+
+func (x *Transfer) GetIncomingTransactionId() int64 {
+	if x != nil {
+		return x.IncomingTransactionId
+	}
+	return 0
+}
+
+func (x *Transfer) GetAutoDetected() bool {
+	if x != nil {
+		return x.AutoDetected
+	}
+	return false
+}
+
+func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Transfer) GetSimilarity() float64 {
+	if x != nil && x.Similarity != nil {
+		return *x.Similarity
+	}
+	return 0
+}
+
+type Refund struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OriginalTransactionId int64                  `protobuf:"varint,2,opt,name=original_transaction_id,json=originalTransactionId,proto3" json:"original_transaction_id,omitempty"`
+	RefundTransactionId   int64                  `protobuf:"varint,3,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	AutoDetected          bool                   `protobuf:"varint,4,opt,name=auto_detected,json=autoDetected,proto3" json:"auto_detected,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Similarity            *float64               `protobuf:"fixed64,6,opt,name=similarity,proto3,oneof" json:"similarity,omitempty"` // merchant similarity, only set by detection
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Refund) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Refund) GetOriginalTransactionId() int64 {
+	if x != nil {
+		return x.OriginalTransactionId
+	}
+	return 0
+}
+
+func (x *Refund) GetRefundTransactionId() int64 {
+	if x != nil {
+		return x.RefundTransactionId
+	}
+	return 0
+}
+
+func (x *Refund) GetAutoDetected() bool {
+	if x != nil {
+		return x.AutoDetected
+	}
+	return false
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetSimilarity() float64 {
+	if x != nil && x.Similarity != nil {
+		return *x.Similarity
+	}
+	return 0
+}
+
+type TransactionSplit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *money.Money           `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Notes         *string                `protobuf:"bytes,5,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplit) Reset() {
+	*x = TransactionSplit{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplit) ProtoMessage() {}
+
+func (x *TransactionSplit) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSplit.ProtoReflect.Descriptor instead.
+func (*TransactionSplit) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransactionSplit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TransactionSplit) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionSplit) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *TransactionSplit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TransactionSplit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type TransactionSplitInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *money.Money           `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	CategoryId    *int64                 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Notes         *string                `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionSplitInput) Reset() {
+	*x = TransactionSplitInput{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSplitInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSplitInput) ProtoMessage() {}
+
+func (x *TransactionSplitInput) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSplitInput.ProtoReflect.Descriptor instead.
+func (*TransactionSplitInput) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TransactionSplitInput) GetAmount() *money.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TransactionSplitInput) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *TransactionSplitInput) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
 type TransactionWithScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	MerchantScore float64                `protobuf:"fixed64,2,opt,name=merchant_score,json=merchantScore,proto3" json:"merchant_score,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	MerchantScore float64                `protobuf:"fixed64,2,opt,name=merchant_score,json=merchantScore,proto3" json:"merchant_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionWithScore) Reset() {
+	*x = TransactionWithScore{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionWithScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionWithScore) ProtoMessage() {}
+
+func (x *TransactionWithScore) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionWithScore.ProtoReflect.Descriptor instead.
+func (*TransactionWithScore) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TransactionWithScore) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionWithScore) GetMerchantScore() float64 {
+	if x != nil {
+		return x.MerchantScore
+	}
+	return 0
+}
+
+type TransactionCountByAccount struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName      string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TransactionCountByAccount) Reset() {
+	*x = TransactionCountByAccount{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionCountByAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionCountByAccount) ProtoMessage() {}
+
+func (x *TransactionCountByAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionCountByAccount.ProtoReflect.Descriptor instead.
+func (*TransactionCountByAccount) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TransactionCountByAccount) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *TransactionCountByAccount) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *TransactionCountByAccount) GetTransactionCount() int64 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+// column references are header names, or 1-based column numbers when the file has no header
+type CsvColumnMapping struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DateColumn        string                 `protobuf:"bytes,1,opt,name=date_column,json=dateColumn,proto3" json:"date_column,omitempty"`
+	DateFormat        *string                `protobuf:"bytes,2,opt,name=date_format,json=dateFormat,proto3,oneof" json:"date_format,omitempty"` // e.g. "YYYY-MM-DD", "MM/DD/YYYY"; detected when empty
+	DescriptionColumn *string                `protobuf:"bytes,3,opt,name=description_column,json=descriptionColumn,proto3,oneof" json:"description_column,omitempty"`
+	AmountColumn      *string                `protobuf:"bytes,4,opt,name=amount_column,json=amountColumn,proto3,oneof" json:"amount_column,omitempty"` // signed amount, negative is outgoing
+	DebitColumn       *string                `protobuf:"bytes,5,opt,name=debit_column,json=debitColumn,proto3,oneof" json:"debit_column,omitempty"`
+	CreditColumn      *string                `protobuf:"bytes,6,opt,name=credit_column,json=creditColumn,proto3,oneof" json:"credit_column,omitempty"`
+	MerchantColumn    *string                `protobuf:"bytes,7,opt,name=merchant_column,json=merchantColumn,proto3,oneof" json:"merchant_column,omitempty"`
+	CurrencyColumn    *string                `protobuf:"bytes,8,opt,name=currency_column,json=currencyColumn,proto3,oneof" json:"currency_column,omitempty"`
+	NotesColumn       *string                `protobuf:"bytes,9,opt,name=notes_column,json=notesColumn,proto3,oneof" json:"notes_column,omitempty"`
+	Delimiter         *string                `protobuf:"bytes,10,opt,name=delimiter,proto3,oneof" json:"delimiter,omitempty"`
+	HasHeader         bool                   `protobuf:"varint,11,opt,name=has_header,json=hasHeader,proto3" json:"has_header,omitempty"`
+	SkipRows          int32                  `protobuf:"varint,12,opt,name=skip_rows,json=skipRows,proto3" json:"skip_rows,omitempty"`
+	InvertSign        bool                   `protobuf:"varint,13,opt,name=invert_sign,json=invertSign,proto3" json:"invert_sign,omitempty"`       // credit card exports where positive means a charge
+	DecimalComma      bool                   `protobuf:"varint,14,opt,name=decimal_comma,json=decimalComma,proto3" json:"decimal_comma,omitempty"` // "1.234,56" style amounts
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CsvColumnMapping) Reset() {
+	*x = CsvColumnMapping{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CsvColumnMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CsvColumnMapping) ProtoMessage() {}
+
+func (x *CsvColumnMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CsvColumnMapping.ProtoReflect.Descriptor instead.
+func (*CsvColumnMapping) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CsvColumnMapping) GetDateColumn() string {
+	if x != nil {
+		return x.DateColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetDateFormat() string {
+	if x != nil && x.DateFormat != nil {
+		return *x.DateFormat
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetDescriptionColumn() string {
+	if x != nil && x.DescriptionColumn != nil {
+		return *x.DescriptionColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetAmountColumn() string {
+	if x != nil && x.AmountColumn != nil {
+		return *x.AmountColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetDebitColumn() string {
+	if x != nil && x.DebitColumn != nil {
+		return *x.DebitColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetCreditColumn() string {
+	if x != nil && x.CreditColumn != nil {
+		return *x.CreditColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetMerchantColumn() string {
+	if x != nil && x.MerchantColumn != nil {
+		return *x.MerchantColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetCurrencyColumn() string {
+	if x != nil && x.CurrencyColumn != nil {
+		return *x.CurrencyColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetNotesColumn() string {
+	if x != nil && x.NotesColumn != nil {
+		return *x.NotesColumn
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetDelimiter() string {
+	if x != nil && x.Delimiter != nil {
+		return *x.Delimiter
+	}
+	return ""
+}
+
+func (x *CsvColumnMapping) GetHasHeader() bool {
+	if x != nil {
+		return x.HasHeader
+	}
+	return false
+}
+
+func (x *CsvColumnMapping) GetSkipRows() int32 {
+	if x != nil {
+		return x.SkipRows
+	}
+	return 0
+}
+
+func (x *CsvColumnMapping) GetInvertSign() bool {
+	if x != nil {
+		return x.InvertSign
+	}
+	return false
+}
+
+func (x *CsvColumnMapping) GetDecimalComma() bool {
+	if x != nil {
+		return x.DecimalComma
+	}
+	return false
+}
+
+type ImportProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bank          *string                `protobuf:"bytes,3,opt,name=bank,proto3,oneof" json:"bank,omitempty"`
+	Mapping       *CsvColumnMapping      `protobuf:"bytes,4,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionWithScore) Reset() {
-	*x = TransactionWithScore{}
-	mi := &file_arian_v1_transaction_proto_msgTypes[1]
+func (x *ImportProfile) Reset() {
+	*x = ImportProfile{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionWithScore) String() string {
+func (x *ImportProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionWithScore) ProtoMessage() {}
+func (*ImportProfile) ProtoMessage() {}
 
-func (x *TransactionWithScore) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_proto_msgTypes[1]
+func (x *ImportProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,49 +917,83 @@ func (x *TransactionWithScore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionWithScore.ProtoReflect.Descriptor instead.
-func (*TransactionWithScore) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ImportProfile.ProtoReflect.Descriptor instead.
+func (*ImportProfile) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TransactionWithScore) GetTransaction() *Transaction {
+func (x *ImportProfile) GetId() int64 {
 	if x != nil {
-		return x.Transaction
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetBank() string {
+	if x != nil && x.Bank != nil {
+		return *x.Bank
+	}
+	return ""
+}
+
+func (x *ImportProfile) GetMapping() *CsvColumnMapping {
+	if x != nil {
+		return x.Mapping
 	}
 	return nil
 }
 
-func (x *TransactionWithScore) GetMerchantScore() float64 {
+func (x *ImportProfile) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MerchantScore
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type TransactionCountByAccount struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AccountId        int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccountName      string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	TransactionCount int64                  `protobuf:"varint,3,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ImportProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *TransactionCountByAccount) Reset() {
-	*x = TransactionCountByAccount{}
-	mi := &file_arian_v1_transaction_proto_msgTypes[2]
+type ImportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Status        ImportRowStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=arian.v1.ImportRowStatus" json:"status,omitempty"`
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	TxDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=tx_date,json=txDate,proto3,oneof" json:"tx_date,omitempty"`
+	TxAmount      *money.Money           `protobuf:"bytes,5,opt,name=tx_amount,json=txAmount,proto3,oneof" json:"tx_amount,omitempty"`
+	Direction     TransactionDirection   `protobuf:"varint,6,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
+	Description   *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Merchant      *string                `protobuf:"bytes,8,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,9,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"` // set once the row has been created
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRow) Reset() {
+	*x = ImportRow{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionCountByAccount) String() string {
+func (x *ImportRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionCountByAccount) ProtoMessage() {}
+func (*ImportRow) ProtoMessage() {}
 
-func (x *TransactionCountByAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_proto_msgTypes[2]
+func (x *ImportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,37 +1004,187 @@ func (x *TransactionCountByAccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionCountByAccount.ProtoReflect.Descriptor instead.
-func (*TransactionCountByAccount) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ImportRow.ProtoReflect.Descriptor instead.
+func (*ImportRow) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TransactionCountByAccount) GetAccountId() int64 {
+func (x *ImportRow) GetLine() int32 {
 	if x != nil {
-		return x.AccountId
+		return x.Line
 	}
 	return 0
 }
 
-func (x *TransactionCountByAccount) GetAccountName() string {
+func (x *ImportRow) GetStatus() ImportRowStatus {
 	if x != nil {
-		return x.AccountName
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRow) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-func (x *TransactionCountByAccount) GetTransactionCount() int64 {
+func (x *ImportRow) GetTxDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TransactionCount
+		return x.TxDate
+	}
+	return nil
+}
+
+func (x *ImportRow) GetTxAmount() *money.Money {
+	if x != nil {
+		return x.TxAmount
+	}
+	return nil
+}
+
+func (x *ImportRow) GetDirection() TransactionDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *ImportRow) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *ImportRow) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *ImportRow) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type TransactionChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"` // column name, e.g. category_id or merchant
+	OldValue      *string                `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	NewValue      *string                `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
+	ActorType     ChangeActorType        `protobuf:"varint,6,opt,name=actor_type,json=actorType,proto3,enum=arian.v1.ChangeActorType" json:"actor_type,omitempty"`
+	ActorUserId   *string                `protobuf:"bytes,7,opt,name=actor_user_id,json=actorUserId,proto3,oneof" json:"actor_user_id,omitempty"`
+	ActorRuleId   *string                `protobuf:"bytes,8,opt,name=actor_rule_id,json=actorRuleId,proto3,oneof" json:"actor_rule_id,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionChange) Reset() {
+	*x = TransactionChange{}
+	mi := &file_arian_v1_transaction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionChange) ProtoMessage() {}
+
+func (x *TransactionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionChange.ProtoReflect.Descriptor instead.
+func (*TransactionChange) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TransactionChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TransactionChange) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
 	}
 	return 0
 }
 
+func (x *TransactionChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TransactionChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *TransactionChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+func (x *TransactionChange) GetActorType() ChangeActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return ChangeActorType_CHANGE_ACTOR_TYPE_UNSPECIFIED
+}
+
+func (x *TransactionChange) GetActorUserId() string {
+	if x != nil && x.ActorUserId != nil {
+		return *x.ActorUserId
+	}
+	return ""
+}
+
+func (x *TransactionChange) GetActorRuleId() string {
+	if x != nil && x.ActorRuleId != nil {
+		return *x.ActorRuleId
+	}
+	return ""
+}
+
+func (x *TransactionChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
 var File_arian_v1_transaction_proto protoreflect.FileDescriptor
 
 const file_arian_v1_transaction_proto_rawDesc = "" +
 	"\n" +
-	"\x1aarian/v1/transaction.proto\x12\barian.v1\x1a\x17arian/v1/category.proto\x1a\x14arian/v1/enums.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\xd3\b\n" +
+	"\x1aarian/v1/transaction.proto\x12\barian.v1\x1a\x17arian/v1/category.proto\x1a\x14arian/v1/enums.proto\x1a\x1darian/v1/shared_expense.proto\x1a\x12arian/v1/tag.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\xec\f\n" +
 	"\vTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x123\n" +
 	"\atx_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06txDate\x12/\n" +
@@ -360,7 +1210,19 @@ const file_arian_v1_transaction_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x123\n" +
 	"\bcategory\x18\x12 \x01(\v2\x12.arian.v1.CategoryH\bR\bcategory\x88\x01\x01\x12&\n" +
-	"\faccount_name\x18\x13 \x01(\tH\tR\vaccountName\x88\x01\x01B\v\n" +
+	"\faccount_name\x18\x13 \x01(\tH\tR\vaccountName\x88\x01\x01\x122\n" +
+	"\x06splits\x18\x14 \x03(\v2\x1a.arian.v1.TransactionSplitR\x06splits\x123\n" +
+	"\btransfer\x18\x15 \x01(\v2\x12.arian.v1.TransferH\n" +
+	"R\btransfer\x88\x01\x01\x12!\n" +
+	"\x04tags\x18\x16 \x03(\v2\r.arian.v1.TagR\x04tags\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampH\vR\tdeletedAt\x88\x01\x01\x123\n" +
+	"\x06status\x18\x18 \x01(\x0e2\x1b.arian.v1.TransactionStatusR\x06status\x122\n" +
+	"\trefund_of\x18\x19 \x01(\v2\x10.arian.v1.RefundH\fR\brefundOf\x88\x01\x01\x12*\n" +
+	"\arefunds\x18\x1a \x03(\v2\x10.arian.v1.RefundR\arefunds\x12$\n" +
+	"\vmerchant_id\x18\x1b \x01(\x03H\rR\n" +
+	"merchantId\x88\x01\x01\x12C\n" +
+	"\x0eshared_expense\x18\x1c \x01(\v2\x17.arian.v1.SharedExpenseH\x0eR\rsharedExpense\x88\x01\x01B\v\n" +
 	"\t_email_idB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_category_idB\v\n" +
@@ -370,7 +1232,55 @@ const file_arian_v1_transaction_proto_rawDesc = "" +
 	"\x0f_foreign_amountB\x10\n" +
 	"\x0e_exchange_rateB\v\n" +
 	"\t_categoryB\x0f\n" +
-	"\r_account_name\"v\n" +
+	"\r_account_nameB\v\n" +
+	"\t_transferB\r\n" +
+	"\v_deleted_atB\f\n" +
+	"\n" +
+	"_refund_ofB\x0e\n" +
+	"\f_merchant_idB\x11\n" +
+	"\x0f_shared_expense\"\x9e\x02\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x126\n" +
+	"\x17outgoing_transaction_id\x18\x02 \x01(\x03R\x15outgoingTransactionId\x126\n" +
+	"\x17incoming_transaction_id\x18\x03 \x01(\x03R\x15incomingTransactionId\x12#\n" +
+	"\rauto_detected\x18\x04 \x01(\bR\fautoDetected\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\n" +
+	"similarity\x18\x06 \x01(\x01H\x00R\n" +
+	"similarity\x88\x01\x01B\r\n" +
+	"\v_similarity\"\x98\x02\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x126\n" +
+	"\x17original_transaction_id\x18\x02 \x01(\x03R\x15originalTransactionId\x122\n" +
+	"\x15refund_transaction_id\x18\x03 \x01(\x03R\x13refundTransactionId\x12#\n" +
+	"\rauto_detected\x18\x04 \x01(\bR\fautoDetected\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\n" +
+	"similarity\x18\x06 \x01(\x01H\x00R\n" +
+	"similarity\x88\x01\x01B\r\n" +
+	"\v_similarity\"\xc6\x02\n" +
+	"\x10TransactionSplit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12*\n" +
+	"\x06amount\x18\x03 \x01(\v2\x12.google.type.MoneyR\x06amount\x12$\n" +
+	"\vcategory_id\x18\x04 \x01(\x03H\x00R\n" +
+	"categoryId\x88\x01\x01\x12\x19\n" +
+	"\x05notes\x18\x05 \x01(\tH\x01R\x05notes\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_category_idB\b\n" +
+	"\x06_notes\"\xb9\x01\n" +
+	"\x15TransactionSplitInput\x122\n" +
+	"\x06amount\x18\x01 \x01(\v2\x12.google.type.MoneyB\x06\xbaH\x03\xc8\x01\x01R\x06amount\x12-\n" +
+	"\vcategory_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12#\n" +
+	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\x05notes\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\b\n" +
+	"\x06_notes\"v\n" +
 	"\x14TransactionWithScore\x127\n" +
 	"\vtransaction\x18\x01 \x01(\v2\x15.arian.v1.TransactionR\vtransaction\x12%\n" +
 	"\x0emerchant_score\x18\x02 \x01(\x01R\rmerchantScore\"\x8a\x01\n" +
@@ -378,7 +1288,83 @@ const file_arian_v1_transaction_proto_rawDesc = "" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03R\taccountId\x12!\n" +
 	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12+\n" +
-	"\x11transaction_count\x18\x03 \x01(\x03R\x10transactionCountB\x87\x01\n" +
+	"\x11transaction_count\x18\x03 \x01(\x03R\x10transactionCount\"\xf1\x05\n" +
+	"\x10CsvColumnMapping\x12(\n" +
+	"\vdate_column\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"dateColumn\x12$\n" +
+	"\vdate_format\x18\x02 \x01(\tH\x00R\n" +
+	"dateFormat\x88\x01\x01\x122\n" +
+	"\x12description_column\x18\x03 \x01(\tH\x01R\x11descriptionColumn\x88\x01\x01\x12(\n" +
+	"\ramount_column\x18\x04 \x01(\tH\x02R\famountColumn\x88\x01\x01\x12&\n" +
+	"\fdebit_column\x18\x05 \x01(\tH\x03R\vdebitColumn\x88\x01\x01\x12(\n" +
+	"\rcredit_column\x18\x06 \x01(\tH\x04R\fcreditColumn\x88\x01\x01\x12,\n" +
+	"\x0fmerchant_column\x18\a \x01(\tH\x05R\x0emerchantColumn\x88\x01\x01\x12,\n" +
+	"\x0fcurrency_column\x18\b \x01(\tH\x06R\x0ecurrencyColumn\x88\x01\x01\x12&\n" +
+	"\fnotes_column\x18\t \x01(\tH\aR\vnotesColumn\x88\x01\x01\x12+\n" +
+	"\tdelimiter\x18\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x98\x01\x01H\bR\tdelimiter\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"has_header\x18\v \x01(\bR\thasHeader\x12$\n" +
+	"\tskip_rows\x18\f \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bskipRows\x12\x1f\n" +
+	"\vinvert_sign\x18\r \x01(\bR\n" +
+	"invertSign\x12#\n" +
+	"\rdecimal_comma\x18\x0e \x01(\bR\fdecimalCommaB\x0e\n" +
+	"\f_date_formatB\x15\n" +
+	"\x13_description_columnB\x10\n" +
+	"\x0e_amount_columnB\x0f\n" +
+	"\r_debit_columnB\x10\n" +
+	"\x0e_credit_columnB\x12\n" +
+	"\x10_merchant_columnB\x12\n" +
+	"\x10_currency_columnB\x0f\n" +
+	"\r_notes_columnB\f\n" +
+	"\n" +
+	"_delimiter\"\x81\x02\n" +
+	"\rImportProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\x04bank\x18\x03 \x01(\tH\x00R\x04bank\x88\x01\x01\x124\n" +
+	"\amapping\x18\x04 \x01(\v2\x1a.arian.v1.CsvColumnMappingR\amapping\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"\x05_bank\"\xe3\x03\n" +
+	"\tImportRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.arian.v1.ImportRowStatusR\x06status\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x00R\x05error\x88\x01\x01\x128\n" +
+	"\atx_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x06txDate\x88\x01\x01\x124\n" +
+	"\ttx_amount\x18\x05 \x01(\v2\x12.google.type.MoneyH\x02R\btxAmount\x88\x01\x01\x12<\n" +
+	"\tdirection\x18\x06 \x01(\x0e2\x1e.arian.v1.TransactionDirectionR\tdirection\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bmerchant\x18\b \x01(\tH\x04R\bmerchant\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\t \x01(\x03H\x05R\rtransactionId\x88\x01\x01B\b\n" +
+	"\x06_errorB\n" +
+	"\n" +
+	"\b_tx_dateB\f\n" +
+	"\n" +
+	"_tx_amountB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_merchantB\x11\n" +
+	"\x0f_transaction_id\"\xab\x03\n" +
+	"\x11TransactionChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12 \n" +
+	"\told_value\x18\x04 \x01(\tH\x00R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\x05 \x01(\tH\x01R\bnewValue\x88\x01\x01\x128\n" +
+	"\n" +
+	"actor_type\x18\x06 \x01(\x0e2\x19.arian.v1.ChangeActorTypeR\tactorType\x12'\n" +
+	"\ractor_user_id\x18\a \x01(\tH\x02R\vactorUserId\x88\x01\x01\x12'\n" +
+	"\ractor_rule_id\x18\b \x01(\tH\x03R\vactorRuleId\x88\x01\x01\x129\n" +
+	"\n" +
+	"changed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAtB\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_valueB\x10\n" +
+	"\x0e_actor_user_idB\x10\n" +
+	"\x0e_actor_rule_idB\x87\x01\n" +
 	"\fcom.arian.v1B\x10TransactionProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
 
 var (
@@ -393,31 +1379,67 @@ func file_arian_v1_transaction_proto_rawDescGZIP() []byte {
 	return file_arian_v1_transaction_proto_rawDescData
 }
 
-var file_arian_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_arian_v1_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_arian_v1_transaction_proto_goTypes = []any{
 	(*Transaction)(nil),               // 0: arian.v1.Transaction
-	(*TransactionWithScore)(nil),      // 1: arian.v1.TransactionWithScore
-	(*TransactionCountByAccount)(nil), // 2: arian.v1.TransactionCountByAccount
-	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
-	(*money.Money)(nil),               // 4: google.type.Money
-	(TransactionDirection)(0),         // 5: arian.v1.TransactionDirection
-	(*Category)(nil),                  // 6: arian.v1.Category
+	(*Transfer)(nil),                  // 1: arian.v1.Transfer
+	(*Refund)(nil),                    // 2: arian.v1.Refund
+	(*TransactionSplit)(nil),          // 3: arian.v1.TransactionSplit
+	(*TransactionSplitInput)(nil),     // 4: arian.v1.TransactionSplitInput
+	(*TransactionWithScore)(nil),      // 5: arian.v1.TransactionWithScore
+	(*TransactionCountByAccount)(nil), // 6: arian.v1.TransactionCountByAccount
+	(*CsvColumnMapping)(nil),          // 7: arian.v1.CsvColumnMapping
+	(*ImportProfile)(nil),             // 8: arian.v1.ImportProfile
+	(*ImportRow)(nil),                 // 9: arian.v1.ImportRow
+	(*TransactionChange)(nil),         // 10: arian.v1.TransactionChange
+	(*timestamppb.Timestamp)(nil),     // 11: google.protobuf.Timestamp
+	(*money.Money)(nil),               // 12: google.type.Money
+	(TransactionDirection)(0),         // 13: arian.v1.TransactionDirection
+	(*Category)(nil),                  // 14: arian.v1.Category
+	(*Tag)(nil),                       // 15: arian.v1.Tag
+	(TransactionStatus)(0),            // 16: arian.v1.TransactionStatus
+	(*SharedExpense)(nil),             // 17: arian.v1.SharedExpense
+	(ImportRowStatus)(0),              // 18: arian.v1.ImportRowStatus
+	(ChangeActorType)(0),              // 19: arian.v1.ChangeActorType
 }
 var file_arian_v1_transaction_proto_depIdxs = []int32{
-	3, // 0: arian.v1.Transaction.tx_date:type_name -> google.protobuf.Timestamp
-	4, // 1: arian.v1.Transaction.tx_amount:type_name -> google.type.Money
-	5, // 2: arian.v1.Transaction.direction:type_name -> arian.v1.TransactionDirection
-	4, // 3: arian.v1.Transaction.balance_after:type_name -> google.type.Money
-	4, // 4: arian.v1.Transaction.foreign_amount:type_name -> google.type.Money
-	3, // 5: arian.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
-	3, // 6: arian.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
-	6, // 7: arian.v1.Transaction.category:type_name -> arian.v1.Category
-	0, // 8: arian.v1.TransactionWithScore.transaction:type_name -> arian.v1.Transaction
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	11, // 0: arian.v1.Transaction.tx_date:type_name -> google.protobuf.Timestamp
+	12, // 1: arian.v1.Transaction.tx_amount:type_name -> google.type.Money
+	13, // 2: arian.v1.Transaction.direction:type_name -> arian.v1.TransactionDirection
+	12, // 3: arian.v1.Transaction.balance_after:type_name -> google.type.Money
+	12, // 4: arian.v1.Transaction.foreign_amount:type_name -> google.type.Money
+	11, // 5: arian.v1.Transaction.created_at:type_name -> google.protobuf.Timestamp
+	11, // 6: arian.v1.Transaction.updated_at:type_name -> google.protobuf.Timestamp
+	14, // 7: arian.v1.Transaction.category:type_name -> arian.v1.Category
+	3,  // 8: arian.v1.Transaction.splits:type_name -> arian.v1.TransactionSplit
+	1,  // 9: arian.v1.Transaction.transfer:type_name -> arian.v1.Transfer
+	15, // 10: arian.v1.Transaction.tags:type_name -> arian.v1.Tag
+	11, // 11: arian.v1.Transaction.deleted_at:type_name -> google.protobuf.Timestamp
+	16, // 12: arian.v1.Transaction.status:type_name -> arian.v1.TransactionStatus
+	2,  // 13: arian.v1.Transaction.refund_of:type_name -> arian.v1.Refund
+	2,  // 14: arian.v1.Transaction.refunds:type_name -> arian.v1.Refund
+	17, // 15: arian.v1.Transaction.shared_expense:type_name -> arian.v1.SharedExpense
+	11, // 16: arian.v1.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	11, // 17: arian.v1.Refund.created_at:type_name -> google.protobuf.Timestamp
+	12, // 18: arian.v1.TransactionSplit.amount:type_name -> google.type.Money
+	11, // 19: arian.v1.TransactionSplit.created_at:type_name -> google.protobuf.Timestamp
+	11, // 20: arian.v1.TransactionSplit.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 21: arian.v1.TransactionSplitInput.amount:type_name -> google.type.Money
+	0,  // 22: arian.v1.TransactionWithScore.transaction:type_name -> arian.v1.Transaction
+	7,  // 23: arian.v1.ImportProfile.mapping:type_name -> arian.v1.CsvColumnMapping
+	11, // 24: arian.v1.ImportProfile.created_at:type_name -> google.protobuf.Timestamp
+	11, // 25: arian.v1.ImportProfile.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 26: arian.v1.ImportRow.status:type_name -> arian.v1.ImportRowStatus
+	11, // 27: arian.v1.ImportRow.tx_date:type_name -> google.protobuf.Timestamp
+	12, // 28: arian.v1.ImportRow.tx_amount:type_name -> google.type.Money
+	13, // 29: arian.v1.ImportRow.direction:type_name -> arian.v1.TransactionDirection
+	19, // 30: arian.v1.TransactionChange.actor_type:type_name -> arian.v1.ChangeActorType
+	11, // 31: arian.v1.TransactionChange.changed_at:type_name -> google.protobuf.Timestamp
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_transaction_proto_init() }
@@ -427,14 +1449,24 @@ func file_arian_v1_transaction_proto_init() {
 	}
 	file_arian_v1_category_proto_init()
 	file_arian_v1_enums_proto_init()
+	file_arian_v1_shared_expense_proto_init()
+	file_arian_v1_tag_proto_init()
 	file_arian_v1_transaction_proto_msgTypes[0].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[1].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[2].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[3].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[4].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[7].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[8].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[9].OneofWrappers = []any{}
+	file_arian_v1_transaction_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_transaction_proto_rawDesc), len(file_arian_v1_transaction_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
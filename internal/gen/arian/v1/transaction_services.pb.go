@@ -45,8 +45,14 @@ type ListTransactionsRequest struct {
 	TimeOfDayStart   *TimeOfDay             `protobuf:"bytes,16,opt,name=time_of_day_start,json=timeOfDayStart,proto3,oneof" json:"time_of_day_start,omitempty"`
 	TimeOfDayEnd     *TimeOfDay             `protobuf:"bytes,17,opt,name=time_of_day_end,json=timeOfDayEnd,proto3,oneof" json:"time_of_day_end,omitempty"`
 	Uncategorized    *bool                  `protobuf:"varint,18,opt,name=uncategorized,proto3,oneof" json:"uncategorized,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Tags             []string               `protobuf:"bytes,19,rep,name=tags,proto3" json:"tags,omitempty"` // tag slugs, matches transactions carrying any of them
+	// filter expression such as `merchant:starbucks amount>20 -tag:work`, applied
+	// on top of the fields above. Parse errors come back as FilterError details.
+	Filter *string `protobuf:"bytes,20,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// empty means pending and posted
+	Statuses      []TransactionStatus `protobuf:"varint,21,rep,packed,name=statuses,proto3,enum=arian.v1.TransactionStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTransactionsRequest) Reset() {
@@ -205,6 +211,96 @@ func (x *ListTransactionsRequest) GetUncategorized() bool {
 	return false
 }
 
+func (x *ListTransactionsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTransactionsRequest) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetStatuses() []TransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type FilterError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// byte offsets of the offending term in the filter
+	Position      int32  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	End           int32  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Term          string `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterError) Reset() {
+	*x = FilterError{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterError) ProtoMessage() {}
+
+func (x *FilterError) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterError.ProtoReflect.Descriptor instead.
+func (*FilterError) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FilterError) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *FilterError) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *FilterError) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *FilterError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type ListTransactionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
@@ -216,7 +312,7 @@ type ListTransactionsResponse struct {
 
 func (x *ListTransactionsResponse) Reset() {
 	*x = ListTransactionsResponse{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[1]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +324,7 @@ func (x *ListTransactionsResponse) String() string {
 func (*ListTransactionsResponse) ProtoMessage() {}
 
 func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[1]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +337,7 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{1}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
@@ -275,7 +371,7 @@ type GetTransactionRequest struct {
 
 func (x *GetTransactionRequest) Reset() {
 	*x = GetTransactionRequest{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[2]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -287,7 +383,7 @@ func (x *GetTransactionRequest) String() string {
 func (*GetTransactionRequest) ProtoMessage() {}
 
 func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[2]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +396,7 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
 func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{2}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetTransactionRequest) GetUserId() string {
@@ -326,7 +422,7 @@ type GetTransactionResponse struct {
 
 func (x *GetTransactionResponse) Reset() {
 	*x = GetTransactionResponse{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[3]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -338,7 +434,7 @@ func (x *GetTransactionResponse) String() string {
 func (*GetTransactionResponse) ProtoMessage() {}
 
 func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[3]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +447,7 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
 func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{3}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTransactionResponse) GetTransaction() *Transaction {
@@ -362,24 +458,29 @@ func (x *GetTransactionResponse) GetTransaction() *Transaction {
 }
 
 type TransactionInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	TxDate        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=tx_date,json=txDate,proto3" json:"tx_date,omitempty"`
-	TxAmount      *money.Money           `protobuf:"bytes,3,opt,name=tx_amount,json=txAmount,proto3" json:"tx_amount,omitempty"`
-	Direction     TransactionDirection   `protobuf:"varint,4,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
-	Description   *string                `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Merchant      *string                `protobuf:"bytes,6,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
-	UserNotes     *string                `protobuf:"bytes,7,opt,name=user_notes,json=userNotes,proto3,oneof" json:"user_notes,omitempty"`
-	CategoryId    *int64                 `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	ForeignAmount *money.Money           `protobuf:"bytes,9,opt,name=foreign_amount,json=foreignAmount,proto3,oneof" json:"foreign_amount,omitempty"`
-	ExchangeRate  *float64               `protobuf:"fixed64,10,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TxDate    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=tx_date,json=txDate,proto3" json:"tx_date,omitempty"`
+	// currency_code is required, it sets how many decimals the amount has
+	TxAmount    *money.Money         `protobuf:"bytes,3,opt,name=tx_amount,json=txAmount,proto3" json:"tx_amount,omitempty"`
+	Direction   TransactionDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=arian.v1.TransactionDirection" json:"direction,omitempty"`
+	Description *string              `protobuf:"bytes,5,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Merchant    *string              `protobuf:"bytes,6,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	UserNotes   *string              `protobuf:"bytes,7,opt,name=user_notes,json=userNotes,proto3,oneof" json:"user_notes,omitempty"`
+	CategoryId  *int64               `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// currency_code is required
+	ForeignAmount *money.Money `protobuf:"bytes,9,opt,name=foreign_amount,json=foreignAmount,proto3,oneof" json:"foreign_amount,omitempty"`
+	ExchangeRate  *float64     `protobuf:"fixed64,10,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
+	// source identifier from the email parser, used to detect retries
+	EmailId       *string            `protobuf:"bytes,11,opt,name=email_id,json=emailId,proto3,oneof" json:"email_id,omitempty"`
+	Status        *TransactionStatus `protobuf:"varint,12,opt,name=status,proto3,enum=arian.v1.TransactionStatus,oneof" json:"status,omitempty"` // defaults to posted
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransactionInput) Reset() {
 	*x = TransactionInput{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[4]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -391,7 +492,7 @@ func (x *TransactionInput) String() string {
 func (*TransactionInput) ProtoMessage() {}
 
 func (x *TransactionInput) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[4]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +505,7 @@ func (x *TransactionInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionInput.ProtoReflect.Descriptor instead.
 func (*TransactionInput) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{4}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TransactionInput) GetAccountId() int64 {
@@ -477,17 +578,34 @@ func (x *TransactionInput) GetExchangeRate() float64 {
 	return 0
 }
 
+func (x *TransactionInput) GetEmailId() string {
+	if x != nil && x.EmailId != nil {
+		return *x.EmailId
+	}
+	return ""
+}
+
+func (x *TransactionInput) GetStatus() TransactionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
 type CreateTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Transactions  []*TransactionInput    `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Transactions []*TransactionInput    `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// by default the batch is all-or-nothing; with best_effort invalid and
+	// duplicate items are skipped and reported in results instead
+	BestEffort    bool `protobuf:"varint,3,opt,name=best_effort,json=bestEffort,proto3" json:"best_effort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTransactionRequest) Reset() {
 	*x = CreateTransactionRequest{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[5]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +617,7 @@ func (x *CreateTransactionRequest) String() string {
 func (*CreateTransactionRequest) ProtoMessage() {}
 
 func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[5]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +630,7 @@ func (x *CreateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{5}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateTransactionRequest) GetUserId() string {
@@ -529,17 +647,93 @@ func (x *CreateTransactionRequest) GetTransactions() []*TransactionInput {
 	return nil
 }
 
+func (x *CreateTransactionRequest) GetBestEffort() bool {
+	if x != nil {
+		return x.BestEffort
+	}
+	return false
+}
+
+type CreateTransactionResult struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Index         int32                   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // position in the request
+	Status        CreateTransactionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=arian.v1.CreateTransactionStatus" json:"status,omitempty"`
+	Transaction   *Transaction            `protobuf:"bytes,3,opt,name=transaction,proto3,oneof" json:"transaction,omitempty"`
+	Reason        *string                 `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransactionResult) Reset() {
+	*x = CreateTransactionResult{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransactionResult) ProtoMessage() {}
+
+func (x *CreateTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransactionResult.ProtoReflect.Descriptor instead.
+func (*CreateTransactionResult) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateTransactionResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CreateTransactionResult) GetStatus() CreateTransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CreateTransactionStatus_CREATE_TRANSACTION_STATUS_UNSPECIFIED
+}
+
+func (x *CreateTransactionResult) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *CreateTransactionResult) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
 type CreateTransactionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	CreatedCount  int32                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Transactions  []*Transaction             `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	CreatedCount  int32                      `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	Results       []*CreateTransactionResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateTransactionResponse) Reset() {
 	*x = CreateTransactionResponse{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[6]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +745,7 @@ func (x *CreateTransactionResponse) String() string {
 func (*CreateTransactionResponse) ProtoMessage() {}
 
 func (x *CreateTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[6]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +758,7 @@ func (x *CreateTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CreateTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{6}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateTransactionResponse) GetTransactions() []*Transaction {
@@ -581,28 +775,38 @@ func (x *CreateTransactionResponse) GetCreatedCount() int32 {
 	return 0
 }
 
+func (x *CreateTransactionResponse) GetResults() []*CreateTransactionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 type UpdateTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	TxDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=tx_date,json=txDate,proto3,oneof" json:"tx_date,omitempty"`
-	TxAmount      *money.Money           `protobuf:"bytes,5,opt,name=tx_amount,json=txAmount,proto3,oneof" json:"tx_amount,omitempty"`
-	Direction     *TransactionDirection  `protobuf:"varint,6,opt,name=direction,proto3,enum=arian.v1.TransactionDirection,oneof" json:"direction,omitempty"`
-	Description   *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Merchant      *string                `protobuf:"bytes,8,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
-	UserNotes     *string                `protobuf:"bytes,9,opt,name=user_notes,json=userNotes,proto3,oneof" json:"user_notes,omitempty"`
-	CategoryId    *int64                 `protobuf:"varint,10,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	ForeignAmount *money.Money           `protobuf:"bytes,11,opt,name=foreign_amount,json=foreignAmount,proto3,oneof" json:"foreign_amount,omitempty"`
-	ExchangeRate  *float64               `protobuf:"fixed64,12,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
-	AccountId     *int64                 `protobuf:"varint,13,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id         int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	TxDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=tx_date,json=txDate,proto3,oneof" json:"tx_date,omitempty"`
+	// currency_code is required, it sets how many decimals the amount has
+	TxAmount    *money.Money          `protobuf:"bytes,5,opt,name=tx_amount,json=txAmount,proto3,oneof" json:"tx_amount,omitempty"`
+	Direction   *TransactionDirection `protobuf:"varint,6,opt,name=direction,proto3,enum=arian.v1.TransactionDirection,oneof" json:"direction,omitempty"`
+	Description *string               `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Merchant    *string               `protobuf:"bytes,8,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	UserNotes   *string               `protobuf:"bytes,9,opt,name=user_notes,json=userNotes,proto3,oneof" json:"user_notes,omitempty"`
+	CategoryId  *int64                `protobuf:"varint,10,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	// currency_code is required
+	ForeignAmount *money.Money       `protobuf:"bytes,11,opt,name=foreign_amount,json=foreignAmount,proto3,oneof" json:"foreign_amount,omitempty"`
+	ExchangeRate  *float64           `protobuf:"fixed64,12,opt,name=exchange_rate,json=exchangeRate,proto3,oneof" json:"exchange_rate,omitempty"`
+	AccountId     *int64             `protobuf:"varint,13,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	Status        *TransactionStatus `protobuf:"varint,14,opt,name=status,proto3,enum=arian.v1.TransactionStatus,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateTransactionRequest) Reset() {
 	*x = UpdateTransactionRequest{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[7]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +818,7 @@ func (x *UpdateTransactionRequest) String() string {
 func (*UpdateTransactionRequest) ProtoMessage() {}
 
 func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[7]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +831,7 @@ func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{7}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateTransactionRequest) GetUserId() string {
@@ -721,6 +925,13 @@ func (x *UpdateTransactionRequest) GetAccountId() int64 {
 	return 0
 }
 
+func (x *UpdateTransactionRequest) GetStatus() TransactionStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+}
+
 type UpdateTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -729,7 +940,7 @@ type UpdateTransactionResponse struct {
 
 func (x *UpdateTransactionResponse) Reset() {
 	*x = UpdateTransactionResponse{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[8]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +952,7 @@ func (x *UpdateTransactionResponse) String() string {
 func (*UpdateTransactionResponse) ProtoMessage() {}
 
 func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[8]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +965,7 @@ func (x *UpdateTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTransactionResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{8}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{10}
 }
 
 type DeleteTransactionRequest struct {
@@ -767,7 +978,7 @@ type DeleteTransactionRequest struct {
 
 func (x *DeleteTransactionRequest) Reset() {
 	*x = DeleteTransactionRequest{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[9]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +990,7 @@ func (x *DeleteTransactionRequest) String() string {
 func (*DeleteTransactionRequest) ProtoMessage() {}
 
 func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[9]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1003,7 @@ func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{9}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteTransactionRequest) GetUserId() string {
@@ -818,7 +1029,7 @@ type DeleteTransactionResponse struct {
 
 func (x *DeleteTransactionResponse) Reset() {
 	*x = DeleteTransactionResponse{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[10]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -830,7 +1041,7 @@ func (x *DeleteTransactionResponse) String() string {
 func (*DeleteTransactionResponse) ProtoMessage() {}
 
 func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[10]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,7 +1054,7 @@ func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{10}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteTransactionResponse) GetAffectedRows() int64 {
@@ -864,7 +1075,7 @@ type CategorizeTransactionsRequest struct {
 
 func (x *CategorizeTransactionsRequest) Reset() {
 	*x = CategorizeTransactionsRequest{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[11]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -876,7 +1087,7 @@ func (x *CategorizeTransactionsRequest) String() string {
 func (*CategorizeTransactionsRequest) ProtoMessage() {}
 
 func (x *CategorizeTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[11]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1100,7 @@ func (x *CategorizeTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategorizeTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*CategorizeTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{11}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CategorizeTransactionsRequest) GetUserId() string {
@@ -922,7 +1133,7 @@ type CategorizeTransactionsResponse struct {
 
 func (x *CategorizeTransactionsResponse) Reset() {
 	*x = CategorizeTransactionsResponse{}
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[12]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +1145,7 @@ func (x *CategorizeTransactionsResponse) String() string {
 func (*CategorizeTransactionsResponse) ProtoMessage() {}
 
 func (x *CategorizeTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_arian_v1_transaction_services_proto_msgTypes[12]
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1158,7 @@ func (x *CategorizeTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CategorizeTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*CategorizeTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{12}
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CategorizeTransactionsResponse) GetAffectedRows() int64 {
@@ -957,57 +1168,2769 @@ func (x *CategorizeTransactionsResponse) GetAffectedRows() int64 {
 	return 0
 }
 
-var File_arian_v1_transaction_services_proto protoreflect.FileDescriptor
+// the filter fields of ListTransactionsRequest, for bulk operations
+type TransactionFilter struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StartDate        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	AmountMin        *money.Money           `protobuf:"bytes,3,opt,name=amount_min,json=amountMin,proto3,oneof" json:"amount_min,omitempty"`
+	AmountMax        *money.Money           `protobuf:"bytes,4,opt,name=amount_max,json=amountMax,proto3,oneof" json:"amount_max,omitempty"`
+	Direction        *TransactionDirection  `protobuf:"varint,5,opt,name=direction,proto3,enum=arian.v1.TransactionDirection,oneof" json:"direction,omitempty"`
+	AccountIds       []int64                `protobuf:"varint,6,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Categories       []string               `protobuf:"bytes,7,rep,name=categories,proto3" json:"categories,omitempty"`
+	MerchantQuery    *string                `protobuf:"bytes,8,opt,name=merchant_query,json=merchantQuery,proto3,oneof" json:"merchant_query,omitempty"`
+	DescriptionQuery *string                `protobuf:"bytes,9,opt,name=description_query,json=descriptionQuery,proto3,oneof" json:"description_query,omitempty"`
+	Currency         *string                `protobuf:"bytes,10,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	TimeOfDayStart   *TimeOfDay             `protobuf:"bytes,11,opt,name=time_of_day_start,json=timeOfDayStart,proto3,oneof" json:"time_of_day_start,omitempty"`
+	TimeOfDayEnd     *TimeOfDay             `protobuf:"bytes,12,opt,name=time_of_day_end,json=timeOfDayEnd,proto3,oneof" json:"time_of_day_end,omitempty"`
+	Uncategorized    *bool                  `protobuf:"varint,13,opt,name=uncategorized,proto3,oneof" json:"uncategorized,omitempty"`
+	Tags             []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	Filter           *string                `protobuf:"bytes,15,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Statuses         []TransactionStatus    `protobuf:"varint,16,rep,packed,name=statuses,proto3,enum=arian.v1.TransactionStatus" json:"statuses,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-const file_arian_v1_transaction_services_proto_rawDesc = "" +
-	"\n" +
-	"#arian/v1/transaction_services.proto\x12\barian.v1\x1a\x15arian/v1/common.proto\x1a\x14arian/v1/enums.proto\x1a\x1aarian/v1/transaction.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\x8c\t\n" +
-	"\x17ListTransactionsRequest\x12!\n" +
-	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
-	"\n" +
-	"account_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\taccountId\x88\x01\x01\x12%\n" +
-	"\x05limit\x18\x03 \x01(\x05B\n" +
-	"\xbaH\a\x1a\x05\x18\xe8\a(\x01H\x01R\x05limit\x88\x01\x01\x12$\n" +
-	"\x06offset\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x02R\x06offset\x88\x01\x01\x12>\n" +
-	"\n" +
-	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tstartDate\x88\x01\x01\x12:\n" +
-	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\aendDate\x88\x01\x01\x12-\n" +
-	"\x06cursor\x18\a \x01(\v2\x10.arian.v1.CursorH\x05R\x06cursor\x88\x01\x01\x126\n" +
-	"\n" +
-	"amount_min\x18\b \x01(\v2\x12.google.type.MoneyH\x06R\tamountMin\x88\x01\x01\x126\n" +
-	"\n" +
-	"amount_max\x18\t \x01(\v2\x12.google.type.MoneyH\aR\tamountMax\x88\x01\x01\x12K\n" +
-	"\tdirection\x18\n" +
-	" \x01(\x0e2\x1e.arian.v1.TransactionDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01H\bR\tdirection\x88\x01\x01\x12\x1f\n" +
-	"\vaccount_ids\x18\v \x03(\x03R\n" +
-	"accountIds\x12\x1e\n" +
-	"\n" +
-	"categories\x18\f \x03(\tR\n" +
-	"categories\x124\n" +
-	"\x0emerchant_query\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01H\tR\rmerchantQuery\x88\x01\x01\x12:\n" +
-	"\x11description_query\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\n" +
-	"R\x10descriptionQuery\x88\x01\x01\x12\x1f\n" +
-	"\bcurrency\x18\x0f \x01(\tH\vR\bcurrency\x88\x01\x01\x12C\n" +
-	"\x11time_of_day_start\x18\x10 \x01(\v2\x13.arian.v1.TimeOfDayH\fR\x0etimeOfDayStart\x88\x01\x01\x12?\n" +
-	"\x0ftime_of_day_end\x18\x11 \x01(\v2\x13.arian.v1.TimeOfDayH\rR\ftimeOfDayEnd\x88\x01\x01\x12)\n" +
-	"\runcategorized\x18\x12 \x01(\bH\x0eR\runcategorized\x88\x01\x01B\r\n" +
-	"\v_account_idB\b\n" +
-	"\x06_limitB\t\n" +
-	"\a_offsetB\r\n" +
-	"\v_start_dateB\v\n" +
-	"\t_end_dateB\t\n" +
-	"\a_cursorB\r\n" +
-	"\v_amount_minB\r\n" +
-	"\v_amount_maxB\f\n" +
-	"\n" +
-	"_directionB\x11\n" +
-	"\x0f_merchant_queryB\x14\n" +
-	"\x12_description_queryB\v\n" +
-	"\t_currencyB\x14\n" +
-	"\x12_time_of_day_startB\x12\n" +
-	"\x10_time_of_day_endB\x10\n" +
-	"\x0e_uncategorized\"\xbe\x01\n" +
-	"\x18ListTransactionsResponse\x129\n" +
+func (x *TransactionFilter) Reset() {
+	*x = TransactionFilter{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionFilter) ProtoMessage() {}
+
+func (x *TransactionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionFilter.ProtoReflect.Descriptor instead.
+func (*TransactionFilter) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransactionFilter) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetAmountMin() *money.Money {
+	if x != nil {
+		return x.AmountMin
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetAmountMax() *money.Money {
+	if x != nil {
+		return x.AmountMax
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetDirection() TransactionDirection {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return TransactionDirection_DIRECTION_UNSPECIFIED
+}
+
+func (x *TransactionFilter) GetAccountIds() []int64 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetMerchantQuery() string {
+	if x != nil && x.MerchantQuery != nil {
+		return *x.MerchantQuery
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetDescriptionQuery() string {
+	if x != nil && x.DescriptionQuery != nil {
+		return *x.DescriptionQuery
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetTimeOfDayStart() *TimeOfDay {
+	if x != nil {
+		return x.TimeOfDayStart
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetTimeOfDayEnd() *TimeOfDay {
+	if x != nil {
+		return x.TimeOfDayEnd
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetUncategorized() bool {
+	if x != nil && x.Uncategorized != nil {
+		return *x.Uncategorized
+	}
+	return false
+}
+
+func (x *TransactionFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TransactionFilter) GetFilter() string {
+	if x != nil && x.Filter != nil {
+		return *x.Filter
+	}
+	return ""
+}
+
+func (x *TransactionFilter) GetStatuses() []TransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// pages through the matches newest first, one message per page
+type StreamTransactionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// all transactions when unset
+	Filter *TransactionFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// defaults to protobuf
+	Format        ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=arian.v1.ExportFormat" json:"format,omitempty"`
+	PageSize      *int32       `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTransactionsRequest) Reset() {
+	*x = StreamTransactionsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTransactionsRequest) ProtoMessage() {}
+
+func (x *StreamTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StreamTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamTransactionsRequest) GetFilter() *TransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamTransactionsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *StreamTransactionsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type StreamTransactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// set for EXPORT_FORMAT_PROTOBUF
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// a chunk of CSV or NDJSON for the other formats. Chunks end on a line
+	// break and the first CSV chunk starts with the header row.
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTransactionsResponse) Reset() {
+	*x = StreamTransactionsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTransactionsResponse) ProtoMessage() {}
+
+func (x *StreamTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StreamTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *StreamTransactionsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AggregateTransactionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// all transactions when unset
+	Filter *TransactionFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// the table is grouped by these, in order, and by currency
+	Dimensions []AggregateDimension `protobuf:"varint,3,rep,packed,name=dimensions,proto3,enum=arian.v1.AggregateDimension" json:"dimensions,omitempty"`
+	Measures   []AggregateMeasure   `protobuf:"varint,4,rep,packed,name=measures,proto3,enum=arian.v1.AggregateMeasure" json:"measures,omitempty"`
+	// linked transfers are left out unless set
+	IncludeTransfers bool `protobuf:"varint,5,opt,name=include_transfers,json=includeTransfers,proto3" json:"include_transfers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AggregateTransactionsRequest) Reset() {
+	*x = AggregateTransactionsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateTransactionsRequest) ProtoMessage() {}
+
+func (x *AggregateTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AggregateTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AggregateTransactionsRequest) GetFilter() *TransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AggregateTransactionsRequest) GetDimensions() []AggregateDimension {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *AggregateTransactionsRequest) GetMeasures() []AggregateMeasure {
+	if x != nil {
+		return x.Measures
+	}
+	return nil
+}
+
+func (x *AggregateTransactionsRequest) GetIncludeTransfers() bool {
+	if x != nil {
+		return x.IncludeTransfers
+	}
+	return false
+}
+
+type AggregateValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*AggregateValue_Count
+	//	*AggregateValue_Amount
+	Value         isAggregateValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateValue) Reset() {
+	*x = AggregateValue{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateValue) ProtoMessage() {}
+
+func (x *AggregateValue) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateValue.ProtoReflect.Descriptor instead.
+func (*AggregateValue) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AggregateValue) GetValue() isAggregateValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *AggregateValue) GetCount() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*AggregateValue_Count); ok {
+			return x.Count
+		}
+	}
+	return 0
+}
+
+func (x *AggregateValue) GetAmount() *money.Money {
+	if x != nil {
+		if x, ok := x.Value.(*AggregateValue_Amount); ok {
+			return x.Amount
+		}
+	}
+	return nil
+}
+
+type isAggregateValue_Value interface {
+	isAggregateValue_Value()
+}
+
+type AggregateValue_Count struct {
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3,oneof"`
+}
+
+type AggregateValue_Amount struct {
+	Amount *money.Money `protobuf:"bytes,2,opt,name=amount,proto3,oneof"`
+}
+
+func (*AggregateValue_Count) isAggregateValue_Value() {}
+
+func (*AggregateValue_Amount) isAggregateValue_Value() {}
+
+type AggregateRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one per dimension, in request order. Dates look like 2026-03-09 (day and
+	// week start), 2026-03 and 2026, directions are incoming or outgoing.
+	// Missing values, e.g. an uncategorized transaction's category, are empty.
+	Keys     []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Currency string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// one per measure, in request order. Amounts are signed, incoming positive
+	// and outgoing negative, so a row mixing both directions shows the net.
+	Values        []*AggregateValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateRow) Reset() {
+	*x = AggregateRow{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRow) ProtoMessage() {}
+
+func (x *AggregateRow) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRow.ProtoReflect.Descriptor instead.
+func (*AggregateRow) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AggregateRow) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *AggregateRow) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *AggregateRow) GetValues() []*AggregateValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AggregateTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dimensions    []AggregateDimension   `protobuf:"varint,1,rep,packed,name=dimensions,proto3,enum=arian.v1.AggregateDimension" json:"dimensions,omitempty"`
+	Measures      []AggregateMeasure     `protobuf:"varint,2,rep,packed,name=measures,proto3,enum=arian.v1.AggregateMeasure" json:"measures,omitempty"`
+	Rows          []*AggregateRow        `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateTransactionsResponse) Reset() {
+	*x = AggregateTransactionsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateTransactionsResponse) ProtoMessage() {}
+
+func (x *AggregateTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AggregateTransactionsResponse) GetDimensions() []AggregateDimension {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *AggregateTransactionsResponse) GetMeasures() []AggregateMeasure {
+	if x != nil {
+		return x.Measures
+	}
+	return nil
+}
+
+func (x *AggregateTransactionsResponse) GetRows() []*AggregateRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type UpdateTransactionsByFilterRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// a filter without conditions, or with only statuses, matches every
+	// transaction and needs confirm_all
+	Filter *TransactionFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// fields to set, at least one is required. 0 clears the category, an empty
+	// string clears the merchant or notes.
+	CategoryId *int64  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	Merchant   *string `protobuf:"bytes,4,opt,name=merchant,proto3,oneof" json:"merchant,omitempty"`
+	UserNotes  *string `protobuf:"bytes,5,opt,name=user_notes,json=userNotes,proto3,oneof" json:"user_notes,omitempty"`
+	AccountId  *int64  `protobuf:"varint,6,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	// count the matching transactions without changing them
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// apply an unscoped filter to every transaction
+	ConfirmAll    bool `protobuf:"varint,8,opt,name=confirm_all,json=confirmAll,proto3" json:"confirm_all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionsByFilterRequest) Reset() {
+	*x = UpdateTransactionsByFilterRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionsByFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionsByFilterRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionsByFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionsByFilterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionsByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetFilter() *TransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetCategoryId() int64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetMerchant() string {
+	if x != nil && x.Merchant != nil {
+		return *x.Merchant
+	}
+	return ""
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetUserNotes() string {
+	if x != nil && x.UserNotes != nil {
+		return *x.UserNotes
+	}
+	return ""
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateTransactionsByFilterRequest) GetConfirmAll() bool {
+	if x != nil {
+		return x.ConfirmAll
+	}
+	return false
+}
+
+type UpdateTransactionsByFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"` // matching rows on a dry run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionsByFilterResponse) Reset() {
+	*x = UpdateTransactionsByFilterResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionsByFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionsByFilterResponse) ProtoMessage() {}
+
+func (x *UpdateTransactionsByFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionsByFilterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionsByFilterResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateTransactionsByFilterResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type DeleteTransactionsByFilterRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// a filter without conditions, or with only statuses, matches every
+	// transaction and needs confirm_all
+	Filter *TransactionFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// count the matching transactions without deleting them
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// delete every transaction matching an unscoped filter
+	ConfirmAll    bool `protobuf:"varint,4,opt,name=confirm_all,json=confirmAll,proto3" json:"confirm_all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionsByFilterRequest) Reset() {
+	*x = DeleteTransactionsByFilterRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionsByFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionsByFilterRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionsByFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionsByFilterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionsByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteTransactionsByFilterRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteTransactionsByFilterRequest) GetFilter() *TransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *DeleteTransactionsByFilterRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteTransactionsByFilterRequest) GetConfirmAll() bool {
+	if x != nil {
+		return x.ConfirmAll
+	}
+	return false
+}
+
+type DeleteTransactionsByFilterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"` // matching rows on a dry run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionsByFilterResponse) Reset() {
+	*x = DeleteTransactionsByFilterResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionsByFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionsByFilterResponse) ProtoMessage() {}
+
+func (x *DeleteTransactionsByFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionsByFilterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionsByFilterResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteTransactionsByFilterResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type ImportStatementRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Format    StatementFormat        `protobuf:"varint,3,opt,name=format,proto3,enum=arian.v1.StatementFormat" json:"format,omitempty"`
+	Content   []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// csv only: either a saved profile or an inline mapping; qif honours mapping.date_format
+	ProfileName *string           `protobuf:"bytes,5,opt,name=profile_name,json=profileName,proto3,oneof" json:"profile_name,omitempty"`
+	Mapping     *CsvColumnMapping `protobuf:"bytes,6,opt,name=mapping,proto3,oneof" json:"mapping,omitempty"`
+	// parse and dedupe without inserting anything
+	PreviewOnly   bool `protobuf:"varint,7,opt,name=preview_only,json=previewOnly,proto3" json:"preview_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStatementRequest) Reset() {
+	*x = ImportStatementRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStatementRequest) ProtoMessage() {}
+
+func (x *ImportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportStatementRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportStatementRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ImportStatementRequest) GetFormat() StatementFormat {
+	if x != nil {
+		return x.Format
+	}
+	return StatementFormat_STATEMENT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportStatementRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportStatementRequest) GetProfileName() string {
+	if x != nil && x.ProfileName != nil {
+		return *x.ProfileName
+	}
+	return ""
+}
+
+func (x *ImportStatementRequest) GetMapping() *CsvColumnMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *ImportStatementRequest) GetPreviewOnly() bool {
+	if x != nil {
+		return x.PreviewOnly
+	}
+	return false
+}
+
+type ImportStatementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rows           []*ImportRow           `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	ParsedCount    int32                  `protobuf:"varint,2,opt,name=parsed_count,json=parsedCount,proto3" json:"parsed_count,omitempty"`
+	CreatedCount   int32                  `protobuf:"varint,3,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	DuplicateCount int32                  `protobuf:"varint,4,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"`
+	InvalidCount   int32                  `protobuf:"varint,5,opt,name=invalid_count,json=invalidCount,proto3" json:"invalid_count,omitempty"`
+	Transactions   []*Transaction         `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportStatementResponse) Reset() {
+	*x = ImportStatementResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStatementResponse) ProtoMessage() {}
+
+func (x *ImportStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStatementResponse.ProtoReflect.Descriptor instead.
+func (*ImportStatementResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportStatementResponse) GetRows() []*ImportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportStatementResponse) GetParsedCount() int32 {
+	if x != nil {
+		return x.ParsedCount
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetInvalidCount() int32 {
+	if x != nil {
+		return x.InvalidCount
+	}
+	return 0
+}
+
+func (x *ImportStatementResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type ListImportProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportProfilesRequest) Reset() {
+	*x = ListImportProfilesRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportProfilesRequest) ProtoMessage() {}
+
+func (x *ListImportProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListImportProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListImportProfilesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListImportProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*ImportProfile       `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportProfilesResponse) Reset() {
+	*x = ListImportProfilesResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportProfilesResponse) ProtoMessage() {}
+
+func (x *ListImportProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListImportProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListImportProfilesResponse) GetProfiles() []*ImportProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+type SaveImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bank          *string                `protobuf:"bytes,3,opt,name=bank,proto3,oneof" json:"bank,omitempty"`
+	Mapping       *CsvColumnMapping      `protobuf:"bytes,4,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveImportProfileRequest) Reset() {
+	*x = SaveImportProfileRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveImportProfileRequest) ProtoMessage() {}
+
+func (x *SaveImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*SaveImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SaveImportProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveImportProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveImportProfileRequest) GetBank() string {
+	if x != nil && x.Bank != nil {
+		return *x.Bank
+	}
+	return ""
+}
+
+func (x *SaveImportProfileRequest) GetMapping() *CsvColumnMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+type SaveImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *ImportProfile         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveImportProfileResponse) Reset() {
+	*x = SaveImportProfileResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveImportProfileResponse) ProtoMessage() {}
+
+func (x *SaveImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*SaveImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SaveImportProfileResponse) GetProfile() *ImportProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type DeleteImportProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileRequest) Reset() {
+	*x = DeleteImportProfileRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileRequest) ProtoMessage() {}
+
+func (x *DeleteImportProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImportProfileRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteImportProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteImportProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteImportProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportProfileResponse) Reset() {
+	*x = DeleteImportProfileResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportProfileResponse) ProtoMessage() {}
+
+func (x *DeleteImportProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImportProfileResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteImportProfileResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+// replaces every split on the transaction; an empty list removes them
+type SetTransactionSplitsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserId        string                   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                    `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Splits        []*TransactionSplitInput `protobuf:"bytes,3,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsRequest) Reset() {
+	*x = SetTransactionSplitsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsRequest) ProtoMessage() {}
+
+func (x *SetTransactionSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetTransactionSplitsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetTransactionSplitsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SetTransactionSplitsRequest) GetSplits() []*TransactionSplitInput {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type SetTransactionSplitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Splits        []*TransactionSplit    `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionSplitsResponse) Reset() {
+	*x = SetTransactionSplitsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionSplitsResponse) ProtoMessage() {}
+
+func (x *SetTransactionSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionSplitsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTransactionSplitsResponse) GetSplits() []*TransactionSplit {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+type LinkTransferRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OutgoingTransactionId int64                  `protobuf:"varint,2,opt,name=outgoing_transaction_id,json=outgoingTransactionId,proto3" json:"outgoing_transaction_id,omitempty"`
+	IncomingTransactionId int64                  `protobuf:"varint,3,opt,name=incoming_transaction_id,json=incomingTransactionId,proto3" json:"incoming_transaction_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LinkTransferRequest) Reset() {
+	*x = LinkTransferRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkTransferRequest) ProtoMessage() {}
+
+func (x *LinkTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkTransferRequest.ProtoReflect.Descriptor instead.
+func (*LinkTransferRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LinkTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkTransferRequest) GetOutgoingTransactionId() int64 {
+	if x != nil {
+		return x.OutgoingTransactionId
+	}
+	return 0
+}
+
+func (x *LinkTransferRequest) GetIncomingTransactionId() int64 {
+	if x != nil {
+		return x.IncomingTransactionId
+	}
+	return 0
+}
+
+type LinkTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkTransferResponse) Reset() {
+	*x = LinkTransferResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkTransferResponse) ProtoMessage() {}
+
+func (x *LinkTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkTransferResponse.ProtoReflect.Descriptor instead.
+func (*LinkTransferResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LinkTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type UnlinkTransferRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// either half of the transfer
+	TransactionId int64 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkTransferRequest) Reset() {
+	*x = UnlinkTransferRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkTransferRequest) ProtoMessage() {}
+
+func (x *UnlinkTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkTransferRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkTransferRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnlinkTransferRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlinkTransferRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type UnlinkTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkTransferResponse) Reset() {
+	*x = UnlinkTransferResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkTransferResponse) ProtoMessage() {}
+
+func (x *UnlinkTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkTransferResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkTransferResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnlinkTransferResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type DetectTransfersRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// how far apart the two halves may be, defaults to 3 days
+	WindowDays *int32 `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3,oneof" json:"window_days,omitempty"`
+	// minimum pg_trgm similarity of the descriptions, defaults to 0.1
+	MinSimilarity *float64 `protobuf:"fixed64,5,opt,name=min_similarity,json=minSimilarity,proto3,oneof" json:"min_similarity,omitempty"`
+	// report the pairs without linking them
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTransfersRequest) Reset() {
+	*x = DetectTransfersRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTransfersRequest) ProtoMessage() {}
+
+func (x *DetectTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTransfersRequest.ProtoReflect.Descriptor instead.
+func (*DetectTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DetectTransfersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DetectTransfersRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *DetectTransfersRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *DetectTransfersRequest) GetWindowDays() int32 {
+	if x != nil && x.WindowDays != nil {
+		return *x.WindowDays
+	}
+	return 0
+}
+
+func (x *DetectTransfersRequest) GetMinSimilarity() float64 {
+	if x != nil && x.MinSimilarity != nil {
+		return *x.MinSimilarity
+	}
+	return 0
+}
+
+func (x *DetectTransfersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DetectTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectTransfersResponse) Reset() {
+	*x = DetectTransfersResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectTransfersResponse) ProtoMessage() {}
+
+func (x *DetectTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectTransfersResponse.ProtoReflect.Descriptor instead.
+func (*DetectTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DetectTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type LinkRefundRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OriginalTransactionId int64                  `protobuf:"varint,2,opt,name=original_transaction_id,json=originalTransactionId,proto3" json:"original_transaction_id,omitempty"`
+	RefundTransactionId   int64                  `protobuf:"varint,3,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *LinkRefundRequest) Reset() {
+	*x = LinkRefundRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRefundRequest) ProtoMessage() {}
+
+func (x *LinkRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRefundRequest.ProtoReflect.Descriptor instead.
+func (*LinkRefundRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LinkRefundRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LinkRefundRequest) GetOriginalTransactionId() int64 {
+	if x != nil {
+		return x.OriginalTransactionId
+	}
+	return 0
+}
+
+func (x *LinkRefundRequest) GetRefundTransactionId() int64 {
+	if x != nil {
+		return x.RefundTransactionId
+	}
+	return 0
+}
+
+type LinkRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refund        *Refund                `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkRefundResponse) Reset() {
+	*x = LinkRefundResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRefundResponse) ProtoMessage() {}
+
+func (x *LinkRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRefundResponse.ProtoReflect.Descriptor instead.
+func (*LinkRefundResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LinkRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type UnlinkRefundRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// the refund, or the purchase to unlink all of its refunds
+	TransactionId int64 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkRefundRequest) Reset() {
+	*x = UnlinkRefundRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkRefundRequest) ProtoMessage() {}
+
+func (x *UnlinkRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkRefundRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkRefundRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnlinkRefundRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlinkRefundRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type UnlinkRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkRefundResponse) Reset() {
+	*x = UnlinkRefundResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkRefundResponse) ProtoMessage() {}
+
+func (x *UnlinkRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkRefundResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkRefundResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnlinkRefundResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type DetectRefundsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	// how long after the purchase a refund may arrive, defaults to 90 days
+	WindowDays *int32 `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3,oneof" json:"window_days,omitempty"`
+	// minimum pg_trgm similarity of the merchants, defaults to 0.5
+	MinSimilarity *float64 `protobuf:"fixed64,5,opt,name=min_similarity,json=minSimilarity,proto3,oneof" json:"min_similarity,omitempty"`
+	// report the suggestions without linking them
+	DryRun        bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectRefundsRequest) Reset() {
+	*x = DetectRefundsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectRefundsRequest) ProtoMessage() {}
+
+func (x *DetectRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRefundsRequest.ProtoReflect.Descriptor instead.
+func (*DetectRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DetectRefundsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DetectRefundsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *DetectRefundsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *DetectRefundsRequest) GetWindowDays() int32 {
+	if x != nil && x.WindowDays != nil {
+		return *x.WindowDays
+	}
+	return 0
+}
+
+func (x *DetectRefundsRequest) GetMinSimilarity() float64 {
+	if x != nil && x.MinSimilarity != nil {
+		return *x.MinSimilarity
+	}
+	return 0
+}
+
+func (x *DetectRefundsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DetectRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetectRefundsResponse) Reset() {
+	*x = DetectRefundsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectRefundsResponse) ProtoMessage() {}
+
+func (x *DetectRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectRefundsResponse.ProtoReflect.Descriptor instead.
+func (*DetectRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DetectRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+type SearchTransactionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// free text, every word matches as a prefix ("starb cof" finds "Starbucks Coffee")
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	AccountId     *int64                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Limit         *int32                 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32                 `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTransactionsRequest) Reset() {
+	*x = SearchTransactionsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTransactionsRequest) ProtoMessage() {}
+
+func (x *SearchTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SearchTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchTransactionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTransactionsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *SearchTransactionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *SearchTransactionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *SearchTransactionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *SearchTransactionsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type TransactionSearchResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Transaction *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Rank        float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// HTML-escaped field text with matched terms wrapped in <mark></mark>, unset
+	// when the field has no match
+	DescriptionHighlight *string `protobuf:"bytes,3,opt,name=description_highlight,json=descriptionHighlight,proto3,oneof" json:"description_highlight,omitempty"`
+	MerchantHighlight    *string `protobuf:"bytes,4,opt,name=merchant_highlight,json=merchantHighlight,proto3,oneof" json:"merchant_highlight,omitempty"`
+	NotesHighlight       *string `protobuf:"bytes,5,opt,name=notes_highlight,json=notesHighlight,proto3,oneof" json:"notes_highlight,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TransactionSearchResult) Reset() {
+	*x = TransactionSearchResult{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionSearchResult) ProtoMessage() {}
+
+func (x *TransactionSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionSearchResult.ProtoReflect.Descriptor instead.
+func (*TransactionSearchResult) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TransactionSearchResult) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TransactionSearchResult) GetDescriptionHighlight() string {
+	if x != nil && x.DescriptionHighlight != nil {
+		return *x.DescriptionHighlight
+	}
+	return ""
+}
+
+func (x *TransactionSearchResult) GetMerchantHighlight() string {
+	if x != nil && x.MerchantHighlight != nil {
+		return *x.MerchantHighlight
+	}
+	return ""
+}
+
+func (x *TransactionSearchResult) GetNotesHighlight() string {
+	if x != nil && x.NotesHighlight != nil {
+		return *x.NotesHighlight
+	}
+	return ""
+}
+
+type SearchTransactionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*TransactionSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	HasMore       bool                       `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTransactionsResponse) Reset() {
+	*x = SearchTransactionsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTransactionsResponse) ProtoMessage() {}
+
+func (x *SearchTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SearchTransactionsResponse) GetResults() []*TransactionSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchTransactionsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type GetTransactionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryRequest) Reset() {
+	*x = GetTransactionHistoryRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryRequest) ProtoMessage() {}
+
+func (x *GetTransactionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetTransactionHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionHistoryRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type GetTransactionHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*TransactionChange   `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionHistoryResponse) Reset() {
+	*x = GetTransactionHistoryResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionHistoryResponse) ProtoMessage() {}
+
+func (x *GetTransactionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTransactionHistoryResponse) GetChanges() []*TransactionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ListDeletedTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         *int32                 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTransactionsRequest) Reset() {
+	*x = ListDeletedTransactionsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTransactionsRequest) ProtoMessage() {}
+
+func (x *ListDeletedTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListDeletedTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDeletedTransactionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedTransactionsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListDeletedTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTransactionsResponse) Reset() {
+	*x = ListDeletedTransactionsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTransactionsResponse) ProtoMessage() {}
+
+func (x *ListDeletedTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListDeletedTransactionsResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type RestoreTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTransactionsRequest) Reset() {
+	*x = RestoreTransactionsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTransactionsRequest) ProtoMessage() {}
+
+func (x *RestoreTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RestoreTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreTransactionsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RestoreTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTransactionsResponse) Reset() {
+	*x = RestoreTransactionsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTransactionsResponse) ProtoMessage() {}
+
+func (x *RestoreTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RestoreTransactionsResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+type PurgeTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ids           []int64                `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTransactionsRequest) Reset() {
+	*x = PurgeTransactionsRequest{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTransactionsRequest) ProtoMessage() {}
+
+func (x *PurgeTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PurgeTransactionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PurgeTransactionsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type PurgeTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AffectedRows  int64                  `protobuf:"varint,1,opt,name=affected_rows,json=affectedRows,proto3" json:"affected_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTransactionsResponse) Reset() {
+	*x = PurgeTransactionsResponse{}
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTransactionsResponse) ProtoMessage() {}
+
+func (x *PurgeTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_arian_v1_transaction_services_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_arian_v1_transaction_services_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PurgeTransactionsResponse) GetAffectedRows() int64 {
+	if x != nil {
+		return x.AffectedRows
+	}
+	return 0
+}
+
+var File_arian_v1_transaction_services_proto protoreflect.FileDescriptor
+
+const file_arian_v1_transaction_services_proto_rawDesc = "" +
+	"\n" +
+	"#arian/v1/transaction_services.proto\x12\barian.v1\x1a\x15arian/v1/common.proto\x1a\x14arian/v1/enums.proto\x1a\x1aarian/v1/transaction.proto\x1a\x1bbuf/validate/validate.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17google/type/money.proto\"\x9a\n" +
+	"\n" +
+	"\x17ListTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\taccountId\x88\x01\x01\x12%\n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x01H\x01R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x02R\x06offset\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\tstartDate\x88\x01\x01\x12:\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\aendDate\x88\x01\x01\x12-\n" +
+	"\x06cursor\x18\a \x01(\v2\x10.arian.v1.CursorH\x05R\x06cursor\x88\x01\x01\x126\n" +
+	"\n" +
+	"amount_min\x18\b \x01(\v2\x12.google.type.MoneyH\x06R\tamountMin\x88\x01\x01\x126\n" +
+	"\n" +
+	"amount_max\x18\t \x01(\v2\x12.google.type.MoneyH\aR\tamountMax\x88\x01\x01\x12K\n" +
+	"\tdirection\x18\n" +
+	" \x01(\x0e2\x1e.arian.v1.TransactionDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01H\bR\tdirection\x88\x01\x01\x12\x1f\n" +
+	"\vaccount_ids\x18\v \x03(\x03R\n" +
+	"accountIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\f \x03(\tR\n" +
+	"categories\x124\n" +
+	"\x0emerchant_query\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01H\tR\rmerchantQuery\x88\x01\x01\x12:\n" +
+	"\x11description_query\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\n" +
+	"R\x10descriptionQuery\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x0f \x01(\tH\vR\bcurrency\x88\x01\x01\x12C\n" +
+	"\x11time_of_day_start\x18\x10 \x01(\v2\x13.arian.v1.TimeOfDayH\fR\x0etimeOfDayStart\x88\x01\x01\x12?\n" +
+	"\x0ftime_of_day_end\x18\x11 \x01(\v2\x13.arian.v1.TimeOfDayH\rR\ftimeOfDayEnd\x88\x01\x01\x12)\n" +
+	"\runcategorized\x18\x12 \x01(\bH\x0eR\runcategorized\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x13 \x03(\tR\x04tags\x12%\n" +
+	"\x06filter\x18\x14 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x0fR\x06filter\x88\x01\x01\x12F\n" +
+	"\bstatuses\x18\x15 \x03(\x0e2\x1b.arian.v1.TransactionStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatusesB\r\n" +
+	"\v_account_idB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offsetB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\t\n" +
+	"\a_cursorB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_maxB\f\n" +
+	"\n" +
+	"_directionB\x11\n" +
+	"\x0f_merchant_queryB\x14\n" +
+	"\x12_description_queryB\v\n" +
+	"\t_currencyB\x14\n" +
+	"\x12_time_of_day_startB\x12\n" +
+	"\x10_time_of_day_endB\x10\n" +
+	"\x0e_uncategorizedB\t\n" +
+	"\a_filter\"i\n" +
+	"\vFilterError\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\x12\x12\n" +
+	"\x04term\x18\x03 \x01(\tR\x04term\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xbe\x01\n" +
+	"\x18ListTransactionsResponse\x129\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x15.arian.v1.TransactionR\ftransactions\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
 	"totalCount\x126\n" +
@@ -1018,7 +3941,7 @@ const file_arian_v1_transaction_services_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
 	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\"Q\n" +
 	"\x16GetTransactionResponse\x127\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x15.arian.v1.TransactionR\vtransaction\"\xbb\x04\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x15.arian.v1.TransactionR\vtransaction\"\xc1\x05\n" +
 	"\x10TransactionInput\x12&\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\taccountId\x123\n" +
@@ -1033,19 +3956,33 @@ const file_arian_v1_transaction_services_proto_rawDesc = "" +
 	"categoryId\x88\x01\x01\x12>\n" +
 	"\x0eforeign_amount\x18\t \x01(\v2\x12.google.type.MoneyH\x04R\rforeignAmount\x88\x01\x01\x12(\n" +
 	"\rexchange_rate\x18\n" +
-	" \x01(\x01H\x05R\fexchangeRate\x88\x01\x01B\x0e\n" +
+	" \x01(\x01H\x05R\fexchangeRate\x88\x01\x01\x12(\n" +
+	"\bemail_id\x18\v \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x06R\aemailId\x88\x01\x01\x12B\n" +
+	"\x06status\x18\f \x01(\x0e2\x1b.arian.v1.TransactionStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\aR\x06status\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\v\n" +
 	"\t_merchantB\r\n" +
 	"\v_user_notesB\x0e\n" +
 	"\f_category_idB\x11\n" +
 	"\x0f_foreign_amountB\x10\n" +
-	"\x0e_exchange_rate\"\x87\x01\n" +
+	"\x0e_exchange_rateB\v\n" +
+	"\t_email_idB\t\n" +
+	"\a_status\"\xa8\x01\n" +
 	"\x18CreateTransactionRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12H\n" +
-	"\ftransactions\x18\x02 \x03(\v2\x1a.arian.v1.TransactionInputB\b\xbaH\x05\x92\x01\x02\b\x01R\ftransactions\"{\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x1a.arian.v1.TransactionInputB\b\xbaH\x05\x92\x01\x02\b\x01R\ftransactions\x12\x1f\n" +
+	"\vbest_effort\x18\x03 \x01(\bR\n" +
+	"bestEffort\"\xe0\x01\n" +
+	"\x17CreateTransactionResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.arian.v1.CreateTransactionStatusR\x06status\x12<\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x15.arian.v1.TransactionH\x00R\vtransaction\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tH\x01R\x06reason\x88\x01\x01B\x0e\n" +
+	"\f_transactionB\t\n" +
+	"\a_reason\"\xb8\x01\n" +
 	"\x19CreateTransactionResponse\x129\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x15.arian.v1.TransactionR\ftransactions\x12#\n" +
-	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\"\x87\x06\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12;\n" +
+	"\aresults\x18\x03 \x03(\v2!.arian.v1.CreateTransactionResultR\aresults\"\xd6\x06\n" +
 	"\x18UpdateTransactionRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x17\n" +
 	"\x02id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x02id\x12;\n" +
@@ -1064,7 +4001,9 @@ const file_arian_v1_transaction_services_proto_rawDesc = "" +
 	"\x0eforeign_amount\x18\v \x01(\v2\x12.google.type.MoneyH\aR\rforeignAmount\x88\x01\x01\x12(\n" +
 	"\rexchange_rate\x18\f \x01(\x01H\bR\fexchangeRate\x88\x01\x01\x12+\n" +
 	"\n" +
-	"account_id\x18\r \x01(\x03B\a\xbaH\x04\"\x02 \x00H\tR\taccountId\x88\x01\x01B\n" +
+	"account_id\x18\r \x01(\x03B\a\xbaH\x04\"\x02 \x00H\tR\taccountId\x88\x01\x01\x12B\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x1b.arian.v1.TransactionStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\n" +
+	"R\x06status\x88\x01\x01B\n" +
 	"\n" +
 	"\b_tx_dateB\f\n" +
 	"\n" +
@@ -1077,7 +4016,8 @@ const file_arian_v1_transaction_services_proto_rawDesc = "" +
 	"\f_category_idB\x11\n" +
 	"\x0f_foreign_amountB\x10\n" +
 	"\x0e_exchange_rateB\r\n" +
-	"\v_account_id\"\x1b\n" +
+	"\v_account_idB\t\n" +
+	"\a_status\"\x1b\n" +
 	"\x19UpdateTransactionResponse\"Y\n" +
 	"\x18DeleteTransactionRequest\x12!\n" +
 	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1a\n" +
@@ -1090,14 +4030,282 @@ const file_arian_v1_transaction_services_proto_rawDesc = "" +
 	"\vcategory_id\x18\x03 \x01(\x03R\n" +
 	"categoryId\"E\n" +
 	"\x1eCategorizeTransactionsResponse\x12#\n" +
-	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows2\xcb\x04\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x99\b\n" +
+	"\x11TransactionFilter\x12>\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartDate\x88\x01\x01\x12:\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendDate\x88\x01\x01\x126\n" +
+	"\n" +
+	"amount_min\x18\x03 \x01(\v2\x12.google.type.MoneyH\x02R\tamountMin\x88\x01\x01\x126\n" +
+	"\n" +
+	"amount_max\x18\x04 \x01(\v2\x12.google.type.MoneyH\x03R\tamountMax\x88\x01\x01\x12K\n" +
+	"\tdirection\x18\x05 \x01(\x0e2\x1e.arian.v1.TransactionDirectionB\b\xbaH\x05\x82\x01\x02\x10\x01H\x04R\tdirection\x88\x01\x01\x12\x1f\n" +
+	"\vaccount_ids\x18\x06 \x03(\x03R\n" +
+	"accountIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\a \x03(\tR\n" +
+	"categories\x124\n" +
+	"\x0emerchant_query\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01H\x05R\rmerchantQuery\x88\x01\x01\x12:\n" +
+	"\x11description_query\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03H\x06R\x10descriptionQuery\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\n" +
+	" \x01(\tH\aR\bcurrency\x88\x01\x01\x12C\n" +
+	"\x11time_of_day_start\x18\v \x01(\v2\x13.arian.v1.TimeOfDayH\bR\x0etimeOfDayStart\x88\x01\x01\x12?\n" +
+	"\x0ftime_of_day_end\x18\f \x01(\v2\x13.arian.v1.TimeOfDayH\tR\ftimeOfDayEnd\x88\x01\x01\x12)\n" +
+	"\runcategorized\x18\r \x01(\bH\n" +
+	"R\runcategorized\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x12%\n" +
+	"\x06filter\x18\x0f \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\vR\x06filter\x88\x01\x01\x12F\n" +
+	"\bstatuses\x18\x10 \x03(\x0e2\x1b.arian.v1.TransactionStatusB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatusesB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\r\n" +
+	"\v_amount_minB\r\n" +
+	"\v_amount_maxB\f\n" +
+	"\n" +
+	"_directionB\x11\n" +
+	"\x0f_merchant_queryB\x14\n" +
+	"\x12_description_queryB\v\n" +
+	"\t_currencyB\x14\n" +
+	"\x12_time_of_day_startB\x12\n" +
+	"\x10_time_of_day_endB\x10\n" +
+	"\x0e_uncategorizedB\t\n" +
+	"\a_filter\"\xe9\x01\n" +
+	"\x19StreamTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x123\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1b.arian.v1.TransactionFilterR\x06filter\x128\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x16.arian.v1.ExportFormatB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06format\x12,\n" +
+	"\tpage_size\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x01H\x00R\bpageSize\x88\x01\x01B\f\n" +
+	"\n" +
+	"_page_size\"k\n" +
+	"\x1aStreamTransactionsResponse\x129\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x15.arian.v1.TransactionR\ftransactions\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\xc3\x02\n" +
+	"\x1cAggregateTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x123\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1b.arian.v1.TransactionFilterR\x06filter\x12Q\n" +
+	"\n" +
+	"dimensions\x18\x03 \x03(\x0e2\x1c.arian.v1.AggregateDimensionB\x13\xbaH\x10\x92\x01\r\x10\x03\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\n" +
+	"dimensions\x12K\n" +
+	"\bmeasures\x18\x04 \x03(\x0e2\x1a.arian.v1.AggregateMeasureB\x13\xbaH\x10\x92\x01\r\b\x01\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\bmeasures\x12+\n" +
+	"\x11include_transfers\x18\x05 \x01(\bR\x10includeTransfers\"_\n" +
+	"\x0eAggregateValue\x12\x16\n" +
+	"\x05count\x18\x01 \x01(\x03H\x00R\x05count\x12,\n" +
+	"\x06amount\x18\x02 \x01(\v2\x12.google.type.MoneyH\x00R\x06amountB\a\n" +
+	"\x05value\"p\n" +
+	"\fAggregateRow\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x120\n" +
+	"\x06values\x18\x03 \x03(\v2\x18.arian.v1.AggregateValueR\x06values\"\xc1\x01\n" +
+	"\x1dAggregateTransactionsResponse\x12<\n" +
+	"\n" +
+	"dimensions\x18\x01 \x03(\x0e2\x1c.arian.v1.AggregateDimensionR\n" +
+	"dimensions\x126\n" +
+	"\bmeasures\x18\x02 \x03(\x0e2\x1a.arian.v1.AggregateMeasureR\bmeasures\x12*\n" +
+	"\x04rows\x18\x03 \x03(\v2\x16.arian.v1.AggregateRowR\x04rows\"\xad\x03\n" +
+	"!UpdateTransactionsByFilterRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12;\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1b.arian.v1.TransactionFilterB\x06\xbaH\x03\xc8\x01\x01R\x06filter\x12-\n" +
+	"\vcategory_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x00R\n" +
+	"categoryId\x88\x01\x01\x12)\n" +
+	"\bmerchant\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01H\x01R\bmerchant\x88\x01\x01\x12,\n" +
+	"\n" +
+	"user_notes\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x02R\tuserNotes\x88\x01\x01\x12+\n" +
+	"\n" +
+	"account_id\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x03R\taccountId\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\vconfirm_all\x18\b \x01(\bR\n" +
+	"confirmAllB\x0e\n" +
+	"\f_category_idB\v\n" +
+	"\t_merchantB\r\n" +
+	"\v_user_notesB\r\n" +
+	"\v_account_id\"I\n" +
+	"\"UpdateTransactionsByFilterResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\xbd\x01\n" +
+	"!DeleteTransactionsByFilterRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12;\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1b.arian.v1.TransactionFilterB\x06\xbaH\x03\xc8\x01\x01R\x06filter\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1f\n" +
+	"\vconfirm_all\x18\x04 \x01(\bR\n" +
+	"confirmAll\"I\n" +
+	"\"DeleteTransactionsByFilterResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\xed\x02\n" +
+	"\x16ImportStatementRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\taccountId\x12=\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x19.arian.v1.StatementFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\x12&\n" +
+	"\acontent\x18\x04 \x01(\fB\f\xbaH\tz\a\x10\x01\x18\x80\x80\x80\x05R\acontent\x12&\n" +
+	"\fprofile_name\x18\x05 \x01(\tH\x00R\vprofileName\x88\x01\x01\x129\n" +
+	"\amapping\x18\x06 \x01(\v2\x1a.arian.v1.CsvColumnMappingH\x01R\amapping\x88\x01\x01\x12!\n" +
+	"\fpreview_only\x18\a \x01(\bR\vpreviewOnlyB\x0f\n" +
+	"\r_profile_nameB\n" +
+	"\n" +
+	"\b_mapping\"\x93\x02\n" +
+	"\x17ImportStatementResponse\x12'\n" +
+	"\x04rows\x18\x01 \x03(\v2\x13.arian.v1.ImportRowR\x04rows\x12!\n" +
+	"\fparsed_count\x18\x02 \x01(\x05R\vparsedCount\x12#\n" +
+	"\rcreated_count\x18\x03 \x01(\x05R\fcreatedCount\x12'\n" +
+	"\x0fduplicate_count\x18\x04 \x01(\x05R\x0eduplicateCount\x12#\n" +
+	"\rinvalid_count\x18\x05 \x01(\x05R\finvalidCount\x129\n" +
+	"\ftransactions\x18\x06 \x03(\v2\x15.arian.v1.TransactionR\ftransactions\">\n" +
+	"\x19ListImportProfilesRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"Q\n" +
+	"\x1aListImportProfilesResponse\x123\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x17.arian.v1.ImportProfileR\bprofiles\"\xbc\x01\n" +
+	"\x18SaveImportProfileRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12\x17\n" +
+	"\x04bank\x18\x03 \x01(\tH\x00R\x04bank\x88\x01\x01\x12<\n" +
+	"\amapping\x18\x04 \x01(\v2\x1a.arian.v1.CsvColumnMappingB\x06\xbaH\x03\xc8\x01\x01R\amappingB\a\n" +
+	"\x05_bank\"N\n" +
+	"\x19SaveImportProfileResponse\x121\n" +
+	"\aprofile\x18\x01 \x01(\v2\x17.arian.v1.ImportProfileR\aprofile\"\\\n" +
+	"\x1aDeleteImportProfileRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"B\n" +
+	"\x1bDeleteImportProfileResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\xb3\x01\n" +
+	"\x1bSetTransactionSplitsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rtransactionId\x12A\n" +
+	"\x06splits\x18\x03 \x03(\v2\x1f.arian.v1.TransactionSplitInputB\b\xbaH\x05\x92\x01\x02\x10dR\x06splits\"R\n" +
+	"\x1cSetTransactionSplitsResponse\x122\n" +
+	"\x06splits\x18\x01 \x03(\v2\x1a.arian.v1.TransactionSplitR\x06splits\"\xba\x01\n" +
+	"\x13LinkTransferRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12?\n" +
+	"\x17outgoing_transaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x15outgoingTransactionId\x12?\n" +
+	"\x17incoming_transaction_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x15incomingTransactionId\"F\n" +
+	"\x14LinkTransferResponse\x12.\n" +
+	"\btransfer\x18\x01 \x01(\v2\x12.arian.v1.TransferR\btransfer\"j\n" +
+	"\x15UnlinkTransferRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rtransactionId\"=\n" +
+	"\x16UnlinkTransferResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x85\x03\n" +
+	"\x16DetectTransfersRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12>\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartDate\x88\x01\x01\x12:\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendDate\x88\x01\x01\x12/\n" +
+	"\vwindow_days\x18\x04 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x0e(\x00H\x02R\n" +
+	"windowDays\x88\x01\x01\x12C\n" +
+	"\x0emin_similarity\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x03R\rminSimilarity\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRunB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\x0e\n" +
+	"\f_window_daysB\x11\n" +
+	"\x0f_min_similarity\"K\n" +
+	"\x17DetectTransfersResponse\x120\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x12.arian.v1.TransferR\ttransfers\"\xb4\x01\n" +
+	"\x11LinkRefundRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12?\n" +
+	"\x17original_transaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x15originalTransactionId\x12;\n" +
+	"\x15refund_transaction_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x13refundTransactionId\">\n" +
+	"\x12LinkRefundResponse\x12(\n" +
+	"\x06refund\x18\x01 \x01(\v2\x10.arian.v1.RefundR\x06refund\"h\n" +
+	"\x13UnlinkRefundRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rtransactionId\";\n" +
+	"\x14UnlinkRefundResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"\x84\x03\n" +
+	"\x14DetectRefundsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12>\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartDate\x88\x01\x01\x12:\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendDate\x88\x01\x01\x120\n" +
+	"\vwindow_days\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xed\x02(\x00H\x02R\n" +
+	"windowDays\x88\x01\x01\x12C\n" +
+	"\x0emin_similarity\x18\x05 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00H\x03R\rminSimilarity\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRunB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\x0e\n" +
+	"\f_window_daysB\x11\n" +
+	"\x0f_min_similarity\"C\n" +
+	"\x15DetectRefundsResponse\x12*\n" +
+	"\arefunds\x18\x01 \x03(\v2\x10.arian.v1.RefundR\arefunds\"\x95\x03\n" +
+	"\x19SearchTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\x05query\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12+\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\taccountId\x88\x01\x01\x12>\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartDate\x88\x01\x01\x12:\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendDate\x88\x01\x01\x12$\n" +
+	"\x05limit\x18\x06 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x03R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x04R\x06offset\x88\x01\x01B\r\n" +
+	"\v_account_idB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_dateB\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\xc7\x02\n" +
+	"\x17TransactionSearchResult\x127\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x15.arian.v1.TransactionR\vtransaction\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x128\n" +
+	"\x15description_highlight\x18\x03 \x01(\tH\x00R\x14descriptionHighlight\x88\x01\x01\x122\n" +
+	"\x12merchant_highlight\x18\x04 \x01(\tH\x01R\x11merchantHighlight\x88\x01\x01\x12,\n" +
+	"\x0fnotes_highlight\x18\x05 \x01(\tH\x02R\x0enotesHighlight\x88\x01\x01B\x18\n" +
+	"\x16_description_highlightB\x15\n" +
+	"\x13_merchant_highlightB\x12\n" +
+	"\x10_notes_highlight\"t\n" +
+	"\x1aSearchTransactionsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.arian.v1.TransactionSearchResultR\aresults\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"q\n" +
+	"\x1cGetTransactionHistoryRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\rtransactionId\"V\n" +
+	"\x1dGetTransactionHistoryResponse\x125\n" +
+	"\achanges\x18\x01 \x03(\v2\x1b.arian.v1.TransactionChangeR\achanges\"\xa5\x01\n" +
+	"\x1eListDeletedTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\x05limit\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x01H\x00R\x05limit\x88\x01\x01\x12$\n" +
+	"\x06offset\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\x06offset\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_offset\"\\\n" +
+	"\x1fListDeletedTransactionsResponse\x129\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x15.arian.v1.TransactionR\ftransactions\"[\n" +
+	"\x1aRestoreTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1a\n" +
+	"\x03ids\x18\x02 \x03(\x03B\b\xbaH\x05\x92\x01\x02\b\x01R\x03ids\"B\n" +
+	"\x1bRestoreTransactionsResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows\"Y\n" +
+	"\x18PurgeTransactionsRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1a\n" +
+	"\x03ids\x18\x02 \x03(\x03B\b\xbaH\x05\x92\x01\x02\b\x01R\x03ids\"@\n" +
+	"\x19PurgeTransactionsResponse\x12#\n" +
+	"\raffected_rows\x18\x01 \x01(\x03R\faffectedRows2\xcf\x13\n" +
 	"\x12TransactionService\x12Y\n" +
 	"\x10ListTransactions\x12!.arian.v1.ListTransactionsRequest\x1a\".arian.v1.ListTransactionsResponse\x12S\n" +
 	"\x0eGetTransaction\x12\x1f.arian.v1.GetTransactionRequest\x1a .arian.v1.GetTransactionResponse\x12\\\n" +
 	"\x11CreateTransaction\x12\".arian.v1.CreateTransactionRequest\x1a#.arian.v1.CreateTransactionResponse\x12\\\n" +
 	"\x11UpdateTransaction\x12\".arian.v1.UpdateTransactionRequest\x1a#.arian.v1.UpdateTransactionResponse\x12\\\n" +
 	"\x11DeleteTransaction\x12\".arian.v1.DeleteTransactionRequest\x1a#.arian.v1.DeleteTransactionResponse\x12k\n" +
-	"\x16CategorizeTransactions\x12'.arian.v1.CategorizeTransactionsRequest\x1a(.arian.v1.CategorizeTransactionsResponseB\x8f\x01\n" +
+	"\x16CategorizeTransactions\x12'.arian.v1.CategorizeTransactionsRequest\x1a(.arian.v1.CategorizeTransactionsResponse\x12a\n" +
+	"\x12StreamTransactions\x12#.arian.v1.StreamTransactionsRequest\x1a$.arian.v1.StreamTransactionsResponse0\x01\x12h\n" +
+	"\x15AggregateTransactions\x12&.arian.v1.AggregateTransactionsRequest\x1a'.arian.v1.AggregateTransactionsResponse\x12w\n" +
+	"\x1aUpdateTransactionsByFilter\x12+.arian.v1.UpdateTransactionsByFilterRequest\x1a,.arian.v1.UpdateTransactionsByFilterResponse\x12w\n" +
+	"\x1aDeleteTransactionsByFilter\x12+.arian.v1.DeleteTransactionsByFilterRequest\x1a,.arian.v1.DeleteTransactionsByFilterResponse\x12V\n" +
+	"\x0fImportStatement\x12 .arian.v1.ImportStatementRequest\x1a!.arian.v1.ImportStatementResponse\x12_\n" +
+	"\x12ListImportProfiles\x12#.arian.v1.ListImportProfilesRequest\x1a$.arian.v1.ListImportProfilesResponse\x12\\\n" +
+	"\x11SaveImportProfile\x12\".arian.v1.SaveImportProfileRequest\x1a#.arian.v1.SaveImportProfileResponse\x12b\n" +
+	"\x13DeleteImportProfile\x12$.arian.v1.DeleteImportProfileRequest\x1a%.arian.v1.DeleteImportProfileResponse\x12e\n" +
+	"\x14SetTransactionSplits\x12%.arian.v1.SetTransactionSplitsRequest\x1a&.arian.v1.SetTransactionSplitsResponse\x12M\n" +
+	"\fLinkTransfer\x12\x1d.arian.v1.LinkTransferRequest\x1a\x1e.arian.v1.LinkTransferResponse\x12S\n" +
+	"\x0eUnlinkTransfer\x12\x1f.arian.v1.UnlinkTransferRequest\x1a .arian.v1.UnlinkTransferResponse\x12V\n" +
+	"\x0fDetectTransfers\x12 .arian.v1.DetectTransfersRequest\x1a!.arian.v1.DetectTransfersResponse\x12G\n" +
+	"\n" +
+	"LinkRefund\x12\x1b.arian.v1.LinkRefundRequest\x1a\x1c.arian.v1.LinkRefundResponse\x12M\n" +
+	"\fUnlinkRefund\x12\x1d.arian.v1.UnlinkRefundRequest\x1a\x1e.arian.v1.UnlinkRefundResponse\x12P\n" +
+	"\rDetectRefunds\x12\x1e.arian.v1.DetectRefundsRequest\x1a\x1f.arian.v1.DetectRefundsResponse\x12_\n" +
+	"\x12SearchTransactions\x12#.arian.v1.SearchTransactionsRequest\x1a$.arian.v1.SearchTransactionsResponse\x12h\n" +
+	"\x15GetTransactionHistory\x12&.arian.v1.GetTransactionHistoryRequest\x1a'.arian.v1.GetTransactionHistoryResponse\x12n\n" +
+	"\x17ListDeletedTransactions\x12(.arian.v1.ListDeletedTransactionsRequest\x1a).arian.v1.ListDeletedTransactionsResponse\x12b\n" +
+	"\x13RestoreTransactions\x12$.arian.v1.RestoreTransactionsRequest\x1a%.arian.v1.RestoreTransactionsResponse\x12\\\n" +
+	"\x11PurgeTransactions\x12\".arian.v1.PurgeTransactionsRequest\x1a#.arian.v1.PurgeTransactionsResponseB\x8f\x01\n" +
 	"\fcom.arian.v1B\x18TransactionServicesProtoP\x01Z$ariand/internal/gen/arian/v1;arianv1\xa2\x02\x03AXX\xaa\x02\bArian.V1\xca\x02\bArian\\V1\xe2\x02\x14Arian\\V1\\GPBMetadata\xea\x02\tArian::V1b\x06proto3"
 
 var (
@@ -1112,69 +4320,219 @@ func file_arian_v1_transaction_services_proto_rawDescGZIP() []byte {
 	return file_arian_v1_transaction_services_proto_rawDescData
 }
 
-var file_arian_v1_transaction_services_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_arian_v1_transaction_services_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_arian_v1_transaction_services_proto_goTypes = []any{
-	(*ListTransactionsRequest)(nil),        // 0: arian.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),       // 1: arian.v1.ListTransactionsResponse
-	(*GetTransactionRequest)(nil),          // 2: arian.v1.GetTransactionRequest
-	(*GetTransactionResponse)(nil),         // 3: arian.v1.GetTransactionResponse
-	(*TransactionInput)(nil),               // 4: arian.v1.TransactionInput
-	(*CreateTransactionRequest)(nil),       // 5: arian.v1.CreateTransactionRequest
-	(*CreateTransactionResponse)(nil),      // 6: arian.v1.CreateTransactionResponse
-	(*UpdateTransactionRequest)(nil),       // 7: arian.v1.UpdateTransactionRequest
-	(*UpdateTransactionResponse)(nil),      // 8: arian.v1.UpdateTransactionResponse
-	(*DeleteTransactionRequest)(nil),       // 9: arian.v1.DeleteTransactionRequest
-	(*DeleteTransactionResponse)(nil),      // 10: arian.v1.DeleteTransactionResponse
-	(*CategorizeTransactionsRequest)(nil),  // 11: arian.v1.CategorizeTransactionsRequest
-	(*CategorizeTransactionsResponse)(nil), // 12: arian.v1.CategorizeTransactionsResponse
-	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
-	(*Cursor)(nil),                         // 14: arian.v1.Cursor
-	(*money.Money)(nil),                    // 15: google.type.Money
-	(TransactionDirection)(0),              // 16: arian.v1.TransactionDirection
-	(*TimeOfDay)(nil),                      // 17: arian.v1.TimeOfDay
-	(*Transaction)(nil),                    // 18: arian.v1.Transaction
-	(*fieldmaskpb.FieldMask)(nil),          // 19: google.protobuf.FieldMask
+	(*ListTransactionsRequest)(nil),            // 0: arian.v1.ListTransactionsRequest
+	(*FilterError)(nil),                        // 1: arian.v1.FilterError
+	(*ListTransactionsResponse)(nil),           // 2: arian.v1.ListTransactionsResponse
+	(*GetTransactionRequest)(nil),              // 3: arian.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),             // 4: arian.v1.GetTransactionResponse
+	(*TransactionInput)(nil),                   // 5: arian.v1.TransactionInput
+	(*CreateTransactionRequest)(nil),           // 6: arian.v1.CreateTransactionRequest
+	(*CreateTransactionResult)(nil),            // 7: arian.v1.CreateTransactionResult
+	(*CreateTransactionResponse)(nil),          // 8: arian.v1.CreateTransactionResponse
+	(*UpdateTransactionRequest)(nil),           // 9: arian.v1.UpdateTransactionRequest
+	(*UpdateTransactionResponse)(nil),          // 10: arian.v1.UpdateTransactionResponse
+	(*DeleteTransactionRequest)(nil),           // 11: arian.v1.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),          // 12: arian.v1.DeleteTransactionResponse
+	(*CategorizeTransactionsRequest)(nil),      // 13: arian.v1.CategorizeTransactionsRequest
+	(*CategorizeTransactionsResponse)(nil),     // 14: arian.v1.CategorizeTransactionsResponse
+	(*TransactionFilter)(nil),                  // 15: arian.v1.TransactionFilter
+	(*StreamTransactionsRequest)(nil),          // 16: arian.v1.StreamTransactionsRequest
+	(*StreamTransactionsResponse)(nil),         // 17: arian.v1.StreamTransactionsResponse
+	(*AggregateTransactionsRequest)(nil),       // 18: arian.v1.AggregateTransactionsRequest
+	(*AggregateValue)(nil),                     // 19: arian.v1.AggregateValue
+	(*AggregateRow)(nil),                       // 20: arian.v1.AggregateRow
+	(*AggregateTransactionsResponse)(nil),      // 21: arian.v1.AggregateTransactionsResponse
+	(*UpdateTransactionsByFilterRequest)(nil),  // 22: arian.v1.UpdateTransactionsByFilterRequest
+	(*UpdateTransactionsByFilterResponse)(nil), // 23: arian.v1.UpdateTransactionsByFilterResponse
+	(*DeleteTransactionsByFilterRequest)(nil),  // 24: arian.v1.DeleteTransactionsByFilterRequest
+	(*DeleteTransactionsByFilterResponse)(nil), // 25: arian.v1.DeleteTransactionsByFilterResponse
+	(*ImportStatementRequest)(nil),             // 26: arian.v1.ImportStatementRequest
+	(*ImportStatementResponse)(nil),            // 27: arian.v1.ImportStatementResponse
+	(*ListImportProfilesRequest)(nil),          // 28: arian.v1.ListImportProfilesRequest
+	(*ListImportProfilesResponse)(nil),         // 29: arian.v1.ListImportProfilesResponse
+	(*SaveImportProfileRequest)(nil),           // 30: arian.v1.SaveImportProfileRequest
+	(*SaveImportProfileResponse)(nil),          // 31: arian.v1.SaveImportProfileResponse
+	(*DeleteImportProfileRequest)(nil),         // 32: arian.v1.DeleteImportProfileRequest
+	(*DeleteImportProfileResponse)(nil),        // 33: arian.v1.DeleteImportProfileResponse
+	(*SetTransactionSplitsRequest)(nil),        // 34: arian.v1.SetTransactionSplitsRequest
+	(*SetTransactionSplitsResponse)(nil),       // 35: arian.v1.SetTransactionSplitsResponse
+	(*LinkTransferRequest)(nil),                // 36: arian.v1.LinkTransferRequest
+	(*LinkTransferResponse)(nil),               // 37: arian.v1.LinkTransferResponse
+	(*UnlinkTransferRequest)(nil),              // 38: arian.v1.UnlinkTransferRequest
+	(*UnlinkTransferResponse)(nil),             // 39: arian.v1.UnlinkTransferResponse
+	(*DetectTransfersRequest)(nil),             // 40: arian.v1.DetectTransfersRequest
+	(*DetectTransfersResponse)(nil),            // 41: arian.v1.DetectTransfersResponse
+	(*LinkRefundRequest)(nil),                  // 42: arian.v1.LinkRefundRequest
+	(*LinkRefundResponse)(nil),                 // 43: arian.v1.LinkRefundResponse
+	(*UnlinkRefundRequest)(nil),                // 44: arian.v1.UnlinkRefundRequest
+	(*UnlinkRefundResponse)(nil),               // 45: arian.v1.UnlinkRefundResponse
+	(*DetectRefundsRequest)(nil),               // 46: arian.v1.DetectRefundsRequest
+	(*DetectRefundsResponse)(nil),              // 47: arian.v1.DetectRefundsResponse
+	(*SearchTransactionsRequest)(nil),          // 48: arian.v1.SearchTransactionsRequest
+	(*TransactionSearchResult)(nil),            // 49: arian.v1.TransactionSearchResult
+	(*SearchTransactionsResponse)(nil),         // 50: arian.v1.SearchTransactionsResponse
+	(*GetTransactionHistoryRequest)(nil),       // 51: arian.v1.GetTransactionHistoryRequest
+	(*GetTransactionHistoryResponse)(nil),      // 52: arian.v1.GetTransactionHistoryResponse
+	(*ListDeletedTransactionsRequest)(nil),     // 53: arian.v1.ListDeletedTransactionsRequest
+	(*ListDeletedTransactionsResponse)(nil),    // 54: arian.v1.ListDeletedTransactionsResponse
+	(*RestoreTransactionsRequest)(nil),         // 55: arian.v1.RestoreTransactionsRequest
+	(*RestoreTransactionsResponse)(nil),        // 56: arian.v1.RestoreTransactionsResponse
+	(*PurgeTransactionsRequest)(nil),           // 57: arian.v1.PurgeTransactionsRequest
+	(*PurgeTransactionsResponse)(nil),          // 58: arian.v1.PurgeTransactionsResponse
+	(*timestamppb.Timestamp)(nil),              // 59: google.protobuf.Timestamp
+	(*Cursor)(nil),                             // 60: arian.v1.Cursor
+	(*money.Money)(nil),                        // 61: google.type.Money
+	(TransactionDirection)(0),                  // 62: arian.v1.TransactionDirection
+	(*TimeOfDay)(nil),                          // 63: arian.v1.TimeOfDay
+	(TransactionStatus)(0),                     // 64: arian.v1.TransactionStatus
+	(*Transaction)(nil),                        // 65: arian.v1.Transaction
+	(CreateTransactionStatus)(0),               // 66: arian.v1.CreateTransactionStatus
+	(*fieldmaskpb.FieldMask)(nil),              // 67: google.protobuf.FieldMask
+	(ExportFormat)(0),                          // 68: arian.v1.ExportFormat
+	(AggregateDimension)(0),                    // 69: arian.v1.AggregateDimension
+	(AggregateMeasure)(0),                      // 70: arian.v1.AggregateMeasure
+	(StatementFormat)(0),                       // 71: arian.v1.StatementFormat
+	(*CsvColumnMapping)(nil),                   // 72: arian.v1.CsvColumnMapping
+	(*ImportRow)(nil),                          // 73: arian.v1.ImportRow
+	(*ImportProfile)(nil),                      // 74: arian.v1.ImportProfile
+	(*TransactionSplitInput)(nil),              // 75: arian.v1.TransactionSplitInput
+	(*TransactionSplit)(nil),                   // 76: arian.v1.TransactionSplit
+	(*Transfer)(nil),                           // 77: arian.v1.Transfer
+	(*Refund)(nil),                             // 78: arian.v1.Refund
+	(*TransactionChange)(nil),                  // 79: arian.v1.TransactionChange
 }
 var file_arian_v1_transaction_services_proto_depIdxs = []int32{
-	13, // 0: arian.v1.ListTransactionsRequest.start_date:type_name -> google.protobuf.Timestamp
-	13, // 1: arian.v1.ListTransactionsRequest.end_date:type_name -> google.protobuf.Timestamp
-	14, // 2: arian.v1.ListTransactionsRequest.cursor:type_name -> arian.v1.Cursor
-	15, // 3: arian.v1.ListTransactionsRequest.amount_min:type_name -> google.type.Money
-	15, // 4: arian.v1.ListTransactionsRequest.amount_max:type_name -> google.type.Money
-	16, // 5: arian.v1.ListTransactionsRequest.direction:type_name -> arian.v1.TransactionDirection
-	17, // 6: arian.v1.ListTransactionsRequest.time_of_day_start:type_name -> arian.v1.TimeOfDay
-	17, // 7: arian.v1.ListTransactionsRequest.time_of_day_end:type_name -> arian.v1.TimeOfDay
-	18, // 8: arian.v1.ListTransactionsResponse.transactions:type_name -> arian.v1.Transaction
-	14, // 9: arian.v1.ListTransactionsResponse.next_cursor:type_name -> arian.v1.Cursor
-	18, // 10: arian.v1.GetTransactionResponse.transaction:type_name -> arian.v1.Transaction
-	13, // 11: arian.v1.TransactionInput.tx_date:type_name -> google.protobuf.Timestamp
-	15, // 12: arian.v1.TransactionInput.tx_amount:type_name -> google.type.Money
-	16, // 13: arian.v1.TransactionInput.direction:type_name -> arian.v1.TransactionDirection
-	15, // 14: arian.v1.TransactionInput.foreign_amount:type_name -> google.type.Money
-	4,  // 15: arian.v1.CreateTransactionRequest.transactions:type_name -> arian.v1.TransactionInput
-	18, // 16: arian.v1.CreateTransactionResponse.transactions:type_name -> arian.v1.Transaction
-	19, // 17: arian.v1.UpdateTransactionRequest.update_mask:type_name -> google.protobuf.FieldMask
-	13, // 18: arian.v1.UpdateTransactionRequest.tx_date:type_name -> google.protobuf.Timestamp
-	15, // 19: arian.v1.UpdateTransactionRequest.tx_amount:type_name -> google.type.Money
-	16, // 20: arian.v1.UpdateTransactionRequest.direction:type_name -> arian.v1.TransactionDirection
-	15, // 21: arian.v1.UpdateTransactionRequest.foreign_amount:type_name -> google.type.Money
-	0,  // 22: arian.v1.TransactionService.ListTransactions:input_type -> arian.v1.ListTransactionsRequest
-	2,  // 23: arian.v1.TransactionService.GetTransaction:input_type -> arian.v1.GetTransactionRequest
-	5,  // 24: arian.v1.TransactionService.CreateTransaction:input_type -> arian.v1.CreateTransactionRequest
-	7,  // 25: arian.v1.TransactionService.UpdateTransaction:input_type -> arian.v1.UpdateTransactionRequest
-	9,  // 26: arian.v1.TransactionService.DeleteTransaction:input_type -> arian.v1.DeleteTransactionRequest
-	11, // 27: arian.v1.TransactionService.CategorizeTransactions:input_type -> arian.v1.CategorizeTransactionsRequest
-	1,  // 28: arian.v1.TransactionService.ListTransactions:output_type -> arian.v1.ListTransactionsResponse
-	3,  // 29: arian.v1.TransactionService.GetTransaction:output_type -> arian.v1.GetTransactionResponse
-	6,  // 30: arian.v1.TransactionService.CreateTransaction:output_type -> arian.v1.CreateTransactionResponse
-	8,  // 31: arian.v1.TransactionService.UpdateTransaction:output_type -> arian.v1.UpdateTransactionResponse
-	10, // 32: arian.v1.TransactionService.DeleteTransaction:output_type -> arian.v1.DeleteTransactionResponse
-	12, // 33: arian.v1.TransactionService.CategorizeTransactions:output_type -> arian.v1.CategorizeTransactionsResponse
-	28, // [28:34] is the sub-list for method output_type
-	22, // [22:28] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	59, // 0: arian.v1.ListTransactionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	59, // 1: arian.v1.ListTransactionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	60, // 2: arian.v1.ListTransactionsRequest.cursor:type_name -> arian.v1.Cursor
+	61, // 3: arian.v1.ListTransactionsRequest.amount_min:type_name -> google.type.Money
+	61, // 4: arian.v1.ListTransactionsRequest.amount_max:type_name -> google.type.Money
+	62, // 5: arian.v1.ListTransactionsRequest.direction:type_name -> arian.v1.TransactionDirection
+	63, // 6: arian.v1.ListTransactionsRequest.time_of_day_start:type_name -> arian.v1.TimeOfDay
+	63, // 7: arian.v1.ListTransactionsRequest.time_of_day_end:type_name -> arian.v1.TimeOfDay
+	64, // 8: arian.v1.ListTransactionsRequest.statuses:type_name -> arian.v1.TransactionStatus
+	65, // 9: arian.v1.ListTransactionsResponse.transactions:type_name -> arian.v1.Transaction
+	60, // 10: arian.v1.ListTransactionsResponse.next_cursor:type_name -> arian.v1.Cursor
+	65, // 11: arian.v1.GetTransactionResponse.transaction:type_name -> arian.v1.Transaction
+	59, // 12: arian.v1.TransactionInput.tx_date:type_name -> google.protobuf.Timestamp
+	61, // 13: arian.v1.TransactionInput.tx_amount:type_name -> google.type.Money
+	62, // 14: arian.v1.TransactionInput.direction:type_name -> arian.v1.TransactionDirection
+	61, // 15: arian.v1.TransactionInput.foreign_amount:type_name -> google.type.Money
+	64, // 16: arian.v1.TransactionInput.status:type_name -> arian.v1.TransactionStatus
+	5,  // 17: arian.v1.CreateTransactionRequest.transactions:type_name -> arian.v1.TransactionInput
+	66, // 18: arian.v1.CreateTransactionResult.status:type_name -> arian.v1.CreateTransactionStatus
+	65, // 19: arian.v1.CreateTransactionResult.transaction:type_name -> arian.v1.Transaction
+	65, // 20: arian.v1.CreateTransactionResponse.transactions:type_name -> arian.v1.Transaction
+	7,  // 21: arian.v1.CreateTransactionResponse.results:type_name -> arian.v1.CreateTransactionResult
+	67, // 22: arian.v1.UpdateTransactionRequest.update_mask:type_name -> google.protobuf.FieldMask
+	59, // 23: arian.v1.UpdateTransactionRequest.tx_date:type_name -> google.protobuf.Timestamp
+	61, // 24: arian.v1.UpdateTransactionRequest.tx_amount:type_name -> google.type.Money
+	62, // 25: arian.v1.UpdateTransactionRequest.direction:type_name -> arian.v1.TransactionDirection
+	61, // 26: arian.v1.UpdateTransactionRequest.foreign_amount:type_name -> google.type.Money
+	64, // 27: arian.v1.UpdateTransactionRequest.status:type_name -> arian.v1.TransactionStatus
+	59, // 28: arian.v1.TransactionFilter.start_date:type_name -> google.protobuf.Timestamp
+	59, // 29: arian.v1.TransactionFilter.end_date:type_name -> google.protobuf.Timestamp
+	61, // 30: arian.v1.TransactionFilter.amount_min:type_name -> google.type.Money
+	61, // 31: arian.v1.TransactionFilter.amount_max:type_name -> google.type.Money
+	62, // 32: arian.v1.TransactionFilter.direction:type_name -> arian.v1.TransactionDirection
+	63, // 33: arian.v1.TransactionFilter.time_of_day_start:type_name -> arian.v1.TimeOfDay
+	63, // 34: arian.v1.TransactionFilter.time_of_day_end:type_name -> arian.v1.TimeOfDay
+	64, // 35: arian.v1.TransactionFilter.statuses:type_name -> arian.v1.TransactionStatus
+	15, // 36: arian.v1.StreamTransactionsRequest.filter:type_name -> arian.v1.TransactionFilter
+	68, // 37: arian.v1.StreamTransactionsRequest.format:type_name -> arian.v1.ExportFormat
+	65, // 38: arian.v1.StreamTransactionsResponse.transactions:type_name -> arian.v1.Transaction
+	15, // 39: arian.v1.AggregateTransactionsRequest.filter:type_name -> arian.v1.TransactionFilter
+	69, // 40: arian.v1.AggregateTransactionsRequest.dimensions:type_name -> arian.v1.AggregateDimension
+	70, // 41: arian.v1.AggregateTransactionsRequest.measures:type_name -> arian.v1.AggregateMeasure
+	61, // 42: arian.v1.AggregateValue.amount:type_name -> google.type.Money
+	19, // 43: arian.v1.AggregateRow.values:type_name -> arian.v1.AggregateValue
+	69, // 44: arian.v1.AggregateTransactionsResponse.dimensions:type_name -> arian.v1.AggregateDimension
+	70, // 45: arian.v1.AggregateTransactionsResponse.measures:type_name -> arian.v1.AggregateMeasure
+	20, // 46: arian.v1.AggregateTransactionsResponse.rows:type_name -> arian.v1.AggregateRow
+	15, // 47: arian.v1.UpdateTransactionsByFilterRequest.filter:type_name -> arian.v1.TransactionFilter
+	15, // 48: arian.v1.DeleteTransactionsByFilterRequest.filter:type_name -> arian.v1.TransactionFilter
+	71, // 49: arian.v1.ImportStatementRequest.format:type_name -> arian.v1.StatementFormat
+	72, // 50: arian.v1.ImportStatementRequest.mapping:type_name -> arian.v1.CsvColumnMapping
+	73, // 51: arian.v1.ImportStatementResponse.rows:type_name -> arian.v1.ImportRow
+	65, // 52: arian.v1.ImportStatementResponse.transactions:type_name -> arian.v1.Transaction
+	74, // 53: arian.v1.ListImportProfilesResponse.profiles:type_name -> arian.v1.ImportProfile
+	72, // 54: arian.v1.SaveImportProfileRequest.mapping:type_name -> arian.v1.CsvColumnMapping
+	74, // 55: arian.v1.SaveImportProfileResponse.profile:type_name -> arian.v1.ImportProfile
+	75, // 56: arian.v1.SetTransactionSplitsRequest.splits:type_name -> arian.v1.TransactionSplitInput
+	76, // 57: arian.v1.SetTransactionSplitsResponse.splits:type_name -> arian.v1.TransactionSplit
+	77, // 58: arian.v1.LinkTransferResponse.transfer:type_name -> arian.v1.Transfer
+	59, // 59: arian.v1.DetectTransfersRequest.start_date:type_name -> google.protobuf.Timestamp
+	59, // 60: arian.v1.DetectTransfersRequest.end_date:type_name -> google.protobuf.Timestamp
+	77, // 61: arian.v1.DetectTransfersResponse.transfers:type_name -> arian.v1.Transfer
+	78, // 62: arian.v1.LinkRefundResponse.refund:type_name -> arian.v1.Refund
+	59, // 63: arian.v1.DetectRefundsRequest.start_date:type_name -> google.protobuf.Timestamp
+	59, // 64: arian.v1.DetectRefundsRequest.end_date:type_name -> google.protobuf.Timestamp
+	78, // 65: arian.v1.DetectRefundsResponse.refunds:type_name -> arian.v1.Refund
+	59, // 66: arian.v1.SearchTransactionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	59, // 67: arian.v1.SearchTransactionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	65, // 68: arian.v1.TransactionSearchResult.transaction:type_name -> arian.v1.Transaction
+	49, // 69: arian.v1.SearchTransactionsResponse.results:type_name -> arian.v1.TransactionSearchResult
+	79, // 70: arian.v1.GetTransactionHistoryResponse.changes:type_name -> arian.v1.TransactionChange
+	65, // 71: arian.v1.ListDeletedTransactionsResponse.transactions:type_name -> arian.v1.Transaction
+	0,  // 72: arian.v1.TransactionService.ListTransactions:input_type -> arian.v1.ListTransactionsRequest
+	3,  // 73: arian.v1.TransactionService.GetTransaction:input_type -> arian.v1.GetTransactionRequest
+	6,  // 74: arian.v1.TransactionService.CreateTransaction:input_type -> arian.v1.CreateTransactionRequest
+	9,  // 75: arian.v1.TransactionService.UpdateTransaction:input_type -> arian.v1.UpdateTransactionRequest
+	11, // 76: arian.v1.TransactionService.DeleteTransaction:input_type -> arian.v1.DeleteTransactionRequest
+	13, // 77: arian.v1.TransactionService.CategorizeTransactions:input_type -> arian.v1.CategorizeTransactionsRequest
+	16, // 78: arian.v1.TransactionService.StreamTransactions:input_type -> arian.v1.StreamTransactionsRequest
+	18, // 79: arian.v1.TransactionService.AggregateTransactions:input_type -> arian.v1.AggregateTransactionsRequest
+	22, // 80: arian.v1.TransactionService.UpdateTransactionsByFilter:input_type -> arian.v1.UpdateTransactionsByFilterRequest
+	24, // 81: arian.v1.TransactionService.DeleteTransactionsByFilter:input_type -> arian.v1.DeleteTransactionsByFilterRequest
+	26, // 82: arian.v1.TransactionService.ImportStatement:input_type -> arian.v1.ImportStatementRequest
+	28, // 83: arian.v1.TransactionService.ListImportProfiles:input_type -> arian.v1.ListImportProfilesRequest
+	30, // 84: arian.v1.TransactionService.SaveImportProfile:input_type -> arian.v1.SaveImportProfileRequest
+	32, // 85: arian.v1.TransactionService.DeleteImportProfile:input_type -> arian.v1.DeleteImportProfileRequest
+	34, // 86: arian.v1.TransactionService.SetTransactionSplits:input_type -> arian.v1.SetTransactionSplitsRequest
+	36, // 87: arian.v1.TransactionService.LinkTransfer:input_type -> arian.v1.LinkTransferRequest
+	38, // 88: arian.v1.TransactionService.UnlinkTransfer:input_type -> arian.v1.UnlinkTransferRequest
+	40, // 89: arian.v1.TransactionService.DetectTransfers:input_type -> arian.v1.DetectTransfersRequest
+	42, // 90: arian.v1.TransactionService.LinkRefund:input_type -> arian.v1.LinkRefundRequest
+	44, // 91: arian.v1.TransactionService.UnlinkRefund:input_type -> arian.v1.UnlinkRefundRequest
+	46, // 92: arian.v1.TransactionService.DetectRefunds:input_type -> arian.v1.DetectRefundsRequest
+	48, // 93: arian.v1.TransactionService.SearchTransactions:input_type -> arian.v1.SearchTransactionsRequest
+	51, // 94: arian.v1.TransactionService.GetTransactionHistory:input_type -> arian.v1.GetTransactionHistoryRequest
+	53, // 95: arian.v1.TransactionService.ListDeletedTransactions:input_type -> arian.v1.ListDeletedTransactionsRequest
+	55, // 96: arian.v1.TransactionService.RestoreTransactions:input_type -> arian.v1.RestoreTransactionsRequest
+	57, // 97: arian.v1.TransactionService.PurgeTransactions:input_type -> arian.v1.PurgeTransactionsRequest
+	2,  // 98: arian.v1.TransactionService.ListTransactions:output_type -> arian.v1.ListTransactionsResponse
+	4,  // 99: arian.v1.TransactionService.GetTransaction:output_type -> arian.v1.GetTransactionResponse
+	8,  // 100: arian.v1.TransactionService.CreateTransaction:output_type -> arian.v1.CreateTransactionResponse
+	10, // 101: arian.v1.TransactionService.UpdateTransaction:output_type -> arian.v1.UpdateTransactionResponse
+	12, // 102: arian.v1.TransactionService.DeleteTransaction:output_type -> arian.v1.DeleteTransactionResponse
+	14, // 103: arian.v1.TransactionService.CategorizeTransactions:output_type -> arian.v1.CategorizeTransactionsResponse
+	17, // 104: arian.v1.TransactionService.StreamTransactions:output_type -> arian.v1.StreamTransactionsResponse
+	21, // 105: arian.v1.TransactionService.AggregateTransactions:output_type -> arian.v1.AggregateTransactionsResponse
+	23, // 106: arian.v1.TransactionService.UpdateTransactionsByFilter:output_type -> arian.v1.UpdateTransactionsByFilterResponse
+	25, // 107: arian.v1.TransactionService.DeleteTransactionsByFilter:output_type -> arian.v1.DeleteTransactionsByFilterResponse
+	27, // 108: arian.v1.TransactionService.ImportStatement:output_type -> arian.v1.ImportStatementResponse
+	29, // 109: arian.v1.TransactionService.ListImportProfiles:output_type -> arian.v1.ListImportProfilesResponse
+	31, // 110: arian.v1.TransactionService.SaveImportProfile:output_type -> arian.v1.SaveImportProfileResponse
+	33, // 111: arian.v1.TransactionService.DeleteImportProfile:output_type -> arian.v1.DeleteImportProfileResponse
+	35, // 112: arian.v1.TransactionService.SetTransactionSplits:output_type -> arian.v1.SetTransactionSplitsResponse
+	37, // 113: arian.v1.TransactionService.LinkTransfer:output_type -> arian.v1.LinkTransferResponse
+	39, // 114: arian.v1.TransactionService.UnlinkTransfer:output_type -> arian.v1.UnlinkTransferResponse
+	41, // 115: arian.v1.TransactionService.DetectTransfers:output_type -> arian.v1.DetectTransfersResponse
+	43, // 116: arian.v1.TransactionService.LinkRefund:output_type -> arian.v1.LinkRefundResponse
+	45, // 117: arian.v1.TransactionService.UnlinkRefund:output_type -> arian.v1.UnlinkRefundResponse
+	47, // 118: arian.v1.TransactionService.DetectRefunds:output_type -> arian.v1.DetectRefundsResponse
+	50, // 119: arian.v1.TransactionService.SearchTransactions:output_type -> arian.v1.SearchTransactionsResponse
+	52, // 120: arian.v1.TransactionService.GetTransactionHistory:output_type -> arian.v1.GetTransactionHistoryResponse
+	54, // 121: arian.v1.TransactionService.ListDeletedTransactions:output_type -> arian.v1.ListDeletedTransactionsResponse
+	56, // 122: arian.v1.TransactionService.RestoreTransactions:output_type -> arian.v1.RestoreTransactionsResponse
+	58, // 123: arian.v1.TransactionService.PurgeTransactions:output_type -> arian.v1.PurgeTransactionsResponse
+	98, // [98:124] is the sub-list for method output_type
+	72, // [72:98] is the sub-list for method input_type
+	72, // [72:72] is the sub-list for extension type_name
+	72, // [72:72] is the sub-list for extension extendee
+	0,  // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_arian_v1_transaction_services_proto_init() }
@@ -1186,16 +4544,31 @@ func file_arian_v1_transaction_services_proto_init() {
 	file_arian_v1_enums_proto_init()
 	file_arian_v1_transaction_proto_init()
 	file_arian_v1_transaction_services_proto_msgTypes[0].OneofWrappers = []any{}
-	file_arian_v1_transaction_services_proto_msgTypes[1].OneofWrappers = []any{}
-	file_arian_v1_transaction_services_proto_msgTypes[4].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[2].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[5].OneofWrappers = []any{}
 	file_arian_v1_transaction_services_proto_msgTypes[7].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[9].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[15].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[16].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[19].OneofWrappers = []any{
+		(*AggregateValue_Count)(nil),
+		(*AggregateValue_Amount)(nil),
+	}
+	file_arian_v1_transaction_services_proto_msgTypes[22].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[26].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[30].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[40].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[46].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[48].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[49].OneofWrappers = []any{}
+	file_arian_v1_transaction_services_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_arian_v1_transaction_services_proto_rawDesc), len(file_arian_v1_transaction_services_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
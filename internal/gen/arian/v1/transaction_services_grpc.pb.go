@@ -19,12 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TransactionService_ListTransactions_FullMethodName       = "/arian.v1.TransactionService/ListTransactions"
-	TransactionService_GetTransaction_FullMethodName         = "/arian.v1.TransactionService/GetTransaction"
-	TransactionService_CreateTransaction_FullMethodName      = "/arian.v1.TransactionService/CreateTransaction"
-	TransactionService_UpdateTransaction_FullMethodName      = "/arian.v1.TransactionService/UpdateTransaction"
-	TransactionService_DeleteTransaction_FullMethodName      = "/arian.v1.TransactionService/DeleteTransaction"
-	TransactionService_CategorizeTransactions_FullMethodName = "/arian.v1.TransactionService/CategorizeTransactions"
+	TransactionService_ListTransactions_FullMethodName           = "/arian.v1.TransactionService/ListTransactions"
+	TransactionService_GetTransaction_FullMethodName             = "/arian.v1.TransactionService/GetTransaction"
+	TransactionService_CreateTransaction_FullMethodName          = "/arian.v1.TransactionService/CreateTransaction"
+	TransactionService_UpdateTransaction_FullMethodName          = "/arian.v1.TransactionService/UpdateTransaction"
+	TransactionService_DeleteTransaction_FullMethodName          = "/arian.v1.TransactionService/DeleteTransaction"
+	TransactionService_CategorizeTransactions_FullMethodName     = "/arian.v1.TransactionService/CategorizeTransactions"
+	TransactionService_StreamTransactions_FullMethodName         = "/arian.v1.TransactionService/StreamTransactions"
+	TransactionService_AggregateTransactions_FullMethodName      = "/arian.v1.TransactionService/AggregateTransactions"
+	TransactionService_UpdateTransactionsByFilter_FullMethodName = "/arian.v1.TransactionService/UpdateTransactionsByFilter"
+	TransactionService_DeleteTransactionsByFilter_FullMethodName = "/arian.v1.TransactionService/DeleteTransactionsByFilter"
+	TransactionService_ImportStatement_FullMethodName            = "/arian.v1.TransactionService/ImportStatement"
+	TransactionService_ListImportProfiles_FullMethodName         = "/arian.v1.TransactionService/ListImportProfiles"
+	TransactionService_SaveImportProfile_FullMethodName          = "/arian.v1.TransactionService/SaveImportProfile"
+	TransactionService_DeleteImportProfile_FullMethodName        = "/arian.v1.TransactionService/DeleteImportProfile"
+	TransactionService_SetTransactionSplits_FullMethodName       = "/arian.v1.TransactionService/SetTransactionSplits"
+	TransactionService_LinkTransfer_FullMethodName               = "/arian.v1.TransactionService/LinkTransfer"
+	TransactionService_UnlinkTransfer_FullMethodName             = "/arian.v1.TransactionService/UnlinkTransfer"
+	TransactionService_DetectTransfers_FullMethodName            = "/arian.v1.TransactionService/DetectTransfers"
+	TransactionService_LinkRefund_FullMethodName                 = "/arian.v1.TransactionService/LinkRefund"
+	TransactionService_UnlinkRefund_FullMethodName               = "/arian.v1.TransactionService/UnlinkRefund"
+	TransactionService_DetectRefunds_FullMethodName              = "/arian.v1.TransactionService/DetectRefunds"
+	TransactionService_SearchTransactions_FullMethodName         = "/arian.v1.TransactionService/SearchTransactions"
+	TransactionService_GetTransactionHistory_FullMethodName      = "/arian.v1.TransactionService/GetTransactionHistory"
+	TransactionService_ListDeletedTransactions_FullMethodName    = "/arian.v1.TransactionService/ListDeletedTransactions"
+	TransactionService_RestoreTransactions_FullMethodName        = "/arian.v1.TransactionService/RestoreTransactions"
+	TransactionService_PurgeTransactions_FullMethodName          = "/arian.v1.TransactionService/PurgeTransactions"
 )
 
 // TransactionServiceClient is the client API for TransactionService service.
@@ -37,6 +57,28 @@ type TransactionServiceClient interface {
 	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*UpdateTransactionResponse, error)
 	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
 	CategorizeTransactions(ctx context.Context, in *CategorizeTransactionsRequest, opts ...grpc.CallOption) (*CategorizeTransactionsResponse, error)
+	StreamTransactions(ctx context.Context, in *StreamTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTransactionsResponse], error)
+	AggregateTransactions(ctx context.Context, in *AggregateTransactionsRequest, opts ...grpc.CallOption) (*AggregateTransactionsResponse, error)
+	UpdateTransactionsByFilter(ctx context.Context, in *UpdateTransactionsByFilterRequest, opts ...grpc.CallOption) (*UpdateTransactionsByFilterResponse, error)
+	// moves the matching transactions to the trash
+	DeleteTransactionsByFilter(ctx context.Context, in *DeleteTransactionsByFilterRequest, opts ...grpc.CallOption) (*DeleteTransactionsByFilterResponse, error)
+	ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error)
+	ListImportProfiles(ctx context.Context, in *ListImportProfilesRequest, opts ...grpc.CallOption) (*ListImportProfilesResponse, error)
+	SaveImportProfile(ctx context.Context, in *SaveImportProfileRequest, opts ...grpc.CallOption) (*SaveImportProfileResponse, error)
+	DeleteImportProfile(ctx context.Context, in *DeleteImportProfileRequest, opts ...grpc.CallOption) (*DeleteImportProfileResponse, error)
+	SetTransactionSplits(ctx context.Context, in *SetTransactionSplitsRequest, opts ...grpc.CallOption) (*SetTransactionSplitsResponse, error)
+	LinkTransfer(ctx context.Context, in *LinkTransferRequest, opts ...grpc.CallOption) (*LinkTransferResponse, error)
+	UnlinkTransfer(ctx context.Context, in *UnlinkTransferRequest, opts ...grpc.CallOption) (*UnlinkTransferResponse, error)
+	DetectTransfers(ctx context.Context, in *DetectTransfersRequest, opts ...grpc.CallOption) (*DetectTransfersResponse, error)
+	LinkRefund(ctx context.Context, in *LinkRefundRequest, opts ...grpc.CallOption) (*LinkRefundResponse, error)
+	UnlinkRefund(ctx context.Context, in *UnlinkRefundRequest, opts ...grpc.CallOption) (*UnlinkRefundResponse, error)
+	DetectRefunds(ctx context.Context, in *DetectRefundsRequest, opts ...grpc.CallOption) (*DetectRefundsResponse, error)
+	SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*SearchTransactionsResponse, error)
+	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	ListDeletedTransactions(ctx context.Context, in *ListDeletedTransactionsRequest, opts ...grpc.CallOption) (*ListDeletedTransactionsResponse, error)
+	RestoreTransactions(ctx context.Context, in *RestoreTransactionsRequest, opts ...grpc.CallOption) (*RestoreTransactionsResponse, error)
+	// permanently removes transactions that are already in the trash
+	PurgeTransactions(ctx context.Context, in *PurgeTransactionsRequest, opts ...grpc.CallOption) (*PurgeTransactionsResponse, error)
 }
 
 type transactionServiceClient struct {
@@ -107,6 +149,215 @@ func (c *transactionServiceClient) CategorizeTransactions(ctx context.Context, i
 	return out, nil
 }
 
+func (c *transactionServiceClient) StreamTransactions(ctx context.Context, in *StreamTransactionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTransactionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TransactionService_ServiceDesc.Streams[0], TransactionService_StreamTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamTransactionsRequest, StreamTransactionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_StreamTransactionsClient = grpc.ServerStreamingClient[StreamTransactionsResponse]
+
+func (c *transactionServiceClient) AggregateTransactions(ctx context.Context, in *AggregateTransactionsRequest, opts ...grpc.CallOption) (*AggregateTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AggregateTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_AggregateTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UpdateTransactionsByFilter(ctx context.Context, in *UpdateTransactionsByFilterRequest, opts ...grpc.CallOption) (*UpdateTransactionsByFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTransactionsByFilterResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UpdateTransactionsByFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteTransactionsByFilter(ctx context.Context, in *DeleteTransactionsByFilterRequest, opts ...grpc.CallOption) (*DeleteTransactionsByFilterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTransactionsByFilterResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteTransactionsByFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ImportStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportStatementResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ImportStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListImportProfiles(ctx context.Context, in *ListImportProfilesRequest, opts ...grpc.CallOption) (*ListImportProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImportProfilesResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListImportProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SaveImportProfile(ctx context.Context, in *SaveImportProfileRequest, opts ...grpc.CallOption) (*SaveImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveImportProfileResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SaveImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteImportProfile(ctx context.Context, in *DeleteImportProfileRequest, opts ...grpc.CallOption) (*DeleteImportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteImportProfileResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DeleteImportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SetTransactionSplits(ctx context.Context, in *SetTransactionSplitsRequest, opts ...grpc.CallOption) (*SetTransactionSplitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTransactionSplitsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SetTransactionSplits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) LinkTransfer(ctx context.Context, in *LinkTransferRequest, opts ...grpc.CallOption) (*LinkTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkTransferResponse)
+	err := c.cc.Invoke(ctx, TransactionService_LinkTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UnlinkTransfer(ctx context.Context, in *UnlinkTransferRequest, opts ...grpc.CallOption) (*UnlinkTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkTransferResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UnlinkTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DetectTransfers(ctx context.Context, in *DetectTransfersRequest, opts ...grpc.CallOption) (*DetectTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectTransfersResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DetectTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) LinkRefund(ctx context.Context, in *LinkRefundRequest, opts ...grpc.CallOption) (*LinkRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkRefundResponse)
+	err := c.cc.Invoke(ctx, TransactionService_LinkRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) UnlinkRefund(ctx context.Context, in *UnlinkRefundRequest, opts ...grpc.CallOption) (*UnlinkRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkRefundResponse)
+	err := c.cc.Invoke(ctx, TransactionService_UnlinkRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DetectRefunds(ctx context.Context, in *DetectRefundsRequest, opts ...grpc.CallOption) (*DetectRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetectRefundsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_DetectRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*SearchTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_SearchTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, TransactionService_GetTransactionHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListDeletedTransactions(ctx context.Context, in *ListDeletedTransactionsRequest, opts ...grpc.CallOption) (*ListDeletedTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_ListDeletedTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) RestoreTransactions(ctx context.Context, in *RestoreTransactionsRequest, opts ...grpc.CallOption) (*RestoreTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_RestoreTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) PurgeTransactions(ctx context.Context, in *PurgeTransactionsRequest, opts ...grpc.CallOption) (*PurgeTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeTransactionsResponse)
+	err := c.cc.Invoke(ctx, TransactionService_PurgeTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransactionServiceServer is the server API for TransactionService service.
 // All implementations must embed UnimplementedTransactionServiceServer
 // for forward compatibility.
@@ -117,6 +368,28 @@ type TransactionServiceServer interface {
 	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*UpdateTransactionResponse, error)
 	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
 	CategorizeTransactions(context.Context, *CategorizeTransactionsRequest) (*CategorizeTransactionsResponse, error)
+	StreamTransactions(*StreamTransactionsRequest, grpc.ServerStreamingServer[StreamTransactionsResponse]) error
+	AggregateTransactions(context.Context, *AggregateTransactionsRequest) (*AggregateTransactionsResponse, error)
+	UpdateTransactionsByFilter(context.Context, *UpdateTransactionsByFilterRequest) (*UpdateTransactionsByFilterResponse, error)
+	// moves the matching transactions to the trash
+	DeleteTransactionsByFilter(context.Context, *DeleteTransactionsByFilterRequest) (*DeleteTransactionsByFilterResponse, error)
+	ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error)
+	ListImportProfiles(context.Context, *ListImportProfilesRequest) (*ListImportProfilesResponse, error)
+	SaveImportProfile(context.Context, *SaveImportProfileRequest) (*SaveImportProfileResponse, error)
+	DeleteImportProfile(context.Context, *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error)
+	SetTransactionSplits(context.Context, *SetTransactionSplitsRequest) (*SetTransactionSplitsResponse, error)
+	LinkTransfer(context.Context, *LinkTransferRequest) (*LinkTransferResponse, error)
+	UnlinkTransfer(context.Context, *UnlinkTransferRequest) (*UnlinkTransferResponse, error)
+	DetectTransfers(context.Context, *DetectTransfersRequest) (*DetectTransfersResponse, error)
+	LinkRefund(context.Context, *LinkRefundRequest) (*LinkRefundResponse, error)
+	UnlinkRefund(context.Context, *UnlinkRefundRequest) (*UnlinkRefundResponse, error)
+	DetectRefunds(context.Context, *DetectRefundsRequest) (*DetectRefundsResponse, error)
+	SearchTransactions(context.Context, *SearchTransactionsRequest) (*SearchTransactionsResponse, error)
+	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	ListDeletedTransactions(context.Context, *ListDeletedTransactionsRequest) (*ListDeletedTransactionsResponse, error)
+	RestoreTransactions(context.Context, *RestoreTransactionsRequest) (*RestoreTransactionsResponse, error)
+	// permanently removes transactions that are already in the trash
+	PurgeTransactions(context.Context, *PurgeTransactionsRequest) (*PurgeTransactionsResponse, error)
 	mustEmbedUnimplementedTransactionServiceServer()
 }
 
@@ -145,6 +418,66 @@ func (UnimplementedTransactionServiceServer) DeleteTransaction(context.Context,
 func (UnimplementedTransactionServiceServer) CategorizeTransactions(context.Context, *CategorizeTransactionsRequest) (*CategorizeTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CategorizeTransactions not implemented")
 }
+func (UnimplementedTransactionServiceServer) StreamTransactions(*StreamTransactionsRequest, grpc.ServerStreamingServer[StreamTransactionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) AggregateTransactions(context.Context, *AggregateTransactionsRequest) (*AggregateTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) UpdateTransactionsByFilter(context.Context, *UpdateTransactionsByFilterRequest) (*UpdateTransactionsByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTransactionsByFilter not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteTransactionsByFilter(context.Context, *DeleteTransactionsByFilterRequest) (*DeleteTransactionsByFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransactionsByFilter not implemented")
+}
+func (UnimplementedTransactionServiceServer) ImportStatement(context.Context, *ImportStatementRequest) (*ImportStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListImportProfiles(context.Context, *ListImportProfilesRequest) (*ListImportProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportProfiles not implemented")
+}
+func (UnimplementedTransactionServiceServer) SaveImportProfile(context.Context, *SaveImportProfileRequest) (*SaveImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveImportProfile not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteImportProfile(context.Context, *DeleteImportProfileRequest) (*DeleteImportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImportProfile not implemented")
+}
+func (UnimplementedTransactionServiceServer) SetTransactionSplits(context.Context, *SetTransactionSplitsRequest) (*SetTransactionSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionSplits not implemented")
+}
+func (UnimplementedTransactionServiceServer) LinkTransfer(context.Context, *LinkTransferRequest) (*LinkTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkTransfer not implemented")
+}
+func (UnimplementedTransactionServiceServer) UnlinkTransfer(context.Context, *UnlinkTransferRequest) (*UnlinkTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkTransfer not implemented")
+}
+func (UnimplementedTransactionServiceServer) DetectTransfers(context.Context, *DetectTransfersRequest) (*DetectTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectTransfers not implemented")
+}
+func (UnimplementedTransactionServiceServer) LinkRefund(context.Context, *LinkRefundRequest) (*LinkRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkRefund not implemented")
+}
+func (UnimplementedTransactionServiceServer) UnlinkRefund(context.Context, *UnlinkRefundRequest) (*UnlinkRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkRefund not implemented")
+}
+func (UnimplementedTransactionServiceServer) DetectRefunds(context.Context, *DetectRefundsRequest) (*DetectRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectRefunds not implemented")
+}
+func (UnimplementedTransactionServiceServer) SearchTransactions(context.Context, *SearchTransactionsRequest) (*SearchTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListDeletedTransactions(context.Context, *ListDeletedTransactionsRequest) (*ListDeletedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) RestoreTransactions(context.Context, *RestoreTransactionsRequest) (*RestoreTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) PurgeTransactions(context.Context, *PurgeTransactionsRequest) (*PurgeTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTransactions not implemented")
+}
 func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
 func (UnimplementedTransactionServiceServer) testEmbeddedByValue()                            {}
 
@@ -274,6 +607,359 @@ func _TransactionService_CategorizeTransactions_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransactionService_StreamTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServiceServer).StreamTransactions(m, &grpc.GenericServerStream[StreamTransactionsRequest, StreamTransactionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TransactionService_StreamTransactionsServer = grpc.ServerStreamingServer[StreamTransactionsResponse]
+
+func _TransactionService_AggregateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).AggregateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_AggregateTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).AggregateTransactions(ctx, req.(*AggregateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UpdateTransactionsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UpdateTransactionsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UpdateTransactionsByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UpdateTransactionsByFilter(ctx, req.(*UpdateTransactionsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteTransactionsByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionsByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteTransactionsByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteTransactionsByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteTransactionsByFilter(ctx, req.(*DeleteTransactionsByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ImportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ImportStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ImportStatement(ctx, req.(*ImportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListImportProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListImportProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListImportProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListImportProfiles(ctx, req.(*ListImportProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SaveImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SaveImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SaveImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SaveImportProfile(ctx, req.(*SaveImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteImportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteImportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DeleteImportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteImportProfile(ctx, req.(*DeleteImportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SetTransactionSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SetTransactionSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SetTransactionSplits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SetTransactionSplits(ctx, req.(*SetTransactionSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_LinkTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).LinkTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_LinkTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).LinkTransfer(ctx, req.(*LinkTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UnlinkTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UnlinkTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UnlinkTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UnlinkTransfer(ctx, req.(*UnlinkTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DetectTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DetectTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DetectTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DetectTransfers(ctx, req.(*DetectTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_LinkRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).LinkRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_LinkRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).LinkRefund(ctx, req.(*LinkRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_UnlinkRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).UnlinkRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_UnlinkRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).UnlinkRefund(ctx, req.(*UnlinkRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DetectRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DetectRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_DetectRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DetectRefunds(ctx, req.(*DetectRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SearchTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SearchTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_SearchTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SearchTransactions(ctx, req.(*SearchTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_GetTransactionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListDeletedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListDeletedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_ListDeletedTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListDeletedTransactions(ctx, req.(*ListDeletedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_RestoreTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).RestoreTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_RestoreTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).RestoreTransactions(ctx, req.(*RestoreTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_PurgeTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PurgeTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransactionService_PurgeTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PurgeTransactions(ctx, req.(*PurgeTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,7 +991,89 @@ var TransactionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CategorizeTransactions",
 			Handler:    _TransactionService_CategorizeTransactions_Handler,
 		},
+		{
+			MethodName: "AggregateTransactions",
+			Handler:    _TransactionService_AggregateTransactions_Handler,
+		},
+		{
+			MethodName: "UpdateTransactionsByFilter",
+			Handler:    _TransactionService_UpdateTransactionsByFilter_Handler,
+		},
+		{
+			MethodName: "DeleteTransactionsByFilter",
+			Handler:    _TransactionService_DeleteTransactionsByFilter_Handler,
+		},
+		{
+			MethodName: "ImportStatement",
+			Handler:    _TransactionService_ImportStatement_Handler,
+		},
+		{
+			MethodName: "ListImportProfiles",
+			Handler:    _TransactionService_ListImportProfiles_Handler,
+		},
+		{
+			MethodName: "SaveImportProfile",
+			Handler:    _TransactionService_SaveImportProfile_Handler,
+		},
+		{
+			MethodName: "DeleteImportProfile",
+			Handler:    _TransactionService_DeleteImportProfile_Handler,
+		},
+		{
+			MethodName: "SetTransactionSplits",
+			Handler:    _TransactionService_SetTransactionSplits_Handler,
+		},
+		{
+			MethodName: "LinkTransfer",
+			Handler:    _TransactionService_LinkTransfer_Handler,
+		},
+		{
+			MethodName: "UnlinkTransfer",
+			Handler:    _TransactionService_UnlinkTransfer_Handler,
+		},
+		{
+			MethodName: "DetectTransfers",
+			Handler:    _TransactionService_DetectTransfers_Handler,
+		},
+		{
+			MethodName: "LinkRefund",
+			Handler:    _TransactionService_LinkRefund_Handler,
+		},
+		{
+			MethodName: "UnlinkRefund",
+			Handler:    _TransactionService_UnlinkRefund_Handler,
+		},
+		{
+			MethodName: "DetectRefunds",
+			Handler:    _TransactionService_DetectRefunds_Handler,
+		},
+		{
+			MethodName: "SearchTransactions",
+			Handler:    _TransactionService_SearchTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransactionHistory",
+			Handler:    _TransactionService_GetTransactionHistory_Handler,
+		},
+		{
+			MethodName: "ListDeletedTransactions",
+			Handler:    _TransactionService_ListDeletedTransactions_Handler,
+		},
+		{
+			MethodName: "RestoreTransactions",
+			Handler:    _TransactionService_RestoreTransactions_Handler,
+		},
+		{
+			MethodName: "PurgeTransactions",
+			Handler:    _TransactionService_PurgeTransactions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTransactions",
+			Handler:       _TransactionService_StreamTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "arian/v1/transaction_services.proto",
 }
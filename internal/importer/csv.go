@@ -0,0 +1,235 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Mapping describes how to read a bank's CSV export. Column references are
+// header names (case-insensitive) or 1-based column numbers. Either Amount or
+// at least one of Debit/Credit must be set. Saved profiles store this as json.
+type Mapping struct {
+	Date         string `json:"date"`
+	DateFormat   string `json:"date_format,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Amount       string `json:"amount,omitempty"`
+	Debit        string `json:"debit,omitempty"`
+	Credit       string `json:"credit,omitempty"`
+	Merchant     string `json:"merchant,omitempty"`
+	Currency     string `json:"currency,omitempty"`
+	Notes        string `json:"notes,omitempty"`
+	Delimiter    string `json:"delimiter,omitempty"`
+	HasHeader    bool   `json:"has_header"`
+	SkipRows     int    `json:"skip_rows,omitempty"`
+	InvertSign   bool   `json:"invert_sign,omitempty"`
+	DecimalComma bool   `json:"decimal_comma,omitempty"`
+}
+
+func (m *Mapping) Validate() error {
+	if strings.TrimSpace(m.Date) == "" {
+		return errors.New("mapping: date column is required")
+	}
+	if m.Amount == "" && m.Debit == "" && m.Credit == "" {
+		return errors.New("mapping: amount or debit/credit columns are required")
+	}
+	if m.Amount != "" && (m.Debit != "" || m.Credit != "") {
+		return errors.New("mapping: use either amount or debit/credit columns, not both")
+	}
+	if utf8.RuneCountInString(m.Delimiter) > 1 {
+		return errors.New("mapping: delimiter must be a single character")
+	}
+	if m.SkipRows < 0 {
+		return errors.New("mapping: skip_rows cannot be negative")
+	}
+	return nil
+}
+
+type csvColumns struct {
+	date, description, amount, debit, credit, merchant, currency, notes int
+}
+
+func parseCSV(r io.Reader, opts Options) ([]Entry, error) {
+	m := opts.Mapping
+	if m == nil {
+		return nil, ErrMissingMapping
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+	if m.Delimiter != "" {
+		reader.Comma, _ = utf8.DecodeRuneInString(m.Delimiter)
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read csv: %w", err)
+	}
+
+	line := 0
+	if m.SkipRows > 0 {
+		if m.SkipRows >= len(records) {
+			return nil, nil
+		}
+		records = records[m.SkipRows:]
+		line = m.SkipRows
+	}
+
+	var header []string
+	if m.HasHeader && len(records) > 0 {
+		header = records[0]
+		records = records[1:]
+		line++
+	}
+
+	cols, err := resolveColumns(m, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, record := range records {
+		line++
+		if isBlankRecord(record) {
+			continue
+		}
+
+		raw, err := csvRecordToRaw(record, cols, m, opts)
+		if err != nil {
+			entries = append(entries, Entry{Line: line, Err: err})
+			continue
+		}
+		entries = append(entries, buildEntry(line, raw, opts))
+	}
+
+	return entries, nil
+}
+
+func resolveColumns(m *Mapping, header []string) (csvColumns, error) {
+	var (
+		cols csvColumns
+		err  error
+	)
+
+	resolve := func(ref string) int {
+		if err != nil || ref == "" {
+			return -1
+		}
+		idx, e := columnIndex(ref, header)
+		if e != nil {
+			err = e
+		}
+		return idx
+	}
+
+	cols.date = resolve(m.Date)
+	cols.description = resolve(m.Description)
+	cols.amount = resolve(m.Amount)
+	cols.debit = resolve(m.Debit)
+	cols.credit = resolve(m.Credit)
+	cols.merchant = resolve(m.Merchant)
+	cols.currency = resolve(m.Currency)
+	cols.notes = resolve(m.Notes)
+
+	return cols, err
+}
+
+func columnIndex(ref string, header []string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	for i, h := range header {
+		if strings.EqualFold(strings.TrimSpace(h), ref) {
+			return i, nil
+		}
+	}
+
+	n, err := strconv.Atoi(ref)
+	if err != nil || n < 1 {
+		return -1, fmt.Errorf("mapping: column %q not found", ref)
+	}
+	return n - 1, nil
+}
+
+func csvRecordToRaw(record []string, cols csvColumns, m *Mapping, opts Options) (rawTx, error) {
+	field := func(idx int) string {
+		if idx < 0 || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	var raw rawTx
+
+	currency := field(cols.currency)
+	if currency == "" {
+		currency = opts.Currency
+	}
+
+	date, err := parseDate(field(cols.date), m.DateFormat, opts.Location)
+	if err != nil {
+		return raw, err
+	}
+	raw.date = date
+
+	if cols.amount >= 0 {
+		raw.cents, err = parseAmount(field(cols.amount), currency, m.DecimalComma)
+		if err != nil {
+			return raw, err
+		}
+	} else {
+		debit, credit := field(cols.debit), field(cols.credit)
+		switch {
+		case debit != "" && credit != "":
+			return raw, errors.New("both debit and credit are set")
+		case debit != "":
+			cents, err := parseAmount(debit, currency, m.DecimalComma)
+			if err != nil {
+				return raw, err
+			}
+			raw.cents = -abs(cents)
+		case credit != "":
+			cents, err := parseAmount(credit, currency, m.DecimalComma)
+			if err != nil {
+				return raw, err
+			}
+			raw.cents = abs(cents)
+		default:
+			return raw, errors.New("missing amount")
+		}
+	}
+
+	if m.InvertSign {
+		raw.cents = -raw.cents
+	}
+
+	raw.currency = field(cols.currency)
+	raw.description = field(cols.description)
+	raw.merchant = field(cols.merchant)
+	raw.notes = field(cols.notes)
+
+	return raw, nil
+}
+
+func isBlankRecord(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,78 @@
+package importer
+
+import (
+	"strings"
+	"time"
+)
+
+// Fingerprint identifies a transaction well enough to spot a statement line
+// that is already in the database. Descriptions are deliberately left out:
+// the email parser and the bank rarely agree on them.
+type Fingerprint struct {
+	Date      string // YYYY-MM-DD in the user's timezone
+	Cents     int64
+	Currency  string
+	Direction int16
+}
+
+func NewFingerprint(date time.Time, cents int64, currency string, direction int16, loc *time.Location) Fingerprint {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Fingerprint{
+		Date:      date.In(loc).Format("2006-01-02"),
+		Cents:     cents,
+		Currency:  strings.ToUpper(currency),
+		Direction: direction,
+	}
+}
+
+// MarkDuplicates flags entries that match an existing fingerprint. Every
+// existing fingerprint absorbs at most one entry, so two identical coffees on
+// the same day still import when only one of them is already stored.
+func MarkDuplicates(entries []Entry, existing []Fingerprint, loc *time.Location) int {
+	remaining := make(map[Fingerprint]int, len(existing))
+	for _, fp := range existing {
+		remaining[fp]++
+	}
+
+	duplicates := 0
+	for i := range entries {
+		e := &entries[i]
+		if e.Err != nil {
+			continue
+		}
+
+		fp := NewFingerprint(e.Params.TxDate, e.Params.TxAmountCents, e.Params.TxCurrency, e.Params.TxDirection, loc)
+		if remaining[fp] > 0 {
+			remaining[fp]--
+			e.Duplicate = true
+			duplicates++
+		}
+	}
+
+	return duplicates
+}
+
+// DateRange returns the span covered by the valid entries, padded by a day on
+// each side so timezone differences don't hide a duplicate.
+func DateRange(entries []Entry) (start, end time.Time, ok bool) {
+	for _, e := range entries {
+		if e.Err != nil {
+			continue
+		}
+		if !ok || e.Params.TxDate.Before(start) {
+			start = e.Params.TxDate
+		}
+		if !ok || e.Params.TxDate.After(end) {
+			end = e.Params.TxDate
+		}
+		ok = true
+	}
+
+	if ok {
+		start = start.AddDate(0, 0, -1)
+		end = end.AddDate(0, 0, 2)
+	}
+	return start, end, ok
+}
@@ -0,0 +1,162 @@
+// Package importer parses bank statement exports (CSV, OFX/QFX, QIF) into
+// transaction params that can go through the regular create path.
+package importer
+
+import (
+	"ariand/internal/db/sqlc"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Format int
+
+const (
+	FormatCSV Format = iota + 1
+	FormatOFX
+	FormatQFX
+	FormatQIF
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatCSV:
+		return "csv"
+	case FormatOFX:
+		return "ofx"
+	case FormatQFX:
+		return "qfx"
+	case FormatQIF:
+		return "qif"
+	default:
+		return fmt.Sprintf("format(%d)", int(f))
+	}
+}
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported statement format")
+	ErrMissingMapping    = errors.New("csv import requires a column mapping")
+	ErrEmptyStatement    = errors.New("statement contains no transactions")
+)
+
+const (
+	directionIncoming int16 = 1
+	directionOutgoing int16 = 2
+)
+
+// Options carries everything the parsers need that isn't in the file itself.
+type Options struct {
+	UserID    uuid.UUID
+	AccountID int64
+	Currency  string         // used when the statement doesn't specify one
+	Location  *time.Location // timezone for dates without an offset
+	Mapping   *Mapping       // required for csv, qif only looks at DateFormat
+}
+
+// Entry is a single parsed statement line. Err is set when the line could not
+// be turned into a transaction; Params is meaningless in that case.
+type Entry struct {
+	Line      int
+	Params    sqlc.CreateTransactionParams
+	Err       error
+	Duplicate bool
+}
+
+// Parse reads a statement in the given format.
+func Parse(format Format, r io.Reader, opts Options) ([]Entry, error) {
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	opts.Currency = strings.ToUpper(strings.TrimSpace(opts.Currency))
+
+	var (
+		entries []Entry
+		err     error
+	)
+	switch format {
+	case FormatCSV:
+		entries, err = parseCSV(r, opts)
+	case FormatOFX, FormatQFX:
+		entries, err = parseOFX(r, opts)
+	case FormatQIF:
+		entries, err = parseQIF(r, opts)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, ErrEmptyStatement
+	}
+
+	return entries, nil
+}
+
+// ----- helpers -----------------------------------------------------------------------------
+
+type rawTx struct {
+	date        time.Time
+	cents       int64 // signed, negative is outgoing
+	currency    string
+	description string
+	merchant    string
+	notes       string
+}
+
+func buildEntry(line int, raw rawTx, opts Options) Entry {
+	entry := Entry{Line: line}
+
+	if raw.cents == 0 {
+		entry.Err = errors.New("amount is zero")
+		return entry
+	}
+
+	direction := directionIncoming
+	cents := raw.cents
+	if cents < 0 {
+		direction = directionOutgoing
+		cents = -cents
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(raw.currency))
+	if currency == "" {
+		currency = opts.Currency
+	}
+	if len(currency) != 3 {
+		entry.Err = fmt.Errorf("invalid currency %q", currency)
+		return entry
+	}
+
+	categoryManuallySet := false
+	merchantManuallySet := false
+
+	entry.Params = sqlc.CreateTransactionParams{
+		UserID:              opts.UserID,
+		AccountID:           opts.AccountID,
+		TxDate:              raw.date,
+		TxAmountCents:       cents,
+		TxCurrency:          currency,
+		TxDirection:         direction,
+		TxDesc:              optionalString(raw.description),
+		Merchant:            optionalString(raw.merchant),
+		UserNotes:           optionalString(raw.notes),
+		CategoryManuallySet: &categoryManuallySet,
+		MerchantManuallySet: &merchantManuallySet,
+	}
+
+	return entry
+}
+
+func optionalString(s string) *string {
+	s = strings.Join(strings.Fields(s), " ")
+	if s == "" {
+		return nil
+	}
+	return &s
+}
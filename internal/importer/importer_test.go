@@ -0,0 +1,236 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in           string
+		currency     string
+		decimalComma bool
+		want         int64
+	}{
+		{"12.34", "CAD", false, 1234},
+		{"-12.34", "CAD", false, -1234},
+		{"$1,234.56", "CAD", false, 123456},
+		{"(45.00)", "CAD", false, -4500},
+		{"45.00-", "CAD", false, -4500},
+		{"+7", "CAD", false, 700},
+		{"0.005", "CAD", false, 1},
+		{"1.234,56", "EUR", true, 123456},
+		{"-3,5", "EUR", true, -350},
+		{"CAD 10.10", "CAD", false, 1010},
+		{"1200", "JPY", false, 1200},
+		{"¥1,200.5", "JPY", false, 1201},
+		{"1.250", "KWD", false, 1250},
+		{"-0.0005", "KWD", false, -1},
+	}
+
+	for _, tt := range tests {
+		got, err := parseAmount(tt.in, tt.currency, tt.decimalComma)
+		if err != nil {
+			t.Errorf("parseAmount(%q) unexpected error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseAmount(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "abc", "1.2.3", "12#"} {
+		if _, err := parseAmount(in, "CAD", false); err == nil {
+			t.Errorf("parseAmount(%q) expected error", in)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	csv := `Date,Description,Debit,Credit
+2024-01-05,COFFEE SHOP,4.50,
+2024-01-06,PAYROLL,,2500.00
+not-a-date,BROKEN,1.00,
+`
+	entries, err := Parse(FormatCSV, strings.NewReader(csv), Options{
+		AccountID: 7,
+		Currency:  "cad",
+		Mapping: &Mapping{
+			Date:        "date",
+			DateFormat:  "YYYY-MM-DD",
+			Description: "Description",
+			Debit:       "Debit",
+			Credit:      "Credit",
+			HasHeader:   true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+
+	coffee := entries[0].Params
+	if coffee.TxAmountCents != 450 || coffee.TxDirection != directionOutgoing || coffee.TxCurrency != "CAD" {
+		t.Errorf("unexpected coffee params: %+v", coffee)
+	}
+	if coffee.AccountID != 7 || coffee.TxDesc == nil || *coffee.TxDesc != "COFFEE SHOP" {
+		t.Errorf("unexpected coffee params: %+v", coffee)
+	}
+
+	if payroll := entries[1].Params; payroll.TxAmountCents != 250000 || payroll.TxDirection != directionIncoming {
+		t.Errorf("unexpected payroll params: %+v", payroll)
+	}
+
+	if entries[2].Err == nil || entries[2].Line != 4 {
+		t.Errorf("expected error on line 4, got %+v", entries[2])
+	}
+}
+
+func TestParseCSV_NumericColumnsAndInvertedSign(t *testing.T) {
+	csv := "01/31/2024;Visa purchase;12,00\n"
+	entries, err := Parse(FormatCSV, strings.NewReader(csv), Options{
+		Currency: "USD",
+		Mapping: &Mapping{
+			Date:         "1",
+			DateFormat:   "MM/DD/YYYY",
+			Description:  "2",
+			Amount:       "3",
+			Delimiter:    ";",
+			InvertSign:   true,
+			DecimalComma: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	p := entries[0].Params
+	if p.TxAmountCents != 1200 || p.TxDirection != directionOutgoing {
+		t.Errorf("unexpected params: %+v", p)
+	}
+	if !p.TxDate.Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date: %v", p.TxDate)
+	}
+}
+
+func TestParseCSV_MissingMapping(t *testing.T) {
+	if _, err := Parse(FormatCSV, strings.NewReader("a,b\n"), Options{}); err != ErrMissingMapping {
+		t.Errorf("expected ErrMissingMapping, got %v", err)
+	}
+}
+
+func TestParseOFX_SGML(t *testing.T) {
+	ofx := `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>CAD
+<BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240105120000[-5:EST]
+<TRNAMT>-23.45
+<FITID>0001
+<NAME>GROCERY &amp; CO
+<MEMO>POS PURCHASE
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240106
+<TRNAMT>100.00
+<FITID>0002
+<NAME>E-TRANSFER
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240106
+<TRNAMT>100.00
+<FITID>0002
+<NAME>E-TRANSFER
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+	entries, err := Parse(FormatOFX, strings.NewReader(ofx), Options{Currency: "USD"})
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2 (repeated FITID should be dropped)", len(entries))
+	}
+
+	grocery := entries[0].Params
+	if grocery.TxAmountCents != 2345 || grocery.TxDirection != directionOutgoing || grocery.TxCurrency != "CAD" {
+		t.Errorf("unexpected grocery params: %+v", grocery)
+	}
+	if grocery.TxDesc == nil || *grocery.TxDesc != "GROCERY & CO - POS PURCHASE" {
+		t.Errorf("unexpected description: %v", grocery.TxDesc)
+	}
+	if want := time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC); !grocery.TxDate.Equal(want) {
+		t.Errorf("unexpected date: %v, want %v", grocery.TxDate, want)
+	}
+}
+
+func TestParseOFX_NotOFX(t *testing.T) {
+	if _, err := Parse(FormatQFX, strings.NewReader("date,amount\n"), Options{Currency: "CAD"}); err == nil {
+		t.Error("expected error for non-ofx content")
+	}
+}
+
+func TestParseQIF(t *testing.T) {
+	qif := `!Type:Bank
+D1/31'24
+T-1,250.00
+PLANDLORD
+MJanuary rent
+^
+D02/01/2024
+T15.00
+PREFUND
+^
+!Type:Cat
+NGroceries
+^
+`
+	entries, err := Parse(FormatQIF, strings.NewReader(qif), Options{Currency: "CAD"})
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+
+	rent := entries[0].Params
+	if rent.TxAmountCents != 125000 || rent.TxDirection != directionOutgoing {
+		t.Errorf("unexpected rent params: %+v", rent)
+	}
+	if rent.UserNotes == nil || *rent.UserNotes != "January rent" {
+		t.Errorf("unexpected notes: %v", rent.UserNotes)
+	}
+	if rent.TxDate.Year() != 2024 || rent.TxDate.Month() != time.January || rent.TxDate.Day() != 31 {
+		t.Errorf("unexpected date: %v", rent.TxDate)
+	}
+}
+
+func TestMarkDuplicates(t *testing.T) {
+	day := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	entry := func(cents int64) Entry {
+		return buildEntry(1, rawTx{date: day, cents: -cents}, Options{Currency: "CAD"})
+	}
+
+	entries := []Entry{entry(500), entry(500), entry(999)}
+	existing := []Fingerprint{NewFingerprint(day.Add(3*time.Hour), 500, "cad", directionOutgoing, time.UTC)}
+
+	if n := MarkDuplicates(entries, existing, time.UTC); n != 1 {
+		t.Fatalf("MarkDuplicates() = %d, want 1", n)
+	}
+	if !entries[0].Duplicate || entries[1].Duplicate || entries[2].Duplicate {
+		t.Errorf("unexpected duplicate flags: %v %v %v", entries[0].Duplicate, entries[1].Duplicate, entries[2].Duplicate)
+	}
+}
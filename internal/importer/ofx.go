@@ -0,0 +1,178 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ofx 1.x is sgml where leaf elements are never closed, 2.x is plain xml. both
+// are handled by treating every "<TAG>value" pair as a leaf and only looking
+// at the aggregates we care about. qfx is ofx with a few intuit extensions.
+
+var (
+	ofxTagRe    = regexp.MustCompile(`<(/?)([A-Za-z0-9.]+)>([^<]*)`)
+	ofxOffsetRe = regexp.MustCompile(`\[([+-]?\d+(?:\.\d+)?)(?::[A-Za-z]+)?\]`)
+)
+
+type ofxTxn struct {
+	line   int
+	fields map[string]string
+}
+
+func parseOFX(r io.Reader, opts Options) ([]Entry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+
+	var (
+		txns     []ofxTxn
+		current  *ofxTxn
+		currency string
+		sawOFX   bool
+		line     int
+	)
+
+	for scanner.Scan() {
+		line++
+		for _, m := range ofxTagRe.FindAllStringSubmatch(scanner.Text(), -1) {
+			closing, tag, value := m[1] == "/", strings.ToUpper(m[2]), strings.TrimSpace(m[3])
+
+			switch {
+			case tag == "OFX":
+				sawOFX = true
+			case tag == "STMTTRN" && !closing:
+				current = &ofxTxn{line: line, fields: map[string]string{}}
+			case tag == "STMTTRN" && closing:
+				if current != nil {
+					txns = append(txns, *current)
+					current = nil
+				}
+			case closing:
+			case tag == "CURDEF" && current == nil:
+				currency = value
+			case current != nil && value != "":
+				current.fields[tag] = unescapeOFX(value)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ofx: %w", err)
+	}
+	if !sawOFX {
+		return nil, fmt.Errorf("not an ofx document")
+	}
+
+	// sgml files sometimes omit the closing aggregate on the last transaction
+	if current != nil {
+		txns = append(txns, *current)
+	}
+
+	if currency == "" {
+		currency = opts.Currency
+	}
+
+	entries := make([]Entry, 0, len(txns))
+	seen := make(map[string]bool)
+	for _, t := range txns {
+		// the same FITID twice in one file is the bank repeating itself
+		if id := t.fields["FITID"]; id != "" {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+		}
+
+		raw, err := ofxTxnToRaw(t, currency, opts)
+		if err != nil {
+			entries = append(entries, Entry{Line: t.line, Err: err})
+			continue
+		}
+		entries = append(entries, buildEntry(t.line, raw, opts))
+	}
+
+	return entries, nil
+}
+
+func ofxTxnToRaw(t ofxTxn, currency string, opts Options) (rawTx, error) {
+	var raw rawTx
+
+	date, err := parseOFXDate(t.fields["DTPOSTED"], opts.Location)
+	if err != nil {
+		return raw, err
+	}
+	raw.date = date
+
+	raw.currency = currency
+	if c := t.fields["CURSYM"]; c != "" {
+		raw.currency = c
+	}
+
+	// ofx amounts always use a dot, but some banks export "1,234.56"
+	raw.cents, err = parseAmount(t.fields["TRNAMT"], raw.currency, false)
+	if err != nil {
+		return raw, err
+	}
+
+	name := t.fields["NAME"]
+	if name == "" {
+		name = t.fields["PAYEEID"]
+	}
+	memo := t.fields["MEMO"]
+
+	switch {
+	case name != "" && memo != "" && !strings.EqualFold(name, memo):
+		raw.description = name + " - " + memo
+	case name != "":
+		raw.description = name
+	default:
+		raw.description = memo
+	}
+
+	return raw, nil
+}
+
+// parseOFXDate handles YYYYMMDD[HHMMSS[.XXX]][[gmt offset[:tz name]]]
+func parseOFXDate(s string, loc *time.Location) (time.Time, error) {
+	if len(s) < 8 {
+		return time.Time{}, fmt.Errorf("invalid ofx date %q", s)
+	}
+
+	if m := ofxOffsetRe.FindStringSubmatch(s); m != nil {
+		hours, err := strconv.ParseFloat(m[1], 64)
+		if err == nil {
+			loc = time.FixedZone("", int(hours*3600))
+		}
+		s = s[:strings.Index(s, "[")]
+	}
+
+	if dot := strings.Index(s, "."); dot >= 0 {
+		s = s[:dot]
+	}
+
+	layout := "20060102150405"
+	switch len(s) {
+	case 8:
+		layout = "20060102"
+	case 12:
+		layout = "200601021504"
+	case 14:
+	default:
+		return time.Time{}, fmt.Errorf("invalid ofx date %q", s)
+	}
+
+	t, err := time.ParseInLocation(layout, s, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid ofx date %q", s)
+	}
+	return t, nil
+}
+
+var ofxEntities = strings.NewReplacer("&amp;", "&", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&apos;", "'", "&nbsp;", " ")
+
+func unescapeOFX(s string) string {
+	return ofxEntities.Replace(s)
+}
@@ -0,0 +1,147 @@
+package importer
+
+import (
+	"ariand/internal/money"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxAmount bounds parsed amounts, in minor units, well inside int64.
+const maxAmount = 1 << 53
+
+// parseAmount turns a statement amount into signed minor units of currency
+// without going through floats. It understands currency symbols, thousands
+// separators, parentheses and trailing minus signs. Anything past the
+// currency's decimals is rounded half up.
+func parseAmount(s, currency string, decimalComma bool) (int64, error) {
+	raw := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("empty amount")
+	}
+
+	negative := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negative = true
+		s = s[1 : len(s)-1]
+	}
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '-':
+			negative = !negative
+		case r == '.' && !decimalComma, r == ',' && decimalComma:
+			b.WriteRune('.')
+		case r == '.', r == ',', r == '+', r == '\'', r == ' ', r == '\u00a0':
+			// thousands separators and explicit plus signs
+		case r == '$', r == '€', r == '£', r == '¥':
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z':
+			// inline currency codes like "CAD"
+		default:
+			return 0, fmt.Errorf("invalid amount %q", raw)
+		}
+	}
+
+	digits := b.String()
+	whole, frac, _ := strings.Cut(digits, ".")
+	if strings.Contains(frac, ".") || (whole == "" && frac == "") {
+		return 0, fmt.Errorf("invalid amount %q", raw)
+	}
+
+	places := money.Digits(currency)
+	scale := int64(1)
+	for i := 0; i < places; i++ {
+		scale *= 10
+	}
+
+	var cents int64
+	for _, r := range whole {
+		cents = cents*10 + int64(r-'0')
+		if cents > maxAmount/scale {
+			return 0, fmt.Errorf("amount %q out of range", raw)
+		}
+	}
+
+	frac += strings.Repeat("0", places+1)
+	for i := 0; i < places; i++ {
+		cents = cents*10 + int64(frac[i]-'0')
+	}
+	if frac[places] >= '5' {
+		cents++
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return cents, nil
+}
+
+var defaultDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	"2006/01/02",
+	"01/02/2006",
+	"1/2/2006",
+	"01/02/06",
+	"1/2/06",
+	"02.01.2006",
+	"20060102",
+	"Jan 2, 2006",
+	"2 Jan 2006",
+	"02-Jan-2006",
+	"02-Jan-06",
+}
+
+// dateLayout converts a human pattern like "DD/MM/YYYY" to a go layout. Patterns
+// that already look like go layouts are returned untouched.
+func dateLayout(pattern string) string {
+	if pattern == "" || strings.Contains(pattern, "2006") || strings.Contains(pattern, "06") {
+		return pattern
+	}
+
+	replacer := strings.NewReplacer(
+		"YYYY", "2006",
+		"YY", "06",
+		"MMMM", "January",
+		"MMM", "Jan",
+		"MM", "01",
+		"DD", "02",
+		"hh", "15",
+		"HH", "15",
+		"mm", "04",
+		"ss", "05",
+		"M", "1",
+		"D", "2",
+	)
+	return replacer.Replace(pattern)
+}
+
+func parseDate(s, pattern string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty date")
+	}
+
+	if layout := dateLayout(pattern); layout != "" {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("date %q does not match format %q", s, pattern)
+		}
+		return t, nil
+	}
+
+	for _, layout := range defaultDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognised date %q", s)
+}
@@ -0,0 +1,123 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// qif dates come in every flavour quicken ever shipped; US month-first is by
+// far the most common so it wins when the day is ambiguous.
+var qifDateLayouts = []string{
+	"1/2/2006",
+	"1/2/06",
+	"2006-01-02",
+	"2006/1/2",
+	"2.1.2006",
+	"2.1.06",
+	"2/1/2006",
+}
+
+func parseQIF(r io.Reader, opts Options) ([]Entry, error) {
+	scanner := bufio.NewScanner(r)
+
+	dateFormat := ""
+	if opts.Mapping != nil {
+		dateFormat = opts.Mapping.DateFormat
+	}
+
+	var (
+		entries []Entry
+		raw     rawTx
+		start   int
+		dirty   bool
+		skip    bool
+		rawErr  error
+		line    int
+	)
+
+	flush := func() {
+		if dirty && !skip {
+			if rawErr != nil {
+				entries = append(entries, Entry{Line: start, Err: rawErr})
+			} else if raw.date.IsZero() {
+				entries = append(entries, Entry{Line: start, Err: errors.New("missing date")})
+			} else {
+				entries = append(entries, buildEntry(start, raw, opts))
+			}
+		}
+		raw, dirty, rawErr = rawTx{}, false, nil
+	}
+
+	for scanner.Scan() {
+		line++
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		code, value := text[0], strings.TrimSpace(text[1:])
+
+		if code == '!' {
+			flush()
+			header := strings.ToLower(value)
+			// only transaction lists carry money movements
+			skip = !strings.HasPrefix(header, "type:") ||
+				strings.HasPrefix(header, "type:cat") ||
+				strings.HasPrefix(header, "type:class") ||
+				strings.HasPrefix(header, "type:memorized")
+			continue
+		}
+
+		if code == '^' {
+			flush()
+			continue
+		}
+
+		if !dirty {
+			start = line
+			dirty = true
+		}
+		if rawErr != nil {
+			continue
+		}
+
+		switch code {
+		case 'D':
+			raw.date, rawErr = parseQIFDate(value, dateFormat, opts.Location)
+		case 'T', 'U':
+			raw.cents, rawErr = parseAmount(value, opts.Currency, false)
+		case 'P':
+			raw.description = value
+		case 'M':
+			raw.notes = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read qif: %w", err)
+	}
+	flush()
+
+	return entries, nil
+}
+
+func parseQIFDate(s, pattern string, loc *time.Location) (time.Time, error) {
+	// quicken writes years after 2000 as 1/31'24
+	s = strings.ReplaceAll(strings.TrimSpace(s), "'", "/")
+	s = strings.ReplaceAll(s, " ", "")
+
+	if pattern != "" {
+		return parseDate(s, pattern, loc)
+	}
+
+	for _, layout := range qifDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognised date %q", s)
+}
@@ -0,0 +1,172 @@
+// Package money converts between amounts stored as integer minor units (the
+// *_cents columns) and google.type.Money.
+//
+// The number of minor units per major unit comes from the currency's ISO 4217
+// exponent, so 100 JPY is stored as 100 and 1.000 KWD as 1000. Arithmetic
+// goes through exact decimals and every lossy step names its rounding mode.
+package money
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// maxDigits is the precision google.type.Money carries in its nanos.
+const maxDigits = 9
+
+// defaultDigits applies to every currency not listed in exponents.
+const defaultDigits = 2
+
+// exponents lists the ISO 4217 currencies that don't use two decimals, plus
+// the crypto assets we track. Crypto is capped at maxDigits.
+var exponents = map[string]int{
+	// no minor unit
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0,
+	"XAF": 0, "XOF": 0, "XPF": 0,
+
+	// thousandths
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+
+	// ten-thousandths
+	"CLF": 4, "UYW": 4,
+
+	// crypto
+	"BTC": 8, "ETH": 9, "USDC": 6, "USDT": 6,
+}
+
+// RoundingMode picks how a value between two minor units is resolved.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest unit, halves away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest unit, halves to the even one.
+	RoundHalfEven
+	// RoundDown truncates toward zero.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+)
+
+// Digits returns the number of decimal places of currency.
+func Digits(currency string) int {
+	if d, ok := exponents[strings.ToUpper(strings.TrimSpace(currency))]; ok {
+		return d
+	}
+	return defaultDigits
+}
+
+// ToMinor converts m into minor units of its currency. Precision past the
+// currency's minor unit is rounded half up; nil is zero.
+func ToMinor(m *moneypb.Money) int64 {
+	if m == nil {
+		return 0
+	}
+	d := Digits(m.GetCurrencyCode())
+	r := new(big.Rat).SetFrac(
+		new(big.Int).Add(
+			new(big.Int).Mul(big.NewInt(m.GetUnits()), pow10(maxDigits)),
+			big.NewInt(int64(m.GetNanos())),
+		),
+		pow10(maxDigits-d),
+	)
+	return round(r, RoundHalfUp)
+}
+
+// FromMinor converts an amount in minor units of currency into Money.
+func FromMinor(amount int64, currency string) *moneypb.Money {
+	scale := pow10(Digits(currency)).Int64()
+	return &moneypb.Money{
+		CurrencyCode: currency,
+		Units:        amount / scale,
+		Nanos:        int32((amount % scale) * pow10(maxDigits-Digits(currency)).Int64()),
+	}
+}
+
+// FromMajor converts a decimal amount in whole units, e.g. 12.5 dollars, into
+// minor units. The float is read by its shortest decimal form so 0.1 stays 0.1.
+func FromMajor(amount float64, currency string, mode RoundingMode) int64 {
+	r := decimal(amount)
+	r.Mul(r, new(big.Rat).SetInt(pow10(Digits(currency))))
+	return round(r, mode)
+}
+
+// ToMajor converts an amount in minor units into whole units, for comparisons
+// against user-entered thresholds.
+func ToMajor(amount int64, currency string) float64 {
+	f, _ := new(big.Rat).SetFrac(big.NewInt(amount), pow10(Digits(currency))).Float64()
+	return f
+}
+
+// Convert converts amount in minor units of from into minor units of to at
+// rate, the price of one unit of from in to.
+func Convert(amount int64, from, to string, rate float64, mode RoundingMode) int64 {
+	r := decimal(rate)
+	r.Mul(r, new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(amount), pow10(Digits(to))),
+		pow10(Digits(from)),
+	))
+	return round(r, mode)
+}
+
+// Format renders an amount in minor units as a plain decimal with the
+// currency's number of places, e.g. "-12.05", "1200" or "1.250".
+func Format(amount int64, currency string) string {
+	d := Digits(currency)
+	sign := ""
+	abs := new(big.Int).SetInt64(amount)
+	if abs.Sign() < 0 {
+		sign = "-"
+		abs.Neg(abs)
+	}
+	digits := abs.String()
+	if d == 0 {
+		return sign + digits
+	}
+	if len(digits) <= d {
+		digits = strings.Repeat("0", d-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-d] + "." + digits[len(digits)-d:]
+}
+
+// round resolves r to an integer using mode.
+func round(r *big.Rat, mode RoundingMode) int64 {
+	q, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return q.Int64()
+	}
+
+	away := big.NewInt(int64(r.Sign()))
+	half := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(r.Denom())
+	switch mode {
+	case RoundDown:
+	case RoundUp:
+		q.Add(q, away)
+	case RoundHalfEven:
+		if half > 0 || (half == 0 && q.Bit(0) == 1) {
+			q.Add(q, away)
+		}
+	default:
+		if half >= 0 {
+			q.Add(q, away)
+		}
+	}
+	return q.Int64()
+}
+
+// decimal reads f by its shortest decimal representation.
+func decimal(f float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
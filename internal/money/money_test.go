@@ -0,0 +1,132 @@
+package money
+
+import (
+	"testing"
+
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+func TestDigits(t *testing.T) {
+	cases := map[string]int{"CAD": 2, "usd": 2, "JPY": 0, "KRW": 0, "BHD": 3, "KWD": 3, "BTC": 8, "": 2}
+	for code, want := range cases {
+		if got := Digits(code); got != want {
+			t.Errorf("Digits(%q) = %d, want %d", code, got, want)
+		}
+	}
+}
+
+func TestMinorRoundTrip(t *testing.T) {
+	cases := []struct {
+		amount   int64
+		currency string
+		units    int64
+		nanos    int32
+	}{
+		{1205, "CAD", 12, 50_000_000},
+		{-1205, "CAD", -12, -50_000_000},
+		{7, "USD", 0, 70_000_000},
+		{1500, "JPY", 1500, 0},
+		{-42, "KRW", -42, 0},
+		{1234, "KWD", 1, 234_000_000},
+		{-5, "BHD", 0, -5_000_000},
+		{123_456_789, "BTC", 1, 234_567_890},
+		{0, "EUR", 0, 0},
+	}
+	for _, c := range cases {
+		m := FromMinor(c.amount, c.currency)
+		if m.GetUnits() != c.units || m.GetNanos() != c.nanos || m.GetCurrencyCode() != c.currency {
+			t.Errorf("FromMinor(%d, %s) = %v, want units %d nanos %d", c.amount, c.currency, m, c.units, c.nanos)
+		}
+		if got := ToMinor(m); got != c.amount {
+			t.Errorf("ToMinor(FromMinor(%d, %s)) = %d", c.amount, c.currency, got)
+		}
+	}
+
+	for _, currency := range []string{"CAD", "JPY", "KWD", "BTC"} {
+		for amount := int64(-2500); amount <= 2500; amount += 7 {
+			if got := ToMinor(FromMinor(amount, currency)); got != amount {
+				t.Fatalf("%s round trip of %d gave %d", currency, amount, got)
+			}
+			if got := FromMajor(ToMajor(amount, currency), currency, RoundHalfEven); got != amount {
+				t.Fatalf("%s major round trip of %d gave %d", currency, amount, got)
+			}
+		}
+	}
+}
+
+func TestToMinorRounds(t *testing.T) {
+	cases := []struct {
+		m    *moneypb.Money
+		want int64
+	}{
+		{&moneypb.Money{CurrencyCode: "CAD", Units: 12, Nanos: 345_000_000}, 1235},
+		{&moneypb.Money{CurrencyCode: "CAD", Units: -12, Nanos: -345_000_000}, -1235},
+		{&moneypb.Money{CurrencyCode: "CAD", Units: 12, Nanos: 344_999_999}, 1234},
+		{&moneypb.Money{CurrencyCode: "JPY", Units: 99, Nanos: 500_000_000}, 100},
+		{nil, 0},
+	}
+	for _, c := range cases {
+		if got := ToMinor(c.m); got != c.want {
+			t.Errorf("ToMinor(%v) = %d, want %d", c.m, got, c.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	cases := []struct {
+		name     string
+		amount   int64
+		from, to string
+		rate     float64
+		mode     RoundingMode
+		want     int64
+	}{
+		{"usd to cad", 1000, "USD", "CAD", 1.3725, RoundHalfUp, 1373},
+		{"truncates", 1000, "USD", "CAD", 1.3725, RoundDown, 1372},
+		{"rounds up", 1000, "USD", "CAD", 1.3721, RoundUp, 1373},
+		{"half even down", 1000, "USD", "CAD", 1.3725, RoundHalfEven, 1372},
+		{"half even up", 1000, "USD", "CAD", 1.3735, RoundHalfEven, 1374},
+		{"negative", -1000, "USD", "CAD", 1.3725, RoundHalfUp, -1373},
+		{"yen to dollars", 10000, "JPY", "USD", 0.0067, RoundHalfUp, 6700},
+		{"dollars to yen", 1999, "USD", "JPY", 149.5, RoundHalfUp, 2989},
+		{"dinar", 1000, "KWD", "USD", 3.25, RoundHalfUp, 325},
+		{"binary float", 10, "USD", "USD", 1.1, RoundDown, 11},
+	}
+	for _, c := range cases {
+		if got := Convert(c.amount, c.from, c.to, c.rate, c.mode); got != c.want {
+			t.Errorf("%s: Convert = %d, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{1205, "CAD", "12.05"},
+		{-5, "CAD", "-0.05"},
+		{0, "CAD", "0.00"},
+		{1200, "JPY", "1200"},
+		{-1250, "KWD", "-1.250"},
+		{1, "BTC", "0.00000001"},
+	}
+	for _, c := range cases {
+		if got := Format(c.amount, c.currency); got != c.want {
+			t.Errorf("Format(%d, %s) = %q, want %q", c.amount, c.currency, got, c.want)
+		}
+	}
+}
+
+func TestFromMajor(t *testing.T) {
+	if got := FromMajor(12.345, "CAD", RoundHalfUp); got != 1235 {
+		t.Errorf("FromMajor(12.345) = %d, want 1235", got)
+	}
+	if got := FromMajor(0.29, "CAD", RoundDown); got != 29 {
+		t.Errorf("FromMajor(0.29) = %d, want 29", got)
+	}
+	if got := FromMajor(-2.5, "JPY", RoundHalfEven); got != -2 {
+		t.Errorf("FromMajor(-2.5 JPY) = %d, want -2", got)
+	}
+}
@@ -0,0 +1,119 @@
+package receipts
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/gen/arian/v1/arianv1connect"
+	"ariand/internal/money"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+// ErrUnreadable is returned when the parser could not make sense of the upload.
+var ErrUnreadable = errors.New("receipt could not be parsed")
+
+// Receipt is what the parser extracted from an image or pdf. Amounts are in
+// minor units of Currency, per ISO 4217.
+type Receipt struct {
+	Merchant   string
+	TotalCents int64
+	Currency   string     // empty when the receipt doesn't say
+	Date       *time.Time // nil when the receipt doesn't say
+	Items      []Item
+}
+
+type Item struct {
+	Name           string
+	Quantity       float64
+	UnitPriceCents *int64
+	TotalCents     int64
+}
+
+// Parser turns an uploaded receipt into structured data.
+type Parser interface {
+	Parse(ctx context.Context, content []byte, contentType string) (*Receipt, error)
+}
+
+// Client talks to the arian-receipts ReceiptParserService over gRPC.
+type Client struct {
+	rpc arianv1connect.ReceiptParserServiceClient
+}
+
+func NewClient(baseURL string, timeout time.Duration) *Client {
+	// gRPC needs HTTP/2, also on plain http:// urls inside the cluster
+	protocols := new(http.Protocols)
+	protocols.SetHTTP2(true)
+	protocols.SetUnencryptedHTTP2(true)
+
+	httpClient := &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{Protocols: protocols},
+	}
+	return &Client{
+		rpc: arianv1connect.NewReceiptParserServiceClient(httpClient, baseURL, connect.WithGRPC()),
+	}
+}
+
+// Parse uploads the raw file and converts the parser's answer
+func (c *Client) Parse(ctx context.Context, content []byte, contentType string) (*Receipt, error) {
+	resp, err := c.rpc.ParseReceipt(ctx, connect.NewRequest(&pb.ParseReceiptRequest{
+		Content:     content,
+		ContentType: contentType,
+	}))
+	if err != nil {
+		if connect.CodeOf(err) == connect.CodeInvalidArgument {
+			return nil, ErrUnreadable
+		}
+		return nil, fmt.Errorf("receipt parser failed: %w", err)
+	}
+
+	return toReceipt(resp.Msg)
+}
+
+func toReceipt(p *pb.ParseReceiptResponse) (*Receipt, error) {
+	currency := strings.ToUpper(strings.TrimSpace(p.GetTotal().GetCurrencyCode()))
+	receipt := &Receipt{
+		Merchant:   strings.TrimSpace(p.GetMerchant()),
+		TotalCents: minorUnits(p.GetTotal(), currency),
+		Currency:   currency,
+		Items:      make([]Item, 0, len(p.GetItems())),
+	}
+	if receipt.TotalCents <= 0 {
+		return nil, ErrUnreadable
+	}
+
+	if d := p.GetReceiptDate(); d != nil {
+		date := time.Date(int(d.GetYear()), time.Month(d.GetMonth()), int(d.GetDay()), 0, 0, 0, 0, time.UTC)
+		if d.GetYear() == 0 || d.GetMonth() == 0 || d.GetDay() == 0 || date.Day() != int(d.GetDay()) {
+			return nil, fmt.Errorf("parser returned invalid date %d-%d-%d", d.GetYear(), d.GetMonth(), d.GetDay())
+		}
+		receipt.Date = &date
+	}
+
+	for _, item := range p.GetItems() {
+		parsed := Item{
+			Name:       strings.TrimSpace(item.GetName()),
+			Quantity:   item.GetQuantity(),
+			TotalCents: minorUnits(item.GetTotal(), currency),
+		}
+		if item.UnitPrice != nil {
+			cents := minorUnits(item.GetUnitPrice(), currency)
+			parsed.UnitPriceCents = &cents
+		}
+		receipt.Items = append(receipt.Items, parsed)
+	}
+
+	return receipt, nil
+}
+
+// minorUnits converts m in the receipt's currency; the parser only has to
+// set the code on the total
+func minorUnits(m *moneypb.Money, currency string) int64 {
+	return money.ToMinor(&moneypb.Money{CurrencyCode: currency, Units: m.GetUnits(), Nanos: m.GetNanos()})
+}
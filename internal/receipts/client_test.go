@@ -0,0 +1,99 @@
+package receipts
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/gen/arian/v1/arianv1connect"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/type/date"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+)
+
+type parserFunc func(context.Context, *connect.Request[pb.ParseReceiptRequest]) (*connect.Response[pb.ParseReceiptResponse], error)
+
+func (f parserFunc) ParseReceipt(ctx context.Context, req *connect.Request[pb.ParseReceiptRequest]) (*connect.Response[pb.ParseReceiptResponse], error) {
+	return f(ctx, req)
+}
+
+// parserServer serves fn as ReceiptParserService over h2c, like arian-receipts
+func parserServer(t *testing.T, fn parserFunc) string {
+	t.Helper()
+	path, handler := arianv1connect.NewReceiptParserServiceHandler(fn)
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+
+	srv := httptest.NewUnstartedServer(mux)
+	srv.Config.Protocols = new(http.Protocols)
+	srv.Config.Protocols.SetHTTP1(true)
+	srv.Config.Protocols.SetUnencryptedHTTP2(true)
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func TestClientParse(t *testing.T) {
+	url := parserServer(t, func(ctx context.Context, req *connect.Request[pb.ParseReceiptRequest]) (*connect.Response[pb.ParseReceiptResponse], error) {
+		if req.Msg.GetContentType() != "image/png" || string(req.Msg.GetContent()) != "png-bytes" {
+			t.Errorf("unexpected request %q %q", req.Msg.GetContentType(), req.Msg.GetContent())
+		}
+		merchant := " Corner Store "
+		return connect.NewResponse(&pb.ParseReceiptResponse{
+			Merchant:    &merchant,
+			Total:       &moneypb.Money{CurrencyCode: "cad", Units: 12, Nanos: 350000000},
+			ReceiptDate: &date.Date{Year: 2024, Month: 5, Day: 2},
+			Items: []*pb.ParsedReceiptItem{
+				{Name: "Milk", Quantity: 2, UnitPrice: &moneypb.Money{Units: 3, Nanos: 100000000}, Total: &moneypb.Money{Units: 6, Nanos: 200000000}},
+				{Name: "Bread", Quantity: 1, Total: &moneypb.Money{Units: 6, Nanos: 150000000}},
+			},
+		}), nil
+	})
+
+	receipt, err := NewClient(url+"/", time.Second).Parse(context.Background(), []byte("png-bytes"), "image/png")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	if receipt.Merchant != "Corner Store" || receipt.TotalCents != 1235 || receipt.Currency != "CAD" {
+		t.Errorf("unexpected receipt: %+v", receipt)
+	}
+	if receipt.Date == nil || !receipt.Date.Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected date: %v", receipt.Date)
+	}
+	if len(receipt.Items) != 2 || *receipt.Items[0].UnitPriceCents != 310 || receipt.Items[1].UnitPriceCents != nil {
+		t.Errorf("unexpected items: %+v", receipt.Items)
+	}
+}
+
+func TestClientParse_MinorUnits(t *testing.T) {
+	url := parserServer(t, func(ctx context.Context, req *connect.Request[pb.ParseReceiptRequest]) (*connect.Response[pb.ParseReceiptResponse], error) {
+		return connect.NewResponse(&pb.ParseReceiptResponse{
+			Total: &moneypb.Money{CurrencyCode: "JPY", Units: 1200},
+			Items: []*pb.ParsedReceiptItem{{Name: "Ramen", Quantity: 1, Total: &moneypb.Money{Units: 1200}}},
+		}), nil
+	})
+
+	receipt, err := NewClient(url, time.Second).Parse(context.Background(), []byte("x"), "image/png")
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if receipt.TotalCents != 1200 || receipt.Items[0].TotalCents != 1200 {
+		t.Errorf("got total %d item %d, want 1200 1200", receipt.TotalCents, receipt.Items[0].TotalCents)
+	}
+}
+
+func TestClientParse_Unreadable(t *testing.T) {
+	url := parserServer(t, func(ctx context.Context, req *connect.Request[pb.ParseReceiptRequest]) (*connect.Response[pb.ParseReceiptResponse], error) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("not a receipt"))
+	})
+
+	_, err := NewClient(url, time.Second).Parse(context.Background(), []byte("x"), "application/pdf")
+	if err != ErrUnreadable {
+		t.Errorf("expected ErrUnreadable, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package receipts
+
+import (
+	"context"
+	"sync"
+)
+
+// Fake is an in-memory Parser for local development and tests. It answers
+// every upload with the configured receipt, or Err when that is set.
+type Fake struct {
+	mu      sync.Mutex
+	Receipt Receipt
+	Err     error
+	Calls   int
+}
+
+func NewFake(receipt Receipt) *Fake {
+	return &Fake{Receipt: receipt}
+}
+
+func (f *Fake) Parse(ctx context.Context, content []byte, contentType string) (*Receipt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Calls++
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	if len(content) == 0 {
+		return nil, ErrUnreadable
+	}
+
+	receipt := f.Receipt
+	receipt.Items = append([]Item(nil), f.Receipt.Items...)
+	return &receipt, nil
+}
@@ -0,0 +1,278 @@
+// Package recurring finds subscriptions and bills in a user's history.
+//
+// The database side normalizes merchants into match keys and links keys that
+// are trigram-similar. This package does the rest: it merges similar keys
+// into groups and decides, per group, whether the charges follow a cadence.
+package recurring
+
+import (
+	"sort"
+	"time"
+)
+
+// Cadence is how often a series repeats. Values match the proto enum and the
+// recurring_series.cadence column.
+type Cadence int16
+
+const (
+	Weekly    Cadence = 1
+	Biweekly  Cadence = 2
+	Monthly   Cadence = 3
+	Quarterly Cadence = 4
+	Annual    Cadence = 5
+)
+
+// cadenceWindows are the accepted gaps in days between two charges.
+var cadenceWindows = []struct {
+	cadence  Cadence
+	min, max int
+}{
+	{Weekly, 6, 8},
+	{Biweekly, 13, 16},
+	{Monthly, 27, 33},
+	{Quarterly, 85, 95},
+	{Annual, 355, 375},
+}
+
+// Days is the nominal length of one period.
+func (c Cadence) Days() int {
+	switch c {
+	case Weekly:
+		return 7
+	case Biweekly:
+		return 14
+	case Monthly:
+		return 30
+	case Quarterly:
+		return 91
+	case Annual:
+		return 365
+	}
+	return 0
+}
+
+// Next returns the date one period after t. Month based cadences keep the
+// day of month, clamped to the length of the target month.
+func (c Cadence) Next(t time.Time) time.Time {
+	switch c {
+	case Weekly:
+		return t.AddDate(0, 0, 7)
+	case Biweekly:
+		return t.AddDate(0, 0, 14)
+	case Monthly:
+		return addMonths(t, 1)
+	case Quarterly:
+		return addMonths(t, 3)
+	case Annual:
+		return addMonths(t, 12)
+	}
+	return t
+}
+
+func (c Cadence) window() (int, int, bool) {
+	for _, w := range cadenceWindows {
+		if w.cadence == c {
+			return w.min, w.max, true
+		}
+	}
+	return 0, 0, false
+}
+
+func classify(days int) (Cadence, bool) {
+	for _, w := range cadenceWindows {
+		if days >= w.min && days <= w.max {
+			return w.cadence, true
+		}
+	}
+	return 0, false
+}
+
+// Occurrence is one charge belonging to a candidate group.
+type Occurrence struct {
+	TransactionID int64
+	AccountID     int64
+	Date          time.Time
+	AmountCents   int64
+}
+
+// Series is a detected recurring payment.
+type Series struct {
+	Cadence             Cadence
+	ExpectedAmountCents int64
+	LastAmountCents     int64
+	LastDate            time.Time
+	NextDue             time.Time
+	Occurrences         []Occurrence // oldest first
+}
+
+const (
+	// share of gaps and amounts that have to agree with the series
+	minAgreement = 0.75
+	// amounts further than this from the median don't count as the same bill
+	amountTolerance = 0.25
+)
+
+// Detect decides whether occs (one merchant, currency and direction) recur.
+// It needs three charges, or two for annual series, and drops series that
+// have missed more than one expected charge as of asOf.
+func Detect(occs []Occurrence, asOf time.Time) (*Series, bool) {
+	if len(occs) < 2 {
+		return nil, false
+	}
+
+	sorted := make([]Occurrence, len(occs))
+	copy(sorted, occs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	gaps := make([]int, 0, len(sorted)-1)
+	for i := 1; i < len(sorted); i++ {
+		gaps = append(gaps, daysBetween(sorted[i-1].Date, sorted[i].Date))
+	}
+
+	cadence, ok := classify(median(gaps))
+	if !ok {
+		return nil, false
+	}
+	if len(sorted) < 3 && cadence != Annual {
+		return nil, false
+	}
+
+	lo, hi, _ := cadence.window()
+	inWindow := 0
+	for _, g := range gaps {
+		if g >= lo && g <= hi {
+			inWindow++
+		}
+	}
+	if !agrees(inWindow, len(gaps)) {
+		return nil, false
+	}
+
+	amounts := make([]int64, len(sorted))
+	for i, o := range sorted {
+		amounts[i] = o.AmountCents
+	}
+	typical := median(amounts)
+	similar := 0
+	for _, a := range amounts {
+		if withinTolerance(a, typical) {
+			similar++
+		}
+	}
+	if !agrees(similar, len(amounts)) {
+		return nil, false
+	}
+
+	last := sorted[len(sorted)-1]
+	if daysBetween(last.Date, asOf) > 2*cadence.Days() {
+		return nil, false
+	}
+
+	recent := amounts
+	if len(recent) > 3 {
+		recent = recent[len(recent)-3:]
+	}
+
+	return &Series{
+		Cadence:             cadence,
+		ExpectedAmountCents: median(recent),
+		LastAmountCents:     last.AmountCents,
+		LastDate:            last.Date,
+		NextDue:             cadence.Next(last.Date),
+		Occurrences:         sorted,
+	}, true
+}
+
+// Group merges keys linked by similar pairs (transitively) and returns each
+// group sorted, largest groups first.
+func Group(keys []string, similar [][2]string) [][]string {
+	parent := make(map[string]string, len(keys))
+	for _, k := range keys {
+		parent[k] = k
+	}
+
+	var find func(string) string
+	find = func(k string) string {
+		if parent[k] != k {
+			parent[k] = find(parent[k])
+		}
+		return parent[k]
+	}
+
+	for _, p := range similar {
+		if _, ok := parent[p[0]]; !ok {
+			continue
+		}
+		if _, ok := parent[p[1]]; !ok {
+			continue
+		}
+		a, b := find(p[0]), find(p[1])
+		if a == b {
+			continue
+		}
+		if b < a {
+			a, b = b, a
+		}
+		parent[b] = a
+	}
+
+	byRoot := make(map[string][]string)
+	for _, k := range keys {
+		r := find(k)
+		byRoot[r] = append(byRoot[r], k)
+	}
+
+	groups := make([][]string, 0, len(byRoot))
+	for _, g := range byRoot {
+		sort.Strings(g)
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i]) != len(groups[j]) {
+			return len(groups[i]) > len(groups[j])
+		}
+		return groups[i][0] < groups[j][0]
+	})
+	return groups
+}
+
+// ----- helpers ---------------------------------------------------------------
+
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); d > last {
+		d = last
+	}
+	return first.AddDate(0, 0, d-1)
+}
+
+func daysBetween(a, b time.Time) int {
+	return int(b.Sub(a).Round(24*time.Hour) / (24 * time.Hour))
+}
+
+func median[T int | int64](v []T) T {
+	if len(v) == 0 {
+		return 0
+	}
+	s := make([]T, len(v))
+	copy(s, v)
+	sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+	mid := len(s) / 2
+	if len(s)%2 == 1 {
+		return s[mid]
+	}
+	return (s[mid-1] + s[mid]) / 2
+}
+
+func agrees(n, total int) bool {
+	return total > 0 && float64(n) >= minAgreement*float64(total)
+}
+
+func withinTolerance(a, typical int64) bool {
+	diff := a - typical
+	if diff < 0 {
+		diff = -diff
+	}
+	return float64(diff) <= amountTolerance*float64(typical)
+}
@@ -0,0 +1,101 @@
+package recurring
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func day(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+}
+
+func occurrences(amount int64, dates ...time.Time) []Occurrence {
+	out := make([]Occurrence, len(dates))
+	for i, d := range dates {
+		out[i] = Occurrence{TransactionID: int64(i + 1), AccountID: 1, Date: d, AmountCents: amount}
+	}
+	return out
+}
+
+func TestDetect_Monthly(t *testing.T) {
+	occs := occurrences(1599, day(2026, 1, 31), day(2026, 3, 2), day(2026, 3, 31), day(2026, 4, 30))
+
+	s, ok := Detect(occs, day(2026, 5, 10))
+	if !ok {
+		t.Fatal("expected a series")
+	}
+	if s.Cadence != Monthly {
+		t.Errorf("cadence = %d, want monthly", s.Cadence)
+	}
+	if s.ExpectedAmountCents != 1599 {
+		t.Errorf("expected amount = %d", s.ExpectedAmountCents)
+	}
+	if want := day(2026, 5, 30); !s.NextDue.Equal(want) {
+		t.Errorf("next due = %v, want %v", s.NextDue, want)
+	}
+}
+
+func TestDetect_WeeklyAndAnnual(t *testing.T) {
+	weekly := occurrences(500, day(2026, 3, 2), day(2026, 3, 9), day(2026, 3, 16), day(2026, 3, 23))
+	if s, ok := Detect(weekly, day(2026, 3, 25)); !ok || s.Cadence != Weekly {
+		t.Errorf("weekly: got %+v, %v", s, ok)
+	}
+
+	annual := occurrences(9900, day(2025, 2, 14), day(2026, 2, 13))
+	s, ok := Detect(annual, day(2026, 6, 1))
+	if !ok || s.Cadence != Annual {
+		t.Fatalf("annual: got %+v, %v", s, ok)
+	}
+	if want := day(2027, 2, 13); !s.NextDue.Equal(want) {
+		t.Errorf("next due = %v, want %v", s.NextDue, want)
+	}
+}
+
+func TestDetect_Rejects(t *testing.T) {
+	cases := map[string][]Occurrence{
+		"too few":   occurrences(1000, day(2026, 1, 1), day(2026, 2, 1)),
+		"irregular": occurrences(1000, day(2026, 1, 1), day(2026, 1, 20), day(2026, 3, 1), day(2026, 3, 4)),
+		"stale":     occurrences(1000, day(2025, 1, 1), day(2025, 2, 1), day(2025, 3, 1)),
+		"amounts": {
+			{Date: day(2026, 1, 1), AmountCents: 1000},
+			{Date: day(2026, 2, 1), AmountCents: 5000},
+			{Date: day(2026, 3, 1), AmountCents: 200},
+			{Date: day(2026, 4, 1), AmountCents: 9000},
+		},
+	}
+	for name, occs := range cases {
+		if s, ok := Detect(occs, day(2026, 4, 15)); ok {
+			t.Errorf("%s: unexpected series %+v", name, s)
+		}
+	}
+}
+
+func TestDetect_PriceChangeUsesRecentAmounts(t *testing.T) {
+	occs := occurrences(1000, day(2026, 1, 5), day(2026, 2, 5), day(2026, 3, 5), day(2026, 4, 5), day(2026, 5, 5))
+	occs[3].AmountCents = 1150
+	occs[4].AmountCents = 1150
+
+	s, ok := Detect(occs, day(2026, 5, 6))
+	if !ok {
+		t.Fatal("expected a series")
+	}
+	if s.ExpectedAmountCents != 1150 || s.LastAmountCents != 1150 {
+		t.Errorf("expected %d, last %d", s.ExpectedAmountCents, s.LastAmountCents)
+	}
+}
+
+func TestGroup(t *testing.T) {
+	keys := []string{"netflix", "netflix com", "spotify", "spotify ab", "spotify usa", "gym"}
+	pairs := [][2]string{{"netflix", "netflix com"}, {"spotify", "spotify ab"}, {"spotify ab", "spotify usa"}, {"unknown", "gym"}}
+
+	got := Group(keys, pairs)
+	want := [][]string{
+		{"spotify", "spotify ab", "spotify usa"},
+		{"netflix", "netflix com"},
+		{"gym"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("groups = %v, want %v", got, want)
+	}
+}
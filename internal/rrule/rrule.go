@@ -0,0 +1,381 @@
+// Package rrule implements the date-only subset of RFC 5545 recurrence rules
+// used for planned transactions, e.g.
+//
+//	FREQ=MONTHLY;BYMONTHDAY=1
+//	FREQ=WEEKLY;INTERVAL=2;BYDAY=FR
+//	FREQ=MONTHLY;BYDAY=-1FR;COUNT=12
+//
+// Supported parts are FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT,
+// UNTIL, BYDAY, BYMONTHDAY and BYMONTH. Weeks start on Monday. Occurrences
+// are whole days: times of day are ignored.
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+// maxPeriods bounds iteration for rules whose filters never match.
+const maxPeriods = 100_000
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ByDay is a BYDAY entry. N is the ordinal within the month (1 = first,
+// -1 = last), 0 means every such weekday.
+type ByDay struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed recurrence rule.
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int        // 0 = unlimited
+	Until      *time.Time // inclusive, date only
+	ByDay      []ByDay
+	ByMonthDay []int
+	ByMonth    []time.Month
+}
+
+// Parse reads a rule such as "FREQ=MONTHLY;BYMONTHDAY=1". A leading "RRULE:"
+// is accepted.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("empty rule")
+	}
+
+	r := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("malformed part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%s given twice", name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			r.Freq, err = parseFreq(value)
+		case "INTERVAL":
+			r.Interval, err = parseInt(value, 1, 1000)
+		case "COUNT":
+			r.Count, err = parseInt(value, 1, 10000)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseUntil(value)
+			r.Until = &until
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseIntList(value, -31, 31)
+		case "BYMONTH":
+			var months []int
+			months, err = parseIntList(value, 1, 12)
+			for _, m := range months {
+				r.ByMonth = append(r.ByMonth, time.Month(m))
+			}
+		case "WKST":
+			if value != "MO" {
+				err = fmt.Errorf("only WKST=MO is supported")
+			}
+		default:
+			err = fmt.Errorf("unsupported part")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	if r.Freq == 0 {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return nil, fmt.Errorf("COUNT and UNTIL are mutually exclusive")
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != Monthly && !(r.Freq == Yearly && len(r.ByMonth) > 0) {
+			return nil, fmt.Errorf("BYDAY ordinals need FREQ=MONTHLY or FREQ=YEARLY with BYMONTH")
+		}
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+	return r, nil
+}
+
+// Between returns the occurrences of the rule starting at dtstart that fall
+// within [from, to], all as midnight in dtstart's location. COUNT is counted
+// from dtstart, not from from.
+func (r *Rule) Between(dtstart, from, to time.Time) []time.Time {
+	loc := dtstart.Location()
+	start := truncate(dtstart)
+	from, to = truncate(from.In(loc)), truncate(to.In(loc))
+	if r.Until != nil {
+		until := time.Date(r.Until.Year(), r.Until.Month(), r.Until.Day(), 0, 0, 0, 0, loc)
+		if until.Before(to) {
+			to = until
+		}
+	}
+
+	var out []time.Time
+	emitted := 0
+	for k := 0; k < maxPeriods; k++ {
+		periodStart := r.periodStart(start, k)
+		if periodStart.After(to) {
+			break
+		}
+
+		for _, d := range r.expand(start, periodStart) {
+			if d.Before(start) {
+				continue
+			}
+			if d.After(to) {
+				return out
+			}
+			emitted++
+			if !d.Before(from) {
+				out = append(out, d)
+			}
+			if r.Count > 0 && emitted >= r.Count {
+				return out
+			}
+		}
+	}
+	return out
+}
+
+// periodStart returns the first day of the k-th period. Weekly periods start
+// on Monday, monthly and yearly ones on the first of the month or year.
+func (r *Rule) periodStart(start time.Time, k int) time.Time {
+	n := k * r.Interval
+	switch r.Freq {
+	case Daily:
+		return start.AddDate(0, 0, n)
+	case Weekly:
+		monday := start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		return monday.AddDate(0, 0, 7*n)
+	case Monthly:
+		return time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, start.Location())
+	default:
+		return time.Date(start.Year()+n, 1, 1, 0, 0, 0, 0, start.Location())
+	}
+}
+
+// expand lists the candidate days of one period in order.
+func (r *Rule) expand(start, period time.Time) []time.Time {
+	var days []time.Time
+	switch r.Freq {
+	case Daily:
+		if r.matchesDay(period) {
+			days = append(days, period)
+		}
+	case Weekly:
+		for i := 0; i < 7; i++ {
+			d := period.AddDate(0, 0, i)
+			if len(r.ByDay) == 0 && d.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchesWeekday(d) && r.matchesMonth(d) {
+				days = append(days, d)
+			}
+		}
+	case Monthly:
+		if r.matchesMonth(period) {
+			days = r.expandMonth(start, period.Year(), period.Month())
+		}
+	case Yearly:
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{start.Month()}
+		}
+		for _, m := range months {
+			days = append(days, r.expandMonth(start, period.Year(), m)...)
+		}
+		sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	}
+	return days
+}
+
+// expandMonth applies BYMONTHDAY and BYDAY within one month. Without either
+// the rule repeats on dtstart's day, skipping months that are too short.
+func (r *Rule) expandMonth(start time.Time, year int, month time.Month) []time.Time {
+	loc := start.Location()
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	length := first.AddDate(0, 1, -1).Day()
+
+	var days []time.Time
+	for day := 1; day <= length; day++ {
+		d := first.AddDate(0, 0, day-1)
+		switch {
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0:
+			if day != start.Day() {
+				continue
+			}
+		case len(r.ByMonthDay) > 0 && !containsMonthDay(r.ByMonthDay, day, length):
+			continue
+		case len(r.ByDay) > 0 && !matchesByDayInMonth(r.ByDay, day, d.Weekday(), length):
+			continue
+		}
+		days = append(days, d)
+	}
+	return days
+}
+
+func (r *Rule) matchesDay(d time.Time) bool {
+	length := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+	if len(r.ByMonthDay) > 0 && !containsMonthDay(r.ByMonthDay, d.Day(), length) {
+		return false
+	}
+	return r.matchesWeekday(d) && r.matchesMonth(d)
+}
+
+func (r *Rule) matchesWeekday(d time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, b := range r.ByDay {
+		if b.Weekday == d.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesMonth(d time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if m == d.Month() {
+			return true
+		}
+	}
+	return false
+}
+
+// ----- helpers ---------------------------------------------------------------
+
+func containsMonthDay(list []int, day, length int) bool {
+	for _, v := range list {
+		if v == day || (v < 0 && length+v+1 == day) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesByDayInMonth(list []ByDay, day int, wd time.Weekday, length int) bool {
+	nth := (day-1)/7 + 1
+	nthFromEnd := -((length-day)/7 + 1)
+	for _, b := range list {
+		if b.Weekday != wd {
+			continue
+		}
+		if b.N == 0 || b.N == nth || b.N == nthFromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+func truncate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func parseFreq(v string) (Frequency, error) {
+	switch v {
+	case "DAILY":
+		return Daily, nil
+	case "WEEKLY":
+		return Weekly, nil
+	case "MONTHLY":
+		return Monthly, nil
+	case "YEARLY":
+		return Yearly, nil
+	}
+	return 0, fmt.Errorf("unsupported frequency %q", v)
+}
+
+func parseInt(v string, min, max int) (int, error) {
+	n, err := strconv.Atoi(v)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%q must be a number between %d and %d", v, min, max)
+	}
+	return n, nil
+}
+
+func parseIntList(v string, min, max int) ([]int, error) {
+	var out []int
+	for _, item := range strings.Split(v, ",") {
+		n, err := parseInt(item, min, max)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, fmt.Errorf("0 is not a valid value")
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func parseUntil(v string) (time.Time, error) {
+	if len(v) >= 8 {
+		if t, err := time.Parse("20060102", v[:8]); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q must look like 20260131", v)
+}
+
+func parseByDay(v string) ([]ByDay, error) {
+	var out []ByDay
+	for _, item := range strings.Split(v, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		wd, ok := weekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+		b := ByDay{Weekday: wd}
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid ordinal in %q", item)
+			}
+			b.N = n
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
@@ -0,0 +1,122 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func formatDates(ds []time.Time) []string {
+	out := make([]string, len(ds))
+	for i, d := range ds {
+		out[i] = d.Format("2006-01-02")
+	}
+	return out
+}
+
+func TestBetween(t *testing.T) {
+	cases := []struct {
+		rule    string
+		dtstart time.Time
+		from    time.Time
+		to      time.Time
+		want    []string
+	}{
+		{
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=1",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 2, 15),
+			to:      date(2026, 5, 1),
+			want:    []string{"2026-03-01", "2026-04-01", "2026-05-01"},
+		},
+		{
+			rule:    "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=FR",
+			dtstart: date(2026, 1, 2),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 2, 10),
+			want:    []string{"2026-01-02", "2026-01-16", "2026-01-30"},
+		},
+		{
+			rule:    "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			want:    []string{"2026-01-30", "2026-02-27", "2026-03-27"},
+		},
+		{
+			rule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+			dtstart: date(2026, 1, 31),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 4, 30),
+			want:    []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"},
+		},
+		{
+			// months without a 31st are skipped, as in RFC 5545
+			rule:    "FREQ=MONTHLY",
+			dtstart: date(2026, 1, 31),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 5, 31),
+			want:    []string{"2026-01-31", "2026-03-31", "2026-05-31"},
+		},
+		{
+			rule:    "FREQ=WEEKLY;BYDAY=MO,TH;UNTIL=20260112",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2026, 12, 31),
+			want:    []string{"2026-01-01", "2026-01-05", "2026-01-08", "2026-01-12"},
+		},
+		{
+			rule:    "FREQ=YEARLY;BYMONTH=3,9;BYMONTHDAY=15",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 1),
+			to:      date(2027, 4, 1),
+			want:    []string{"2026-03-15", "2026-09-15", "2027-03-15"},
+		},
+		{
+			// COUNT includes occurrences before from
+			rule:    "FREQ=DAILY;COUNT=5",
+			dtstart: date(2026, 1, 1),
+			from:    date(2026, 1, 4),
+			to:      date(2026, 1, 31),
+			want:    []string{"2026-01-04", "2026-01-05"},
+		},
+	}
+
+	for _, tc := range cases {
+		r, err := Parse(tc.rule)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.rule, err)
+		}
+		got := formatDates(r.Between(tc.dtstart, tc.from, tc.to))
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.rule, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%s: got %v, want %v", tc.rule, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;COUNT=2;UNTIL=20260101",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=MONTHLY;FREQ=WEEKLY",
+	} {
+		if _, err := Parse(rule); err == nil {
+			t.Errorf("%q: expected an error", rule)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package rules
+
+import (
+	"ariand/internal/db/sqlc"
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+// maxConflictSamples caps the transaction ids kept per conflicting pair
+const maxConflictSamples = 5
+
+// RuleReport is how a single rule fared when replayed over history.
+type RuleReport struct {
+	RuleID       uuid.UUID
+	Matches      int
+	CategoryWins int
+	MerchantWins int
+
+	// Unreachable is set when a higher priority rule matches everything this
+	// rule matches and takes all of its actions first. ShadowedBy names it.
+	Unreachable bool
+	ShadowedBy  uuid.UUID
+}
+
+// NeverWins reports a rule that matched transactions but always lost them to
+// a higher priority rule.
+func (r *RuleReport) NeverWins() bool {
+	return r.Matches > 0 && r.CategoryWins == 0 && r.MerchantWins == 0
+}
+
+// CategoryConflict is a pair of rules that both matched the same transactions
+// but assign different categories. First comes first in priority order.
+type CategoryConflict struct {
+	First, Second                 uuid.UUID
+	FirstCategory, SecondCategory int64
+	Transactions                  int
+	SampleIDs                     []int64
+}
+
+type Analysis struct {
+	Transactions int
+	Rules        []RuleReport
+	Conflicts    []CategoryConflict
+}
+
+// Analyze replays txs through ruleset, which must be in priority order, the
+// same way rules are applied: the first matching rule with a category sets
+// the category and the first matching rule with a merchant sets the merchant.
+// Rules that don't parse never match.
+func Analyze(ruleset []sqlc.TransactionRule, txs []sqlc.Transaction, accounts map[int64]*sqlc.GetAccountRow, env *Env) *Analysis {
+	analysis := &Analysis{
+		Transactions: len(txs),
+		Rules:        make([]RuleReport, len(ruleset)),
+	}
+
+	parsed := make([]*RuleConditions, len(ruleset))
+	for i := range ruleset {
+		analysis.Rules[i].RuleID = ruleset[i].RuleID
+		parsed[i], _ = ParseRuleConditions(ruleset[i].Conditions)
+	}
+	markUnreachable(ruleset, parsed, analysis.Rules)
+
+	type pair struct{ first, second int }
+	conflicts := make(map[pair]*CategoryConflict)
+	var order []pair
+
+	matched := make([]int, 0, len(ruleset))
+	for t := range txs {
+		tx := &txs[t]
+		matched = matched[:0]
+		for i, conditions := range parsed {
+			if conditions == nil {
+				continue
+			}
+			if ok, err := EvaluateRule(conditions, tx, accounts[tx.AccountID], env); err == nil && ok {
+				matched = append(matched, i)
+				analysis.Rules[i].Matches++
+			}
+		}
+
+		categoryWinner, merchantWinner := -1, -1
+		for _, i := range matched {
+			if categoryWinner < 0 && ruleset[i].CategoryID != nil {
+				categoryWinner = i
+				analysis.Rules[i].CategoryWins++
+			}
+			if merchantWinner < 0 && ruleset[i].Merchant != nil {
+				merchantWinner = i
+				analysis.Rules[i].MerchantWins++
+			}
+		}
+
+		for x, i := range matched {
+			for _, j := range matched[x+1:] {
+				first, second := ruleset[i].CategoryID, ruleset[j].CategoryID
+				if first == nil || second == nil || *first == *second {
+					continue
+				}
+				key := pair{i, j}
+				c, ok := conflicts[key]
+				if !ok {
+					c = &CategoryConflict{
+						First:          ruleset[i].RuleID,
+						Second:         ruleset[j].RuleID,
+						FirstCategory:  *first,
+						SecondCategory: *second,
+					}
+					conflicts[key] = c
+					order = append(order, key)
+				}
+				c.Transactions++
+				if len(c.SampleIDs) < maxConflictSamples {
+					c.SampleIDs = append(c.SampleIDs, tx.ID)
+				}
+			}
+		}
+	}
+
+	for _, key := range order {
+		analysis.Conflicts = append(analysis.Conflicts, *conflicts[key])
+	}
+	return analysis
+}
+
+// Implies reports whether b is sure to match every transaction a matches.
+// It only compares conditions, so false means "not provable", not "no".
+func Implies(a, b *RuleConditions) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	switch LogicOperator(b.Logic) {
+	case LogicAND:
+		for i := range b.Conditions {
+			if !impliesCondition(a, &b.Conditions[i]) {
+				return false
+			}
+		}
+		return true
+
+	case LogicOR:
+		if LogicOperator(a.Logic) == LogicOR {
+			// every way a can match has to be one of b's
+			for i := range a.Conditions {
+				if !containsCondition(b.Conditions, &a.Conditions[i]) {
+					return false
+				}
+			}
+			return len(a.Conditions) > 0
+		}
+		for i := range b.Conditions {
+			if impliesCondition(a, &b.Conditions[i]) {
+				return true
+			}
+		}
+		return false
+
+	default:
+		return false
+	}
+}
+
+// impliesCondition reports whether c holds whenever a matches.
+func impliesCondition(a *RuleConditions, c *Condition) bool {
+	switch LogicOperator(a.Logic) {
+	case LogicAND:
+		return containsCondition(a.Conditions, c)
+	case LogicOR:
+		for i := range a.Conditions {
+			if !reflect.DeepEqual(a.Conditions[i], *c) {
+				return false
+			}
+		}
+		return len(a.Conditions) > 0
+	default:
+		return false
+	}
+}
+
+func containsCondition(conditions []Condition, c *Condition) bool {
+	for i := range conditions {
+		if reflect.DeepEqual(conditions[i], *c) {
+			return true
+		}
+	}
+	return false
+}
+
+// markUnreachable flags rules whose every action is always taken by a higher
+// priority rule that matches whenever they do.
+func markUnreachable(ruleset []sqlc.TransactionRule, parsed []*RuleConditions, reports []RuleReport) {
+	for i := range ruleset {
+		if parsed[i] == nil {
+			continue
+		}
+		categoryTaken := ruleset[i].CategoryID == nil
+		merchantTaken := ruleset[i].Merchant == nil
+		var shadow uuid.UUID
+
+		for j := 0; j < i && !(categoryTaken && merchantTaken); j++ {
+			if !Implies(parsed[i], parsed[j]) {
+				continue
+			}
+			if !categoryTaken && ruleset[j].CategoryID != nil {
+				categoryTaken, shadow = true, ruleset[j].RuleID
+			}
+			if !merchantTaken && ruleset[j].Merchant != nil {
+				merchantTaken, shadow = true, ruleset[j].RuleID
+			}
+		}
+
+		if categoryTaken && merchantTaken {
+			reports[i].Unreachable = true
+			reports[i].ShadowedBy = shadow
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"ariand/internal/db/sqlc"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func testRule(t *testing.T, categoryID int64, merchant string, conditions string) sqlc.TransactionRule {
+	t.Helper()
+	if _, err := ParseRuleConditions([]byte(conditions)); err != nil {
+		t.Fatalf("bad test rule %s: %v", conditions, err)
+	}
+	rule := sqlc.TransactionRule{RuleID: uuid.New(), Conditions: []byte(conditions)}
+	if categoryID != 0 {
+		rule.CategoryID = &categoryID
+	}
+	if merchant != "" {
+		rule.Merchant = &merchant
+	}
+	return rule
+}
+
+func testTx(id int64, desc string, cents int64) sqlc.Transaction {
+	return sqlc.Transaction{ID: id, TxDesc: &desc, TxAmountCents: cents, TxCurrency: "CAD"}
+}
+
+func TestAnalyze(t *testing.T) {
+	coffee := testRule(t, 1, "", `{"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"coffee"}]}`)
+	bigCoffee := testRule(t, 2, "", `{"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"coffee"},{"field":"amount","operator":"greater_than","value":20}]}`)
+	bigAnything := testRule(t, 3, "", `{"logic":"AND","conditions":[{"field":"amount","operator":"greater_than","value":20}]}`)
+	groceries := testRule(t, 4, "", `{"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"grocer"}]}`)
+
+	ruleset := []sqlc.TransactionRule{coffee, bigCoffee, bigAnything, groceries}
+	txs := []sqlc.Transaction{
+		testTx(1, "Coffee Shop", 450),
+		testTx(2, "Coffee Beans Bulk", 3200),
+		testTx(3, "Hardware Store", 5000),
+		testTx(4, "Coffee Roasters", 2500),
+	}
+
+	a := Analyze(ruleset, txs, nil, nil)
+	if a.Transactions != 4 {
+		t.Fatalf("Transactions = %d, want 4", a.Transactions)
+	}
+
+	want := []struct {
+		matches, wins int
+		neverWins     bool
+		unreachable   bool
+	}{
+		{3, 3, false, false},
+		{2, 0, true, true},
+		{3, 1, false, false},
+		{0, 0, false, false},
+	}
+	for i, w := range want {
+		r := a.Rules[i]
+		if r.Matches != w.matches || r.CategoryWins != w.wins || r.NeverWins() != w.neverWins || r.Unreachable != w.unreachable {
+			t.Errorf("rule %d: got matches %d wins %d never %v unreachable %v, want %+v",
+				i, r.Matches, r.CategoryWins, r.NeverWins(), r.Unreachable, w)
+		}
+	}
+	if a.Rules[1].ShadowedBy != coffee.RuleID {
+		t.Errorf("bigCoffee should be shadowed by coffee")
+	}
+
+	// the three coffee and amount rules all disagree on txs 2 and 4
+	if len(a.Conflicts) != 3 {
+		t.Fatalf("got %d conflicts, want 3: %+v", len(a.Conflicts), a.Conflicts)
+	}
+	if a.Conflicts[0].First != coffee.RuleID || a.Conflicts[0].Second != bigCoffee.RuleID {
+		t.Errorf("conflicts should come in priority order, got %+v", a.Conflicts[0])
+	}
+	for _, c := range a.Conflicts {
+		if c.Transactions != 2 || len(c.SampleIDs) != 2 || c.SampleIDs[0] != 2 {
+			t.Errorf("unexpected conflict %+v", c)
+		}
+	}
+}
+
+func TestAnalyzeMerchantKeepsRuleReachable(t *testing.T) {
+	category := testRule(t, 1, "", `{"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"uber"}]}`)
+	both := testRule(t, 2, "Uber", `{"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"uber"},{"field":"tx_desc","operator":"contains","value":"eats"}]}`)
+
+	a := Analyze([]sqlc.TransactionRule{category, both}, []sqlc.Transaction{testTx(1, "UBER EATS", 1800)}, nil, nil)
+	if a.Rules[1].Unreachable || a.Rules[1].NeverWins() || a.Rules[1].MerchantWins != 1 {
+		t.Errorf("a rule still setting the merchant should count, got %+v", a.Rules[1])
+	}
+}
+
+func TestImplies(t *testing.T) {
+	parse := func(s string) *RuleConditions {
+		rc, err := ParseRuleConditions([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rc
+	}
+	a := `{"field":"tx_desc","operator":"contains","value":"a"}`
+	b := `{"field":"tx_desc","operator":"contains","value":"b"}`
+
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"narrower and", `{"logic":"AND","conditions":[` + a + `,` + b + `]}`, `{"logic":"AND","conditions":[` + a + `]}`, true},
+		{"wider and", `{"logic":"AND","conditions":[` + a + `]}`, `{"logic":"AND","conditions":[` + a + `,` + b + `]}`, false},
+		{"and into or", `{"logic":"AND","conditions":[` + a + `]}`, `{"logic":"OR","conditions":[` + a + `,` + b + `]}`, true},
+		{"or into or", `{"logic":"OR","conditions":[` + a + `]}`, `{"logic":"OR","conditions":[` + b + `,` + a + `]}`, true},
+		{"or into and", `{"logic":"OR","conditions":[` + a + `,` + b + `]}`, `{"logic":"AND","conditions":[` + a + `]}`, false},
+	}
+	for _, tt := range tests {
+		if got := Implies(parse(tt.a), parse(tt.b)); got != tt.want {
+			t.Errorf("%s: Implies = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
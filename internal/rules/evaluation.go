@@ -2,23 +2,51 @@ package rules
 
 import (
 	"ariand/internal/db/sqlc"
+	"ariand/internal/money"
 	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// Env is what conditions read about the user rather than the transaction.
+// A nil Env or missing part behaves as if the user had no categories and
+// lived in UTC.
+type Env struct {
+	// Categories maps the user's category ids to their slugs.
+	Categories map[int64]string
+	// Location is the user's timezone, calendar fields use the date of
+	// tx_date there.
+	Location *time.Location
+}
+
 // EvaluateRule evaluates a rule against transaction and account data
-func EvaluateRule(rule *RuleConditions, tx *sqlc.Transaction, account *sqlc.GetAccountRow) (bool, error) {
+func EvaluateRule(rule *RuleConditions, tx *sqlc.Transaction, account *sqlc.GetAccountRow, env *Env) (bool, error) {
 	if rule == nil || tx == nil {
 		return false, nil
 	}
+	if env == nil {
+		env = &Env{}
+	}
+	loc := env.Location
+	if loc == nil {
+		loc = time.UTC
+	}
 
-	switch LogicOperator(rule.Logic) {
+	// the conditions only read tx, so a copy keeps the caller's time zone
+	local := *tx
+	local.TxDate = tx.TxDate.In(loc)
+	return evaluateGroup(rule.Logic, rule.Conditions, &local, account, env.Categories)
+}
+
+// evaluateGroup combines the results of conditions with logic
+func evaluateGroup(logic string, conditions []Condition, tx *sqlc.Transaction, account *sqlc.GetAccountRow, categories map[int64]string) (bool, error) {
+	switch LogicOperator(logic) {
 	case LogicAND:
 		// All conditions must be true
-		for _, condition := range rule.Conditions {
-			matches, err := evaluateCondition(&condition, tx, account)
+		for i := range conditions {
+			matches, err := evaluateNode(&conditions[i], tx, account, categories)
 			if err != nil {
 				return false, err
 			}
@@ -30,8 +58,8 @@ func EvaluateRule(rule *RuleConditions, tx *sqlc.Transaction, account *sqlc.GetA
 
 	case LogicOR:
 		// At least one condition must be true
-		for _, condition := range rule.Conditions {
-			matches, err := evaluateCondition(&condition, tx, account)
+		for i := range conditions {
+			matches, err := evaluateNode(&conditions[i], tx, account, categories)
 			if err != nil {
 				return false, err
 			}
@@ -46,8 +74,21 @@ func EvaluateRule(rule *RuleConditions, tx *sqlc.Transaction, account *sqlc.GetA
 	}
 }
 
+// evaluateNode evaluates a leaf condition, a nested group or a not
+func evaluateNode(condition *Condition, tx *sqlc.Transaction, account *sqlc.GetAccountRow, categories map[int64]string) (bool, error) {
+	switch {
+	case condition.IsNot():
+		matches, err := evaluateNode(condition.Not, tx, account, categories)
+		return !matches && err == nil, err
+	case condition.IsGroup():
+		return evaluateGroup(condition.Logic, condition.Conditions, tx, account, categories)
+	default:
+		return evaluateCondition(condition, tx, account, categories)
+	}
+}
+
 // evaluateCondition evaluates a single condition against transaction data
-func evaluateCondition(condition *Condition, tx *sqlc.Transaction, account *sqlc.GetAccountRow) (bool, error) {
+func evaluateCondition(condition *Condition, tx *sqlc.Transaction, account *sqlc.GetAccountRow, categories map[int64]string) (bool, error) {
 	field := FieldType(condition.Field)
 	operator := OperatorType(condition.Operator)
 
@@ -76,12 +117,58 @@ func evaluateCondition(condition *Condition, tx *sqlc.Transaction, account *sqlc
 	case FieldCurrency:
 		fieldValue = &tx.TxCurrency
 	case FieldAmount:
-		// Convert cents to dollars for rule matching
-		amount := float64(tx.TxAmountCents) / 100.0
+		// Convert minor units to whole units for rule matching
+		amount := money.ToMajor(tx.TxAmountCents, tx.TxCurrency)
 		numericValue = &amount
 	case FieldTxDirection:
 		val := float64(tx.TxDirection)
 		numericValue = &val
+	case FieldUserNotes:
+		fieldValue = tx.UserNotes
+	case FieldForeignCurrency:
+		fieldValue = tx.ForeignCurrency
+	case FieldForeignAmount:
+		if tx.ForeignAmountCents != nil && tx.ForeignCurrency != nil {
+			amount := money.ToMajor(*tx.ForeignAmountCents, *tx.ForeignCurrency)
+			numericValue = &amount
+		}
+	case FieldCategory:
+		if tx.CategoryID == nil {
+			return false, nil
+		}
+		slug, ok := categories[*tx.CategoryID]
+		if !ok {
+			return false, nil
+		}
+		if pattern, err := getStringValue(condition.Value); err == nil && strings.Contains(pattern, "*") {
+			switch operator {
+			case OpEquals:
+				return matchSlugPattern(slug, pattern), nil
+			case OpNotEquals:
+				return !matchSlugPattern(slug, pattern), nil
+			}
+		}
+		fieldValue = &slug
+	case FieldCategoryID:
+		if tx.CategoryID != nil {
+			val := float64(*tx.CategoryID)
+			numericValue = &val
+		}
+	case FieldAccountID:
+		val := float64(tx.AccountID)
+		numericValue = &val
+	case FieldDayOfWeek:
+		// ISO weekday, monday is 1 and sunday 7
+		val := float64((int(tx.TxDate.Weekday())+6)%7 + 1)
+		numericValue = &val
+	case FieldDayOfMonth:
+		val := float64(tx.TxDate.Day())
+		numericValue = &val
+	case FieldMonth:
+		val := float64(tx.TxDate.Month())
+		numericValue = &val
+	case FieldTxDate:
+		return evaluateDateCondition(operator, tx.TxDate, condition)
 	default:
 		return false, nil
 	}
@@ -266,6 +353,46 @@ func evaluateNumericBetween(value float64, condition *Condition) (bool, error) {
 	return isInRange, nil
 }
 
+// matchSlugPattern matches a category slug against a pattern where * stands
+// for any run of characters, so "food.*" matches every category under food
+// like the category filter does.
+func matchSlugPattern(slug, pattern string) bool {
+	parts := strings.Split(strings.ToLower(pattern), "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(strings.ToLower(slug))
+}
+
+// evaluateDateCondition compares the calendar date of txDate with the condition
+// value, ignoring the time of day
+func evaluateDateCondition(operator OperatorType, txDate time.Time, condition *Condition) (bool, error) {
+	value, err := getStringValue(condition.Value)
+	if err != nil {
+		return false, err
+	}
+
+	compareDate, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return false, err
+	}
+
+	date := time.Date(txDate.Year(), txDate.Month(), txDate.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch operator {
+	case OpEquals:
+		return date.Equal(compareDate), nil
+	case OpNotEquals:
+		return !date.Equal(compareDate), nil
+	case OpBefore:
+		return date.Before(compareDate), nil
+	case OpAfter:
+		return date.After(compareDate), nil
+	default:
+		return false, nil
+	}
+}
+
 // GetRuleFieldsUsed returns a list of fields that a rule uses
 // This can be helpful for determining what data is needed for evaluation
 func GetRuleFieldsUsed(rule *RuleConditions) []FieldType {
@@ -274,10 +401,9 @@ func GetRuleFieldsUsed(rule *RuleConditions) []FieldType {
 	}
 
 	fieldsSet := make(map[FieldType]bool)
-	for _, condition := range rule.Conditions {
-		field := FieldType(condition.Field)
-		fieldsSet[field] = true
-	}
+	walkConditions(rule.Conditions, func(condition *Condition) {
+		fieldsSet[FieldType(condition.Field)] = true
+	})
 
 	fields := make([]FieldType, 0, len(fieldsSet))
 	for field := range fieldsSet {
@@ -294,10 +420,11 @@ func GetRuleComplexity(rule *RuleConditions) int {
 		return 0
 	}
 
-	complexity := len(rule.Conditions)
+	complexity := 0
 
 	// Add complexity for specific operators
-	for _, condition := range rule.Conditions {
+	walkConditions(rule.Conditions, func(condition *Condition) {
+		complexity++
 		operator := OperatorType(condition.Operator)
 		switch operator {
 		case OpRegex:
@@ -307,11 +434,29 @@ func GetRuleComplexity(rule *RuleConditions) int {
 		case OpBetween:
 			complexity += 1 // Two comparisons
 		}
-	}
+	})
 
 	return complexity
 }
 
+// walkConditions calls fn for every leaf condition, however deeply nested
+func walkConditions(conditions []Condition, fn func(*Condition)) {
+	for i := range conditions {
+		walkCondition(&conditions[i], fn)
+	}
+}
+
+func walkCondition(condition *Condition, fn func(*Condition)) {
+	switch {
+	case condition.IsNot():
+		walkCondition(condition.Not, fn)
+	case condition.IsGroup():
+		walkConditions(condition.Conditions, fn)
+	default:
+		fn(condition)
+	}
+}
+
 // GetRuleDescription returns a human-readable description of the rule
 // This can be used for UI display or logging
 func GetRuleDescription(rule *RuleConditions) string {
@@ -319,17 +464,30 @@ func GetRuleDescription(rule *RuleConditions) string {
 		return "Empty rule"
 	}
 
-	descriptions := make([]string, len(rule.Conditions))
-	for i, condition := range rule.Conditions {
-		descriptions[i] = getConditionDescription(&condition)
+	return describeGroup(rule.Logic, rule.Conditions)
+}
+
+func describeGroup(logic string, conditions []Condition) string {
+	descriptions := make([]string, len(conditions))
+	for i := range conditions {
+		condition := &conditions[i]
+		switch {
+		case condition.IsNot():
+			descriptions[i] = "not (" + describeGroup(string(LogicAND), []Condition{*condition.Not}) + ")"
+		case condition.IsGroup() && len(condition.Conditions) > 1:
+			descriptions[i] = "(" + describeGroup(condition.Logic, condition.Conditions) + ")"
+		case condition.IsGroup():
+			descriptions[i] = describeGroup(condition.Logic, condition.Conditions)
+		default:
+			descriptions[i] = getConditionDescription(condition)
+		}
 	}
 
-	logic := strings.ToLower(rule.Logic)
 	if len(descriptions) == 1 {
 		return descriptions[0]
 	}
 
-	return strings.Join(descriptions, " "+logic+" ")
+	return strings.Join(descriptions, " "+strings.ToLower(logic)+" ")
 }
 
 func getConditionDescription(condition *Condition) string {
@@ -359,14 +517,18 @@ func getConditionDescription(condition *Condition) string {
 		return describeNumericOperation(field, condition, "<")
 	case OpBetween:
 		return fmt.Sprintf("%s between %s and %s", field, formatFloat(*condition.MinValue), formatFloat(*condition.MaxValue))
+	case OpBefore:
+		return describeStringOperation(field, condition, "before")
+	case OpAfter:
+		return describeStringOperation(field, condition, "after")
 	default:
 		return field + " " + condition.Operator + " (unknown)"
 	}
 }
 
 func describeEqualsOperation(field string, condition *Condition, verb string) string {
-	isStringField := IsStringField(FieldType(condition.Field))
-	if isStringField {
+	isQuoted := IsStringField(FieldType(condition.Field)) || IsDateField(FieldType(condition.Field))
+	if isQuoted {
 		value, _ := getStringValue(condition.Value)
 		return fmt.Sprintf("%s %s '%s'", field, verb, value)
 	}
@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluateNewFields(t *testing.T) {
+	notes, eur := "split with sam", "EUR"
+	foreign, category := int64(2550), int64(12)
+
+	// a saturday
+	tx := testTx(1, "BISTRO", 3000)
+	tx.TxDate = time.Date(2025, time.March, 15, 19, 30, 0, 0, time.UTC)
+	tx.AccountID = 3
+	tx.UserNotes = &notes
+	tx.ForeignCurrency, tx.ForeignAmountCents = &eur, &foreign
+	tx.CategoryID = &category
+
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{`{"field":"tx_date","operator":"equals","value":"2025-03-15"}`, true},
+		{`{"field":"tx_date","operator":"before","value":"2025-03-15"}`, false},
+		{`{"field":"tx_date","operator":"before","value":"2025-03-16"}`, true},
+		{`{"field":"tx_date","operator":"after","value":"2025-03-14"}`, true},
+		{`{"field":"tx_date","operator":"not_equals","value":"2025-03-15"}`, false},
+		{`{"field":"day_of_week","operator":"between","min_value":6,"max_value":7}`, true},
+		{`{"field":"day_of_week","operator":"equals","value":6}`, true},
+		{`{"field":"day_of_month","operator":"equals","value":15}`, true},
+		{`{"field":"month","operator":"equals","value":3}`, true},
+		{`{"field":"user_notes","operator":"contains","value":"SAM"}`, true},
+		{`{"field":"foreign_currency","operator":"equals","value":"eur"}`, true},
+		{`{"field":"foreign_amount","operator":"greater_than","value":25.5}`, false},
+		{`{"field":"foreign_amount","operator":"equals","value":25.5}`, true},
+		{`{"field":"category_id","operator":"equals","value":12}`, true},
+		{`{"field":"account_id","operator":"not_equals","value":3}`, false},
+	}
+	for _, tt := range tests {
+		conditions, err := ParseRuleConditions([]byte(`{"logic":"AND","conditions":[` + tt.condition + `]}`))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.condition, err)
+		}
+		got, err := EvaluateRule(conditions, &tx, nil, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.condition, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.condition, got, tt.want)
+		}
+	}
+}
+
+// 19:30 in UTC on saturday is already sunday in Tokyo
+func TestEvaluateCalendarFieldsInLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+	tx := testTx(1, "BISTRO", 3000)
+	tx.TxDate = time.Date(2025, time.March, 15, 19, 30, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		loc       *time.Location
+		condition string
+	}{
+		{nil, `{"field":"tx_date","operator":"equals","value":"2025-03-15"}`},
+		{nil, `{"field":"day_of_week","operator":"equals","value":6}`},
+		{tokyo, `{"field":"tx_date","operator":"equals","value":"2025-03-16"}`},
+		{tokyo, `{"field":"day_of_week","operator":"equals","value":7}`},
+		{tokyo, `{"field":"day_of_month","operator":"equals","value":16}`},
+	} {
+		conditions, err := ParseRuleConditions([]byte(`{"logic":"AND","conditions":[` + tt.condition + `]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := EvaluateRule(conditions, &tx, nil, &Env{Location: tt.loc}); err != nil || !got {
+			t.Errorf("%s in %v: got %v, %v", tt.condition, tt.loc, got, err)
+		}
+	}
+	if !tx.TxDate.Equal(time.Date(2025, time.March, 15, 19, 30, 0, 0, time.UTC)) || tx.TxDate.Location() != time.UTC {
+		t.Errorf("EvaluateRule changed the caller's tx_date to %v", tx.TxDate)
+	}
+}
+
+func TestEvaluateCategorySlug(t *testing.T) {
+	category := int64(12)
+	tx := testTx(1, "BISTRO", 3000)
+	tx.CategoryID = &category
+	env := &Env{Categories: map[int64]string{12: "food.restaurants", 13: "food"}}
+
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{`{"field":"category","operator":"equals","value":"food.restaurants"}`, true},
+		{`{"field":"category","operator":"equals","value":"Food.Restaurants"}`, true},
+		{`{"field":"category","operator":"equals","value":"food"}`, false},
+		{`{"field":"category","operator":"equals","value":"food.*"}`, true},
+		{`{"field":"category","operator":"not_equals","value":"food.*"}`, false},
+		{`{"field":"category","operator":"equals","value":"travel.*"}`, false},
+		{`{"field":"category","operator":"starts_with","value":"food."}`, true},
+	}
+	for _, tt := range tests {
+		conditions, err := ParseRuleConditions([]byte(`{"logic":"AND","conditions":[` + tt.condition + `]}`))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.condition, err)
+		}
+		got, err := EvaluateRule(conditions, &tx, nil, env)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.condition, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.condition, got, tt.want)
+		}
+	}
+
+	// a category the user can't see has no slug
+	other := int64(99)
+	tx.CategoryID = &other
+	conditions, _ := ParseRuleConditions([]byte(`{"logic":"AND","conditions":[{"field":"category","operator":"not_equals","value":"food"}]}`))
+	if got, _ := EvaluateRule(conditions, &tx, nil, env); got {
+		t.Error("matched a category without a slug")
+	}
+}
+
+func TestEvaluateMissingFieldsNeverMatch(t *testing.T) {
+	tx := testTx(1, "BISTRO", 3000)
+	for _, condition := range []string{
+		`{"field":"user_notes","operator":"not_contains","value":"x"}`,
+		`{"field":"foreign_currency","operator":"not_equals","value":"EUR"}`,
+		`{"field":"foreign_amount","operator":"less_than","value":10}`,
+		`{"field":"category_id","operator":"not_equals","value":12}`,
+		`{"field":"category","operator":"not_equals","value":"food"}`,
+	} {
+		conditions, err := ParseRuleConditions([]byte(`{"logic":"AND","conditions":[` + condition + `]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := EvaluateRule(conditions, &tx, nil, nil); got {
+			t.Errorf("%s matched a transaction without the field", condition)
+		}
+	}
+}
+
+func TestValidateNewFields(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		path      string
+		code      string
+	}{
+		{"bad date", `{"field":"tx_date","operator":"before","value":"15/03/2025"}`, "conditions[0].value", "INVALID_DATE"},
+		{"date operator", `{"field":"tx_date","operator":"greater_than","value":"2025-03-15"}`, "conditions[0].operator", "INVALID_OPERATOR_FOR_FIELD"},
+		{"weekday range", `{"field":"day_of_week","operator":"equals","value":0}`, "conditions[0].value", "INVALID_VALUE"},
+		{"month range", `{"field":"month","operator":"between","min_value":6,"max_value":13}`, "conditions[0].max_value", "INVALID_VALUE"},
+		{"day range", `{"field":"day_of_month","operator":"equals","value":32}`, "conditions[0].value", "INVALID_VALUE"},
+		{"id operator", `{"field":"category_id","operator":"greater_than","value":3}`, "conditions[0].operator", "INVALID_OPERATOR_FOR_FIELD"},
+		{"id value", `{"field":"account_id","operator":"equals","value":1.5}`, "conditions[0].value", "INVALID_VALUE"},
+		{"foreign amount", `{"field":"foreign_amount","operator":"between","min_value":-5,"max_value":5}`, "conditions[0].min_value", "INVALID_VALUE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := []byte(`{"logic":"AND","conditions":[` + tt.condition + `]}`)
+			if _, err := ParseRuleConditions(rule); err == nil {
+				t.Error("ParseRuleConditions should reject the rule")
+			}
+
+			result := ValidateRuleJSONDetailed(rule)
+			for _, e := range result.Errors {
+				if e.Field == tt.path && e.Code == tt.code {
+					return
+				}
+			}
+			t.Errorf("expected %s at %s, got %v", tt.code, tt.path, result.Errors)
+		})
+	}
+}
+
+func TestValidateDetailedStringEquals(t *testing.T) {
+	for _, condition := range []string{
+		`{"field":"merchant","operator":"equals","value":"Starbucks"}`,
+		`{"field":"tx_date","operator":"equals","value":"2025-03-15"}`,
+	} {
+		if result := ValidateRuleJSONDetailed([]byte(`{"logic":"AND","conditions":[` + condition + `]}`)); !result.Valid {
+			t.Errorf("%s should be valid, got %v", condition, result.Errors)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package rules
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEvaluateNestedGroups(t *testing.T) {
+	conditions, err := ParseRuleConditions([]byte(`{
+		"version": 2,
+		"logic": "OR",
+		"conditions": [
+			{
+				"logic": "AND",
+				"conditions": [
+					{"field": "tx_desc", "operator": "contains", "value": "uber"},
+					{"field": "amount", "operator": "greater_than", "value": 30}
+				]
+			},
+			{
+				"logic": "AND",
+				"conditions": [
+					{"field": "tx_desc", "operator": "starts_with", "value": "lyft"},
+					{"not": {"field": "amount", "operator": "less_than", "value": 10}}
+				]
+			}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		desc  string
+		cents int64
+		want  bool
+	}{
+		{"UBER TRIP", 4500, true},
+		{"UBER TRIP", 1200, false},
+		{"LYFT RIDE", 1200, true},
+		{"LYFT RIDE", 500, false},
+		{"TAXI", 4500, false},
+	}
+	for _, tt := range tests {
+		tx := testTx(1, tt.desc, tt.cents)
+		got, err := EvaluateRule(conditions, &tx, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s %d: got %v, want %v", tt.desc, tt.cents, got, tt.want)
+		}
+	}
+
+	fields := GetRuleFieldsUsed(conditions)
+	if len(fields) != 2 {
+		t.Errorf("GetRuleFieldsUsed = %v, want tx_desc and amount", fields)
+	}
+}
+
+func TestValidateNestedPaths(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		path string
+		code string
+	}{
+		{
+			name: "leaf in group",
+			rule: `{"logic":"AND","conditions":[
+				{"field":"tx_desc","operator":"contains","value":"a"},
+				{"logic":"OR","conditions":[
+					{"field":"tx_desc","operator":"contains","value":"b"},
+					{"field":"bogus","operator":"equals","value":"c"}
+				]}
+			]}`,
+			path: "conditions[1].conditions[1].field",
+			code: "INVALID_FIELD",
+		},
+		{
+			name: "group logic",
+			rule: `{"logic":"AND","conditions":[{"logic":"XOR","conditions":[{"field":"tx_desc","operator":"contains","value":"a"}]}]}`,
+			path: "conditions[0].logic",
+			code: "INVALID_VALUE",
+		},
+		{
+			name: "empty group",
+			rule: `{"logic":"AND","conditions":[{"logic":"OR","conditions":[]}]}`,
+			path: "conditions[0].conditions",
+			code: "REQUIRED_FIELD",
+		},
+		{
+			name: "inside not",
+			rule: `{"logic":"AND","conditions":[{"not":{"field":"amount","operator":"greater_than"}}]}`,
+			path: "conditions[0].not.value",
+			code: "REQUIRED_FIELD",
+		},
+		{
+			name: "not with a field",
+			rule: `{"logic":"AND","conditions":[{"field":"amount","not":{"field":"amount","operator":"greater_than","value":1}}]}`,
+			path: "conditions[0]",
+			code: "CONFLICTING_FIELDS",
+		},
+		{
+			name: "version 1 with groups",
+			rule: `{"version":1,"logic":"AND","conditions":[{"logic":"OR","conditions":[{"field":"tx_desc","operator":"contains","value":"a"}]}]}`,
+			path: "version",
+			code: "INVALID_VALUE",
+		},
+		{
+			name: "unknown version",
+			rule: `{"version":9,"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"a"}]}`,
+			path: "version",
+			code: "INVALID_VALUE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateRuleJSONDetailed([]byte(tt.rule))
+			if result.Valid {
+				t.Fatal("expected rule to be invalid")
+			}
+			for _, e := range result.Errors {
+				if e.Field == tt.path && e.Code == tt.code {
+					return
+				}
+			}
+			t.Errorf("expected %s at %s, got %v", tt.code, tt.path, result.Errors)
+		})
+	}
+}
+
+func TestValidateMaxDepth(t *testing.T) {
+	nest := func(levels int) string {
+		rule := `{"field":"tx_desc","operator":"contains","value":"a"}`
+		for i := 0; i < levels; i++ {
+			rule = `{"not":` + rule + `}`
+		}
+		return `{"logic":"AND","conditions":[` + rule + `]}`
+	}
+
+	if result := ValidateRuleJSONDetailed([]byte(nest(MaxConditionDepth - 1))); !result.Valid {
+		t.Errorf("%d levels should be valid, got %v", MaxConditionDepth, result.Errors)
+	}
+
+	result := ValidateRuleJSONDetailed([]byte(nest(MaxConditionDepth)))
+	if result.Valid || result.Errors[0].Code != "MAX_DEPTH_EXCEEDED" {
+		t.Fatalf("expected MAX_DEPTH_EXCEEDED, got %v", result.Errors)
+	}
+	if want := "conditions[0]" + strings.Repeat(".not", MaxConditionDepth-1); result.Errors[0].Field != want {
+		t.Errorf("error at %s, want %s", result.Errors[0].Field, want)
+	}
+	if err := ValidateRuleConditions(mustParseUnvalidated(t, nest(MaxConditionDepth))); err == nil {
+		t.Error("ValidateRuleConditions should enforce the depth limit too")
+	}
+}
+
+func TestNormalizeVersion(t *testing.T) {
+	flat, err := NormalizeAndValidateRule([]byte(`{"logic":"and","conditions":[{"field":"merchant","operator":"equals","value":"STARBUCKS"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, _ := json.Marshal(flat)
+	if strings.Contains(string(out), "version") {
+		t.Errorf("flat rules should stay unversioned, got %s", out)
+	}
+
+	nested, err := NormalizeAndValidateRule([]byte(`{"logic":"and","conditions":[{"not":{"field":"merchant","operator":"equals","value":"STARBUCKS"}}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nested.Version != SpecVersionNested {
+		t.Errorf("Version = %d, want %d", nested.Version, SpecVersionNested)
+	}
+	if v := nested.Conditions[0].Not.Value; v != "starbucks" {
+		t.Errorf("values under not should be normalized, got %v", v)
+	}
+}
+
+func mustParseUnvalidated(t *testing.T, s string) *RuleConditions {
+	t.Helper()
+	var rc RuleConditions
+	if err := json.Unmarshal([]byte(s), &rc); err != nil {
+		t.Fatal(err)
+	}
+	return &rc
+}
@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"ariand/internal/db/sqlc"
+)
+
+type PreviewOutcome int
+
+const (
+	// PreviewUnchanged means the transaction already has what the rule sets.
+	PreviewUnchanged PreviewOutcome = iota
+	// PreviewWouldChange means applying the rule would update at least one field.
+	PreviewWouldChange
+	// PreviewBlocked means the rule would set something different, but only
+	// on fields the user set by hand.
+	PreviewBlocked
+)
+
+// PreviewMatch is what a rule would do to one matching transaction.
+type PreviewMatch struct {
+	Tx      *sqlc.Transaction
+	Outcome PreviewOutcome
+
+	CategoryChanges bool
+	MerchantChanges bool
+
+	// the field differs but was set manually, so it is left alone
+	CategoryBlocked bool
+	MerchantBlocked bool
+}
+
+// Preview evaluates a rule that sets categoryID and merchant over txs and
+// reports every match in order. Manually set fields are respected, and a
+// transaction the conditions fail to evaluate on is skipped, the same way
+// applying rules to existing transactions does.
+func Preview(conditions *RuleConditions, categoryID *int64, merchant *string, txs []sqlc.Transaction, accounts map[int64]*sqlc.GetAccountRow, env *Env) []PreviewMatch {
+	var matches []PreviewMatch
+	for i := range txs {
+		tx := &txs[i]
+		ok, err := EvaluateRule(conditions, tx, accounts[tx.AccountID], env)
+		if err != nil || !ok {
+			continue
+		}
+
+		m := PreviewMatch{Tx: tx}
+		if categoryID != nil && (tx.CategoryID == nil || *tx.CategoryID != *categoryID) {
+			m.CategoryBlocked = tx.CategoryManuallySet
+			m.CategoryChanges = !tx.CategoryManuallySet
+		}
+		if merchant != nil && (tx.Merchant == nil || *tx.Merchant != *merchant) {
+			m.MerchantBlocked = tx.MerchantManuallySet
+			m.MerchantChanges = !tx.MerchantManuallySet
+		}
+
+		switch {
+		case m.CategoryChanges || m.MerchantChanges:
+			m.Outcome = PreviewWouldChange
+		case m.CategoryBlocked || m.MerchantBlocked:
+			m.Outcome = PreviewBlocked
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"ariand/internal/db/sqlc"
+	"testing"
+)
+
+func TestPreview(t *testing.T) {
+	conditions, err := ParseRuleConditions([]byte(`{"logic":"AND","conditions":[{"field":"tx_desc","operator":"contains","value":"uber"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rides, other := int64(7), int64(9)
+	merchant := "Uber"
+
+	fresh := testTx(1, "UBER TRIP", 1200)
+	done := testTx(2, "UBER TRIP", 1500)
+	done.CategoryID, done.Merchant = &rides, &merchant
+	manual := testTx(3, "UBER EATS", 2200)
+	manual.CategoryID, manual.CategoryManuallySet = &other, true
+	manual.Merchant, manual.MerchantManuallySet = &merchant, true
+	half := testTx(4, "UBER EATS", 1900)
+	half.CategoryID, half.CategoryManuallySet = &other, true
+	miss := testTx(5, "LYFT", 1000)
+
+	matches := Preview(conditions, &rides, &merchant, []sqlc.Transaction{fresh, done, manual, half, miss}, nil, nil)
+	if len(matches) != 4 {
+		t.Fatalf("got %d matches, want 4", len(matches))
+	}
+
+	want := []struct {
+		id                int64
+		outcome           PreviewOutcome
+		category, blocked bool
+	}{
+		{1, PreviewWouldChange, true, false},
+		{2, PreviewUnchanged, false, false},
+		{3, PreviewBlocked, false, true},
+		{4, PreviewWouldChange, false, true},
+	}
+	for i, w := range want {
+		m := matches[i]
+		if m.Tx.ID != w.id || m.Outcome != w.outcome || m.CategoryChanges != w.category || m.CategoryBlocked != w.blocked {
+			t.Errorf("match %d: got %+v, want %+v", i, m, w)
+		}
+	}
+	if !matches[3].MerchantChanges {
+		t.Error("the merchant of tx 4 isn't manual and should change")
+	}
+}
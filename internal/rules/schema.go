@@ -3,23 +3,69 @@ package rules
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
+	"time"
+)
+
+// Spec versions. Version 1 rules are a flat list of conditions; version 2
+// adds nested groups and not. Rules without a version are read as whichever
+// their shape needs.
+const (
+	SpecVersionFlat   = 1
+	SpecVersionNested = 2
+
+	// MaxConditionDepth bounds how deeply groups and nots can nest.
+	MaxConditionDepth = 5
 )
 
 type RuleConditions struct {
+	Version    int         `json:"version,omitempty"`
 	Logic      string      `json:"logic"`
 	Conditions []Condition `json:"conditions"`
 }
 
+// Condition is one of three kinds: a leaf that tests a field, a group that
+// combines its conditions with logic, or a not that negates another condition.
 type Condition struct {
-	Field         string      `json:"field"`
-	Operator      string      `json:"operator"`
+	Field         string      `json:"field,omitempty"`
+	Operator      string      `json:"operator,omitempty"`
 	Value         interface{} `json:"value,omitempty"`
 	Values        []string    `json:"values,omitempty"`
 	MinValue      *float64    `json:"min_value,omitempty"`
 	MaxValue      *float64    `json:"max_value,omitempty"`
 	Currency      *string     `json:"currency,omitempty"`
 	CaseSensitive *bool       `json:"case_sensitive,omitempty"`
+
+	Logic      string      `json:"logic,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty"`
+	Not        *Condition  `json:"not,omitempty"`
+}
+
+// IsGroup reports whether c combines nested conditions.
+func (c *Condition) IsGroup() bool {
+	return c.Logic != "" || len(c.Conditions) > 0
+}
+
+// IsNot reports whether c negates a nested condition.
+func (c *Condition) IsNot() bool {
+	return c.Not != nil
+}
+
+// hasLeafFields reports whether any of the fields a leaf uses are set.
+func (c *Condition) hasLeafFields() bool {
+	return c.Field != "" || c.Operator != "" || c.Value != nil || len(c.Values) > 0 ||
+		c.MinValue != nil || c.MaxValue != nil || c.Currency != nil || c.CaseSensitive != nil
+}
+
+// IsNested reports whether rule uses groups or nots.
+func (r *RuleConditions) IsNested() bool {
+	for i := range r.Conditions {
+		if r.Conditions[i].IsGroup() || r.Conditions[i].IsNot() {
+			return true
+		}
+	}
+	return false
 }
 
 type LogicOperator string
@@ -40,8 +86,25 @@ const (
 	FieldBank        FieldType = "bank"
 	FieldCurrency    FieldType = "currency"
 	FieldAmount      FieldType = "amount"
+
+	FieldUserNotes       FieldType = "user_notes"
+	FieldForeignCurrency FieldType = "foreign_currency"
+	FieldForeignAmount   FieldType = "foreign_amount"
+	FieldCategory        FieldType = "category" // slug of the current category
+	FieldCategoryID      FieldType = "category_id"
+	FieldAccountID       FieldType = "account_id"
+
+	// calendar fields are read from tx_date. day_of_week is ISO, 1=monday to
+	// 7=sunday, so the weekend is between 6 and 7.
+	FieldTxDate     FieldType = "tx_date"
+	FieldDayOfWeek  FieldType = "day_of_week"
+	FieldDayOfMonth FieldType = "day_of_month"
+	FieldMonth      FieldType = "month"
 )
 
+// DateLayout is the format of tx_date values.
+const DateLayout = "2006-01-02"
+
 type OperatorType string
 
 const (
@@ -56,6 +119,8 @@ const (
 	OpGreaterThan OperatorType = "greater_than"
 	OpLessThan    OperatorType = "less_than"
 	OpBetween     OperatorType = "between"
+	OpBefore      OperatorType = "before"
+	OpAfter       OperatorType = "after"
 )
 
 func IsStringField(field FieldType) bool {
@@ -64,12 +129,51 @@ func IsStringField(field FieldType) bool {
 		field == FieldAccountType ||
 		field == FieldAccountName ||
 		field == FieldBank ||
-		field == FieldCurrency
+		field == FieldCurrency ||
+		field == FieldUserNotes ||
+		field == FieldForeignCurrency ||
+		field == FieldCategory
 }
 
 func IsNumericField(field FieldType) bool {
 	return field == FieldAmount ||
-		field == FieldTxDirection
+		field == FieldTxDirection ||
+		field == FieldForeignAmount ||
+		field == FieldCategoryID ||
+		field == FieldAccountID ||
+		field == FieldDayOfWeek ||
+		field == FieldDayOfMonth ||
+		field == FieldMonth
+}
+
+func IsDateField(field FieldType) bool {
+	return field == FieldTxDate
+}
+
+func IsValidField(field FieldType) bool {
+	return IsStringField(field) || IsNumericField(field) || IsDateField(field)
+}
+
+// IsIDField reports fields holding a row id, which only compare for equality.
+func IsIDField(field FieldType) bool {
+	return field == FieldCategoryID ||
+		field == FieldAccountID
+}
+
+// FieldRange returns the inclusive bounds of fields with a fixed range.
+func FieldRange(field FieldType) (lo, hi float64, ok bool) {
+	switch field {
+	case FieldTxDirection:
+		return 0, 2, true
+	case FieldDayOfWeek:
+		return 1, 7, true
+	case FieldDayOfMonth:
+		return 1, 31, true
+	case FieldMonth:
+		return 1, 12, true
+	default:
+		return 0, 0, false
+	}
 }
 
 func IsStringOperator(op OperatorType) bool {
@@ -91,6 +195,18 @@ func IsNumericOperator(op OperatorType) bool {
 		op == OpBetween
 }
 
+func IsDateOperator(op OperatorType) bool {
+	return op == OpEquals ||
+		op == OpNotEquals ||
+		op == OpBefore ||
+		op == OpAfter
+}
+
+func IsIDOperator(op OperatorType) bool {
+	return op == OpEquals ||
+		op == OpNotEquals
+}
+
 func RequiresValues(op OperatorType) bool {
 	return op == OpContainsAny
 }
@@ -107,6 +223,9 @@ func GetStringFields() []string {
 		string(FieldAccountName),
 		string(FieldBank),
 		string(FieldCurrency),
+		string(FieldUserNotes),
+		string(FieldForeignCurrency),
+		string(FieldCategory),
 	}
 }
 
@@ -114,6 +233,18 @@ func GetNumericFields() []string {
 	return []string{
 		string(FieldAmount),
 		string(FieldTxDirection),
+		string(FieldForeignAmount),
+		string(FieldCategoryID),
+		string(FieldAccountID),
+		string(FieldDayOfWeek),
+		string(FieldDayOfMonth),
+		string(FieldMonth),
+	}
+}
+
+func GetDateFields() []string {
+	return []string{
+		string(FieldTxDate),
 	}
 }
 
@@ -140,6 +271,15 @@ func GetNumericOperators() []string {
 	}
 }
 
+func GetDateOperators() []string {
+	return []string{
+		string(OpEquals),
+		string(OpNotEquals),
+		string(OpBefore),
+		string(OpAfter),
+	}
+}
+
 func ParseRuleConditions(jsonData []byte) (*RuleConditions, error) {
 	var rule RuleConditions
 	if err := json.Unmarshal(jsonData, &rule); err != nil {
@@ -158,26 +298,83 @@ func ValidateRuleConditions(rule *RuleConditions) error {
 		return fmt.Errorf("rule cannot be nil")
 	}
 
-	logic := LogicOperator(strings.ToUpper(rule.Logic))
+	switch rule.Version {
+	case 0, SpecVersionNested:
+	case SpecVersionFlat:
+		if rule.IsNested() {
+			return fmt.Errorf("nested groups and not need version %d", SpecVersionNested)
+		}
+	default:
+		return fmt.Errorf("unsupported version: %d", rule.Version)
+	}
+
+	return validateGroup(&rule.Logic, rule.Conditions, "", 1)
+}
+
+// validateGroup checks and normalizes the logic and conditions of the rule or
+// of a nested group. path numbers conditions from 1, e.g. "2.1".
+func validateGroup(logicValue *string, conditions []Condition, path string, depth int) error {
+	logic := LogicOperator(strings.ToUpper(*logicValue))
 	if logic != LogicAND && logic != LogicOR {
-		return fmt.Errorf("logic must be 'AND' or 'OR', got: %s", rule.Logic)
+		return fmt.Errorf("%slogic must be 'AND' or 'OR', got: %s", groupPrefix(path), *logicValue)
 	}
-	rule.Logic = string(logic)
+	*logicValue = string(logic)
 
-	if len(rule.Conditions) == 0 {
-		return fmt.Errorf("at least one condition is required")
+	if len(conditions) == 0 {
+		return fmt.Errorf("%sat least one condition is required", groupPrefix(path))
 	}
 
-	for i, condition := range rule.Conditions {
-		if err := ValidateCondition(&condition); err != nil {
-			return fmt.Errorf("condition %d: %w", i+1, err)
+	for i := range conditions {
+		if err := validateNode(&conditions[i], fmt.Sprintf("%s%d", dotted(path), i+1), depth); err != nil {
+			return err
 		}
-		rule.Conditions[i] = condition
 	}
 
 	return nil
 }
 
+func validateNode(condition *Condition, path string, depth int) error {
+	nested := condition.IsGroup() || condition.IsNot()
+	if nested && depth >= MaxConditionDepth {
+		return fmt.Errorf("condition %s: groups nest at most %d levels deep", path, MaxConditionDepth)
+	}
+
+	switch {
+	case condition.IsNot():
+		if condition.IsGroup() || condition.hasLeafFields() {
+			return fmt.Errorf("condition %s: not can't be combined with other properties", path)
+		}
+		return validateNode(condition.Not, path+".not", depth+1)
+
+	case condition.IsGroup():
+		if condition.hasLeafFields() {
+			return fmt.Errorf("condition %s: a group can't also have field properties", path)
+		}
+		return validateGroup(&condition.Logic, condition.Conditions, path, depth+1)
+
+	default:
+		if err := ValidateCondition(condition); err != nil {
+			return fmt.Errorf("condition %s: %w", path, err)
+		}
+		return nil
+	}
+}
+
+func groupPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return "condition " + path + ": "
+}
+
+func dotted(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + "."
+}
+
+// ValidateCondition checks and normalizes a single leaf condition.
 func ValidateCondition(condition *Condition) error {
 	if condition == nil {
 		return fmt.Errorf("condition cannot be nil")
@@ -207,9 +404,7 @@ func ValidateCondition(condition *Condition) error {
 }
 
 func validateConditionBasics(condition *Condition) error {
-	field := FieldType(condition.Field)
-	isValidField := IsStringField(field) || IsNumericField(field)
-	if !isValidField {
+	if !IsValidField(FieldType(condition.Field)) {
 		return fmt.Errorf("invalid field: %s", condition.Field)
 	}
 	return nil
@@ -218,15 +413,21 @@ func validateConditionBasics(condition *Condition) error {
 func validateConditionOperatorMatch(field FieldType, operator OperatorType, condition *Condition) error {
 	isStringFieldWithBadOperator := IsStringField(field) && !IsStringOperator(operator)
 	isNumericFieldWithBadOperator := IsNumericField(field) && !IsNumericOperator(operator)
+	isDateFieldWithBadOperator := IsDateField(field) && !IsDateOperator(operator)
+	isIDFieldWithBadOperator := IsIDField(field) && !IsIDOperator(operator)
 
 	if isStringFieldWithBadOperator {
 		return fmt.Errorf("operator '%s' is not valid for string field '%s'", condition.Operator, condition.Field)
 	}
 
-	if isNumericFieldWithBadOperator {
+	if isNumericFieldWithBadOperator || isIDFieldWithBadOperator {
 		return fmt.Errorf("operator '%s' is not valid for numeric field '%s'", condition.Operator, condition.Field)
 	}
 
+	if isDateFieldWithBadOperator {
+		return fmt.Errorf("operator '%s' is not valid for date field '%s'", condition.Operator, condition.Field)
+	}
+
 	return nil
 }
 
@@ -299,8 +500,20 @@ func validateConditionFieldRules(field FieldType, condition *Condition) error {
 		return validateAmountFieldRules(condition)
 	}
 
-	if field == FieldTxDirection {
-		return validateTxDirectionFieldRules(condition)
+	if field == FieldForeignAmount {
+		return validateAmountFieldRules(condition)
+	}
+
+	if _, _, ok := FieldRange(field); ok {
+		return validateRangeFieldRules(field, condition)
+	}
+
+	if IsDateField(field) {
+		return validateDateFieldRules(condition)
+	}
+
+	if IsIDField(field) {
+		return validateIDFieldRules(field, condition)
 	}
 
 	return nil
@@ -318,30 +531,43 @@ func validateAmountFieldRules(condition *Condition) error {
 	return nil
 }
 
-func validateTxDirectionFieldRules(condition *Condition) error {
+func validateRangeFieldRules(field FieldType, condition *Condition) error {
+	lo, hi, _ := FieldRange(field)
+	inRange := func(v float64) bool { return v >= lo && v <= hi }
+
 	if condition.Value != nil {
-		if numValue, err := getNumericValue(condition.Value); err == nil {
-			isValidDirection := numValue >= 0 && numValue <= 2
-			if !isValidDirection {
-				return fmt.Errorf("tx_direction must be between 0 and 2")
-			}
+		if numValue, err := getNumericValue(condition.Value); err == nil && !inRange(numValue) {
+			return fmt.Errorf("%s must be between %g and %g", field, lo, hi)
 		}
 	}
 
-	if condition.MinValue != nil {
-		isValidMin := *condition.MinValue >= 0 && *condition.MinValue <= 2
-		if !isValidMin {
-			return fmt.Errorf("tx_direction must be between 0 and 2")
-		}
+	if condition.MinValue != nil && !inRange(*condition.MinValue) {
+		return fmt.Errorf("%s must be between %g and %g", field, lo, hi)
 	}
 
-	if condition.MaxValue != nil {
-		isValidMax := *condition.MaxValue >= 0 && *condition.MaxValue <= 2
-		if !isValidMax {
-			return fmt.Errorf("tx_direction must be between 0 and 2")
-		}
+	if condition.MaxValue != nil && !inRange(*condition.MaxValue) {
+		return fmt.Errorf("%s must be between %g and %g", field, lo, hi)
+	}
+
+	return nil
+}
+
+func validateIDFieldRules(field FieldType, condition *Condition) error {
+	id, err := getNumericValue(condition.Value)
+	if err != nil || id < 1 || id != math.Trunc(id) {
+		return fmt.Errorf("%s must be a positive whole number", field)
 	}
+	return nil
+}
 
+func validateDateFieldRules(condition *Condition) error {
+	value, ok := condition.Value.(string)
+	if !ok {
+		return fmt.Errorf("tx_date value must be a date string")
+	}
+	if _, err := time.Parse(DateLayout, value); err != nil {
+		return fmt.Errorf("tx_date value must be a date like %s", DateLayout)
+	}
 	return nil
 }
 
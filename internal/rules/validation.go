@@ -3,8 +3,10 @@ package rules
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // ValidationError represents a structured validation error
@@ -69,42 +71,75 @@ func ValidateRuleJSONDetailed(jsonData []byte) *ValidationResult {
 		return result
 	}
 
+	// Validate version
+	switch rule.Version {
+	case 0, SpecVersionNested:
+	case SpecVersionFlat:
+		if rule.IsNested() {
+			addError(result, "version", fmt.Sprintf("Nested groups and not need version %d", SpecVersionNested), "INVALID_VALUE")
+		}
+	default:
+		addError(result, "version", fmt.Sprintf("Unsupported version: %d", rule.Version), "INVALID_VALUE")
+	}
+
+	validateGroupDetailed(rule.Logic, rule.Conditions, "", 1, result)
+
+	return result
+}
+
+// validateGroupDetailed validates the logic and conditions of the rule or of a
+// nested group. fieldPrefix is empty for the rule and ends in a dot otherwise,
+// so errors read like "conditions[1].conditions[0].field".
+func validateGroupDetailed(logicValue string, conditions []Condition, fieldPrefix string, depth int, result *ValidationResult) {
 	// Validate logic
-	if rule.Logic == "" {
-		result.Valid = false
-		result.Errors = append(result.Errors, ValidationError{
-			Field:   "logic",
-			Message: "Logic is required",
-			Code:    "REQUIRED_FIELD",
-		})
+	if logicValue == "" {
+		addError(result, fieldPrefix+"logic", "Logic is required", "REQUIRED_FIELD")
 	} else {
-		logic := LogicOperator(strings.ToUpper(rule.Logic))
+		logic := LogicOperator(strings.ToUpper(logicValue))
 		if logic != LogicAND && logic != LogicOR {
-			result.Valid = false
-			result.Errors = append(result.Errors, ValidationError{
-				Field:   "logic",
-				Message: fmt.Sprintf("Logic must be 'AND' or 'OR', got: %s", rule.Logic),
-				Code:    "INVALID_VALUE",
-			})
+			msg := fmt.Sprintf("Logic must be 'AND' or 'OR', got: %s", logicValue)
+			addError(result, fieldPrefix+"logic", msg, "INVALID_VALUE")
 		}
 	}
 
 	// Validate conditions
-	if len(rule.Conditions) == 0 {
-		result.Valid = false
-		result.Errors = append(result.Errors, ValidationError{
-			Field:   "conditions",
-			Message: "At least one condition is required",
-			Code:    "REQUIRED_FIELD",
-		})
-	} else {
-		for i, condition := range rule.Conditions {
-			fieldPrefix := fmt.Sprintf("conditions[%d]", i)
-			validateConditionDetailed(&condition, fieldPrefix, result)
-		}
+	if len(conditions) == 0 {
+		addError(result, fieldPrefix+"conditions", "At least one condition is required", "REQUIRED_FIELD")
+		return
 	}
 
-	return result
+	for i := range conditions {
+		validateNodeDetailed(&conditions[i], fmt.Sprintf("%sconditions[%d]", fieldPrefix, i), depth, result)
+	}
+}
+
+// validateNodeDetailed dispatches on the kind of condition
+func validateNodeDetailed(condition *Condition, fieldPrefix string, depth int, result *ValidationResult) {
+	nested := condition.IsGroup() || condition.IsNot()
+	if nested && depth >= MaxConditionDepth {
+		msg := fmt.Sprintf("Groups nest at most %d levels deep", MaxConditionDepth)
+		addError(result, fieldPrefix, msg, "MAX_DEPTH_EXCEEDED")
+		return
+	}
+
+	switch {
+	case condition.IsNot():
+		if condition.IsGroup() || condition.hasLeafFields() {
+			addError(result, fieldPrefix, "not can't be combined with other properties", "CONFLICTING_FIELDS")
+			return
+		}
+		validateNodeDetailed(condition.Not, fieldPrefix+".not", depth+1, result)
+
+	case condition.IsGroup():
+		if condition.hasLeafFields() {
+			addError(result, fieldPrefix, "A group can't also have field properties", "CONFLICTING_FIELDS")
+			return
+		}
+		validateGroupDetailed(condition.Logic, condition.Conditions, fieldPrefix+".", depth+1, result)
+
+	default:
+		validateConditionDetailed(condition, fieldPrefix, result)
+	}
 }
 
 func validateConditionDetailed(condition *Condition, fieldPrefix string, result *ValidationResult) {
@@ -130,8 +165,7 @@ func validateBasicFields(condition *Condition, fieldPrefix string, result *Valid
 	}
 
 	field := FieldType(condition.Field)
-	isValidField := IsStringField(field) || IsNumericField(field)
-	if !isValidField {
+	if !IsValidField(field) {
 		addError(result, fieldPrefix+".field", fmt.Sprintf("Invalid field: %s", condition.Field), "INVALID_FIELD")
 		return false
 	}
@@ -147,6 +181,8 @@ func validateBasicFields(condition *Condition, fieldPrefix string, result *Valid
 func validateFieldOperatorMatch(field FieldType, operator OperatorType, condition *Condition, fieldPrefix string, result *ValidationResult) bool {
 	isStringFieldWithBadOperator := IsStringField(field) && !IsStringOperator(operator)
 	isNumericFieldWithBadOperator := IsNumericField(field) && !IsNumericOperator(operator)
+	isDateFieldWithBadOperator := IsDateField(field) && !IsDateOperator(operator)
+	isIDFieldWithBadOperator := IsIDField(field) && !IsIDOperator(operator)
 
 	if isStringFieldWithBadOperator {
 		msg := fmt.Sprintf("Operator '%s' is not valid for string field '%s'", condition.Operator, condition.Field)
@@ -154,12 +190,18 @@ func validateFieldOperatorMatch(field FieldType, operator OperatorType, conditio
 		return false
 	}
 
-	if isNumericFieldWithBadOperator {
+	if isNumericFieldWithBadOperator || isIDFieldWithBadOperator {
 		msg := fmt.Sprintf("Operator '%s' is not valid for numeric field '%s'", condition.Operator, condition.Field)
 		addError(result, fieldPrefix+".operator", msg, "INVALID_OPERATOR_FOR_FIELD")
 		return false
 	}
 
+	if isDateFieldWithBadOperator {
+		msg := fmt.Sprintf("Operator '%s' is not valid for date field '%s'", condition.Operator, condition.Field)
+		addError(result, fieldPrefix+".operator", msg, "INVALID_OPERATOR_FOR_FIELD")
+		return false
+	}
+
 	return true
 }
 
@@ -228,8 +270,8 @@ func validateRegularOperator(operator OperatorType, condition *Condition, fieldP
 		return
 	}
 
-	// For numeric operators, validate that the value can be parsed as a number
-	if IsNumericOperator(operator) {
+	// For numeric fields, validate that the value can be parsed as a number
+	if IsNumericField(FieldType(condition.Field)) && IsNumericOperator(operator) {
 		if _, err := getNumericValue(condition.Value); err != nil {
 			msg := fmt.Sprintf("Invalid numeric value for operator '%s': %s", condition.Operator, err.Error())
 			addError(result, fieldPrefix+".value", msg, "INVALID_NUMERIC_VALUE")
@@ -246,7 +288,9 @@ func validateFieldSpecificRules(field FieldType, operator OperatorType, conditio
 	validateCaseSensitiveRule(field, condition, fieldPrefix, result)
 	validateCurrencyRule(condition, fieldPrefix, result)
 	validateAmountRules(field, condition, fieldPrefix, result)
-	validateTxDirectionRules(field, condition, fieldPrefix, result)
+	validateRangeRules(field, condition, fieldPrefix, result)
+	validateIDRules(field, condition, fieldPrefix, result)
+	validateDateRules(field, condition, fieldPrefix, result)
 	validateRegexPattern(operator, condition, fieldPrefix, result)
 }
 
@@ -264,7 +308,7 @@ func validateCurrencyRule(condition *Condition, fieldPrefix string, result *Vali
 }
 
 func validateAmountRules(field FieldType, condition *Condition, fieldPrefix string, result *ValidationResult) {
-	if field != FieldAmount {
+	if field != FieldAmount && field != FieldForeignAmount {
 		return
 	}
 
@@ -277,32 +321,55 @@ func validateAmountRules(field FieldType, condition *Condition, fieldPrefix stri
 	}
 }
 
-func validateTxDirectionRules(field FieldType, condition *Condition, fieldPrefix string, result *ValidationResult) {
-	if field != FieldTxDirection {
+// validateRangeRules checks tx_direction and the calendar fields stay within
+// their fixed bounds
+func validateRangeRules(field FieldType, condition *Condition, fieldPrefix string, result *ValidationResult) {
+	lo, hi, ok := FieldRange(field)
+	if !ok {
 		return
 	}
 
+	inRange := func(v float64) bool { return v >= lo && v <= hi }
+	msg := fmt.Sprintf("%s must be between %g and %g", field, lo, hi)
+
 	if condition.Value != nil {
-		if numValue, err := getNumericValue(condition.Value); err == nil {
-			isValidDirection := numValue >= 0 && numValue <= 2
-			if !isValidDirection {
-				addError(result, fieldPrefix+".value", "tx_direction must be between 0 and 2", "INVALID_VALUE")
-			}
+		if numValue, err := getNumericValue(condition.Value); err == nil && !inRange(numValue) {
+			addError(result, fieldPrefix+".value", msg, "INVALID_VALUE")
 		}
 	}
 
-	if condition.MinValue != nil {
-		isValidMin := *condition.MinValue >= 0 && *condition.MinValue <= 2
-		if !isValidMin {
-			addError(result, fieldPrefix+".min_value", "tx_direction must be between 0 and 2", "INVALID_VALUE")
-		}
+	if condition.MinValue != nil && !inRange(*condition.MinValue) {
+		addError(result, fieldPrefix+".min_value", msg, "INVALID_VALUE")
 	}
 
-	if condition.MaxValue != nil {
-		isValidMax := *condition.MaxValue >= 0 && *condition.MaxValue <= 2
-		if !isValidMax {
-			addError(result, fieldPrefix+".max_value", "tx_direction must be between 0 and 2", "INVALID_VALUE")
-		}
+	if condition.MaxValue != nil && !inRange(*condition.MaxValue) {
+		addError(result, fieldPrefix+".max_value", msg, "INVALID_VALUE")
+	}
+}
+
+func validateIDRules(field FieldType, condition *Condition, fieldPrefix string, result *ValidationResult) {
+	if !IsIDField(field) || condition.Value == nil {
+		return
+	}
+
+	if id, err := getNumericValue(condition.Value); err == nil && (id < 1 || id != math.Trunc(id)) {
+		addError(result, fieldPrefix+".value", fmt.Sprintf("%s must be a positive whole number", field), "INVALID_VALUE")
+	}
+}
+
+func validateDateRules(field FieldType, condition *Condition, fieldPrefix string, result *ValidationResult) {
+	if !IsDateField(field) || condition.Value == nil {
+		return
+	}
+
+	value, ok := condition.Value.(string)
+	if !ok {
+		addError(result, fieldPrefix+".value", "tx_date value must be a date string", "INVALID_VALUE")
+		return
+	}
+
+	if _, err := time.Parse(DateLayout, value); err != nil {
+		addError(result, fieldPrefix+".value", fmt.Sprintf("tx_date value must be a date like %s", DateLayout), "INVALID_DATE")
 	}
 }
 
@@ -341,10 +408,11 @@ func NormalizeAndValidateRule(jsonData []byte) (*RuleConditions, error) {
 
 	// Additional normalization
 	rule.Logic = strings.ToUpper(rule.Logic)
+	if rule.Version == 0 && rule.IsNested() {
+		rule.Version = SpecVersionNested
+	}
 
-	for i := range rule.Conditions {
-		condition := &rule.Conditions[i]
-
+	walkConditions(rule.Conditions, func(condition *Condition) {
 		// Set default case sensitivity for string fields
 		if IsStringField(FieldType(condition.Field)) && condition.CaseSensitive == nil {
 			defaultCase := false
@@ -362,59 +430,108 @@ func NormalizeAndValidateRule(jsonData []byte) (*RuleConditions, error) {
 				condition.Values[j] = strings.ToLower(condition.Values[j])
 			}
 		}
-	}
+	})
 
 	return rule, nil
 }
 
 // GetValidationSchema returns a JSON schema description for frontend validation
 func GetValidationSchema() map[string]interface{} {
+	logic := map[string]interface{}{
+		"type": "string",
+		"enum": []string{"AND", "OR"},
+	}
+	conditions := map[string]interface{}{
+		"type":     "array",
+		"minItems": 1,
+		"items":    map[string]interface{}{"$ref": "#/$defs/condition"},
+	}
+
 	return map[string]interface{}{
 		"type":     "object",
 		"required": []string{"logic", "conditions"},
 		"properties": map[string]interface{}{
-			"logic": map[string]interface{}{
-				"type": "string",
-				"enum": []string{"AND", "OR"},
+			"version": map[string]interface{}{
+				"type": "integer",
+				"enum": []int{SpecVersionFlat, SpecVersionNested},
 			},
-			"conditions": map[string]interface{}{
-				"type":     "array",
-				"minItems": 1,
-				"items": map[string]interface{}{
-					"type":     "object",
-					"required": []string{"field", "operator"},
-					"properties": map[string]interface{}{
-						"field": map[string]interface{}{
-							"type": "string",
-							"enum": append(GetStringFields(), GetNumericFields()...),
-						},
-						"operator": map[string]interface{}{
+			"logic":      logic,
+			"conditions": conditions,
+		},
+		"$defs": map[string]interface{}{
+			"condition": map[string]interface{}{
+				"oneOf": []interface{}{
+					map[string]interface{}{"$ref": "#/$defs/leaf"},
+					map[string]interface{}{"$ref": "#/$defs/group"},
+					map[string]interface{}{"$ref": "#/$defs/not"},
+				},
+			},
+			"group": map[string]interface{}{
+				"type":                 "object",
+				"required":             []string{"logic", "conditions"},
+				"additionalProperties": false,
+				"properties": map[string]interface{}{
+					"logic":      logic,
+					"conditions": conditions,
+				},
+			},
+			"not": map[string]interface{}{
+				"type":                 "object",
+				"required":             []string{"not"},
+				"additionalProperties": false,
+				"properties": map[string]interface{}{
+					"not": map[string]interface{}{"$ref": "#/$defs/condition"},
+				},
+			},
+			"leaf": map[string]interface{}{
+				"type":     "object",
+				"required": []string{"field", "operator"},
+				"properties": map[string]interface{}{
+					"field": map[string]interface{}{
+						"type": "string",
+						"enum": concat(GetStringFields(), GetNumericFields(), GetDateFields()),
+					},
+					"operator": map[string]interface{}{
+						"type": "string",
+						"enum": concat(GetStringOperators(), GetNumericOperators(), GetDateOperators()),
+					},
+					"value": map[string]interface{}{
+						"type": []string{"string", "number"},
+					},
+					"values": map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
 							"type": "string",
-							"enum": append(GetStringOperators(), GetNumericOperators()...),
-						},
-						"value": map[string]interface{}{
-							"type": []string{"string", "number"},
-						},
-						"values": map[string]interface{}{
-							"type": "array",
-							"items": map[string]interface{}{
-								"type": "string",
-							},
-						},
-						"min_value": map[string]interface{}{
-							"type":    "number",
-							"minimum": 0,
-						},
-						"max_value": map[string]interface{}{
-							"type":    "number",
-							"minimum": 0,
-						},
-						"case_sensitive": map[string]interface{}{
-							"type": "boolean",
 						},
 					},
+					"min_value": map[string]interface{}{
+						"type":    "number",
+						"minimum": 0,
+					},
+					"max_value": map[string]interface{}{
+						"type":    "number",
+						"minimum": 0,
+					},
+					"case_sensitive": map[string]interface{}{
+						"type": "boolean",
+					},
 				},
 			},
 		},
 	}
 }
+
+// concat joins lists, keeping the first of any repeated entries
+func concat(lists ...[]string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, list := range lists {
+		for _, s := range list {
+			if !seen[s] {
+				seen[s] = true
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"ariand/internal/db/sqlc"
 	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
 	"context"
 	"fmt"
 
@@ -19,6 +20,10 @@ type AccountService interface {
 	Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateAccountRequest) error
 	Delete(ctx context.Context, userID uuid.UUID, accountID int64) (int64, error)
 	List(ctx context.Context, userID uuid.UUID) ([]*pb.Account, error)
+	ListDeleted(ctx context.Context, userID uuid.UUID) ([]*pb.Account, error)
+	Restore(ctx context.Context, userID uuid.UUID, accountID int64) (int64, error)
+	Purge(ctx context.Context, userID uuid.UUID, accountID int64) (int64, error)
+	Projection(ctx context.Context, userID uuid.UUID, accountID int64, days *int32) (*pb.AccountProjection, error)
 }
 
 type acctSvc struct {
@@ -39,7 +44,7 @@ func (s *acctSvc) Create(ctx context.Context, req *pb.CreateAccountRequest) (*pb
 	}
 
 	anchorBalance := req.GetAnchorBalance()
-	anchorCents := moneyToCents(anchorBalance)
+	anchorCents := money.ToMinor(anchorBalance)
 	anchorCurrency := anchorBalance.GetCurrencyCode()
 
 	mainCurrency := req.GetMainCurrency()
@@ -64,6 +69,7 @@ func (s *acctSvc) Create(ctx context.Context, req *pb.CreateAccountRequest) (*pb
 		AnchorCurrency:     anchorCurrency,
 		MainCurrency:       mainCurrency,
 		Colors:             colors,
+		IncludePending:     req.GetIncludePending(),
 	}
 
 	created, err := s.queries.CreateAccount(ctx, params)
@@ -110,7 +116,7 @@ func (s *acctSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateAc
 		params.AnchorDate = &t
 	}
 	if req.AnchorBalance != nil {
-		cents := moneyToCents(req.AnchorBalance)
+		cents := money.ToMinor(req.AnchorBalance)
 		currency := req.AnchorBalance.CurrencyCode
 		params.AnchorBalanceCents = &cents
 		params.AnchorCurrency = &currency
@@ -121,13 +127,17 @@ func (s *acctSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateAc
 	if len(req.Colors) > 0 {
 		params.Colors = req.Colors
 	}
+	if req.IncludePending != nil {
+		params.IncludePending = req.IncludePending
+	}
 
 	err := s.queries.UpdateAccount(ctx, params)
 	if err != nil {
 		return wrapErr("AccountService.Update", err)
 	}
 
-	anchorFieldsChanged := params.AnchorDate != nil || params.AnchorBalanceCents != nil
+	// include_pending decides which transactions carry a running balance
+	anchorFieldsChanged := params.AnchorDate != nil || params.AnchorBalanceCents != nil || params.IncludePending != nil
 	if anchorFieldsChanged {
 		if err := s.queries.SyncAccountBalances(ctx, params.ID); err != nil {
 			s.log.Warn("failed to sync account balances after updating anchor", "account_id", params.ID, "error", err)
@@ -166,18 +176,20 @@ func (s *acctSvc) List(ctx context.Context, userID uuid.UUID) ([]*pb.Account, er
 
 func accountRowToPb(a sqlc.Account, balanceCents int64, balanceCurrency string) *pb.Account {
 	return &pb.Account{
-		Id:            a.ID,
-		OwnerId:       a.OwnerID.String(),
-		Name:          a.Name,
-		Bank:          a.Bank,
-		Type:          pb.AccountType(a.AccountType),
-		Alias:         a.Alias,
-		AnchorDate:    timestamppb.New(a.AnchorDate),
-		AnchorBalance: centsToMoney(a.AnchorBalanceCents, a.AnchorCurrency),
-		MainCurrency:  a.MainCurrency,
-		Colors:        a.Colors,
-		CreatedAt:     timestamppb.New(a.CreatedAt),
-		UpdatedAt:     timestamppb.New(a.UpdatedAt),
-		Balance:       centsToMoney(balanceCents, balanceCurrency),
+		Id:             a.ID,
+		OwnerId:        a.OwnerID.String(),
+		Name:           a.Name,
+		Bank:           a.Bank,
+		Type:           pb.AccountType(a.AccountType),
+		Alias:          a.Alias,
+		AnchorDate:     timestamppb.New(a.AnchorDate),
+		AnchorBalance:  money.FromMinor(a.AnchorBalanceCents, a.AnchorCurrency),
+		MainCurrency:   a.MainCurrency,
+		Colors:         a.Colors,
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		UpdatedAt:      timestamppb.New(a.UpdatedAt),
+		Balance:        money.FromMinor(balanceCents, balanceCurrency),
+		DeletedAt:      toProtoTimestamp(a.DeletedAt),
+		IncludePending: a.IncludePending,
 	}
 }
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const maxAggregateDimensions = 3
+
+// ----- methods -----------------------------------------------------------------------------
+
+// Aggregate groups the transactions matching the filter by the requested
+// dimensions and currency and computes the requested measures per group.
+func (s *txnSvc) Aggregate(ctx context.Context, userID uuid.UUID, req *pb.AggregateTransactionsRequest) (*pb.AggregateTransactionsResponse, error) {
+	dims, measures := req.GetDimensions(), req.GetMeasures()
+	if err := validateAggregate(dims, measures); err != nil {
+		return nil, fmt.Errorf("TransactionService.Aggregate: %w", err)
+	}
+
+	f := req.GetFilter()
+	if f == nil {
+		f = &pb.TransactionFilter{}
+	}
+	listParams, err := s.listTxParams(ctx, userID, filterToListRequest(f))
+	if err != nil {
+		return nil, fmt.Errorf("TransactionService.Aggregate.Filter: %w", err)
+	}
+
+	params := buildAggregateParams(dims, listParams)
+	params.Tz = userLocation(ctx, s.queries, userID).String()
+	params.IncludeTransfers = req.GetIncludeTransfers()
+
+	rows, err := s.queries.AggregateTransactions(ctx, params)
+	if err != nil {
+		return nil, wrapErr("TransactionService.Aggregate", err)
+	}
+
+	resp := &pb.AggregateTransactionsResponse{
+		Dimensions: dims,
+		Measures:   measures,
+		Rows:       make([]*pb.AggregateRow, 0, len(rows)),
+	}
+	for i := range rows {
+		resp.Rows = append(resp.Rows, aggregateRowToPb(&rows[i], len(dims), measures))
+	}
+	return resp, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+// buildAggregateParams maps dimensions onto the query's key slots. The enum
+// values are the positions in the query's keys array.
+func buildAggregateParams(dims []pb.AggregateDimension, p sqlc.ListTransactionsParams) sqlc.AggregateTransactionsParams {
+	params := sqlc.AggregateTransactionsParams{
+		UserID:                  p.UserID,
+		Start:                   p.Start,
+		End:                     p.End,
+		AmountMinCents:          p.AmountMinCents,
+		AmountMaxCents:          p.AmountMaxCents,
+		Direction:               p.Direction,
+		AccountIds:              p.AccountIds,
+		Categories:              p.Categories,
+		CategoryPatterns:        p.CategoryPatterns,
+		ExcludeCategoryPatterns: p.ExcludeCategoryPatterns,
+		AccountNames:            p.AccountNames,
+		ExcludeAccountIds:       p.ExcludeAccountIds,
+		ExcludeAccountNames:     p.ExcludeAccountNames,
+		Tags:                    p.Tags,
+		ExcludeTags:             p.ExcludeTags,
+		MerchantQ:               p.MerchantQ,
+		ExcludeMerchantQ:        p.ExcludeMerchantQ,
+		DescQ:                   p.DescQ,
+		Currency:                p.Currency,
+		TodStart:                p.TodStart,
+		TodEnd:                  p.TodEnd,
+		Uncategorized:           p.Uncategorized,
+		Statuses:                p.Statuses,
+	}
+
+	slots := []*int32{&params.Dim1, &params.Dim2, &params.Dim3}
+	for i, d := range dims {
+		*slots[i] = int32(d)
+		switch d {
+		case pb.AggregateDimension_AGGREGATE_DIMENSION_CATEGORY, pb.AggregateDimension_AGGREGATE_DIMENSION_PARENT_CATEGORY:
+			params.SplitParts = true
+		case pb.AggregateDimension_AGGREGATE_DIMENSION_TAG:
+			params.ByTag = true
+		}
+	}
+	return params
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func aggregateRowToPb(r *sqlc.AggregateTransactionsRow, dims int, measures []pb.AggregateMeasure) *pb.AggregateRow {
+	keys := []string{r.Key1, r.Key2, r.Key3}
+	row := &pb.AggregateRow{
+		Keys:     keys[:dims],
+		Currency: r.Currency,
+		Values:   make([]*pb.AggregateValue, len(measures)),
+	}
+
+	amount := func(cents int64) *pb.AggregateValue {
+		return &pb.AggregateValue{Value: &pb.AggregateValue_Amount{Amount: money.FromMinor(cents, r.Currency)}}
+	}
+	for i, m := range measures {
+		switch m {
+		case pb.AggregateMeasure_AGGREGATE_MEASURE_COUNT:
+			row.Values[i] = &pb.AggregateValue{Value: &pb.AggregateValue_Count{Count: r.TransactionCount}}
+		case pb.AggregateMeasure_AGGREGATE_MEASURE_SUM:
+			row.Values[i] = amount(r.SumCents)
+		case pb.AggregateMeasure_AGGREGATE_MEASURE_AVG:
+			row.Values[i] = amount(r.AvgCents)
+		case pb.AggregateMeasure_AGGREGATE_MEASURE_MIN:
+			row.Values[i] = amount(r.MinCents)
+		case pb.AggregateMeasure_AGGREGATE_MEASURE_MAX:
+			row.Values[i] = amount(r.MaxCents)
+		case pb.AggregateMeasure_AGGREGATE_MEASURE_MEDIAN:
+			row.Values[i] = amount(r.MedianCents)
+		}
+	}
+	return row
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+func validateAggregate(dims []pb.AggregateDimension, measures []pb.AggregateMeasure) error {
+	if len(dims) > maxAggregateDimensions {
+		return fmt.Errorf("at most %d dimensions are supported: %w", maxAggregateDimensions, ErrValidation)
+	}
+	seenDims := make(map[pb.AggregateDimension]bool, len(dims))
+	for _, d := range dims {
+		if _, ok := pb.AggregateDimension_name[int32(d)]; !ok || d == pb.AggregateDimension_AGGREGATE_DIMENSION_UNSPECIFIED {
+			return fmt.Errorf("unknown dimension %d: %w", d, ErrValidation)
+		}
+		if seenDims[d] {
+			return fmt.Errorf("dimension %s given twice: %w", d, ErrValidation)
+		}
+		seenDims[d] = true
+	}
+
+	if len(measures) == 0 {
+		return fmt.Errorf("at least one measure is required: %w", ErrValidation)
+	}
+	for _, m := range measures {
+		if _, ok := pb.AggregateMeasure_name[int32(m)]; !ok || m == pb.AggregateMeasure_AGGREGATE_MEASURE_UNSPECIFIED {
+			return fmt.Errorf("unknown measure %d: %w", m, ErrValidation)
+		}
+	}
+	return nil
+}
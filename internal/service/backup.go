@@ -82,6 +82,7 @@ func (s *backupSvc) BackupToProto(b *backup.Backup) *pb.Backup {
 			UserNotes:     tx.UserNotes,
 			ForeignAmount: tx.ForeignAmount,
 			ExchangeRate:  tx.ExchangeRate,
+			Status:        tx.Status,
 		}
 	}
 
@@ -156,6 +157,7 @@ func (s *backupSvc) BackupFromProto(pbBackup *pb.Backup) *backup.Backup {
 			UserNotes:     tx.UserNotes,
 			ForeignAmount: tx.ForeignAmount,
 			ExchangeRate:  tx.ExchangeRate,
+			Status:        tx.Status,
 		}
 	}
 
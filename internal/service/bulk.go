@@ -0,0 +1,245 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ----- methods -----------------------------------------------------------------------------
+
+// UpdateByFilter sets category, merchant, notes and/or account on every
+// transaction matching the filter and returns how many were changed, or on a
+// dry run how many match.
+func (s *txnSvc) UpdateByFilter(ctx context.Context, userID uuid.UUID, req *pb.UpdateTransactionsByFilterRequest) (int64, error) {
+	if req.CategoryId == nil && req.Merchant == nil && req.UserNotes == nil && req.AccountId == nil {
+		return 0, fmt.Errorf("TransactionService.UpdateByFilter: set at least one of category_id, merchant, user_notes or account_id: %w", ErrValidation)
+	}
+
+	matches, err := s.matchFilter(ctx, userID, req.GetFilter(), req.GetConfirmAll() || req.GetDryRun())
+	if err != nil {
+		return 0, fmt.Errorf("TransactionService.UpdateByFilter.Match: %w", err)
+	}
+
+	if req.AccountId != nil {
+		account, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{UserID: userID, ID: req.GetAccountId()})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("TransactionService.UpdateByFilter: account %d not found: %w", req.GetAccountId(), ErrValidation)
+		}
+		if err != nil {
+			return 0, wrapErr("TransactionService.UpdateByFilter.GetAccount", err)
+		}
+		for _, m := range matches {
+			if m.TxCurrency != account.Account.AnchorCurrency {
+				return 0, fmt.Errorf("TransactionService.UpdateByFilter: transaction %d is in %s, account %d in %s: %w",
+					m.ID, m.TxCurrency, account.Account.ID, account.Account.AnchorCurrency, ErrValidation)
+			}
+		}
+	}
+
+	if req.GetDryRun() || len(matches) == 0 {
+		return int64(len(matches)), nil
+	}
+
+	ids := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+
+	actor := actorFromContext(ctx, userID)
+	var updated int64
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		rows, err := q.BulkUpdateTransactions(ctx, sqlc.BulkUpdateTransactionsParams{
+			CategoryID:     req.CategoryId,
+			Merchant:       req.Merchant,
+			UserNotes:      req.UserNotes,
+			AccountID:      req.AccountId,
+			TransactionIds: ids,
+			UserID:         userID,
+		})
+		if err != nil {
+			return err
+		}
+		updated = int64(len(rows))
+
+		var changes []fieldChange
+		add := func(txID int64, field, oldValue, newValue string) {
+			if oldValue != newValue {
+				changes = append(changes, fieldChange{txID: txID, field: field, oldValue: oldValue, newValue: newValue, actor: actor})
+			}
+		}
+		for _, r := range rows {
+			add(r.ID, "account_id", strconv.FormatInt(r.OldAccountID, 10), strconv.FormatInt(r.AccountID, 10))
+			add(r.ID, "category_id", formatOptionalInt(r.OldCategoryID), formatOptionalInt(r.CategoryID))
+			add(r.ID, "merchant", deref(r.OldMerchant), deref(r.Merchant))
+			add(r.ID, "user_notes", deref(r.OldUserNotes), deref(r.UserNotes))
+		}
+		if err := recordHistory(ctx, q, changes); err != nil {
+			return err
+		}
+
+		// the merchant follows the text, and aliases belong to the account owner
+		if req.Merchant != nil || req.AccountId != nil {
+			updatedIDs := make([]int64, len(rows))
+			for i, r := range rows {
+				updatedIDs[i] = r.ID
+			}
+			if err := resolveMerchants(ctx, q, actor, sqlc.ResolveTransactionMerchantsParams{TransactionIds: updatedIDs}); err != nil {
+				return err
+			}
+		}
+
+		if req.AccountId == nil {
+			return nil
+		}
+		accountIDs := []int64{req.GetAccountId()}
+		for _, r := range rows {
+			accountIDs = append(accountIDs, r.OldAccountID)
+		}
+		return syncAccounts(ctx, q, accountIDs)
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.UpdateByFilter", err)
+	}
+
+	s.log.Info("updated transactions by filter", "user_id", userID, "count", updated)
+	return updated, nil
+}
+
+// DeleteByFilter moves every transaction matching the filter to the trash and
+// returns how many were deleted, or on a dry run how many match.
+func (s *txnSvc) DeleteByFilter(ctx context.Context, userID uuid.UUID, req *pb.DeleteTransactionsByFilterRequest) (int64, error) {
+	matches, err := s.matchFilter(ctx, userID, req.GetFilter(), req.GetConfirmAll() || req.GetDryRun())
+	if err != nil {
+		return 0, fmt.Errorf("TransactionService.DeleteByFilter.Match: %w", err)
+	}
+	if req.GetDryRun() || len(matches) == 0 {
+		return int64(len(matches)), nil
+	}
+
+	ids := make([]int64, len(matches))
+	accountIDs := make([]int64, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+		accountIDs[i] = m.AccountID
+	}
+
+	var deleted int64
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		deleted, err = q.BulkDeleteTransactions(ctx, sqlc.BulkDeleteTransactionsParams{
+			UserID:         userID,
+			TransactionIds: ids,
+		})
+		if err != nil {
+			return err
+		}
+		return syncAccounts(ctx, q, accountIDs)
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.DeleteByFilter", err)
+	}
+
+	s.log.Info("deleted transactions by filter", "user_id", userID, "count", deleted)
+	return deleted, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+func filterToListRequest(f *pb.TransactionFilter) *pb.ListTransactionsRequest {
+	return &pb.ListTransactionsRequest{
+		StartDate:        f.GetStartDate(),
+		EndDate:          f.GetEndDate(),
+		AmountMin:        f.GetAmountMin(),
+		AmountMax:        f.GetAmountMax(),
+		Direction:        f.Direction,
+		AccountIds:       f.GetAccountIds(),
+		Categories:       f.GetCategories(),
+		MerchantQuery:    f.MerchantQuery,
+		DescriptionQuery: f.DescriptionQuery,
+		Currency:         f.Currency,
+		TimeOfDayStart:   f.GetTimeOfDayStart(),
+		TimeOfDayEnd:     f.GetTimeOfDayEnd(),
+		Uncategorized:    f.Uncategorized,
+		Tags:             f.GetTags(),
+		Filter:           f.Filter,
+		Statuses:         f.GetStatuses(),
+	}
+}
+
+func buildTxIDsByFilterParams(p sqlc.ListTransactionsParams) sqlc.ListTransactionIDsByFilterParams {
+	return sqlc.ListTransactionIDsByFilterParams{
+		UserID:                  p.UserID,
+		Start:                   p.Start,
+		End:                     p.End,
+		AmountMinCents:          p.AmountMinCents,
+		AmountMaxCents:          p.AmountMaxCents,
+		Direction:               p.Direction,
+		AccountIds:              p.AccountIds,
+		Categories:              p.Categories,
+		CategoryPatterns:        p.CategoryPatterns,
+		ExcludeCategoryPatterns: p.ExcludeCategoryPatterns,
+		AccountNames:            p.AccountNames,
+		ExcludeAccountIds:       p.ExcludeAccountIds,
+		ExcludeAccountNames:     p.ExcludeAccountNames,
+		Tags:                    p.Tags,
+		ExcludeTags:             p.ExcludeTags,
+		MerchantQ:               p.MerchantQ,
+		ExcludeMerchantQ:        p.ExcludeMerchantQ,
+		DescQ:                   p.DescQ,
+		Currency:                p.Currency,
+		TodStart:                p.TodStart,
+		TodEnd:                  p.TodEnd,
+		Uncategorized:           p.Uncategorized,
+		Statuses:                p.Statuses,
+	}
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// matchFilter lists the transactions a bulk operation applies to. A filter
+// without any condition is rejected unless confirmAll is set, so a forgotten
+// field can't touch every transaction the user has. Statuses alone don't
+// scope a filter, nearly every transaction is posted.
+func (s *txnSvc) matchFilter(ctx context.Context, userID uuid.UUID, f *pb.TransactionFilter, confirmAll bool) ([]sqlc.ListTransactionIDsByFilterRow, error) {
+	if f == nil {
+		return nil, fmt.Errorf("filter is required: %w", ErrValidation)
+	}
+
+	params, err := s.listTxParams(ctx, userID, filterToListRequest(f))
+	if err != nil {
+		return nil, err
+	}
+	conditions := params
+	conditions.Statuses = nil
+	if !confirmAll && reflect.DeepEqual(conditions, sqlc.ListTransactionsParams{UserID: userID}) {
+		return nil, fmt.Errorf("filter matches every transaction, set confirm_all to proceed: %w", ErrValidation)
+	}
+
+	rows, err := s.queries.ListTransactionIDsByFilter(ctx, buildTxIDsByFilterParams(params))
+	if err != nil {
+		return nil, wrapErr("TransactionService.ListByFilter", err)
+	}
+	return rows, nil
+}
+
+// syncAccounts resyncs the running balances of each account once.
+func syncAccounts(ctx context.Context, q *sqlc.Queries, accountIDs []int64) error {
+	synced := make(map[int64]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		if synced[id] {
+			continue
+		}
+		synced[id] = true
+		if err := q.SyncAccountBalances(ctx, id); err != nil {
+			return fmt.Errorf("sync balances for account %d: %w", id, err)
+		}
+	}
+	return nil
+}
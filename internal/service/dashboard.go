@@ -3,12 +3,13 @@ package service
 import (
 	"ariand/internal/db/sqlc"
 	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
 	"context"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
-	"google.golang.org/genproto/googleapis/type/money"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
 )
 
 // ----- types -------------------------------------------------------------------------------
@@ -49,6 +50,7 @@ type CategorySpendingResult struct {
 	PreviousPeriod PeriodInfo
 	Current        []sqlc.GetTopCategoriesRow
 	Previous       []sqlc.GetTopCategoriesRow
+	Currency       string
 }
 
 type NetWorthHistoryParams struct {
@@ -61,19 +63,21 @@ type NetWorthHistoryParams struct {
 // ----- interface ---------------------------------------------------------------------------
 
 type DashboardService interface {
-	Balance(ctx context.Context, userID uuid.UUID) (*money.Money, error)
-	Debt(ctx context.Context, userID uuid.UUID) (*money.Money, error)
-	NetBalance(ctx context.Context, userID uuid.UUID) (*money.Money, error)
+	Balance(ctx context.Context, userID uuid.UUID) (*moneypb.Money, error)
+	Debt(ctx context.Context, userID uuid.UUID) (*moneypb.Money, error)
+	NetBalance(ctx context.Context, userID uuid.UUID) (*moneypb.Money, error)
 	Trends(ctx context.Context, userID uuid.UUID, req *pb.GetSpendingTrendsRequest) ([]*pb.TrendPoint, error)
 	Summary(ctx context.Context, userID uuid.UUID, req *pb.GetDashboardSummaryRequest) (*pb.DashboardSummary, error)
 	MonthlyComparison(ctx context.Context, userID uuid.UUID, monthsBack int32) ([]*pb.MonthlyComparison, error)
 	TopCategories(ctx context.Context, userID uuid.UUID, req *pb.GetTopCategoriesRequest) ([]*pb.TopCategory, error)
+	TopTags(ctx context.Context, userID uuid.UUID, req *pb.GetTopTagsRequest) ([]*pb.TopTag, error)
 	TopMerchants(ctx context.Context, userID uuid.UUID, req *pb.GetTopMerchantsRequest) ([]*pb.TopMerchant, error)
 	AccountBalances(ctx context.Context, userID uuid.UUID) ([]*pb.AccountBalance, error)
 	GetSpendingTrends(ctx context.Context, userID uuid.UUID, startDate string, endDate string, categoryID *int64, accountID *int64) ([]*pb.TrendPoint, error)
 	GetCategorySpendingComparison(ctx context.Context, params CategorySpendingParams) (*CategorySpendingResult, error)
 	GetNetWorthHistory(ctx context.Context, params NetWorthHistoryParams) ([]*pb.NetWorthPoint, error)
 	GetEarliestTransactionDate(ctx context.Context, userID uuid.UUID) (time.Time, error)
+	ProjectedBalances(ctx context.Context, userID uuid.UUID, days *int32) ([]*pb.AccountProjection, error)
 }
 
 type dashSvc struct {
@@ -86,7 +90,7 @@ func newDashSvc(queries *sqlc.Queries) DashboardService {
 
 // ----- methods -----------------------------------------------------------------------------
 
-func (s *dashSvc) Balance(ctx context.Context, userID uuid.UUID) (*money.Money, error) {
+func (s *dashSvc) Balance(ctx context.Context, userID uuid.UUID) (*moneypb.Money, error) {
 	balances, err := s.queries.GetAccountBalances(ctx, userID)
 	if err != nil {
 		return nil, wrapErr("DashboardService.Balance", err)
@@ -102,7 +106,7 @@ func (s *dashSvc) Balance(ctx context.Context, userID uuid.UUID) (*money.Money,
 	return s.centsToMoney(ctx, userID, totalCents)
 }
 
-func (s *dashSvc) Debt(ctx context.Context, userID uuid.UUID) (*money.Money, error) {
+func (s *dashSvc) Debt(ctx context.Context, userID uuid.UUID) (*moneypb.Money, error) {
 	balances, err := s.queries.GetAccountBalances(ctx, userID)
 	if err != nil {
 		return nil, wrapErr("DashboardService.Debt", err)
@@ -133,9 +137,11 @@ func (s *dashSvc) Trends(ctx context.Context, userID uuid.UUID, req *pb.GetSpend
 		return nil, wrapErr("DashboardService.Trends", err)
 	}
 
+	currency := s.getUserPrimaryCurrency(ctx, userID)
+
 	result := make([]*pb.TrendPoint, len(trends))
 	for i, trend := range trends {
-		result[i] = trendPointToPb(&trend)
+		result[i] = trendPointToPb(&trend, currency)
 	}
 	return result, nil
 }
@@ -146,7 +152,9 @@ func (s *dashSvc) Summary(ctx context.Context, userID uuid.UUID, req *pb.GetDash
 	if err != nil {
 		return nil, wrapErr("DashboardService.Summary", err)
 	}
-	return dashboardSummaryToPb(&summary), nil
+
+	currency := s.getUserPrimaryCurrency(ctx, userID)
+	return dashboardSummaryToPb(&summary, currency), nil
 }
 
 func (s *dashSvc) MonthlyComparison(ctx context.Context, userID uuid.UUID, monthsBack int32) ([]*pb.MonthlyComparison, error) {
@@ -156,9 +164,11 @@ func (s *dashSvc) MonthlyComparison(ctx context.Context, userID uuid.UUID, month
 		return nil, wrapErr("DashboardService.MonthlyComparison", err)
 	}
 
+	currency := s.getUserPrimaryCurrency(ctx, userID)
+
 	result := make([]*pb.MonthlyComparison, len(comparison))
 	for i, comp := range comparison {
-		result[i] = monthlyComparisonToPb(&comp)
+		result[i] = monthlyComparisonToPb(&comp, currency)
 	}
 	return result, nil
 }
@@ -170,9 +180,27 @@ func (s *dashSvc) TopCategories(ctx context.Context, userID uuid.UUID, req *pb.G
 		return nil, wrapErr("DashboardService.TopCategories", err)
 	}
 
+	currency := s.getUserPrimaryCurrency(ctx, userID)
+
 	result := make([]*pb.TopCategory, len(categories))
 	for i, cat := range categories {
-		result[i] = topCategoryToPb(&cat)
+		result[i] = topCategoryToPb(&cat, currency)
+	}
+	return result, nil
+}
+
+func (s *dashSvc) TopTags(ctx context.Context, userID uuid.UUID, req *pb.GetTopTagsRequest) ([]*pb.TopTag, error) {
+	params := buildTopTagsParams(userID, req)
+	tags, err := s.queries.GetTopTags(ctx, params)
+	if err != nil {
+		return nil, wrapErr("DashboardService.TopTags", err)
+	}
+
+	currency := s.getUserPrimaryCurrency(ctx, userID)
+
+	result := make([]*pb.TopTag, len(tags))
+	for i, tag := range tags {
+		result[i] = topTagToPb(&tag, currency)
 	}
 	return result, nil
 }
@@ -184,14 +212,16 @@ func (s *dashSvc) TopMerchants(ctx context.Context, userID uuid.UUID, req *pb.Ge
 		return nil, wrapErr("DashboardService.TopMerchants", err)
 	}
 
+	currency := s.getUserPrimaryCurrency(ctx, userID)
+
 	result := make([]*pb.TopMerchant, len(merchants))
 	for i, merchant := range merchants {
-		result[i] = topMerchantToPb(&merchant)
+		result[i] = topMerchantToPb(&merchant, currency)
 	}
 	return result, nil
 }
 
-func (s *dashSvc) NetBalance(ctx context.Context, userID uuid.UUID) (*money.Money, error) {
+func (s *dashSvc) NetBalance(ctx context.Context, userID uuid.UUID) (*moneypb.Money, error) {
 	balances, err := s.queries.GetAccountBalances(ctx, userID)
 	if err != nil {
 		return nil, wrapErr("DashboardService.NetBalance", err)
@@ -283,6 +313,7 @@ func (s *dashSvc) GetCategorySpendingComparison(
 	}
 
 	return &CategorySpendingResult{
+		Currency: s.getUserPrimaryCurrency(ctx, params.UserID),
 		CurrentPeriod: PeriodInfo{
 			StartDate: periods.currentStart.Format("2006-01-02"),
 			EndDate:   periods.currentEnd.Format("2006-01-02"),
@@ -403,32 +434,15 @@ func (s *dashSvc) calculatePeriods(params CategorySpendingParams, now time.Time,
 // getUserPrimaryCurrency retrieves the user's primary currency
 // Falls back to CAD if not found or on error
 func (s *dashSvc) getUserPrimaryCurrency(ctx context.Context, userID uuid.UUID) string {
-	currency, err := s.queries.GetUserPrimaryCurrency(ctx, userID)
-	if err != nil {
-		return "CAD"
-	}
-	return currency
+	return userCurrency(ctx, s.queries, userID)
 }
 
 func (s *dashSvc) getUserLocation(ctx context.Context, userID uuid.UUID) *time.Location {
-	timezone, err := s.queries.GetUserTimezone(ctx, userID)
-	if err != nil {
-		return time.UTC
-	}
-	loc, err := time.LoadLocation(timezone)
-	if err != nil {
-		return time.UTC
-	}
-	return loc
+	return userLocation(ctx, s.queries, userID)
 }
 
-func (s *dashSvc) centsToMoney(ctx context.Context, userID uuid.UUID, cents int64) (*money.Money, error) {
-	currency := s.getUserPrimaryCurrency(ctx, userID)
-	return &money.Money{
-		CurrencyCode: currency,
-		Units:        cents / 100,
-		Nanos:        int32((cents % 100) * 10_000_000),
-	}, nil
+func (s *dashSvc) centsToMoney(ctx context.Context, userID uuid.UUID, cents int64) (*moneypb.Money, error) {
+	return money.FromMinor(cents, s.getUserPrimaryCurrency(ctx, userID)), nil
 }
 
 func (s *dashSvc) GetNetWorthHistory(
@@ -485,6 +499,15 @@ func buildTopCategoriesParams(userID uuid.UUID, req *pb.GetTopCategoriesRequest)
 	}
 }
 
+func buildTopTagsParams(userID uuid.UUID, req *pb.GetTopTagsRequest) sqlc.GetTopTagsParams {
+	return sqlc.GetTopTagsParams{
+		UserID: userID,
+		Start:  dateToTime(req.StartDate),
+		End:    dateToTime(req.EndDate),
+		Limit:  req.Limit,
+	}
+}
+
 func buildTopMerchantsParams(userID uuid.UUID, req *pb.GetTopMerchantsRequest) sqlc.GetTopMerchantsParams {
 	return sqlc.GetTopMerchantsParams{
 		UserID: userID,
@@ -507,7 +530,7 @@ func buildMonthlyComparisonParams(userID uuid.UUID, monthsBack int32) sqlc.GetMo
 
 // ----- conversion helpers ------------------------------------------------------------------
 
-func trendPointToPb(trend *sqlc.GetDashboardTrendsRow) *pb.TrendPoint {
+func trendPointToPb(trend *sqlc.GetDashboardTrendsRow, currency string) *pb.TrendPoint {
 	if trend == nil {
 		return nil
 	}
@@ -516,25 +539,25 @@ func trendPointToPb(trend *sqlc.GetDashboardTrendsRow) *pb.TrendPoint {
 	trendDate, _ := time.Parse("2006-01-02", trend.Date)
 	return &pb.TrendPoint{
 		Date:     timeToDate(trendDate),
-		Income:   centsToMoney(trend.IncomeCents, "CAD"),
-		Expenses: centsToMoney(trend.ExpenseCents, "CAD"),
+		Income:   money.FromMinor(trend.IncomeCents, currency),
+		Expenses: money.FromMinor(trend.ExpenseCents, currency),
 	}
 }
 
-func monthlyComparisonToPb(comp *sqlc.GetMonthlyComparisonRow) *pb.MonthlyComparison {
+func monthlyComparisonToPb(comp *sqlc.GetMonthlyComparisonRow, currency string) *pb.MonthlyComparison {
 	if comp == nil {
 		return nil
 	}
 
 	return &pb.MonthlyComparison{
 		Month:    comp.Month,
-		Income:   centsToMoney(comp.IncomeCents, "CAD"),
-		Expenses: centsToMoney(comp.ExpenseCents, "CAD"),
-		Net:      centsToMoney(comp.NetCents, "CAD"),
+		Income:   money.FromMinor(comp.IncomeCents, currency),
+		Expenses: money.FromMinor(comp.ExpenseCents, currency),
+		Net:      money.FromMinor(comp.NetCents, currency),
 	}
 }
 
-func topCategoryToPb(cat *sqlc.GetTopCategoriesRow) *pb.TopCategory {
+func topCategoryToPb(cat *sqlc.GetTopCategoriesRow, currency string) *pb.TopCategory {
 	if cat == nil {
 		return nil
 	}
@@ -543,25 +566,34 @@ func topCategoryToPb(cat *sqlc.GetTopCategoriesRow) *pb.TopCategory {
 		Slug:             cat.Slug,
 		Color:            cat.Color,
 		TransactionCount: cat.TransactionCount,
-		TotalAmount:      centsToMoney(cat.TotalAmountCents, "CAD"),
+		TotalAmount:      money.FromMinor(cat.TotalAmountCents, currency),
 	}
 }
 
-func topMerchantToPb(merchant *sqlc.GetTopMerchantsRow) *pb.TopMerchant {
-	if merchant == nil {
+func topTagToPb(tag *sqlc.GetTopTagsRow, currency string) *pb.TopTag {
+	if tag == nil {
 		return nil
 	}
 
-	merchantName := ""
-	if merchant.Merchant != nil {
-		merchantName = *merchant.Merchant
+	return &pb.TopTag{
+		Slug:             tag.Slug,
+		Color:            tag.Color,
+		TransactionCount: tag.TransactionCount,
+		TotalAmount:      money.FromMinor(tag.TotalAmountCents, currency),
+	}
+}
+
+func topMerchantToPb(merchant *sqlc.GetTopMerchantsRow, currency string) *pb.TopMerchant {
+	if merchant == nil {
+		return nil
 	}
 
 	return &pb.TopMerchant{
-		Merchant:         merchantName,
+		MerchantId:       merchant.MerchantID,
+		Merchant:         merchant.Merchant,
 		TransactionCount: merchant.TransactionCount,
-		TotalAmount:      centsToMoney(merchant.TotalAmountCents, "CAD"),
-		AvgAmount:        centsToMoney(merchant.AvgAmountCents, "CAD"),
+		TotalAmount:      money.FromMinor(merchant.TotalAmountCents, currency),
+		AvgAmount:        money.FromMinor(merchant.AvgAmountCents, currency),
 	}
 }
 
@@ -574,12 +606,12 @@ func accountBalanceToPb(row *sqlc.GetAccountBalancesRow) *pb.AccountBalance {
 		Id:             row.ID,
 		Name:           row.Name,
 		AccountType:    pb.AccountType(row.AccountType),
-		CurrentBalance: centsToMoney(row.BalanceCents, row.Currency),
+		CurrentBalance: money.FromMinor(row.BalanceCents, row.Currency),
 		Currency:       row.Currency,
 	}
 }
 
-func dashboardSummaryToPb(summary *sqlc.GetDashboardSummaryRow) *pb.DashboardSummary {
+func dashboardSummaryToPb(summary *sqlc.GetDashboardSummaryRow, currency string) *pb.DashboardSummary {
 	if summary == nil {
 		return nil
 	}
@@ -587,8 +619,8 @@ func dashboardSummaryToPb(summary *sqlc.GetDashboardSummaryRow) *pb.DashboardSum
 	return &pb.DashboardSummary{
 		TotalAccounts:             summary.TotalAccounts,
 		TotalTransactions:         summary.TotalTransactions,
-		TotalIncome:               centsToMoney(summary.TotalIncomeCents, "CAD"),
-		TotalExpenses:             centsToMoney(summary.TotalExpenseCents, "CAD"),
+		TotalIncome:               money.FromMinor(summary.TotalIncomeCents, currency),
+		TotalExpenses:             money.FromMinor(summary.TotalExpenseCents, currency),
 		UncategorizedTransactions: summary.UncategorizedTransactions,
 	}
 }
@@ -602,7 +634,7 @@ func netWorthPointToPb(row *sqlc.GetNetWorthHistoryRow, currency string) *pb.Net
 	pointDate, _ := time.Parse("2006-01-02", row.Date)
 	return &pb.NetWorthPoint{
 		Date:     timeToDate(pointDate),
-		NetWorth: centsToMoney(row.NetWorthCents, currency),
+		NetWorth: money.FromMinor(row.NetWorthCents, currency),
 	}
 }
 
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	actorUser     int16 = 1
+	actorInternal int16 = 2
+	actorRule     int16 = 3
+)
+
+type internalActorKey struct{}
+
+// WithInternalActor marks changes made under ctx as coming from an internal
+// service (the email parser and friends) rather than the user they act for.
+func WithInternalActor(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internalActorKey{}, true)
+}
+
+// changeActor is who made a change: a user, an internal service acting for a
+// user, or a rule.
+type changeActor struct {
+	kind   int16
+	userID uuid.UUID
+	ruleID uuid.UUID
+}
+
+func actorFromContext(ctx context.Context, userID uuid.UUID) changeActor {
+	if internal, _ := ctx.Value(internalActorKey{}).(bool); internal {
+		return changeActor{kind: actorInternal, userID: userID}
+	}
+	return changeActor{kind: actorUser, userID: userID}
+}
+
+func ruleActor(ruleID uuid.UUID) changeActor {
+	return changeActor{kind: actorRule, ruleID: ruleID}
+}
+
+type fieldChange struct {
+	txID     int64
+	field    string
+	oldValue string
+	newValue string
+	actor    changeActor
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *txnSvc) GetHistory(ctx context.Context, userID uuid.UUID, txID int64) ([]*pb.TransactionChange, error) {
+	// surfaces not-found for transactions the user can't see
+	if _, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: txID}); err != nil {
+		return nil, wrapErr("TransactionService.GetHistory.GetTransaction", err)
+	}
+
+	rows, err := s.queries.ListTransactionHistory(ctx, sqlc.ListTransactionHistoryParams{
+		UserID:        userID,
+		TransactionID: txID,
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.GetHistory", err)
+	}
+
+	result := make([]*pb.TransactionChange, len(rows))
+	for i := range rows {
+		result[i] = transactionChangeToPb(&rows[i])
+	}
+	return result, nil
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// recordHistory appends changes to the history log, one insert per actor.
+// Call it inside the database transaction that made the changes.
+func recordHistory(ctx context.Context, q *sqlc.Queries, changes []fieldChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	byActor := make(map[changeActor]*sqlc.InsertTransactionHistoryParams)
+	var order []changeActor
+	for _, c := range changes {
+		params, ok := byActor[c.actor]
+		if !ok {
+			params = &sqlc.InsertTransactionHistoryParams{ActorType: c.actor.kind}
+			if c.actor.userID != uuid.Nil {
+				params.ActorUserID = &c.actor.userID
+			}
+			if c.actor.ruleID != uuid.Nil {
+				params.ActorRuleID = &c.actor.ruleID
+			}
+			byActor[c.actor] = params
+			order = append(order, c.actor)
+		}
+		params.TransactionIds = append(params.TransactionIds, c.txID)
+		params.Fields = append(params.Fields, c.field)
+		params.OldValues = append(params.OldValues, c.oldValue)
+		params.NewValues = append(params.NewValues, c.newValue)
+	}
+
+	for _, actor := range order {
+		if err := q.InsertTransactionHistory(ctx, *byActor[actor]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffTransactions lists the user-facing fields that differ between two
+// versions of a transaction. Bookkeeping columns (balances, flags, timestamps)
+// are left out.
+func diffTransactions(before, after *sqlc.Transaction, actor changeActor) []fieldChange {
+	var changes []fieldChange
+	add := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, fieldChange{
+				txID:     after.ID,
+				field:    field,
+				oldValue: oldValue,
+				newValue: newValue,
+				actor:    actor,
+			})
+		}
+	}
+
+	add("account_id", strconv.FormatInt(before.AccountID, 10), strconv.FormatInt(after.AccountID, 10))
+	add("tx_date", before.TxDate.UTC().Format(time.RFC3339), after.TxDate.UTC().Format(time.RFC3339))
+	add("tx_amount_cents", strconv.FormatInt(before.TxAmountCents, 10), strconv.FormatInt(after.TxAmountCents, 10))
+	add("tx_currency", before.TxCurrency, after.TxCurrency)
+	add("tx_direction", strconv.Itoa(int(before.TxDirection)), strconv.Itoa(int(after.TxDirection)))
+	add("tx_desc", deref(before.TxDesc), deref(after.TxDesc))
+	add("category_id", formatOptionalInt(before.CategoryID), formatOptionalInt(after.CategoryID))
+	add("merchant", deref(before.Merchant), deref(after.Merchant))
+	add("merchant_id", formatOptionalInt(before.MerchantID), formatOptionalInt(after.MerchantID))
+	add("user_notes", deref(before.UserNotes), deref(after.UserNotes))
+	add("foreign_amount_cents", formatOptionalInt(before.ForeignAmountCents), formatOptionalInt(after.ForeignAmountCents))
+	add("foreign_currency", deref(before.ForeignCurrency), deref(after.ForeignCurrency))
+	add("exchange_rate", formatOptionalFloat(before.ExchangeRate), formatOptionalFloat(after.ExchangeRate))
+	add("status", strconv.Itoa(int(before.Status)), strconv.Itoa(int(after.Status)))
+
+	return changes
+}
+
+// ruleChanges records what a rule run did to category and merchant. The two
+// can come from different rules.
+func ruleChanges(
+	txID int64,
+	oldCategoryID, newCategoryID *int64,
+	oldMerchant, newMerchant *string,
+	categoryRuleID, merchantRuleID uuid.UUID,
+) []fieldChange {
+	var changes []fieldChange
+	if oldCategory, newCategory := formatOptionalInt(oldCategoryID), formatOptionalInt(newCategoryID); oldCategory != newCategory {
+		changes = append(changes, fieldChange{
+			txID:     txID,
+			field:    "category_id",
+			oldValue: oldCategory,
+			newValue: newCategory,
+			actor:    ruleActor(categoryRuleID),
+		})
+	}
+	if deref(oldMerchant) != deref(newMerchant) {
+		changes = append(changes, fieldChange{
+			txID:     txID,
+			field:    "merchant",
+			oldValue: deref(oldMerchant),
+			newValue: deref(newMerchant),
+			actor:    ruleActor(merchantRuleID),
+		})
+	}
+	return changes
+}
+
+func formatOptionalInt(v *int64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatInt(*v, 10)
+}
+
+func formatOptionalFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func transactionChangeToPb(h *sqlc.TransactionHistory) *pb.TransactionChange {
+	change := &pb.TransactionChange{
+		Id:            h.ID,
+		TransactionId: h.TransactionID,
+		Field:         h.Field,
+		OldValue:      h.OldValue,
+		NewValue:      h.NewValue,
+		ActorType:     pb.ChangeActorType(h.ActorType),
+		ChangedAt:     timestamppb.New(h.ChangedAt),
+	}
+	if h.ActorUserID != nil {
+		id := h.ActorUserID.String()
+		change.ActorUserId = &id
+	}
+	if h.ActorRuleID != nil {
+		id := h.ActorRuleID.String()
+		change.ActorRuleId = &id
+	}
+	return change
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/importer"
+	"ariand/internal/money"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *txnSvc) Import(ctx context.Context, userID uuid.UUID, req *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error) {
+	format, err := statementFormatFromPb(req.GetFormat())
+	if err != nil {
+		return nil, fmt.Errorf("TransactionService.Import: %w", err)
+	}
+
+	account, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{
+		UserID: userID,
+		ID:     req.GetAccountId(),
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.Import.GetAccount", err)
+	}
+
+	mapping, err := s.resolveImportMapping(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := userLocation(ctx, s.queries, userID)
+
+	entries, err := importer.Parse(format, bytes.NewReader(req.GetContent()), importer.Options{
+		UserID:    userID,
+		AccountID: account.Account.ID,
+		Currency:  account.Account.AnchorCurrency,
+		Location:  loc,
+		Mapping:   mapping,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("TransactionService.Import: %v: %w", err, ErrValidation)
+	}
+
+	if err := s.markImportDuplicates(ctx, userID, account.Account.ID, entries, loc); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ImportStatementResponse{
+		Rows:        make([]*pb.ImportRow, len(entries)),
+		ParsedCount: int32(len(entries)),
+	}
+
+	var (
+		fresh      []sqlc.CreateTransactionParams
+		freshIndex []int
+	)
+	for i, entry := range entries {
+		row := importEntryToPb(entry)
+		resp.Rows[i] = row
+
+		switch row.Status {
+		case pb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID:
+			resp.InvalidCount++
+		case pb.ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE:
+			resp.DuplicateCount++
+		case pb.ImportRowStatus_IMPORT_ROW_STATUS_NEW:
+			fresh = append(fresh, entry.Params)
+			freshIndex = append(freshIndex, i)
+		}
+	}
+
+	if req.GetPreviewOnly() || len(fresh) == 0 {
+		return resp, nil
+	}
+
+	results, err := s.createFromParams(ctx, userID, fresh, false)
+	if err != nil {
+		return nil, fmt.Errorf("TransactionService.Import: %w", err)
+	}
+
+	for i, result := range results {
+		row := resp.Rows[freshIndex[i]]
+		row.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_CREATED
+		row.TransactionId = &result.Transaction.Id
+		resp.Transactions = append(resp.Transactions, result.Transaction)
+	}
+	resp.CreatedCount = int32(len(resp.Transactions))
+
+	s.log.Info("imported statement",
+		"account_id", account.Account.ID,
+		"format", format.String(),
+		"created", resp.CreatedCount,
+		"duplicates", resp.DuplicateCount,
+		"invalid", resp.InvalidCount,
+	)
+
+	return resp, nil
+}
+
+func (s *txnSvc) ListImportProfiles(ctx context.Context, userID uuid.UUID) ([]*pb.ImportProfile, error) {
+	rows, err := s.queries.ListImportProfiles(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("TransactionService.ListImportProfiles", err)
+	}
+
+	result := make([]*pb.ImportProfile, 0, len(rows))
+	for i := range rows {
+		profile, err := importProfileToPb(&rows[i])
+		if err != nil {
+			return nil, wrapErr("TransactionService.ListImportProfiles", err)
+		}
+		result = append(result, profile)
+	}
+
+	return result, nil
+}
+
+func (s *txnSvc) SaveImportProfile(ctx context.Context, userID uuid.UUID, req *pb.SaveImportProfileRequest) (*pb.ImportProfile, error) {
+	mapping := mappingFromPb(req.GetMapping())
+	if mapping == nil {
+		return nil, fmt.Errorf("TransactionService.SaveImportProfile: mapping is required: %w", ErrValidation)
+	}
+	if err := mapping.Validate(); err != nil {
+		return nil, fmt.Errorf("TransactionService.SaveImportProfile: %v: %w", err, ErrValidation)
+	}
+
+	raw, err := json.Marshal(mapping)
+	if err != nil {
+		return nil, wrapErr("TransactionService.SaveImportProfile.Marshal", err)
+	}
+
+	row, err := s.queries.UpsertImportProfile(ctx, sqlc.UpsertImportProfileParams{
+		UserID:  userID,
+		Name:    req.GetName(),
+		Bank:    req.Bank,
+		Mapping: raw,
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.SaveImportProfile", err)
+	}
+
+	profile, err := importProfileToPb(&row)
+	if err != nil {
+		return nil, wrapErr("TransactionService.SaveImportProfile", err)
+	}
+
+	return profile, nil
+}
+
+func (s *txnSvc) DeleteImportProfile(ctx context.Context, userID uuid.UUID, name string) (int64, error) {
+	affected, err := s.queries.DeleteImportProfile(ctx, sqlc.DeleteImportProfileParams{
+		UserID: userID,
+		Name:   name,
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.DeleteImportProfile", err)
+	}
+	return affected, nil
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+func (s *txnSvc) resolveImportMapping(ctx context.Context, userID uuid.UUID, req *pb.ImportStatementRequest) (*importer.Mapping, error) {
+	if req.Mapping != nil {
+		return mappingFromPb(req.Mapping), nil
+	}
+
+	if req.ProfileName == nil {
+		if req.GetFormat() == pb.StatementFormat_STATEMENT_FORMAT_CSV {
+			return nil, fmt.Errorf("TransactionService.Import: csv import needs a mapping or profile_name: %w", ErrValidation)
+		}
+		return nil, nil
+	}
+
+	profile, err := s.queries.GetImportProfileByName(ctx, sqlc.GetImportProfileByNameParams{
+		UserID: userID,
+		Name:   req.GetProfileName(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("TransactionService.Import: import profile %q not found: %w", req.GetProfileName(), ErrValidation)
+	}
+	if err != nil {
+		return nil, wrapErr("TransactionService.Import.GetProfile", err)
+	}
+
+	var mapping importer.Mapping
+	if err := json.Unmarshal(profile.Mapping, &mapping); err != nil {
+		return nil, wrapErr("TransactionService.Import.DecodeProfile", err)
+	}
+
+	return &mapping, nil
+}
+
+func (s *txnSvc) markImportDuplicates(ctx context.Context, userID uuid.UUID, accountID int64, entries []importer.Entry, loc *time.Location) error {
+	start, end, ok := importer.DateRange(entries)
+	if !ok {
+		return nil
+	}
+
+	rows, err := s.queries.ListTransactionFingerprints(ctx, sqlc.ListTransactionFingerprintsParams{
+		UserID:    userID,
+		AccountID: accountID,
+		Start:     start,
+		End:       end,
+	})
+	if err != nil {
+		return wrapErr("TransactionService.Import.Fingerprints", err)
+	}
+
+	existing := make([]importer.Fingerprint, 0, len(rows))
+	for _, row := range rows {
+		direction := int16(row.TxDirection)
+		existing = append(existing, importer.NewFingerprint(row.TxDate, row.TxAmountCents, row.TxCurrency, direction, loc))
+
+		// converted rows can match on either side of the conversion
+		if row.ForeignAmountCents != nil && row.ForeignCurrency != nil {
+			existing = append(existing, importer.NewFingerprint(row.TxDate, *row.ForeignAmountCents, *row.ForeignCurrency, direction, loc))
+		}
+	}
+
+	importer.MarkDuplicates(entries, existing, loc)
+	return nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func statementFormatFromPb(f pb.StatementFormat) (importer.Format, error) {
+	switch f {
+	case pb.StatementFormat_STATEMENT_FORMAT_CSV:
+		return importer.FormatCSV, nil
+	case pb.StatementFormat_STATEMENT_FORMAT_OFX:
+		return importer.FormatOFX, nil
+	case pb.StatementFormat_STATEMENT_FORMAT_QFX:
+		return importer.FormatQFX, nil
+	case pb.StatementFormat_STATEMENT_FORMAT_QIF:
+		return importer.FormatQIF, nil
+	default:
+		return 0, fmt.Errorf("unsupported statement format %s: %w", f, ErrValidation)
+	}
+}
+
+func importEntryToPb(entry importer.Entry) *pb.ImportRow {
+	row := &pb.ImportRow{
+		Line: int32(entry.Line),
+	}
+
+	if entry.Err != nil {
+		msg := entry.Err.Error()
+		row.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_INVALID
+		row.Error = &msg
+		return row
+	}
+
+	row.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_NEW
+	if entry.Duplicate {
+		row.Status = pb.ImportRowStatus_IMPORT_ROW_STATUS_DUPLICATE
+	}
+
+	p := entry.Params
+	row.TxDate = timestamppb.New(p.TxDate)
+	row.TxAmount = money.FromMinor(p.TxAmountCents, p.TxCurrency)
+	row.Direction = pb.TransactionDirection(p.TxDirection)
+	row.Description = p.TxDesc
+	row.Merchant = p.Merchant
+
+	return row
+}
+
+func mappingFromPb(m *pb.CsvColumnMapping) *importer.Mapping {
+	if m == nil {
+		return nil
+	}
+	return &importer.Mapping{
+		Date:         m.GetDateColumn(),
+		DateFormat:   m.GetDateFormat(),
+		Description:  m.GetDescriptionColumn(),
+		Amount:       m.GetAmountColumn(),
+		Debit:        m.GetDebitColumn(),
+		Credit:       m.GetCreditColumn(),
+		Merchant:     m.GetMerchantColumn(),
+		Currency:     m.GetCurrencyColumn(),
+		Notes:        m.GetNotesColumn(),
+		Delimiter:    m.GetDelimiter(),
+		HasHeader:    m.GetHasHeader(),
+		SkipRows:     int(m.GetSkipRows()),
+		InvertSign:   m.GetInvertSign(),
+		DecimalComma: m.GetDecimalComma(),
+	}
+}
+
+func mappingToPb(m *importer.Mapping) *pb.CsvColumnMapping {
+	optional := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return &s
+	}
+
+	return &pb.CsvColumnMapping{
+		DateColumn:        m.Date,
+		DateFormat:        optional(m.DateFormat),
+		DescriptionColumn: optional(m.Description),
+		AmountColumn:      optional(m.Amount),
+		DebitColumn:       optional(m.Debit),
+		CreditColumn:      optional(m.Credit),
+		MerchantColumn:    optional(m.Merchant),
+		CurrencyColumn:    optional(m.Currency),
+		NotesColumn:       optional(m.Notes),
+		Delimiter:         optional(m.Delimiter),
+		HasHeader:         m.HasHeader,
+		SkipRows:          int32(m.SkipRows),
+		InvertSign:        m.InvertSign,
+		DecimalComma:      m.DecimalComma,
+	}
+}
+
+func importProfileToPb(p *sqlc.ImportProfile) (*pb.ImportProfile, error) {
+	var mapping importer.Mapping
+	if err := json.Unmarshal(p.Mapping, &mapping); err != nil {
+		return nil, fmt.Errorf("decode mapping for profile %d: %w", p.ID, err)
+	}
+
+	return &pb.ImportProfile{
+		Id:        p.ID,
+		Name:      p.Name,
+		Bank:      p.Bank,
+		Mapping:   mappingToPb(&mapping),
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}, nil
+}
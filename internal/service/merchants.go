@@ -0,0 +1,477 @@
+package service
+
+import (
+	"ariand/internal/db"
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----- interface ---------------------------------------------------------------------------
+
+type MerchantService interface {
+	List(ctx context.Context, userID uuid.UUID) ([]*pb.Merchant, error)
+	Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Merchant, error)
+	Create(ctx context.Context, userID uuid.UUID, req *pb.CreateMerchantRequest) (*pb.Merchant, error)
+	Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateMerchantRequest) (*pb.Merchant, error)
+	Delete(ctx context.Context, userID uuid.UUID, id int64) (int64, error)
+	Merge(ctx context.Context, userID uuid.UUID, targetID int64, sourceIDs []int64) (*pb.Merchant, error)
+	Split(ctx context.Context, userID uuid.UUID, req *pb.SplitMerchantRequest) (*pb.Merchant, *pb.Merchant, error)
+}
+
+type merchantSvc struct {
+	db      *db.DB
+	queries *sqlc.Queries
+	log     *log.Logger
+}
+
+func newMerchantSvc(database *db.DB, logger *log.Logger) MerchantService {
+	return &merchantSvc{
+		db:      database,
+		queries: database.Queries,
+		log:     logger,
+	}
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *merchantSvc) List(ctx context.Context, userID uuid.UUID) ([]*pb.Merchant, error) {
+	rows, err := s.queries.ListMerchants(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("MerchantService.List", err)
+	}
+
+	ids := make([]int64, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].Merchant.ID
+	}
+	aliases, err := merchantAliases(ctx, s.queries, ids)
+	if err != nil {
+		return nil, wrapErr("MerchantService.List.Aliases", err)
+	}
+
+	result := make([]*pb.Merchant, len(rows))
+	for i := range rows {
+		result[i] = merchantToPb(&rows[i].Merchant, aliases[rows[i].Merchant.ID], rows[i].TransactionCount)
+	}
+	return result, nil
+}
+
+func (s *merchantSvc) Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Merchant, error) {
+	merchant, err := getMerchant(ctx, s.queries, userID, id)
+	if err != nil {
+		return nil, wrapErr("MerchantService.Get", err)
+	}
+	return merchant, nil
+}
+
+// Create adds the merchant with its name and aliases as patterns and points
+// the user's matching transactions at it.
+func (s *merchantSvc) Create(ctx context.Context, userID uuid.UUID, req *pb.CreateMerchantRequest) (*pb.Merchant, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, fmt.Errorf("MerchantService.Create: name is required: %w", ErrValidation)
+	}
+	aliases, err := cleanMerchantAliases(append([]string{name}, req.GetAliases()...))
+	if err != nil {
+		return nil, wrapErr("MerchantService.Create", err)
+	}
+
+	var id int64
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		if err := checkMerchantCategory(ctx, q, userID, req.DefaultCategoryId); err != nil {
+			return err
+		}
+		if err := checkMerchantAliasConflicts(ctx, q, userID, 0, aliases); err != nil {
+			return err
+		}
+
+		merchant, err := q.CreateMerchant(ctx, sqlc.CreateMerchantParams{
+			UserID:            userID,
+			Name:              name,
+			DefaultCategoryID: req.DefaultCategoryId,
+			WebsiteUrl:        nonEmpty(req.WebsiteUrl),
+			LogoUrl:           nonEmpty(req.LogoUrl),
+		})
+		if err != nil {
+			return err
+		}
+		id = merchant.ID
+
+		if err := q.AddMerchantAliases(ctx, sqlc.AddMerchantAliasesParams{
+			MerchantID: id,
+			UserID:     userID,
+			Aliases:    aliases,
+		}); err != nil {
+			return err
+		}
+		return resolveOwnerMerchants(ctx, q, userID)
+	})
+	if err != nil {
+		return nil, wrapErr("MerchantService.Create", err)
+	}
+
+	return s.Get(ctx, userID, id)
+}
+
+func (s *merchantSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateMerchantRequest) (*pb.Merchant, error) {
+	params := sqlc.UpdateMerchantParams{
+		ID:                req.GetId(),
+		UserID:            userID,
+		DefaultCategoryID: req.DefaultCategoryId,
+		WebsiteUrl:        req.WebsiteUrl,
+		LogoUrl:           req.LogoUrl,
+	}
+	if req.Name != nil {
+		name := strings.TrimSpace(req.GetName())
+		if name == "" {
+			return nil, fmt.Errorf("MerchantService.Update: name cannot be empty: %w", ErrValidation)
+		}
+		params.Name = &name
+	}
+
+	add, err := cleanMerchantAliases(req.GetAddAliases())
+	if err != nil {
+		return nil, wrapErr("MerchantService.Update", err)
+	}
+
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		if err := checkMerchantCategory(ctx, q, userID, positive(req.DefaultCategoryId)); err != nil {
+			return err
+		}
+		if _, err := q.UpdateMerchant(ctx, params); err != nil {
+			return err
+		}
+
+		if len(req.GetRemoveAliases()) == 0 && len(add) == 0 {
+			return nil
+		}
+		if _, err := q.DeleteMerchantAliases(ctx, sqlc.DeleteMerchantAliasesParams{
+			MerchantID: req.GetId(),
+			UserID:     userID,
+			Aliases:    req.GetRemoveAliases(),
+		}); err != nil {
+			return err
+		}
+		if len(add) > 0 {
+			if err := checkMerchantAliasConflicts(ctx, q, userID, req.GetId(), add); err != nil {
+				return err
+			}
+			if err := q.AddMerchantAliases(ctx, sqlc.AddMerchantAliasesParams{
+				MerchantID: req.GetId(),
+				UserID:     userID,
+				Aliases:    add,
+			}); err != nil {
+				return err
+			}
+		}
+		return resolveOwnerMerchants(ctx, q, userID)
+	})
+	if err != nil {
+		return nil, wrapErr("MerchantService.Update", err)
+	}
+
+	return s.Get(ctx, userID, req.GetId())
+}
+
+// Delete removes the merchant. Its transactions are re-resolved, so they may
+// land on another merchant with an overlapping alias.
+func (s *merchantSvc) Delete(ctx context.Context, userID uuid.UUID, id int64) (int64, error) {
+	var affected int64
+	err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		affected, err = q.DeleteMerchants(ctx, sqlc.DeleteMerchantsParams{
+			UserID:      userID,
+			MerchantIds: []int64{id},
+		})
+		if err != nil || affected == 0 {
+			return err
+		}
+		return resolveOwnerMerchants(ctx, q, userID)
+	})
+	if err != nil {
+		return 0, wrapErr("MerchantService.Delete", err)
+	}
+	return affected, nil
+}
+
+// Merge folds the source merchants into the target: their aliases and
+// transactions move over and the sources are deleted.
+func (s *merchantSvc) Merge(ctx context.Context, userID uuid.UUID, targetID int64, sourceIDs []int64) (*pb.Merchant, error) {
+	if len(sourceIDs) == 0 {
+		return nil, fmt.Errorf("MerchantService.Merge: at least one source is required: %w", ErrValidation)
+	}
+	if slices.Contains(sourceIDs, targetID) {
+		return nil, fmt.Errorf("MerchantService.Merge: target %d is also a source: %w", targetID, ErrValidation)
+	}
+
+	err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		if err := ensureOwnMerchants(ctx, q, userID, append([]int64{targetID}, sourceIDs...)); err != nil {
+			return err
+		}
+
+		if _, err := q.MoveMerchantAliases(ctx, sqlc.MoveMerchantAliasesParams{
+			TargetID:  targetID,
+			UserID:    userID,
+			SourceIds: sourceIDs,
+		}); err != nil {
+			return err
+		}
+		moved, err := q.MoveMerchantTransactions(ctx, sqlc.MoveMerchantTransactionsParams{
+			TargetID:  targetID,
+			UserID:    userID,
+			SourceIds: sourceIDs,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := q.DeleteMerchants(ctx, sqlc.DeleteMerchantsParams{
+			UserID:      userID,
+			MerchantIds: sourceIDs,
+		}); err != nil {
+			return err
+		}
+
+		s.log.Info("merged merchants", "target", targetID, "sources", sourceIDs, "transactions", moved)
+		return nil
+	})
+	if err != nil {
+		return nil, wrapErr("MerchantService.Merge", err)
+	}
+
+	return s.Get(ctx, userID, targetID)
+}
+
+// Split moves some aliases of a merchant to a new one and re-resolves the
+// transactions so those matching the moved aliases follow them.
+func (s *merchantSvc) Split(ctx context.Context, userID uuid.UUID, req *pb.SplitMerchantRequest) (*pb.Merchant, *pb.Merchant, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, nil, fmt.Errorf("MerchantService.Split: name is required: %w", ErrValidation)
+	}
+	aliases, err := cleanMerchantAliases(req.GetAliases())
+	if err != nil {
+		return nil, nil, wrapErr("MerchantService.Split", err)
+	}
+	if len(aliases) == 0 {
+		return nil, nil, fmt.Errorf("MerchantService.Split: at least one alias is required: %w", ErrValidation)
+	}
+
+	var newID int64
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		if err := ensureOwnMerchants(ctx, q, userID, []int64{req.GetMerchantId()}); err != nil {
+			return err
+		}
+		if err := checkMerchantCategory(ctx, q, userID, req.DefaultCategoryId); err != nil {
+			return err
+		}
+
+		merchant, err := q.CreateMerchant(ctx, sqlc.CreateMerchantParams{
+			UserID:            userID,
+			Name:              name,
+			DefaultCategoryID: req.DefaultCategoryId,
+		})
+		if err != nil {
+			return err
+		}
+		newID = merchant.ID
+
+		moved, err := q.MoveMerchantAliases(ctx, sqlc.MoveMerchantAliasesParams{
+			TargetID:  newID,
+			UserID:    userID,
+			SourceIds: []int64{req.GetMerchantId()},
+			Aliases:   aliases,
+		})
+		if err != nil {
+			return err
+		}
+		if moved != int64(len(aliases)) {
+			return fmt.Errorf("every alias has to belong to merchant %d: %w", req.GetMerchantId(), ErrValidation)
+		}
+
+		// the name becomes an alias too unless another merchant already has it
+		if err := q.AddMerchantAliases(ctx, sqlc.AddMerchantAliasesParams{
+			MerchantID: newID,
+			UserID:     userID,
+			Aliases:    []string{name},
+		}); err != nil {
+			return err
+		}
+		return resolveOwnerMerchants(ctx, q, userID)
+	})
+	if err != nil {
+		return nil, nil, wrapErr("MerchantService.Split", err)
+	}
+
+	original, err := s.Get(ctx, userID, req.GetMerchantId())
+	if err != nil {
+		return nil, nil, err
+	}
+	created, err := s.Get(ctx, userID, newID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return original, created, nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func merchantToPb(m *sqlc.Merchant, aliases []string, transactionCount int64) *pb.Merchant {
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return &pb.Merchant{
+		Id:                m.ID,
+		Name:              m.Name,
+		Aliases:           aliases,
+		DefaultCategoryId: m.DefaultCategoryID,
+		WebsiteUrl:        m.WebsiteUrl,
+		LogoUrl:           m.LogoUrl,
+		TransactionCount:  transactionCount,
+		CreatedAt:         timestamppb.New(m.CreatedAt),
+		UpdatedAt:         timestamppb.New(m.UpdatedAt),
+	}
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// resolveMerchants re-resolves the merchant of the given transactions, or of
+// every transaction in the owner's accounts, and records what changed. Call
+// it inside the database transaction that changed descriptions or aliases.
+func resolveMerchants(ctx context.Context, q *sqlc.Queries, actor changeActor, params sqlc.ResolveTransactionMerchantsParams) error {
+	rows, err := q.ResolveTransactionMerchants(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	var changes []fieldChange
+	for _, r := range rows {
+		changes = append(changes, fieldChange{
+			txID:     r.ID,
+			field:    "merchant_id",
+			oldValue: formatOptionalInt(r.OldMerchantID),
+			newValue: formatOptionalInt(r.MerchantID),
+			actor:    actor,
+		})
+		if oldValue, newValue := formatOptionalInt(r.OldCategoryID), formatOptionalInt(r.CategoryID); oldValue != newValue {
+			changes = append(changes, fieldChange{
+				txID:     r.ID,
+				field:    "category_id",
+				oldValue: oldValue,
+				newValue: newValue,
+				actor:    actor,
+			})
+		}
+	}
+	return recordHistory(ctx, q, changes)
+}
+
+// resolveOwnerMerchants re-resolves every transaction in accounts the user
+// owns after their aliases changed.
+func resolveOwnerMerchants(ctx context.Context, q *sqlc.Queries, userID uuid.UUID) error {
+	return resolveMerchants(ctx, q, actorFromContext(ctx, userID), sqlc.ResolveTransactionMerchantsParams{OwnerID: &userID})
+}
+
+func getMerchant(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, id int64) (*pb.Merchant, error) {
+	row, err := q.GetMerchant(ctx, sqlc.GetMerchantParams{ID: id, UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	aliases, err := merchantAliases(ctx, q, []int64{id})
+	if err != nil {
+		return nil, err
+	}
+	return merchantToPb(&row.Merchant, aliases[id], row.TransactionCount), nil
+}
+
+func merchantAliases(ctx context.Context, q *sqlc.Queries, merchantIDs []int64) (map[int64][]string, error) {
+	result := make(map[int64][]string, len(merchantIDs))
+	if len(merchantIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := q.ListMerchantAliases(ctx, merchantIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		result[r.MerchantID] = append(result[r.MerchantID], r.Alias)
+	}
+	return result, nil
+}
+
+// cleanMerchantAliases trims and dedupes aliases. Matching ignores digits
+// and punctuation, so an alias needs at least one letter.
+func cleanMerchantAliases(aliases []string) ([]string, error) {
+	seen := make(map[string]bool, len(aliases))
+	out := make([]string, 0, len(aliases))
+	for _, a := range aliases {
+		a = strings.TrimSpace(a)
+		if a == "" || seen[strings.ToLower(a)] {
+			continue
+		}
+		if !strings.ContainsFunc(a, unicode.IsLetter) {
+			return nil, fmt.Errorf("alias %q needs at least one letter: %w", a, ErrValidation)
+		}
+		seen[strings.ToLower(a)] = true
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+// checkMerchantAliasConflicts rejects aliases that match the same
+// descriptions as an alias of another merchant.
+func checkMerchantAliasConflicts(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, merchantID int64, aliases []string) error {
+	conflicts, err := q.ListMerchantAliasConflicts(ctx, sqlc.ListMerchantAliasConflictsParams{
+		UserID:     userID,
+		MerchantID: merchantID,
+		Aliases:    aliases,
+	})
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("alias %q already belongs to merchant %q: %w", conflicts[0].Alias, conflicts[0].Name, ErrValidation)
+	}
+	return nil
+}
+
+func checkMerchantCategory(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, categoryID *int64) error {
+	if categoryID == nil {
+		return nil
+	}
+	_, err := q.GetCategory(ctx, sqlc.GetCategoryParams{ID: *categoryID, UserID: userID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("category %d not found: %w", *categoryID, ErrValidation)
+	}
+	return err
+}
+
+func ensureOwnMerchants(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, merchantIDs []int64) error {
+	unique := make(map[int64]bool, len(merchantIDs))
+	for _, id := range merchantIDs {
+		unique[id] = true
+	}
+
+	found, err := q.CountUserMerchants(ctx, sqlc.CountUserMerchantsParams{
+		UserID:      userID,
+		MerchantIds: merchantIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if found != int64(len(unique)) {
+		return fmt.Errorf("unknown merchant id: %w", ErrValidation)
+	}
+	return nil
+}
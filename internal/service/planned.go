@@ -0,0 +1,611 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"ariand/internal/rrule"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultProjectionDays = 30
+	maxProjectionDays     = 366
+	// a real transaction this many days before or after an occurrence can fulfill it,
+	// unfulfilled occurrences stay expected for as long
+	plannedMatchDays = 3
+	// share by which the real amount may differ from the planned one
+	plannedAmountTolerance = 0.1
+	// how far ahead next_occurrence looks
+	plannedHorizonDays = 5 * 366
+)
+
+// ----- interface ---------------------------------------------------------------------------
+
+type PlannedTransactionService interface {
+	List(ctx context.Context, userID uuid.UUID, accountID *int64) ([]*pb.PlannedTransaction, error)
+	Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.PlannedTransaction, error)
+	Create(ctx context.Context, userID uuid.UUID, req *pb.CreatePlannedTransactionRequest) (*pb.PlannedTransaction, error)
+	Update(ctx context.Context, userID uuid.UUID, req *pb.UpdatePlannedTransactionRequest) (*pb.PlannedTransaction, error)
+	Delete(ctx context.Context, userID uuid.UUID, id int64) (int64, error)
+}
+
+type plannedSvc struct {
+	queries *sqlc.Queries
+	log     *log.Logger
+}
+
+func newPlannedSvc(queries *sqlc.Queries, logger *log.Logger) PlannedTransactionService {
+	return &plannedSvc{queries: queries, log: logger}
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *plannedSvc) List(ctx context.Context, userID uuid.UUID, accountID *int64) ([]*pb.PlannedTransaction, error) {
+	schedules, err := loadPlannedSchedules(ctx, s.queries, userID, accountID)
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.List", err)
+	}
+
+	today := localToday(userLocation(ctx, s.queries, userID))
+	result := make([]*pb.PlannedTransaction, len(schedules))
+	for i, ps := range schedules {
+		result[i] = ps.toPb(today)
+	}
+	return result, nil
+}
+
+func (s *plannedSvc) Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.PlannedTransaction, error) {
+	row, err := s.queries.GetPlannedTransaction(ctx, sqlc.GetPlannedTransactionParams{
+		UserID: userID,
+		ID:     id,
+	})
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Get", err)
+	}
+
+	planned, err := s.plannedToPb(ctx, userID, &row.PlannedTransaction)
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Get", err)
+	}
+	return planned, nil
+}
+
+func (s *plannedSvc) Create(ctx context.Context, userID uuid.UUID, req *pb.CreatePlannedTransactionRequest) (*pb.PlannedTransaction, error) {
+	account, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{
+		UserID: userID,
+		ID:     req.GetAccountId(),
+	})
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Create.Account", err)
+	}
+
+	params, err := buildCreatePlannedParams(userID, &account.Account, req)
+	if err != nil {
+		return nil, fmt.Errorf("PlannedTransactionService.Create: %v: %w", err, ErrValidation)
+	}
+
+	created, err := s.queries.CreatePlannedTransaction(ctx, params)
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Create", err)
+	}
+
+	planned, err := s.plannedToPb(ctx, userID, &created)
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Create", err)
+	}
+	return planned, nil
+}
+
+func (s *plannedSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdatePlannedTransactionRequest) (*pb.PlannedTransaction, error) {
+	existing, err := s.queries.GetPlannedTransaction(ctx, sqlc.GetPlannedTransactionParams{
+		UserID: userID,
+		ID:     req.GetId(),
+	})
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Update", err)
+	}
+
+	params, err := buildUpdatePlannedParams(userID, &existing.PlannedTransaction, req)
+	if err != nil {
+		return nil, fmt.Errorf("PlannedTransactionService.Update: %v: %w", err, ErrValidation)
+	}
+
+	updated, err := s.queries.UpdatePlannedTransaction(ctx, params)
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Update", err)
+	}
+
+	planned, err := s.plannedToPb(ctx, userID, &updated)
+	if err != nil {
+		return nil, wrapErr("PlannedTransactionService.Update", err)
+	}
+	return planned, nil
+}
+
+func (s *plannedSvc) Delete(ctx context.Context, userID uuid.UUID, id int64) (int64, error) {
+	affected, err := s.queries.DeletePlannedTransaction(ctx, sqlc.DeletePlannedTransactionParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return 0, wrapErr("PlannedTransactionService.Delete", err)
+	}
+	return affected, nil
+}
+
+// Projection returns the account's balance for today and the next days with
+// its planned transactions applied.
+func (s *acctSvc) Projection(ctx context.Context, userID uuid.UUID, accountID int64, days *int32) (*pb.AccountProjection, error) {
+	row, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{
+		UserID: userID,
+		ID:     accountID,
+	})
+	if err != nil {
+		return nil, wrapErr("AccountService.Projection.Account", err)
+	}
+
+	schedules, err := loadPlannedSchedules(ctx, s.queries, userID, &accountID)
+	if err != nil {
+		return nil, wrapErr("AccountService.Projection.Planned", err)
+	}
+
+	n, err := projectionDays(days)
+	if err != nil {
+		return nil, fmt.Errorf("AccountService.Projection: %w", err)
+	}
+
+	today := localToday(userLocation(ctx, s.queries, userID))
+	return buildProjection(&row.Account, row.BalanceCents, schedules, today, n), nil
+}
+
+// ProjectedBalances is Projection for every account of the user.
+func (s *dashSvc) ProjectedBalances(ctx context.Context, userID uuid.UUID, days *int32) ([]*pb.AccountProjection, error) {
+	n, err := projectionDays(days)
+	if err != nil {
+		return nil, fmt.Errorf("DashboardService.ProjectedBalances: %w", err)
+	}
+
+	accounts, err := s.queries.ListAccounts(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("DashboardService.ProjectedBalances.Accounts", err)
+	}
+
+	schedules, err := loadPlannedSchedules(ctx, s.queries, userID, nil)
+	if err != nil {
+		return nil, wrapErr("DashboardService.ProjectedBalances.Planned", err)
+	}
+	byAccount := make(map[int64][]*plannedSchedule)
+	for _, ps := range schedules {
+		byAccount[ps.AccountID] = append(byAccount[ps.AccountID], ps)
+	}
+
+	today := localToday(s.getUserLocation(ctx, userID))
+	result := make([]*pb.AccountProjection, len(accounts))
+	for i := range accounts {
+		a := &accounts[i]
+		result[i] = buildProjection(&a.Account, a.BalanceCents, byAccount[a.Account.ID], today, n)
+	}
+	return result, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+func buildCreatePlannedParams(userID uuid.UUID, account *sqlc.Account, req *pb.CreatePlannedTransactionRequest) (sqlc.CreatePlannedTransactionParams, error) {
+	// transactions are stored in the anchor currency, planned ones have to
+	// match them to be fulfilled and to add up with the balance
+	amount := req.GetAmount()
+	currency := amount.GetCurrencyCode()
+	if currency == "" {
+		currency = account.AnchorCurrency
+	}
+	if currency != account.AnchorCurrency {
+		return sqlc.CreatePlannedTransactionParams{}, fmt.Errorf("amount must be in the account currency %s", account.AnchorCurrency)
+	}
+
+	cents := minorUnits(amount, currency)
+	if cents <= 0 {
+		return sqlc.CreatePlannedTransactionParams{}, fmt.Errorf("amount must be positive")
+	}
+	if req.GetDirection() == pb.TransactionDirection_DIRECTION_UNSPECIFIED {
+		return sqlc.CreatePlannedTransactionParams{}, fmt.Errorf("direction is required")
+	}
+	startDate := dateToTime(req.GetStartDate())
+	if startDate == nil {
+		return sqlc.CreatePlannedTransactionParams{}, fmt.Errorf("start_date is required")
+	}
+
+	rule, err := normalizeRRule(req.Rrule)
+	if err != nil {
+		return sqlc.CreatePlannedTransactionParams{}, err
+	}
+
+	return sqlc.CreatePlannedTransactionParams{
+		UserID:        userID,
+		AccountID:     account.ID,
+		TxAmountCents: cents,
+		TxCurrency:    currency,
+		TxDirection:   int16(req.GetDirection()),
+		TxDesc:        nonEmpty(req.Description),
+		Merchant:      nonEmpty(req.Merchant),
+		CategoryID:    positive(req.CategoryId),
+		StartDate:     *startDate,
+		Rrule:         nonEmpty(rule),
+	}, nil
+}
+
+func buildUpdatePlannedParams(userID uuid.UUID, existing *sqlc.PlannedTransaction, req *pb.UpdatePlannedTransactionRequest) (sqlc.UpdatePlannedTransactionParams, error) {
+	params := sqlc.UpdatePlannedTransactionParams{
+		ID:         req.GetId(),
+		UserID:     userID,
+		TxDesc:     req.Description,
+		Merchant:   req.Merchant,
+		CategoryID: req.CategoryId,
+		StartDate:  dateToTime(req.StartDate),
+	}
+
+	if req.Amount != nil {
+		if c := req.Amount.GetCurrencyCode(); c != "" && c != existing.TxCurrency {
+			return params, fmt.Errorf("amount must be in the account currency %s", existing.TxCurrency)
+		}
+		cents := minorUnits(req.Amount, existing.TxCurrency)
+		if cents <= 0 {
+			return params, fmt.Errorf("amount must be positive")
+		}
+		params.TxAmountCents = &cents
+	}
+	if req.Direction != nil {
+		if *req.Direction == pb.TransactionDirection_DIRECTION_UNSPECIFIED {
+			return params, fmt.Errorf("direction cannot be unspecified")
+		}
+		direction := int16(*req.Direction)
+		params.TxDirection = &direction
+	}
+	if req.Rrule != nil {
+		rule, err := normalizeRRule(req.Rrule)
+		if err != nil {
+			return params, err
+		}
+		params.Rrule = rule
+	}
+
+	return params, nil
+}
+
+// normalizeRRule validates the rule and strips an "RRULE:" prefix. An empty
+// rule stays empty, meaning a one-off.
+func normalizeRRule(rule *string) (*string, error) {
+	if rule == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimPrefix(strings.TrimSpace(*rule), "RRULE:")
+	if trimmed == "" {
+		return &trimmed, nil
+	}
+	if _, err := rrule.Parse(trimmed); err != nil {
+		return nil, fmt.Errorf("invalid rrule: %w", err)
+	}
+	trimmed = strings.ToUpper(trimmed)
+	return &trimmed, nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func (s *plannedSvc) plannedToPb(ctx context.Context, userID uuid.UUID, p *sqlc.PlannedTransaction) (*pb.PlannedTransaction, error) {
+	fulfillments, err := s.queries.ListPlannedFulfillments(ctx, []int64{p.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	ps := newPlannedSchedule(*p, fulfillments)
+	return ps.toPb(localToday(userLocation(ctx, s.queries, userID))), nil
+}
+
+func (ps *plannedSchedule) toPb(today time.Time) *pb.PlannedTransaction {
+	planned := &pb.PlannedTransaction{
+		Id:          ps.ID,
+		AccountId:   ps.AccountID,
+		Amount:      money.FromMinor(ps.TxAmountCents, ps.TxCurrency),
+		Direction:   ps.TxDirection,
+		Description: ps.TxDesc,
+		Merchant:    ps.Merchant,
+		CategoryId:  ps.CategoryID,
+		StartDate:   timeToDate(ps.StartDate),
+		Rrule:       ps.Rrule,
+		CreatedAt:   timestamppb.New(ps.CreatedAt),
+		UpdatedAt:   timestamppb.New(ps.UpdatedAt),
+	}
+
+	if next := ps.pending(today, today.AddDate(0, 0, plannedHorizonDays)); len(next) > 0 {
+		planned.NextOccurrence = timeToDate(next[0])
+	}
+
+	for _, f := range ps.fulfillments {
+		planned.Fulfillments = append(planned.Fulfillments, &pb.PlannedFulfillment{
+			OccurrenceDate: timeToDate(f.OccurrenceDate),
+			TransactionId:  f.TransactionID,
+		})
+	}
+	return planned
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// plannedSchedule is a planned transaction with its parsed rule and the
+// occurrences already fulfilled. Dates are midnight UTC.
+type plannedSchedule struct {
+	sqlc.PlannedTransaction
+	rule         *rrule.Rule
+	fulfillments []sqlc.ListPlannedFulfillmentsRow
+	fulfilled    map[time.Time]bool
+}
+
+func newPlannedSchedule(p sqlc.PlannedTransaction, fulfillments []sqlc.ListPlannedFulfillmentsRow) *plannedSchedule {
+	ps := &plannedSchedule{
+		PlannedTransaction: p,
+		fulfillments:       fulfillments,
+		fulfilled:          make(map[time.Time]bool, len(fulfillments)),
+	}
+	for _, f := range fulfillments {
+		ps.fulfilled[utcDate(f.OccurrenceDate)] = true
+	}
+	if p.Rrule != nil {
+		// stored rules were validated on write
+		ps.rule, _ = rrule.Parse(*p.Rrule)
+	}
+	return ps
+}
+
+// pending lists the unfulfilled occurrences within [from, to].
+func (ps *plannedSchedule) pending(from, to time.Time) []time.Time {
+	start := utcDate(ps.StartDate)
+
+	var dates []time.Time
+	if ps.rule != nil {
+		dates = ps.rule.Between(start, from, to)
+	} else if !start.Before(from) && !start.After(to) {
+		dates = []time.Time{start}
+	}
+
+	out := dates[:0]
+	for _, d := range dates {
+		if !ps.fulfilled[d] {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+func (ps *plannedSchedule) signedCents() int64 {
+	if ps.TxDirection == pb.TransactionDirection_DIRECTION_OUTGOING {
+		return -ps.TxAmountCents
+	}
+	return ps.TxAmountCents
+}
+
+func loadPlannedSchedules(ctx context.Context, queries *sqlc.Queries, userID uuid.UUID, accountID *int64) ([]*plannedSchedule, error) {
+	rows, err := queries.ListPlannedTransactions(ctx, sqlc.ListPlannedTransactionsParams{
+		UserID:    userID,
+		AccountID: accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	planned := make([]sqlc.PlannedTransaction, len(rows))
+	for i := range rows {
+		planned[i] = rows[i].PlannedTransaction
+	}
+	return withFulfillments(ctx, queries, planned)
+}
+
+func withFulfillments(ctx context.Context, queries *sqlc.Queries, planned []sqlc.PlannedTransaction) ([]*plannedSchedule, error) {
+	if len(planned) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, len(planned))
+	for i := range planned {
+		ids[i] = planned[i].ID
+	}
+	rows, err := queries.ListPlannedFulfillments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byPlanned := make(map[int64][]sqlc.ListPlannedFulfillmentsRow)
+	for _, r := range rows {
+		byPlanned[r.PlannedID] = append(byPlanned[r.PlannedID], r)
+	}
+
+	schedules := make([]*plannedSchedule, len(planned))
+	for i := range planned {
+		schedules[i] = newPlannedSchedule(planned[i], byPlanned[planned[i].ID])
+	}
+	return schedules, nil
+}
+
+// buildProjection walks day by day from today. Occurrences missed in the
+// last few days are assumed to still arrive and land on today. Balances are
+// in the anchor currency, like the transactions they add up.
+func buildProjection(account *sqlc.Account, balanceCents int64, schedules []*plannedSchedule, today time.Time, days int) *pb.AccountProjection {
+	currency := account.AnchorCurrency
+	last := today.AddDate(0, 0, days-1)
+
+	byDay := make(map[time.Time][]*pb.PlannedOccurrence)
+	deltas := make(map[time.Time]int64)
+	for _, ps := range schedules {
+		for _, d := range ps.pending(today.AddDate(0, 0, -plannedMatchDays), last) {
+			if d.Before(today) {
+				d = today
+			}
+			byDay[d] = append(byDay[d], &pb.PlannedOccurrence{
+				PlannedTransactionId: ps.ID,
+				Date:                 timeToDate(d),
+				Amount:               money.FromMinor(ps.TxAmountCents, ps.TxCurrency),
+				Direction:            ps.TxDirection,
+				Description:          coalescePtr(ps.Merchant, ps.TxDesc),
+			})
+			deltas[d] += ps.signedCents()
+		}
+	}
+
+	projection := &pb.AccountProjection{
+		AccountId:      account.ID,
+		AccountName:    account.Name,
+		CurrentBalance: money.FromMinor(balanceCents, currency),
+		Points:         make([]*pb.ProjectedBalancePoint, 0, days),
+	}
+	running := balanceCents
+	for d := today; !d.After(last); d = d.AddDate(0, 0, 1) {
+		running += deltas[d]
+		projection.Points = append(projection.Points, &pb.ProjectedBalancePoint{
+			Date:        timeToDate(d),
+			Balance:     money.FromMinor(running, currency),
+			Occurrences: byDay[d],
+		})
+	}
+	return projection
+}
+
+// fulfillPlanned marks planned occurrences as taken care of by freshly
+// created transactions: same account, direction and currency, an amount
+// within 10% and a date within a few days. Each transaction fulfills at most
+// one occurrence, the closest by date then amount. Failures are logged, not
+// returned: the transactions themselves are stored.
+func (s *txnSvc) fulfillPlanned(ctx context.Context, userID uuid.UUID, created []sqlc.Transaction) {
+	if len(created) == 0 {
+		return
+	}
+
+	accountIDs := make([]int64, 0, len(created))
+	seen := make(map[int64]bool)
+	for i := range created {
+		if !seen[created[i].AccountID] {
+			seen[created[i].AccountID] = true
+			accountIDs = append(accountIDs, created[i].AccountID)
+		}
+	}
+
+	planned, err := s.queries.ListPlannedTransactionsForAccounts(ctx, accountIDs)
+	if err != nil {
+		s.log.Warn("failed to load planned transactions", "error", err)
+		return
+	}
+	schedules, err := withFulfillments(ctx, s.queries, planned)
+	if err != nil {
+		s.log.Warn("failed to load planned fulfillments", "error", err)
+		return
+	}
+	if len(schedules) == 0 {
+		return
+	}
+
+	loc := userLocation(ctx, s.queries, userID)
+	for i := range created {
+		tx := &created[i]
+		ps, day, ok := matchPlanned(schedules, tx, localDate(tx.TxDate, loc))
+		if !ok {
+			continue
+		}
+
+		if _, err := s.queries.FulfillPlannedTransaction(ctx, sqlc.FulfillPlannedTransactionParams{
+			PlannedID:      ps.ID,
+			OccurrenceDate: day,
+			TransactionID:  tx.ID,
+		}); err != nil {
+			s.log.Warn("failed to fulfill planned transaction", "planned_id", ps.ID, "transaction_id", tx.ID, "error", err)
+			continue
+		}
+		ps.fulfilled[day] = true
+	}
+}
+
+func matchPlanned(schedules []*plannedSchedule, tx *sqlc.Transaction, txDay time.Time) (*plannedSchedule, time.Time, bool) {
+	type candidate struct {
+		ps         *plannedSchedule
+		day        time.Time
+		dayDiff    time.Duration
+		amountDiff int64
+	}
+
+	var candidates []candidate
+	for _, ps := range schedules {
+		if ps.AccountID != tx.AccountID || ps.TxDirection != tx.TxDirection || ps.TxCurrency != tx.TxCurrency {
+			continue
+		}
+		amountDiff := tx.TxAmountCents - ps.TxAmountCents
+		if amountDiff < 0 {
+			amountDiff = -amountDiff
+		}
+		if float64(amountDiff) > plannedAmountTolerance*float64(ps.TxAmountCents) {
+			continue
+		}
+
+		window := time.Duration(plannedMatchDays) * 24 * time.Hour
+		for _, d := range ps.pending(txDay.Add(-window), txDay.Add(window)) {
+			dayDiff := d.Sub(txDay)
+			if dayDiff < 0 {
+				dayDiff = -dayDiff
+			}
+			candidates = append(candidates, candidate{ps: ps, day: d, dayDiff: dayDiff, amountDiff: amountDiff})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, time.Time{}, false
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].dayDiff != candidates[j].dayDiff {
+			return candidates[i].dayDiff < candidates[j].dayDiff
+		}
+		return candidates[i].amountDiff < candidates[j].amountDiff
+	})
+	return candidates[0].ps, candidates[0].day, true
+}
+
+func projectionDays(days *int32) (int, error) {
+	switch {
+	case days == nil:
+		return defaultProjectionDays, nil
+	case *days < 1 || *days > maxProjectionDays:
+		return 0, fmt.Errorf("days must be between 1 and %d: %w", maxProjectionDays, ErrValidation)
+	default:
+		return int(*days), nil
+	}
+}
+
+// localDate is the calendar day of t in loc, as midnight UTC like DATE columns.
+func localDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func localToday(loc *time.Location) time.Time {
+	return localDate(time.Now(), loc)
+}
+
+func utcDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func nonEmpty(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
+	}
+	return s
+}
+
+func positive(v *int64) *int64 {
+	if v == nil || *v <= 0 {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,357 @@
+package service
+
+import (
+	"ariand/internal/db"
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"ariand/internal/receipts"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	receiptMatchNone      int16 = 1
+	receiptMatchSuggested int16 = 2
+	receiptMatchConfirmed int16 = 3
+	receiptMatchRejected  int16 = 4
+)
+
+// ----- interface ---------------------------------------------------------------------------
+
+type ReceiptService interface {
+	Upload(ctx context.Context, userID uuid.UUID, content []byte, contentType string) (*pb.Receipt, []*pb.TransactionWithScore, error)
+	Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Receipt, error)
+	List(ctx context.Context, userID uuid.UUID, req *pb.ListReceiptsRequest) ([]*pb.Receipt, error)
+	ConfirmMatch(ctx context.Context, userID uuid.UUID, receiptID int64, transactionID *int64) (*pb.Receipt, error)
+	RejectMatch(ctx context.Context, userID uuid.UUID, receiptID int64) (*pb.Receipt, error)
+	Delete(ctx context.Context, userID uuid.UUID, id int64) (int64, error)
+}
+
+type receiptSvc struct {
+	db      *db.DB
+	queries *sqlc.Queries
+	log     *log.Logger
+	parser  receipts.Parser
+}
+
+func newReceiptSvc(database *db.DB, logger *log.Logger, parser receipts.Parser) ReceiptService {
+	return &receiptSvc{
+		db:      database,
+		queries: database.Queries,
+		log:     logger,
+		parser:  parser,
+	}
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *receiptSvc) Upload(ctx context.Context, userID uuid.UUID, content []byte, contentType string) (*pb.Receipt, []*pb.TransactionWithScore, error) {
+	parsed, err := s.parser.Parse(ctx, content, contentType)
+	if errors.Is(err, receipts.ErrUnreadable) {
+		return nil, nil, fmt.Errorf("ReceiptService.Upload: %v: %w", err, ErrValidation)
+	}
+	if err != nil {
+		return nil, nil, wrapErr("ReceiptService.Upload.Parse", err)
+	}
+
+	candidates, err := s.findCandidates(ctx, userID, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := buildCreateReceiptParams(userID, parsed, contentType)
+	if len(candidates) > 0 {
+		best := candidates[0]
+		score := float32(best.MerchantScore)
+		params.TransactionID = &best.Transaction.Id
+		params.MatchStatus = receiptMatchSuggested
+		params.MatchScore = &score
+	}
+
+	var (
+		receipt sqlc.Receipt
+		items   []sqlc.ReceiptItem
+	)
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		var err error
+		receipt, err = q.CreateReceipt(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		if len(parsed.Items) == 0 {
+			return nil
+		}
+		items, err = q.BulkCreateReceiptItems(ctx, buildReceiptItemsParams(receipt.ID, parsed.Items))
+		return err
+	})
+	if err != nil {
+		return nil, nil, wrapErr("ReceiptService.Upload.Store", err)
+	}
+
+	s.log.Info("stored receipt",
+		"receipt_id", receipt.ID,
+		"items", len(items),
+		"candidates", len(candidates),
+	)
+
+	return receiptToPb(&receipt, items), candidates, nil
+}
+
+func (s *receiptSvc) Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Receipt, error) {
+	receipt, err := s.queries.GetReceipt(ctx, sqlc.GetReceiptParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, wrapErr("ReceiptService.Get", err)
+	}
+
+	return s.one(ctx, receipt, "ReceiptService.Get.Items")
+}
+
+func (s *receiptSvc) List(ctx context.Context, userID uuid.UUID, req *pb.ListReceiptsRequest) ([]*pb.Receipt, error) {
+	params := sqlc.ListReceiptsParams{
+		UserID: userID,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+	if req.MatchStatus != nil && *req.MatchStatus != pb.ReceiptMatchStatus_RECEIPT_MATCH_STATUS_UNSPECIFIED {
+		status := int16(*req.MatchStatus)
+		params.MatchStatus = &status
+	}
+
+	rows, err := s.queries.ListReceipts(ctx, params)
+	if err != nil {
+		return nil, wrapErr("ReceiptService.List", err)
+	}
+
+	result, err := s.withItems(ctx, rows)
+	if err != nil {
+		return nil, wrapErr("ReceiptService.List.Items", err)
+	}
+	return result, nil
+}
+
+func (s *receiptSvc) ConfirmMatch(ctx context.Context, userID uuid.UUID, receiptID int64, transactionID *int64) (*pb.Receipt, error) {
+	receipt, err := s.queries.GetReceipt(ctx, sqlc.GetReceiptParams{
+		ID:     receiptID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, wrapErr("ReceiptService.ConfirmMatch.GetReceipt", err)
+	}
+
+	params := sqlc.SetReceiptMatchParams{
+		ID:            receiptID,
+		UserID:        userID,
+		TransactionID: receipt.TransactionID,
+		MatchStatus:   receiptMatchConfirmed,
+		MatchScore:    receipt.MatchScore,
+	}
+
+	if transactionID != nil && (receipt.TransactionID == nil || *transactionID != *receipt.TransactionID) {
+		// the user picked a transaction themselves, make sure they can see it
+		if _, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{
+			UserID: userID,
+			ID:     *transactionID,
+		}); err != nil {
+			return nil, wrapErr("ReceiptService.ConfirmMatch.GetTransaction", err)
+		}
+		params.TransactionID = transactionID
+		params.MatchScore = nil
+	}
+
+	if params.TransactionID == nil {
+		return nil, fmt.Errorf("ReceiptService.ConfirmMatch: receipt has no suggested transaction, pass transaction_id: %w", ErrValidation)
+	}
+
+	updated, err := s.queries.SetReceiptMatch(ctx, params)
+	if err != nil {
+		return nil, wrapErr("ReceiptService.ConfirmMatch", err)
+	}
+
+	return s.one(ctx, updated, "ReceiptService.ConfirmMatch.Items")
+}
+
+func (s *receiptSvc) RejectMatch(ctx context.Context, userID uuid.UUID, receiptID int64) (*pb.Receipt, error) {
+	updated, err := s.queries.SetReceiptMatch(ctx, sqlc.SetReceiptMatchParams{
+		ID:          receiptID,
+		UserID:      userID,
+		MatchStatus: receiptMatchRejected,
+	})
+	if err != nil {
+		return nil, wrapErr("ReceiptService.RejectMatch", err)
+	}
+
+	return s.one(ctx, updated, "ReceiptService.RejectMatch.Items")
+}
+
+func (s *receiptSvc) Delete(ctx context.Context, userID uuid.UUID, id int64) (int64, error) {
+	affected, err := s.queries.DeleteReceipt(ctx, sqlc.DeleteReceiptParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return 0, wrapErr("ReceiptService.Delete", err)
+	}
+	return affected, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+func buildCreateReceiptParams(userID uuid.UUID, parsed *receipts.Receipt, contentType string) sqlc.CreateReceiptParams {
+	params := sqlc.CreateReceiptParams{
+		UserID:      userID,
+		MatchStatus: receiptMatchNone,
+		TotalCents:  parsed.TotalCents,
+		ReceiptDate: parsed.Date,
+		ContentType: contentType,
+	}
+	if parsed.Merchant != "" {
+		params.Merchant = &parsed.Merchant
+	}
+	if parsed.Currency != "" {
+		params.Currency = &parsed.Currency
+	}
+	return params
+}
+
+// unit prices are optional, -1 marks the missing ones for the bulk insert
+func buildReceiptItemsParams(receiptID int64, items []receipts.Item) sqlc.BulkCreateReceiptItemsParams {
+	params := sqlc.BulkCreateReceiptItemsParams{
+		ReceiptID:      receiptID,
+		Names:          make([]string, len(items)),
+		Quantities:     make([]float64, len(items)),
+		UnitPriceCents: make([]int64, len(items)),
+		TotalCents:     make([]int64, len(items)),
+	}
+	for i, item := range items {
+		params.Names[i] = item.Name
+		params.Quantities[i] = item.Quantity
+		params.UnitPriceCents[i] = -1
+		if item.UnitPriceCents != nil {
+			params.UnitPriceCents[i] = *item.UnitPriceCents
+		}
+		params.TotalCents[i] = item.TotalCents
+	}
+	return params
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func receiptToPb(r *sqlc.Receipt, items []sqlc.ReceiptItem) *pb.Receipt {
+	currency := deref(r.Currency)
+
+	proto := &pb.Receipt{
+		Id:            r.ID,
+		Merchant:      r.Merchant,
+		Total:         money.FromMinor(r.TotalCents, currency),
+		MatchStatus:   pb.ReceiptMatchStatus(r.MatchStatus),
+		TransactionId: r.TransactionID,
+		ContentType:   r.ContentType,
+		Items:         make([]*pb.ReceiptItem, len(items)),
+		CreatedAt:     timestamppb.New(r.CreatedAt),
+		UpdatedAt:     timestamppb.New(r.UpdatedAt),
+	}
+
+	if r.ReceiptDate != nil {
+		proto.ReceiptDate = timeToDate(*r.ReceiptDate)
+	}
+	if r.MatchScore != nil {
+		score := float64(*r.MatchScore)
+		proto.MatchScore = &score
+	}
+
+	for i, item := range items {
+		proto.Items[i] = &pb.ReceiptItem{
+			Id:       item.ID,
+			Name:     item.Name,
+			Quantity: item.Quantity,
+			Total:    money.FromMinor(item.TotalCents, currency),
+		}
+		if item.UnitPriceCents != nil {
+			proto.Items[i].UnitPrice = money.FromMinor(*item.UnitPriceCents, currency)
+		}
+	}
+
+	return proto
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// findCandidates ranks transactions by how well their description matches the
+// receipt merchant. A receipt without a merchant can't be matched automatically.
+func (s *receiptSvc) findCandidates(ctx context.Context, userID uuid.UUID, parsed *receipts.Receipt) ([]*pb.TransactionWithScore, error) {
+	merchant := strings.TrimSpace(parsed.Merchant)
+	if merchant == "" {
+		return nil, nil
+	}
+
+	date := time.Now()
+	if parsed.Date != nil {
+		date = *parsed.Date
+	}
+
+	rows, err := s.queries.FindCandidateTransactions(ctx, sqlc.FindCandidateTransactionsParams{
+		UserID:     userID,
+		Merchant:   merchant,
+		Date:       date,
+		TotalCents: parsed.TotalCents,
+	})
+	if err != nil {
+		return nil, wrapErr("ReceiptService.Upload.FindCandidates", err)
+	}
+
+	result := make([]*pb.TransactionWithScore, len(rows))
+	for i := range rows {
+		result[i] = &pb.TransactionWithScore{
+			Transaction:   txToPb(&rows[i].Transaction),
+			MerchantScore: float64(rows[i].MerchantScore),
+		}
+	}
+	return result, nil
+}
+
+func (s *receiptSvc) withItems(ctx context.Context, rows []sqlc.Receipt) ([]*pb.Receipt, error) {
+	if len(rows) == 0 {
+		return []*pb.Receipt{}, nil
+	}
+
+	ids := make([]int64, len(rows))
+	for i, r := range rows {
+		ids[i] = r.ID
+	}
+
+	items, err := s.queries.ListReceiptItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byReceipt := make(map[int64][]sqlc.ReceiptItem, len(rows))
+	for _, item := range items {
+		byReceipt[item.ReceiptID] = append(byReceipt[item.ReceiptID], item)
+	}
+
+	result := make([]*pb.Receipt, len(rows))
+	for i := range rows {
+		result[i] = receiptToPb(&rows[i], byReceipt[rows[i].ID])
+	}
+	return result, nil
+}
+
+func (s *receiptSvc) one(ctx context.Context, receipt sqlc.Receipt, op string) (*pb.Receipt, error) {
+	result, err := s.withItems(ctx, []sqlc.Receipt{receipt})
+	if err != nil {
+		return nil, wrapErr(op, err)
+	}
+	return result[0], nil
+}
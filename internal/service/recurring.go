@@ -0,0 +1,349 @@
+package service
+
+import (
+	"ariand/internal/db"
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"ariand/internal/recurring"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	recurringSuggested int16 = 1
+	recurringConfirmed int16 = 2
+	recurringDismissed int16 = 3
+
+	defaultRecurringLookbackDays = 400
+	minRecurringLookbackDays     = 30
+	maxRecurringLookbackDays     = 1830
+	// merchant keys at least this trigram-similar are treated as one payee
+	recurringKeySimilarity = 0.6
+)
+
+// ----- interface ---------------------------------------------------------------------------
+
+type RecurringService interface {
+	Detect(ctx context.Context, userID uuid.UUID, lookbackDays *int32) ([]*pb.RecurringSeries, error)
+	List(ctx context.Context, userID uuid.UUID, status *pb.RecurringStatus) ([]*pb.RecurringSeries, error)
+	Confirm(ctx context.Context, userID uuid.UUID, id int64) (*pb.RecurringSeries, error)
+	Dismiss(ctx context.Context, userID uuid.UUID, id int64) (*pb.RecurringSeries, error)
+}
+
+type recurringSvc struct {
+	db      *db.DB
+	queries *sqlc.Queries
+	log     *log.Logger
+}
+
+func newRecurringSvc(database *db.DB, logger *log.Logger) RecurringService {
+	return &recurringSvc{
+		db:      database,
+		queries: database.Queries,
+		log:     logger,
+	}
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+// Detect rebuilds the user's series from recent history. Existing series are
+// updated in place so confirmations and dismissals survive, and dismissed
+// series are left out of the result.
+func (s *recurringSvc) Detect(ctx context.Context, userID uuid.UUID, lookbackDays *int32) ([]*pb.RecurringSeries, error) {
+	days := int32(defaultRecurringLookbackDays)
+	if lookbackDays != nil {
+		days = *lookbackDays
+	}
+	if days < minRecurringLookbackDays || days > maxRecurringLookbackDays {
+		return nil, fmt.Errorf("RecurringService.Detect: lookback_days must be between %d and %d: %w",
+			minRecurringLookbackDays, maxRecurringLookbackDays, ErrValidation)
+	}
+	now := time.Now()
+
+	rows, err := s.queries.ListRecurringCandidates(ctx, sqlc.ListRecurringCandidatesParams{
+		UserID: userID,
+		Since:  now.AddDate(0, 0, -int(days)),
+	})
+	if err != nil {
+		return nil, wrapErr("RecurringService.Detect.Candidates", err)
+	}
+	if len(rows) == 0 {
+		return []*pb.RecurringSeries{}, nil
+	}
+
+	groups, err := s.groupCandidates(ctx, userID, rows)
+	if err != nil {
+		return nil, wrapErr("RecurringService.Detect.Group", err)
+	}
+
+	var detected []*pb.RecurringSeries
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		for _, g := range groups {
+			series, ok := recurring.Detect(g.occurrences, now)
+			if !ok {
+				continue
+			}
+
+			last := series.Occurrences[len(series.Occurrences)-1]
+			row, err := q.UpsertRecurringSeries(ctx, sqlc.UpsertRecurringSeriesParams{
+				UserID:              userID,
+				MatchKey:            g.key,
+				Name:                g.name,
+				AccountID:           last.AccountID,
+				Cadence:             int16(series.Cadence),
+				Direction:           int16(g.direction),
+				Currency:            g.currency,
+				ExpectedAmountCents: series.ExpectedAmountCents,
+				LastAmountCents:     series.LastAmountCents,
+				LastDate:            series.LastDate,
+				NextDueDate:         series.NextDue,
+				Occurrences:         int32(len(series.Occurrences)),
+			})
+			if err != nil {
+				return err
+			}
+
+			txIDs := make([]int64, len(series.Occurrences))
+			for i, o := range series.Occurrences {
+				txIDs[i] = o.TransactionID
+			}
+			if err := q.DeleteRecurringSeriesTransactions(ctx, row.ID); err != nil {
+				return err
+			}
+			if err := q.LinkRecurringSeriesTransactions(ctx, sqlc.LinkRecurringSeriesTransactionsParams{
+				SeriesID:       row.ID,
+				TransactionIds: txIDs,
+			}); err != nil {
+				return err
+			}
+
+			if row.Status == recurringDismissed {
+				continue
+			}
+			if row.PriceIncreaseFromCents != nil {
+				s.log.Info("subscription price increased", "series", row.ID, "name", row.Name, "from", *row.PriceIncreaseFromCents, "to", row.LastAmountCents)
+			}
+			detected = append(detected, recurringSeriesToPb(&row, txIDs))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapErr("RecurringService.Detect.Upsert", err)
+	}
+
+	if detected == nil {
+		detected = []*pb.RecurringSeries{}
+	}
+	return detected, nil
+}
+
+func (s *recurringSvc) List(ctx context.Context, userID uuid.UUID, status *pb.RecurringStatus) ([]*pb.RecurringSeries, error) {
+	params := sqlc.ListRecurringSeriesParams{UserID: userID}
+	if status != nil && *status != pb.RecurringStatus_RECURRING_STATUS_UNSPECIFIED {
+		v := int16(*status)
+		params.Status = &v
+	}
+
+	rows, err := s.queries.ListRecurringSeries(ctx, params)
+	if err != nil {
+		return nil, wrapErr("RecurringService.List", err)
+	}
+
+	ids := make([]int64, len(rows))
+	for i := range rows {
+		ids[i] = rows[i].ID
+	}
+	txIDs, err := s.seriesTransactionIDs(ctx, ids)
+	if err != nil {
+		return nil, wrapErr("RecurringService.List.Transactions", err)
+	}
+
+	result := make([]*pb.RecurringSeries, len(rows))
+	for i := range rows {
+		result[i] = recurringSeriesToPb(&rows[i], txIDs[rows[i].ID])
+	}
+	return result, nil
+}
+
+// Confirm marks the series as a known subscription and acknowledges any
+// flagged price increase.
+func (s *recurringSvc) Confirm(ctx context.Context, userID uuid.UUID, id int64) (*pb.RecurringSeries, error) {
+	series, err := s.setStatus(ctx, userID, id, recurringConfirmed)
+	if err != nil {
+		return nil, wrapErr("RecurringService.Confirm", err)
+	}
+	return series, nil
+}
+
+// Dismiss hides the series; later detection runs keep it dismissed.
+func (s *recurringSvc) Dismiss(ctx context.Context, userID uuid.UUID, id int64) (*pb.RecurringSeries, error) {
+	series, err := s.setStatus(ctx, userID, id, recurringDismissed)
+	if err != nil {
+		return nil, wrapErr("RecurringService.Dismiss", err)
+	}
+	return series, nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func recurringSeriesToPb(r *sqlc.RecurringSeries, txIDs []int64) *pb.RecurringSeries {
+	series := &pb.RecurringSeries{
+		Id:             r.ID,
+		Name:           r.Name,
+		AccountId:      r.AccountID,
+		Cadence:        pb.RecurringCadence(r.Cadence),
+		Direction:      r.Direction,
+		ExpectedAmount: money.FromMinor(r.ExpectedAmountCents, r.Currency),
+		LastAmount:     money.FromMinor(r.LastAmountCents, r.Currency),
+		LastDate:       timestamppb.New(r.LastDate),
+		NextDueDate:    timeToDate(r.NextDueDate),
+		Occurrences:    r.Occurrences,
+		Status:         pb.RecurringStatus(r.Status),
+		TransactionIds: txIDs,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+		UpdatedAt:      timestamppb.New(r.UpdatedAt),
+	}
+	if r.PriceIncreaseFromCents != nil {
+		series.PriceIncreaseFrom = money.FromMinor(*r.PriceIncreaseFromCents, r.Currency)
+	}
+	return series
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+type recurringGroup struct {
+	key         string
+	name        string
+	currency    string
+	direction   pb.TransactionDirection
+	occurrences []recurring.Occurrence
+}
+
+// groupCandidates merges similar merchant keys and splits the result by
+// currency and direction. Each group is named after its most common key.
+func (s *recurringSvc) groupCandidates(ctx context.Context, userID uuid.UUID, rows []sqlc.ListRecurringCandidatesRow) ([]*recurringGroup, error) {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, r := range rows {
+		if !seen[r.MatchKey] {
+			seen[r.MatchKey] = true
+			keys = append(keys, r.MatchKey)
+		}
+	}
+
+	pairs, err := s.queries.ListSimilarRecurringKeys(ctx, sqlc.ListSimilarRecurringKeysParams{
+		MatchKeys:     keys,
+		MinSimilarity: recurringKeySimilarity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	similar := make([][2]string, len(pairs))
+	for i, p := range pairs {
+		similar[i] = [2]string{p.KeyA, p.KeyB}
+	}
+
+	existing, err := s.queries.ListRecurringSeries(ctx, sqlc.ListRecurringSeriesParams{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]bool, len(existing))
+	for _, e := range existing {
+		stored[e.MatchKey] = true
+	}
+
+	canonical := make(map[string]string, len(keys))
+	for _, members := range recurring.Group(keys, similar) {
+		for _, k := range members {
+			canonical[k] = stableRecurringKey(members, keys, stored)
+		}
+	}
+
+	type bucket struct {
+		key       string
+		currency  string
+		direction pb.TransactionDirection
+	}
+	byBucket := make(map[bucket]*recurringGroup)
+	var groups []*recurringGroup
+	for _, r := range rows {
+		b := bucket{key: canonical[r.MatchKey], currency: r.TxCurrency, direction: r.TxDirection}
+		g, ok := byBucket[b]
+		if !ok {
+			g = &recurringGroup{key: b.key, currency: b.currency, direction: b.direction}
+			byBucket[b] = g
+			groups = append(groups, g)
+		}
+		// rows are oldest first, so the name ends up as the latest spelling
+		if r.MatchKey == b.key {
+			g.name = r.Name
+		}
+		g.occurrences = append(g.occurrences, recurring.Occurrence{
+			TransactionID: r.ID,
+			AccountID:     r.AccountID,
+			Date:          r.TxDate,
+			AmountCents:   r.TxAmountCents,
+		})
+	}
+	return groups, nil
+}
+
+// stableRecurringKey picks the key a group of similar merchant keys is
+// stored under. Series are upserted by key, so it must not change as history
+// grows: a key that already has a series wins, else the one seen first.
+func stableRecurringKey(members, ordered []string, stored map[string]bool) string {
+	for _, k := range members {
+		if stored[k] {
+			return k
+		}
+	}
+	in := make(map[string]bool, len(members))
+	for _, k := range members {
+		in[k] = true
+	}
+	for _, k := range ordered {
+		if in[k] {
+			return k
+		}
+	}
+	return members[0]
+}
+
+func (s *recurringSvc) seriesTransactionIDs(ctx context.Context, seriesIDs []int64) (map[int64][]int64, error) {
+	result := make(map[int64][]int64, len(seriesIDs))
+	if len(seriesIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.queries.ListRecurringSeriesTransactionIDs(ctx, seriesIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		result[r.SeriesID] = append(result[r.SeriesID], r.TransactionID)
+	}
+	return result, nil
+}
+
+func (s *recurringSvc) setStatus(ctx context.Context, userID uuid.UUID, id int64, status int16) (*pb.RecurringSeries, error) {
+	row, err := s.queries.SetRecurringSeriesStatus(ctx, sqlc.SetRecurringSeriesStatusParams{
+		Status: status,
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txIDs, err := s.seriesTransactionIDs(ctx, []int64{row.ID})
+	if err != nil {
+		return nil, err
+	}
+	return recurringSeriesToPb(&row, txIDs[row.ID]), nil
+}
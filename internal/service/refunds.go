@@ -0,0 +1,260 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultRefundWindowDays    = 90
+	defaultRefundMinSimilarity = 0.5
+)
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *txnSvc) LinkRefund(ctx context.Context, userID uuid.UUID, originalID, refundID int64) (*pb.Refund, error) {
+	original, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: originalID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkRefund.GetOriginal", err)
+	}
+	refund, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: refundID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkRefund.GetRefund", err)
+	}
+
+	if original.TxDirection != 2 || refund.TxDirection != 1 {
+		return nil, fmt.Errorf("TransactionService.LinkRefund: a refund links an incoming transaction to an outgoing purchase: %w", ErrValidation)
+	}
+	if original.TxCurrency != refund.TxCurrency {
+		return nil, fmt.Errorf("TransactionService.LinkRefund: the refund and the purchase are in different currencies: %w", ErrValidation)
+	}
+
+	transfers, err := s.queries.ListTransfersForTransactions(ctx, []int64{originalID, refundID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkRefund.Transfers", err)
+	}
+	if len(transfers) > 0 {
+		return nil, fmt.Errorf("TransactionService.LinkRefund: transaction is part of a transfer: %w", ErrValidation)
+	}
+
+	existing, err := s.queries.ListRefundsForTransactions(ctx, []int64{refundID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkRefund.Existing", err)
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("TransactionService.LinkRefund: transaction is already linked as a refund or purchase: %w", ErrValidation)
+	}
+
+	refunded, err := s.queries.GetRefundedCents(ctx, originalID)
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkRefund.Refunded", err)
+	}
+	if refunded+refund.TxAmountCents > original.TxAmountCents {
+		return nil, fmt.Errorf("TransactionService.LinkRefund: refunds would exceed the purchase amount: %w", ErrValidation)
+	}
+
+	var link sqlc.Refund
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		// a manual link overrides an earlier unlink of the same pair
+		if _, err := q.DeleteDismissedRefund(ctx, sqlc.DeleteDismissedRefundParams{
+			UserID:       userID,
+			OriginalTxID: originalID,
+			RefundTxID:   refundID,
+		}); err != nil {
+			return err
+		}
+
+		link, err = q.CreateRefund(ctx, sqlc.CreateRefundParams{
+			UserID:       userID,
+			OriginalTxID: originalID,
+			RefundTxID:   refundID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkRefund", err)
+	}
+
+	return refundToPb(&link), nil
+}
+
+func (s *txnSvc) UnlinkRefund(ctx context.Context, userID uuid.UUID, txID int64) (int64, error) {
+	affected, err := s.queries.DismissRefunds(ctx, sqlc.DismissRefundsParams{
+		UserID:        userID,
+		TransactionID: txID,
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.UnlinkRefund", err)
+	}
+	return affected, nil
+}
+
+func (s *txnSvc) DetectRefunds(ctx context.Context, userID uuid.UUID, req *pb.DetectRefundsRequest) ([]*pb.Refund, error) {
+	params := buildRefundCandidatesParams(userID, req.GetWindowDays(), req.MinSimilarity)
+	if req.StartDate != nil {
+		start := req.StartDate.AsTime()
+		params.Start = &start
+	}
+	if req.EndDate != nil {
+		end := req.EndDate.AsTime()
+		params.End = &end
+	}
+
+	refunds, err := s.detectRefunds(ctx, params, req.GetDryRun())
+	if err != nil {
+		return nil, wrapErr("TransactionService.DetectRefunds", err)
+	}
+	return refunds, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+func buildRefundCandidatesParams(userID uuid.UUID, windowDays int32, minSimilarity *float64) sqlc.ListRefundCandidatesParams {
+	params := sqlc.ListRefundCandidatesParams{
+		UserID:        userID,
+		WindowDays:    defaultRefundWindowDays,
+		MinSimilarity: defaultRefundMinSimilarity,
+	}
+	if windowDays > 0 {
+		params.WindowDays = windowDays
+	}
+	if minSimilarity != nil {
+		params.MinSimilarity = float32(*minSimilarity)
+	}
+	return params
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// detectRefunds links candidates greedily: the query returns the best matches
+// first, every refund pays back one purchase and a purchase takes refunds
+// until they add up to its amount.
+func (s *txnSvc) detectRefunds(ctx context.Context, params sqlc.ListRefundCandidatesParams, dryRun bool) ([]*pb.Refund, error) {
+	candidates, err := s.queries.ListRefundCandidates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[int64]bool)
+	remaining := make(map[int64]int64)
+	var pairs []sqlc.ListRefundCandidatesRow
+	for _, c := range candidates {
+		if used[c.RefundID] {
+			continue
+		}
+		left, ok := remaining[c.OriginalID]
+		if !ok {
+			left = c.RemainingCents
+		}
+		if c.RefundCents > left {
+			continue
+		}
+		used[c.RefundID] = true
+		remaining[c.OriginalID] = left - c.RefundCents
+		pairs = append(pairs, c)
+	}
+
+	result := make([]*pb.Refund, len(pairs))
+	if dryRun {
+		for i, p := range pairs {
+			result[i] = refundCandidateToPb(&p)
+		}
+		return result, nil
+	}
+
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		for i, p := range pairs {
+			refund, err := q.CreateRefund(ctx, sqlc.CreateRefundParams{
+				UserID:       params.UserID,
+				OriginalTxID: p.OriginalID,
+				RefundTxID:   p.RefundID,
+				AutoDetected: true,
+			})
+			if err != nil {
+				return err
+			}
+			result[i] = refundToPb(&refund)
+			result[i].Similarity = refundCandidateToPb(&p).Similarity
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) > 0 {
+		s.log.Info("linked refunds", "user_id", params.UserID, "count", len(result))
+	}
+	return result, nil
+}
+
+// linkNewRefunds looks for the purchases that freshly created transactions
+// pay back. Failures are logged, not returned: the transactions are stored.
+func (s *txnSvc) linkNewRefunds(ctx context.Context, userID uuid.UUID, txIDs []int64) {
+	if len(txIDs) == 0 {
+		return
+	}
+
+	params := buildRefundCandidatesParams(userID, 0, nil)
+	params.TransactionIds = txIDs
+
+	if _, err := s.detectRefunds(ctx, params, false); err != nil {
+		s.log.Warn("failed to detect refunds for new transactions", "count", len(txIDs), "error", err)
+	}
+}
+
+func (s *txnSvc) attachRefunds(ctx context.Context, txs []*pb.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(txs))
+	byID := make(map[int64]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.Id
+		byID[tx.Id] = tx
+	}
+
+	rows, err := s.queries.ListRefundsForTransactions(ctx, ids)
+	if err != nil {
+		return wrapErr("TransactionService.ListRefunds", err)
+	}
+
+	for i := range rows {
+		refund := refundToPb(&rows[i])
+		if tx, ok := byID[rows[i].OriginalTxID]; ok {
+			tx.Refunds = append(tx.Refunds, refund)
+		}
+		if tx, ok := byID[rows[i].RefundTxID]; ok {
+			tx.RefundOf = refund
+		}
+	}
+	return nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func refundToPb(r *sqlc.Refund) *pb.Refund {
+	return &pb.Refund{
+		Id:                    r.ID,
+		OriginalTransactionId: r.OriginalTxID,
+		RefundTransactionId:   r.RefundTxID,
+		AutoDetected:          r.AutoDetected,
+		CreatedAt:             timestamppb.New(r.CreatedAt),
+	}
+}
+
+func refundCandidateToPb(c *sqlc.ListRefundCandidatesRow) *pb.Refund {
+	similarity := float64(c.Score)
+	return &pb.Refund{
+		OriginalTransactionId: c.OriginalID,
+		RefundTransactionId:   c.RefundID,
+		AutoDetected:          true,
+		Similarity:            &similarity,
+	}
+}
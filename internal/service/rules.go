@@ -1,17 +1,31 @@
 package service
 
 import (
+	"ariand/internal/db"
 	"ariand/internal/db/sqlc"
 	pb "ariand/internal/gen/arian/v1"
 	"ariand/internal/rules"
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	defaultRulePreviewLimit = 50
+	maxRulePreviewLimit     = 200
+
+	// previews read this many transactions at a time, at most
+	// maxRulePreviewScan in total
+	rulePreviewBatchSize = 500
+	maxRulePreviewScan   = 10000
+)
+
 // ----- interface ---------------------------------------------------------------------------
 
 type RuleService interface {
@@ -20,18 +34,23 @@ type RuleService interface {
 	Update(ctx context.Context, userID uuid.UUID, ruleID uuid.UUID, ruleName *string, conditions []byte, categoryID *int64, merchant *string) error
 	Delete(ctx context.Context, userID uuid.UUID, ruleID uuid.UUID) (int64, error)
 	List(ctx context.Context, userID uuid.UUID) ([]*pb.Rule, error)
+	Reorder(ctx context.Context, userID uuid.UUID, ruleIDs []uuid.UUID) ([]*pb.Rule, error)
+	SetActive(ctx context.Context, userID uuid.UUID, ruleID uuid.UUID, active bool) (*pb.Rule, error)
+	Analyze(ctx context.Context, userID uuid.UUID) (*pb.AnalyzeRulesResponse, error)
+	Preview(ctx context.Context, userID uuid.UUID, conditions []byte, categoryID *int64, merchant *string, limit, offset *int32) (*pb.PreviewRuleResponse, error)
 
 	ApplyToTransaction(ctx context.Context, userID uuid.UUID, tx *sqlc.Transaction, account *sqlc.GetAccountRow) (*RuleMatchResult, error)
 	ApplyToExisting(ctx context.Context, userID uuid.UUID, transactionIDs []int64) (int, error)
 }
 
 type catRuleSvc struct {
+	db      *db.DB
 	queries *sqlc.Queries
 	log     *log.Logger
 }
 
-func newCatRuleSvc(queries *sqlc.Queries, logger *log.Logger) RuleService {
-	return &catRuleSvc{queries: queries, log: logger}
+func newCatRuleSvc(database *db.DB, logger *log.Logger) RuleService {
+	return &catRuleSvc{db: database, queries: database.Queries, log: logger}
 }
 
 // ----- methods -----------------------------------------------------------------------------
@@ -39,6 +58,10 @@ func newCatRuleSvc(queries *sqlc.Queries, logger *log.Logger) RuleService {
 type RuleMatchResult struct {
 	CategoryID *int64
 	Merchant   *string
+
+	// rules that supplied CategoryID and Merchant, for the history log
+	CategoryRuleID uuid.UUID
+	MerchantRuleID uuid.UUID
 }
 
 func (s *catRuleSvc) Create(ctx context.Context, userID uuid.UUID, ruleName string, conditions []byte, categoryID *int64, merchant *string) (*pb.Rule, error) {
@@ -125,13 +148,191 @@ func (s *catRuleSvc) List(ctx context.Context, userID uuid.UUID) ([]*pb.Rule, er
 	return result, nil
 }
 
+// Reorder sets the rules' priority to their position in ruleIDs, which has to
+// list each of the user's rules exactly once.
+func (s *catRuleSvc) Reorder(ctx context.Context, userID uuid.UUID, ruleIDs []uuid.UUID) ([]*pb.Rule, error) {
+	var rows []sqlc.TransactionRule
+	err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		existing, err := q.ListRules(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := checkRuleOrder(existing, ruleIDs); err != nil {
+			return err
+		}
+		if _, err := q.ReorderRules(ctx, sqlc.ReorderRulesParams{RuleIds: ruleIDs, UserID: userID}); err != nil {
+			return err
+		}
+		rows, err = q.ListRules(ctx, userID)
+		return err
+	})
+	if err != nil {
+		// keeps the validation message, wrapErr would drop it
+		return nil, fmt.Errorf("RuleService.Reorder: %w", err)
+	}
+
+	result := make([]*pb.Rule, len(rows))
+	for i := range rows {
+		result[i] = ruleToPb(&rows[i])
+	}
+	return result, nil
+}
+
+func (s *catRuleSvc) SetActive(ctx context.Context, userID uuid.UUID, ruleID uuid.UUID, active bool) (*pb.Rule, error) {
+	rule, err := s.queries.SetRuleActive(ctx, sqlc.SetRuleActiveParams{
+		IsActive: active,
+		RuleID:   ruleID,
+		UserID:   userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("RuleService.SetActive: rule %s not found: %w", ruleID, ErrValidation)
+	}
+	if err != nil {
+		return nil, wrapErr("RuleService.SetActive", err)
+	}
+
+	return ruleToPb(&rule), nil
+}
+
+// Analyze replays every transaction the user can see, manually set ones
+// included, through their active rules and reports how each rule fares.
+func (s *catRuleSvc) Analyze(ctx context.Context, userID uuid.UUID) (*pb.AnalyzeRulesResponse, error) {
+	activeRules, err := s.queries.GetActiveRules(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("RuleService.Analyze.FetchRules", err)
+	}
+
+	includeManuallySet := true
+	transactions, err := s.queries.GetTransactionsForRuleApplication(ctx, sqlc.GetTransactionsForRuleApplicationParams{
+		UserID:             userID,
+		IncludeManuallySet: &includeManuallySet,
+	})
+	if err != nil {
+		return nil, wrapErr("RuleService.Analyze.FetchTransactions", err)
+	}
+
+	accounts := make(map[int64]*sqlc.GetAccountRow)
+	if err := s.transactionAccounts(ctx, userID, transactions, accounts); err != nil {
+		return nil, wrapErr("RuleService.Analyze.FetchAccounts", err)
+	}
+
+	env, err := s.ruleEnv(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("RuleService.Analyze.FetchCategories", err)
+	}
+
+	return analysisToPb(rules.Analyze(activeRules, transactions, accounts, env), activeRules), nil
+}
+
+// Preview dry-runs a rule over the transactions the user can see, newest
+// first, and pages through the ones it would change and the ones only manual
+// flags keep it from changing. It stops reading once both pages are full or
+// after maxRulePreviewScan transactions; the summary says whether it saw all.
+func (s *catRuleSvc) Preview(ctx context.Context, userID uuid.UUID, conditions []byte, categoryID *int64, merchant *string, limit, offset *int32) (*pb.PreviewRuleResponse, error) {
+	parsed, err := rules.ParseRuleConditions(conditions)
+	if err != nil {
+		return nil, fmt.Errorf("RuleService.Preview: %v: %w", err, ErrValidation)
+	}
+
+	start := int(deref(offset))
+	if start < 0 {
+		return nil, fmt.Errorf("RuleService.Preview: offset must not be negative: %w", ErrValidation)
+	}
+	pageSize := defaultRulePreviewLimit
+	if limit != nil {
+		pageSize = int(*limit)
+	}
+	if pageSize < 1 || pageSize > maxRulePreviewLimit {
+		return nil, fmt.Errorf("RuleService.Preview: limit must be between 1 and %d: %w", maxRulePreviewLimit, ErrValidation)
+	}
+	end := start + pageSize
+
+	env, err := s.ruleEnv(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("RuleService.Preview.FetchCategories", err)
+	}
+
+	resp := &pb.PreviewRuleResponse{
+		WouldChange:     []*pb.RulePreviewMatch{},
+		BlockedByManual: []*pb.RulePreviewMatch{},
+		Summary:         &pb.RulePreviewSummary{},
+	}
+
+	// read newest first in batches and stop once both pages are full, so a
+	// preview doesn't load the whole history
+	includeManuallySet := true
+	batchSize := int32(rulePreviewBatchSize)
+	params := sqlc.GetTransactionsForRuleApplicationParams{
+		UserID:             userID,
+		IncludeManuallySet: &includeManuallySet,
+		Limit:              &batchSize,
+	}
+	accounts := make(map[int64]*sqlc.GetAccountRow)
+	var changed, blocked int
+	for {
+		transactions, err := s.queries.GetTransactionsForRuleApplication(ctx, params)
+		if err != nil {
+			return nil, wrapErr("RuleService.Preview.FetchTransactions", err)
+		}
+		if err := s.transactionAccounts(ctx, userID, transactions, accounts); err != nil {
+			return nil, wrapErr("RuleService.Preview.FetchAccounts", err)
+		}
+
+		matches := rules.Preview(parsed, categoryID, merchant, transactions, accounts, env)
+		resp.Summary.TransactionsScanned += int64(len(transactions))
+		resp.Summary.Matched += int64(len(matches))
+		for i := range matches {
+			m := &matches[i]
+			if m.CategoryChanges {
+				resp.Summary.CategoryChanges++
+			}
+			if m.MerchantChanges {
+				resp.Summary.MerchantChanges++
+			}
+
+			switch m.Outcome {
+			case rules.PreviewWouldChange:
+				if changed >= start && changed < end {
+					resp.WouldChange = append(resp.WouldChange, previewMatchToPb(m, categoryID, merchant))
+				}
+				changed++
+			case rules.PreviewBlocked:
+				if blocked >= start && blocked < end {
+					resp.BlockedByManual = append(resp.BlockedByManual, previewMatchToPb(m, categoryID, merchant))
+				}
+				blocked++
+			default:
+				resp.Summary.Unchanged++
+			}
+		}
+
+		if len(transactions) < rulePreviewBatchSize {
+			resp.Summary.Complete = true
+			break
+		}
+		if (changed >= end && blocked >= end) || resp.Summary.TransactionsScanned >= maxRulePreviewScan {
+			break
+		}
+		last := transactions[len(transactions)-1]
+		params.CursorDate, params.CursorID = &last.TxDate, &last.ID
+	}
+	resp.Summary.WouldChange = int64(changed)
+	resp.Summary.BlockedByManual = int64(blocked)
+
+	return resp, nil
+}
+
 func (s *catRuleSvc) ApplyToTransaction(ctx context.Context, userID uuid.UUID, tx *sqlc.Transaction, account *sqlc.GetAccountRow) (*RuleMatchResult, error) {
 	activeRules, err := s.queries.GetActiveRules(ctx, userID)
 	if err != nil {
 		return nil, wrapErr("RuleService.ApplyToTransaction", err)
 	}
+	env, err := s.ruleEnv(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("RuleService.ApplyToTransaction.FetchCategories", err)
+	}
 
-	return s.evaluateRulesForTransaction(activeRules, tx, account), nil
+	return s.evaluateRulesForTransaction(activeRules, tx, account, env), nil
 }
 
 func (s *catRuleSvc) ApplyToExisting(ctx context.Context, userID uuid.UUID, transactionIDs []int64) (int, error) {
@@ -155,8 +356,15 @@ func (s *catRuleSvc) ApplyToExisting(ctx context.Context, userID uuid.UUID, tran
 	}
 
 	type updateKey struct {
-		categoryID int64
-		merchant   string
+		categoryID     int64
+		merchant       string
+		categoryRuleID uuid.UUID
+		merchantRuleID uuid.UUID
+	}
+
+	env, err := s.ruleEnv(ctx, userID)
+	if err != nil {
+		return 0, wrapErr("RuleService.ApplyToExisting.FetchCategories", err)
 	}
 
 	updateGroups := make(map[updateKey][]int64)
@@ -171,14 +379,17 @@ func (s *catRuleSvc) ApplyToExisting(ctx context.Context, userID uuid.UUID, tran
 			continue
 		}
 
-		ruleResult := s.evaluateRulesForTransaction(activeRules, &tx, &account)
+		ruleResult := s.evaluateRulesForTransaction(activeRules, &tx, &account, env)
 
 		noMatch := ruleResult.CategoryID == nil && ruleResult.Merchant == nil
 		if noMatch {
 			continue
 		}
 
-		key := updateKey{}
+		key := updateKey{
+			categoryRuleID: ruleResult.CategoryRuleID,
+			merchantRuleID: ruleResult.MerchantRuleID,
+		}
 		if ruleResult.CategoryID != nil {
 			key.categoryID = *ruleResult.CategoryID
 		}
@@ -191,18 +402,37 @@ func (s *catRuleSvc) ApplyToExisting(ctx context.Context, userID uuid.UUID, tran
 
 	totalUpdated := 0
 	for key, txIDs := range updateGroups {
-		affected, err := s.queries.BulkApplyRuleToTransactions(ctx, sqlc.BulkApplyRuleToTransactionsParams{
-			CategoryID:     key.categoryID,
-			Merchant:       key.merchant,
-			TransactionIds: txIDs,
-			UserID:         userID,
+		var rows []sqlc.BulkApplyRuleToTransactionsRow
+		err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+			var err error
+			rows, err = q.BulkApplyRuleToTransactions(ctx, sqlc.BulkApplyRuleToTransactionsParams{
+				CategoryID:     key.categoryID,
+				Merchant:       key.merchant,
+				TransactionIds: txIDs,
+				UserID:         userID,
+			})
+			if err != nil {
+				return err
+			}
+
+			var changes []fieldChange
+			for _, r := range rows {
+				changes = append(changes, ruleChanges(r.ID, r.OldCategoryID, r.CategoryID, r.OldMerchant, r.Merchant, key.categoryRuleID, key.merchantRuleID)...)
+			}
+			if err := recordHistory(ctx, q, changes); err != nil {
+				return err
+			}
+			if key.merchant == "" {
+				return nil
+			}
+			return resolveMerchants(ctx, q, ruleActor(key.merchantRuleID), sqlc.ResolveTransactionMerchantsParams{TransactionIds: txIDs})
 		})
 		if err != nil {
 			s.log.Warn("failed to bulk apply rules", "error", err)
 			continue
 		}
 
-		totalUpdated += int(affected)
+		totalUpdated += len(rows)
 	}
 
 	return totalUpdated, nil
@@ -255,9 +485,85 @@ func ruleToPb(r *sqlc.TransactionRule) *pb.Rule {
 	return rule
 }
 
+func analysisToPb(a *rules.Analysis, ruleset []sqlc.TransactionRule) *pb.AnalyzeRulesResponse {
+	resp := &pb.AnalyzeRulesResponse{
+		TransactionsAnalyzed: int64(a.Transactions),
+		Rules:                make([]*pb.RuleReport, len(a.Rules)),
+		Conflicts:            make([]*pb.RuleConflict, len(a.Conflicts)),
+	}
+
+	for i, r := range a.Rules {
+		report := &pb.RuleReport{
+			RuleId:        r.RuleID.String(),
+			RuleName:      ruleset[i].RuleName,
+			PriorityOrder: ruleset[i].PriorityOrder,
+			MatchCount:    int64(r.Matches),
+			CategoryWins:  int64(r.CategoryWins),
+			MerchantWins:  int64(r.MerchantWins),
+			NeverWins:     r.NeverWins(),
+			Unreachable:   r.Unreachable,
+		}
+		if r.Unreachable {
+			shadow := r.ShadowedBy.String()
+			report.ShadowedByRuleId = &shadow
+		}
+		resp.Rules[i] = report
+	}
+
+	for i, c := range a.Conflicts {
+		resp.Conflicts[i] = &pb.RuleConflict{
+			RuleId:               c.First.String(),
+			OtherRuleId:          c.Second.String(),
+			CategoryId:           c.FirstCategory,
+			OtherCategoryId:      c.SecondCategory,
+			TransactionCount:     int64(c.Transactions),
+			SampleTransactionIds: c.SampleIDs,
+		}
+	}
+
+	return resp
+}
+
+func previewMatchToPb(m *rules.PreviewMatch, categoryID *int64, merchant *string) *pb.RulePreviewMatch {
+	result := &pb.RulePreviewMatch{
+		Transaction:     txToPb(m.Tx),
+		CategoryBlocked: m.CategoryBlocked,
+		MerchantBlocked: m.MerchantBlocked,
+	}
+	if m.CategoryChanges {
+		result.ProposedCategoryId = categoryID
+	}
+	if m.MerchantChanges {
+		result.ProposedMerchant = merchant
+	}
+	return result
+}
+
 // ----- internal helpers --------------------------------------------------------------------
 
-func (s *catRuleSvc) evaluateRulesForTransaction(activeRules []sqlc.TransactionRule, tx *sqlc.Transaction, account *sqlc.GetAccountRow) *RuleMatchResult {
+// checkRuleOrder makes sure ruleIDs lists each of the existing rules once.
+func checkRuleOrder(existing []sqlc.TransactionRule, ruleIDs []uuid.UUID) error {
+	owned := make(map[uuid.UUID]bool, len(existing))
+	for _, r := range existing {
+		owned[r.RuleID] = true
+	}
+	seen := make(map[uuid.UUID]bool, len(ruleIDs))
+	for _, id := range ruleIDs {
+		if !owned[id] {
+			return fmt.Errorf("rule %s not found: %w", id, ErrValidation)
+		}
+		if seen[id] {
+			return fmt.Errorf("rule %s listed twice: %w", id, ErrValidation)
+		}
+		seen[id] = true
+	}
+	if len(ruleIDs) != len(existing) {
+		return fmt.Errorf("got %d of %d rules, list all of them: %w", len(ruleIDs), len(existing), ErrValidation)
+	}
+	return nil
+}
+
+func (s *catRuleSvc) evaluateRulesForTransaction(activeRules []sqlc.TransactionRule, tx *sqlc.Transaction, account *sqlc.GetAccountRow, env *rules.Env) *RuleMatchResult {
 	result := &RuleMatchResult{}
 
 	for _, rule := range activeRules {
@@ -266,17 +572,19 @@ func (s *catRuleSvc) evaluateRulesForTransaction(activeRules []sqlc.TransactionR
 			continue
 		}
 
-		matches, err := rules.EvaluateRule(conditions, tx, account)
+		matches, err := rules.EvaluateRule(conditions, tx, account, env)
 		if err != nil || !matches {
 			continue
 		}
 
 		if result.CategoryID == nil && rule.CategoryID != nil {
 			result.CategoryID = rule.CategoryID
+			result.CategoryRuleID = rule.RuleID
 		}
 
 		if result.Merchant == nil && rule.Merchant != nil {
 			result.Merchant = rule.Merchant
+			result.MerchantRuleID = rule.RuleID
 		}
 
 		if result.CategoryID != nil && result.Merchant != nil {
@@ -286,3 +594,33 @@ func (s *catRuleSvc) evaluateRulesForTransaction(activeRules []sqlc.TransactionR
 
 	return result
 }
+
+// ruleEnv loads what conditions read about the user: category slugs and
+// timezone
+func (s *catRuleSvc) ruleEnv(ctx context.Context, userID uuid.UUID) (*rules.Env, error) {
+	categories, err := s.queries.ListCategories(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	slugs := make(map[int64]string, len(categories))
+	for _, c := range categories {
+		slugs[c.ID] = c.Slug
+	}
+	return &rules.Env{Categories: slugs, Location: userLocation(ctx, s.queries, userID)}, nil
+}
+
+// transactionAccounts adds the accounts of txs that aren't in accounts yet,
+// for rules that look at account fields.
+func (s *catRuleSvc) transactionAccounts(ctx context.Context, userID uuid.UUID, txs []sqlc.Transaction, accounts map[int64]*sqlc.GetAccountRow) error {
+	for _, tx := range txs {
+		if _, ok := accounts[tx.AccountID]; ok {
+			continue
+		}
+		account, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{UserID: userID, ID: tx.AccountID})
+		if err != nil {
+			return err
+		}
+		accounts[tx.AccountID] = &account
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSearchLimit = 25
+	maxSearchLimit     = 100
+	maxSearchTerms     = 10
+)
+
+// SearchTransactions marks matched terms with these control characters so
+// highlights can be escaped before the <mark> tags are added.
+const (
+	searchMatchStart = "\x02"
+	searchMatchStop  = "\x03"
+)
+
+var highlightReplacer = strings.NewReplacer(searchMatchStart, "<mark>", searchMatchStop, "</mark>")
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *txnSvc) Search(ctx context.Context, userID uuid.UUID, req *pb.SearchTransactionsRequest) ([]*pb.TransactionSearchResult, bool, error) {
+	params, err := buildSearchTxParams(userID, req)
+	if err != nil {
+		return nil, false, wrapErr("TransactionService.Search", err)
+	}
+
+	rows, err := s.queries.SearchTransactions(ctx, params)
+	if err != nil {
+		return nil, false, wrapErr("TransactionService.Search", err)
+	}
+
+	// one extra row was requested to know whether another page exists
+	hasMore := len(rows) == int(params.Limit)
+	if hasMore {
+		rows = rows[:len(rows)-1]
+	}
+
+	result := make([]*pb.TransactionSearchResult, len(rows))
+	txs := make([]*pb.Transaction, len(rows))
+	for i := range rows {
+		txs[i] = txToPb(&rows[i].Transaction)
+		result[i] = &pb.TransactionSearchResult{
+			Transaction:          txs[i],
+			Rank:                 rows[i].Rank,
+			DescriptionHighlight: highlightOrNil(rows[i].DescriptionHighlight),
+			MerchantHighlight:    highlightOrNil(rows[i].MerchantHighlight),
+			NotesHighlight:       highlightOrNil(rows[i].NotesHighlight),
+		}
+	}
+	if err := s.attachSplits(ctx, userID, txs); err != nil {
+		return nil, false, err
+	}
+	if err := s.attachTransfers(ctx, txs); err != nil {
+		return nil, false, err
+	}
+	if err := s.attachRefunds(ctx, txs); err != nil {
+		return nil, false, err
+	}
+	if err := s.attachSharedExpenses(ctx, txs); err != nil {
+		return nil, false, err
+	}
+	if err := s.attachTags(ctx, userID, txs); err != nil {
+		return nil, false, err
+	}
+
+	return result, hasMore, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+func buildSearchTxParams(userID uuid.UUID, req *pb.SearchTransactionsRequest) (sqlc.SearchTransactionsParams, error) {
+	tsquery := buildPrefixTsquery(req.GetQuery())
+	if tsquery == "" {
+		return sqlc.SearchTransactionsParams{}, fmt.Errorf("search query needs at least one letter or digit: %w", ErrValidation)
+	}
+
+	if req.GetOffset() < 0 {
+		return sqlc.SearchTransactionsParams{}, fmt.Errorf("offset must not be negative: %w", ErrValidation)
+	}
+
+	limit := int32(defaultSearchLimit)
+	if req.Limit != nil {
+		limit = min(max(req.GetLimit(), 1), maxSearchLimit)
+	}
+
+	params := sqlc.SearchTransactionsParams{
+		UserID:    userID,
+		Tsquery:   tsquery,
+		RawQuery:  strings.TrimSpace(req.GetQuery()),
+		AccountID: req.AccountId,
+		Limit:     limit + 1,
+		Offset:    req.GetOffset(),
+	}
+	if req.StartDate != nil {
+		start := req.StartDate.AsTime()
+		params.Start = &start
+	}
+	if req.EndDate != nil {
+		end := req.EndDate.AsTime()
+		params.End = &end
+	}
+	return params, nil
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// buildPrefixTsquery turns free text into a to_tsquery expression where every
+// word must match as a prefix: "Star-bucks cof" becomes "star:* & bucks:* & cof:*".
+// Only letters and digits survive, so the result is always valid tsquery syntax.
+func buildPrefixTsquery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) > maxSearchTerms {
+		words = words[:maxSearchTerms]
+	}
+
+	terms := make([]string, len(words))
+	for i, w := range words {
+		terms[i] = w + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// highlightOrNil HTML-escapes a headline and wraps its matches in <mark>. It
+// drops headlines without a match, ts_headline returns the start of the field
+// even when nothing in it matched.
+func highlightOrNil(headline string) *string {
+	if !strings.Contains(headline, searchMatchStart) {
+		return nil
+	}
+	highlight := highlightReplacer.Replace(html.EscapeString(headline))
+	return &highlight
+}
@@ -4,33 +4,47 @@ import (
 	"ariand/internal/config"
 	"ariand/internal/db"
 	"ariand/internal/exchange"
+	"ariand/internal/receipts"
 
 	"github.com/charmbracelet/log"
 )
 
 type Services struct {
-	Transactions TransactionService
-	Categories   CategoryService
-	Rules        RuleService
-	Accounts     AccountService
-	Dashboard    DashboardService
-	Users        UserService
-	Backup       BackupService
+	Transactions   TransactionService
+	Categories     CategoryService
+	Rules          RuleService
+	Accounts       AccountService
+	Dashboard      DashboardService
+	Users          UserService
+	Backup         BackupService
+	Receipts       ReceiptService
+	Tags           TagService
+	Recurring      RecurringService
+	Planned        PlannedTransactionService
+	Merchants      MerchantService
+	SharedExpenses SharedExpenseService
 }
 
 func New(database *db.DB, logger *log.Logger, cfg *config.Config) (*Services, error) {
 	queries := database.Queries
 	catSvc := newCatSvc(queries, logger.WithPrefix("cat"))
-	ruleSvc := newCatRuleSvc(queries, logger.WithPrefix("rules"))
+	ruleSvc := newCatRuleSvc(database, logger.WithPrefix("rules"))
 	exchangeClient := exchange.NewClient(cfg.ExchangeAPIURL)
+	receiptParser := receipts.NewClient(cfg.ReceiptsURL, cfg.ReceiptParserTimeout)
 
 	return &Services{
-		Transactions: newTxnSvc(queries, logger.WithPrefix("txn"), catSvc, ruleSvc, exchangeClient),
-		Categories:   catSvc,
-		Rules:        ruleSvc,
-		Accounts:     newAcctSvc(queries, logger.WithPrefix("acct")),
-		Dashboard:    newDashSvc(queries),
-		Users:        newUserSvc(queries, logger.WithPrefix("user")),
-		Backup:       newBackupSvc(queries),
+		Transactions:   newTxnSvc(database, logger.WithPrefix("txn"), catSvc, ruleSvc, exchangeClient),
+		Categories:     catSvc,
+		Rules:          ruleSvc,
+		Accounts:       newAcctSvc(queries, logger.WithPrefix("acct")),
+		Dashboard:      newDashSvc(queries),
+		Users:          newUserSvc(queries, logger.WithPrefix("user")),
+		Backup:         newBackupSvc(queries),
+		Receipts:       newReceiptSvc(database, logger.WithPrefix("rcpt"), receiptParser),
+		Tags:           newTagSvc(queries, logger.WithPrefix("tag")),
+		Recurring:      newRecurringSvc(database, logger.WithPrefix("recur")),
+		Planned:        newPlannedSvc(queries, logger.WithPrefix("plan")),
+		Merchants:      newMerchantSvc(database, logger.WithPrefix("merch")),
+		SharedExpenses: newSharedExpenseSvc(database, logger.WithPrefix("share")),
 	}, nil
 }
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"ariand/internal/db"
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"ariand/internal/shares"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----- interface ---------------------------------------------------------------------------
+
+type SharedExpenseService interface {
+	Set(ctx context.Context, userID uuid.UUID, req *pb.SetSharedExpenseRequest) (*pb.SharedExpense, error)
+	Clear(ctx context.Context, userID uuid.UUID, txID int64) (int64, error)
+	ListBalances(ctx context.Context, userID uuid.UUID) ([]*pb.SharedBalance, error)
+	SettleUp(ctx context.Context, userID uuid.UUID, req *pb.SettleUpRequest) (*pb.Settlement, error)
+	ListSettlements(ctx context.Context, userID uuid.UUID, otherUserID *string) ([]*pb.Settlement, error)
+}
+
+type sharedExpenseSvc struct {
+	db      *db.DB
+	queries *sqlc.Queries
+	log     *log.Logger
+}
+
+func newSharedExpenseSvc(database *db.DB, logger *log.Logger) SharedExpenseService {
+	return &sharedExpenseSvc{
+		db:      database,
+		queries: database.Queries,
+		log:     logger,
+	}
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+// Set replaces who paid for the transaction and how its cost is divided.
+func (s *sharedExpenseSvc) Set(ctx context.Context, userID uuid.UUID, req *pb.SetSharedExpenseRequest) (*pb.SharedExpense, error) {
+	tx, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: req.GetTransactionId()})
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.Set.GetTransaction", err)
+	}
+	if tx.TxDirection == pb.TransactionDirection_DIRECTION_INCOMING {
+		return nil, fmt.Errorf("SharedExpenseService.Set: transaction %d is incoming, only spending can be shared: %w", tx.ID, ErrValidation)
+	}
+
+	members, err := s.queries.ListAccountMemberIDs(ctx, tx.AccountID)
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.Set.Members", err)
+	}
+	params, err := buildSharedExpenseParts(&tx, members, req)
+	if err != nil {
+		return nil, fmt.Errorf("SharedExpenseService.Set: %w", err)
+	}
+	payerID, err := memberID(members, req.GetPayerId())
+	if err != nil {
+		return nil, fmt.Errorf("SharedExpenseService.Set: payer: %w", err)
+	}
+
+	var expense sqlc.SharedExpense
+	var parts []sqlc.SharedExpensePart
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		expense, err = q.UpsertSharedExpense(ctx, sqlc.UpsertSharedExpenseParams{
+			TransactionID: tx.ID,
+			PayerID:       payerID,
+			SplitMethod:   int16(req.GetSplitMethod()),
+			CreatedBy:     userID,
+		})
+		if err != nil {
+			return err
+		}
+		if err := q.DeleteSharedExpenseParts(ctx, tx.ID); err != nil {
+			return err
+		}
+		parts, err = q.BulkCreateSharedExpenseParts(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.Set", err)
+	}
+
+	return sharedExpenseToPb(&expense, parts, tx.TxCurrency), nil
+}
+
+func (s *sharedExpenseSvc) Clear(ctx context.Context, userID uuid.UUID, txID int64) (int64, error) {
+	// surfaces not-found for transactions the user can't see
+	if _, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: txID}); err != nil {
+		return 0, wrapErr("SharedExpenseService.Clear.GetTransaction", err)
+	}
+
+	affected, err := s.queries.DeleteSharedExpense(ctx, txID)
+	if err != nil {
+		return 0, wrapErr("SharedExpenseService.Clear", err)
+	}
+	return affected, nil
+}
+
+func (s *sharedExpenseSvc) ListBalances(ctx context.Context, userID uuid.UUID) ([]*pb.SharedBalance, error) {
+	rows, err := s.queries.ListSharedBalances(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.ListBalances", err)
+	}
+
+	result := make([]*pb.SharedBalance, len(rows))
+	for i := range rows {
+		result[i] = sharedBalanceToPb(&rows[i])
+	}
+	return result, nil
+}
+
+// SettleUp records a reimbursement between the user and another user in the
+// direction that evens out their balance, by default all of it. Unless it
+// points at an existing transaction, the reimbursement is booked in the
+// given account together with the settlement.
+func (s *sharedExpenseSvc) SettleUp(ctx context.Context, userID uuid.UUID, req *pb.SettleUpRequest) (*pb.Settlement, error) {
+	otherID, err := uuid.Parse(req.GetOtherUserId())
+	if err != nil {
+		return nil, fmt.Errorf("SharedExpenseService.SettleUp: invalid other_user_id: %w", ErrValidation)
+	}
+	if otherID == userID {
+		return nil, fmt.Errorf("SharedExpenseService.SettleUp: cannot settle up with yourself: %w", ErrValidation)
+	}
+
+	rows, err := s.queries.ListSharedBalances(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.SettleUp.Balances", err)
+	}
+	balances := make(map[string]int64)
+	for _, r := range rows {
+		if r.OtherUserID == otherID {
+			balances[r.Currency] = r.BalanceCents
+		}
+	}
+
+	currency := strings.ToUpper(req.GetCurrency())
+	if code := strings.ToUpper(req.GetAmount().GetCurrencyCode()); code != "" {
+		if currency != "" && currency != code {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: amount is in %s but currency is %s: %w", code, currency, ErrValidation)
+		}
+		currency = code
+	}
+	if currency == "" {
+		if len(balances) != 1 {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: balance spans %d currencies, pick one: %w", len(balances), ErrValidation)
+		}
+		for c := range balances {
+			currency = c
+		}
+	}
+
+	balance := balances[currency]
+	if balance == 0 {
+		return nil, fmt.Errorf("SharedExpenseService.SettleUp: already settled up in %s: %w", currency, ErrValidation)
+	}
+	owed := balance
+	if owed < 0 {
+		owed = -owed
+	}
+
+	amount := owed
+	if req.Amount != nil {
+		amount = minorUnits(req.Amount, currency)
+		if amount <= 0 || amount > owed {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: amount must be between 0 and the balance of %d cents: %w", owed, ErrValidation)
+		}
+	}
+
+	// a positive balance means the other user owes, so they pay
+	from, to := userID, otherID
+	direction := pb.TransactionDirection_DIRECTION_OUTGOING
+	if balance > 0 {
+		from, to = otherID, userID
+		direction = pb.TransactionDirection_DIRECTION_INCOMING
+	}
+
+	switch {
+	case req.TransactionId != nil && req.AccountId != nil:
+		return nil, fmt.Errorf("SharedExpenseService.SettleUp: set transaction_id or account_id, not both: %w", ErrValidation)
+	case req.TransactionId != nil:
+		tx, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: req.GetTransactionId()})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: transaction %d not found: %w", req.GetTransactionId(), ErrValidation)
+		}
+		if err != nil {
+			return nil, wrapErr("SharedExpenseService.SettleUp.GetTransaction", err)
+		}
+		// the transaction has to be the reimbursement itself
+		if tx.TxCurrency != currency || tx.TxDirection != direction || tx.TxAmountCents != amount {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: transaction %d is %s %s, the settlement is %s %s: %w",
+				tx.ID, tx.TxDirection, money.Format(tx.TxAmountCents, tx.TxCurrency), direction, money.Format(amount, currency), ErrValidation)
+		}
+	case req.AccountId != nil:
+		account, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{UserID: userID, ID: req.GetAccountId()})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: account %d not found: %w", req.GetAccountId(), ErrValidation)
+		}
+		if err != nil {
+			return nil, wrapErr("SharedExpenseService.SettleUp.GetAccount", err)
+		}
+		if account.Account.AnchorCurrency != currency {
+			return nil, fmt.Errorf("SharedExpenseService.SettleUp: account %d is in %s, not %s: %w",
+				account.Account.ID, account.Account.AnchorCurrency, currency, ErrValidation)
+		}
+	default:
+		return nil, fmt.Errorf("SharedExpenseService.SettleUp: transaction_id or account_id is required: %w", ErrValidation)
+	}
+
+	var settlement sqlc.Settlement
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		txID := req.TransactionId
+		if req.AccountId != nil {
+			desc := "Settle up"
+			if req.GetNote() != "" {
+				desc = req.GetNote()
+			}
+			tx, err := q.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+				AccountID:     req.GetAccountId(),
+				TxDate:        time.Now(),
+				TxAmountCents: amount,
+				TxCurrency:    currency,
+				TxDirection:   int16(direction),
+				TxDesc:        &desc,
+				UserID:        userID,
+			})
+			if err != nil {
+				return fmt.Errorf("create reimbursement: %w", err)
+			}
+			if err := q.SyncAccountBalances(ctx, tx.AccountID); err != nil {
+				return fmt.Errorf("sync balances for account %d: %w", tx.AccountID, err)
+			}
+			txID = &tx.ID
+		}
+
+		settlement, err = q.CreateSettlement(ctx, sqlc.CreateSettlementParams{
+			FromUserID:    from,
+			ToUserID:      to,
+			AmountCents:   amount,
+			Currency:      currency,
+			TransactionID: txID,
+			Note:          nonEmpty(req.Note),
+			CreatedBy:     userID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.SettleUp", err)
+	}
+
+	s.log.Info("settled up", "from", from, "to", to, "amount_cents", amount, "currency", currency)
+	return settlementToPb(&settlement), nil
+}
+
+func (s *sharedExpenseSvc) ListSettlements(ctx context.Context, userID uuid.UUID, otherUserID *string) ([]*pb.Settlement, error) {
+	params := sqlc.ListSettlementsParams{UserID: userID}
+	if otherUserID != nil {
+		otherID, err := uuid.Parse(*otherUserID)
+		if err != nil {
+			return nil, fmt.Errorf("SharedExpenseService.ListSettlements: invalid other_user_id: %w", ErrValidation)
+		}
+		params.OtherUserID = &otherID
+	}
+
+	rows, err := s.queries.ListSettlements(ctx, params)
+	if err != nil {
+		return nil, wrapErr("SharedExpenseService.ListSettlements", err)
+	}
+
+	result := make([]*pb.Settlement, len(rows))
+	for i := range rows {
+		result[i] = settlementToPb(&rows[i])
+	}
+	return result, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+// buildSharedExpenseParts turns the request's parts into exact amounts that
+// add up to the transaction amount.
+func buildSharedExpenseParts(tx *sqlc.Transaction, members []uuid.UUID, req *pb.SetSharedExpenseRequest) (sqlc.BulkCreateSharedExpensePartsParams, error) {
+	params := sqlc.BulkCreateSharedExpensePartsParams{TransactionID: tx.ID}
+	if len(req.GetParts()) == 0 {
+		return params, fmt.Errorf("at least one part is required: %w", ErrValidation)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.GetParts()))
+	weights := make([]float64, 0, len(req.GetParts()))
+	exact := make([]int64, 0, len(req.GetParts()))
+	for i, part := range req.GetParts() {
+		id, err := memberID(members, part.GetUserId())
+		if err != nil {
+			return params, fmt.Errorf("part %d: %w", i, err)
+		}
+		if seen[id] {
+			return params, fmt.Errorf("part %d: user %s is listed twice: %w", i, id, ErrValidation)
+		}
+		seen[id] = true
+		params.UserIds = append(params.UserIds, id)
+
+		switch req.GetSplitMethod() {
+		case pb.SharedSplitMethod_SHARED_SPLIT_METHOD_PERCENTAGE, pb.SharedSplitMethod_SHARED_SPLIT_METHOD_SHARES:
+			if part.Weight == nil {
+				return params, fmt.Errorf("part %d: weight is required: %w", i, ErrValidation)
+			}
+			weights = append(weights, part.GetWeight())
+		case pb.SharedSplitMethod_SHARED_SPLIT_METHOD_EXACT:
+			if part.Amount == nil {
+				return params, fmt.Errorf("part %d: amount is required: %w", i, ErrValidation)
+			}
+			if part.Amount.GetCurrencyCode() != "" && part.Amount.GetCurrencyCode() != tx.TxCurrency {
+				return params, fmt.Errorf("part %d: amount must be in %s: %w", i, tx.TxCurrency, ErrValidation)
+			}
+			exact = append(exact, minorUnits(part.Amount, tx.TxCurrency))
+		default:
+			return params, fmt.Errorf("split_method is required: %w", ErrValidation)
+		}
+	}
+
+	var amounts []int64
+	var err error
+	switch req.GetSplitMethod() {
+	case pb.SharedSplitMethod_SHARED_SPLIT_METHOD_PERCENTAGE:
+		amounts, err = shares.Percentages(tx.TxAmountCents, weights)
+	case pb.SharedSplitMethod_SHARED_SPLIT_METHOD_SHARES:
+		amounts, err = shares.Allocate(tx.TxAmountCents, weights)
+	default:
+		amounts, err = shares.Exact(tx.TxAmountCents, exact)
+	}
+	if err != nil {
+		return params, fmt.Errorf("%v: %w", err, ErrValidation)
+	}
+
+	params.AmountCents = amounts
+	params.Weights = weights
+	if params.Weights == nil {
+		params.Weights = make([]float64, len(amounts))
+	}
+	return params, nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func sharedExpenseToPb(e *sqlc.SharedExpense, parts []sqlc.SharedExpensePart, currency string) *pb.SharedExpense {
+	result := &pb.SharedExpense{
+		TransactionId: e.TransactionID,
+		PayerId:       e.PayerID.String(),
+		SplitMethod:   pb.SharedSplitMethod(e.SplitMethod),
+		Parts:         make([]*pb.SharedExpensePart, len(parts)),
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+		UpdatedAt:     timestamppb.New(e.UpdatedAt),
+	}
+	for i, p := range parts {
+		result.Parts[i] = &pb.SharedExpensePart{
+			UserId: p.UserID.String(),
+			Amount: money.FromMinor(p.AmountCents, currency),
+			Weight: p.Weight,
+		}
+	}
+	return result
+}
+
+func sharedBalanceToPb(r *sqlc.ListSharedBalancesRow) *pb.SharedBalance {
+	return &pb.SharedBalance{
+		UserId:      r.OtherUserID.String(),
+		Email:       r.Email,
+		DisplayName: r.DisplayName,
+		Balance:     money.FromMinor(r.BalanceCents, r.Currency),
+	}
+}
+
+func settlementToPb(s *sqlc.Settlement) *pb.Settlement {
+	return &pb.Settlement{
+		Id:            s.ID,
+		FromUserId:    s.FromUserID.String(),
+		ToUserId:      s.ToUserID.String(),
+		Amount:        money.FromMinor(s.AmountCents, s.Currency),
+		TransactionId: s.TransactionID,
+		Note:          s.Note,
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+	}
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// memberID parses id and checks that it can see the account.
+func memberID(members []uuid.UUID, id string) (uuid.UUID, error) {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid user id %q: %w", id, ErrValidation)
+	}
+	for _, m := range members {
+		if m == parsed {
+			return parsed, nil
+		}
+	}
+	return uuid.Nil, fmt.Errorf("user %s has no access to the account: %w", parsed, ErrValidation)
+}
+
+func (s *txnSvc) attachSharedExpenses(ctx context.Context, txs []*pb.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(txs))
+	byID := make(map[int64]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.Id
+		byID[tx.Id] = tx
+	}
+
+	expenses, err := s.queries.ListSharedExpenses(ctx, ids)
+	if err != nil {
+		return wrapErr("TransactionService.AttachSharedExpenses", err)
+	}
+	if len(expenses) == 0 {
+		return nil
+	}
+	parts, err := s.queries.ListSharedExpenseParts(ctx, ids)
+	if err != nil {
+		return wrapErr("TransactionService.AttachSharedExpenses.Parts", err)
+	}
+	partsByTx := make(map[int64][]sqlc.SharedExpensePart)
+	for _, p := range parts {
+		partsByTx[p.TransactionID] = append(partsByTx[p.TransactionID], p)
+	}
+
+	for i := range expenses {
+		tx := byID[expenses[i].TransactionID]
+		tx.SharedExpense = sharedExpenseToPb(&expenses[i], partsByTx[tx.Id], tx.GetTxAmount().GetCurrencyCode())
+	}
+	return nil
+}
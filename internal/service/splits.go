@@ -0,0 +1,164 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *txnSvc) SetSplits(ctx context.Context, userID uuid.UUID, req *pb.SetTransactionSplitsRequest) ([]*pb.TransactionSplit, error) {
+	var (
+		rows     []sqlc.TransactionSplit
+		currency string
+	)
+
+	err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		tx, err := q.GetTransaction(ctx, sqlc.GetTransactionParams{
+			UserID: userID,
+			ID:     req.GetTransactionId(),
+		})
+		if err != nil {
+			return wrapErr("TransactionService.SetSplits.GetTransaction", err)
+		}
+		currency = tx.TxCurrency
+
+		params, err := s.buildSplitParams(ctx, q, userID, &tx, req.GetSplits())
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.DeleteTransactionSplits(ctx, tx.ID); err != nil {
+			return wrapErr("TransactionService.SetSplits.Delete", err)
+		}
+
+		if len(params.AmountCents) == 0 {
+			return nil
+		}
+
+		rows, err = q.BulkCreateTransactionSplits(ctx, params)
+		if err != nil {
+			return wrapErr("TransactionService.SetSplits.Create", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.TransactionSplit, len(rows))
+	for i := range rows {
+		result[i] = splitToPb(&rows[i], currency)
+	}
+	return result, nil
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// buildSplitParams validates the requested parts against the parent: every part
+// is positive, in the parent's currency, uses one of the user's categories, and
+// together they add up to exactly the parent amount.
+func (s *txnSvc) buildSplitParams(
+	ctx context.Context,
+	q *sqlc.Queries,
+	userID uuid.UUID,
+	tx *sqlc.Transaction,
+	splits []*pb.TransactionSplitInput,
+) (sqlc.BulkCreateTransactionSplitsParams, error) {
+	params := sqlc.BulkCreateTransactionSplitsParams{TransactionID: tx.ID}
+	if len(splits) == 0 {
+		return params, nil
+	}
+	if len(splits) == 1 {
+		return params, fmt.Errorf("a split needs at least two parts, categorize the transaction instead: %w", ErrValidation)
+	}
+
+	checked := make(map[int64]bool)
+	total := int64(0)
+	for i, split := range splits {
+		amount := split.GetAmount()
+		if code := amount.GetCurrencyCode(); code != "" && !strings.EqualFold(code, tx.TxCurrency) {
+			return params, fmt.Errorf("split %d: currency %s does not match transaction currency %s: %w", i, code, tx.TxCurrency, ErrValidation)
+		}
+
+		cents := minorUnits(amount, tx.TxCurrency)
+		if cents <= 0 {
+			return params, fmt.Errorf("split %d: amount must be positive: %w", i, ErrValidation)
+		}
+		total += cents
+
+		categoryID := split.GetCategoryId()
+		if categoryID != 0 && !checked[categoryID] {
+			_, err := q.GetCategory(ctx, sqlc.GetCategoryParams{ID: categoryID, UserID: userID})
+			if errors.Is(err, pgx.ErrNoRows) {
+				return params, fmt.Errorf("split %d: category %d not found: %w", i, categoryID, ErrValidation)
+			}
+			if err != nil {
+				return params, wrapErr("TransactionService.SetSplits.GetCategory", err)
+			}
+			checked[categoryID] = true
+		}
+
+		params.AmountCents = append(params.AmountCents, cents)
+		params.CategoryIds = append(params.CategoryIds, categoryID)
+		params.Notes = append(params.Notes, split.GetNotes())
+	}
+
+	if total != tx.TxAmountCents {
+		return params, fmt.Errorf("splits add up to %s but the transaction is %s: %w",
+			money.Format(total, tx.TxCurrency), money.Format(tx.TxAmountCents, tx.TxCurrency), ErrValidation)
+	}
+
+	return params, nil
+}
+
+// attachSplits loads the parts of every split transaction in one query.
+func (s *txnSvc) attachSplits(ctx context.Context, userID uuid.UUID, txs []*pb.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(txs))
+	byID := make(map[int64]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.Id
+		byID[tx.Id] = tx
+	}
+
+	rows, err := s.queries.ListTransactionSplits(ctx, sqlc.ListTransactionSplitsParams{
+		UserID:         userID,
+		TransactionIds: ids,
+	})
+	if err != nil {
+		return wrapErr("TransactionService.ListSplits", err)
+	}
+
+	for i := range rows {
+		tx := byID[rows[i].TransactionID]
+		tx.Splits = append(tx.Splits, splitToPb(&rows[i], tx.GetTxAmount().GetCurrencyCode()))
+	}
+	return nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func splitToPb(split *sqlc.TransactionSplit, currency string) *pb.TransactionSplit {
+	return &pb.TransactionSplit{
+		Id:            split.ID,
+		TransactionId: split.TransactionID,
+		Amount:        money.FromMinor(split.AmountCents, currency),
+		CategoryId:    split.CategoryID,
+		Notes:         split.Notes,
+		CreatedAt:     timestamppb.New(split.CreatedAt),
+		UpdatedAt:     timestamppb.New(split.UpdatedAt),
+	}
+}
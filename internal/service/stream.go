@@ -0,0 +1,151 @@
+package service
+
+import (
+	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	defaultStreamPageSize = 500
+	maxStreamPageSize     = 1000
+)
+
+var streamCSVHeader = []string{
+	"id", "date", "status", "account_id", "direction", "amount", "currency",
+	"description", "merchant", "merchant_id", "category_id", "tags", "notes",
+	"foreign_amount", "foreign_currency", "exchange_rate",
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+// Stream pages through the transactions matching the filter, newest first,
+// and hands each page to send in the requested format. Only one page is held
+// in memory at a time.
+func (s *txnSvc) Stream(ctx context.Context, userID uuid.UUID, req *pb.StreamTransactionsRequest, send func(*pb.StreamTransactionsResponse) error) error {
+	f := req.GetFilter()
+	if f == nil {
+		f = &pb.TransactionFilter{}
+	}
+	listReq := filterToListRequest(f)
+	limit := int32(defaultStreamPageSize)
+	if req.PageSize != nil {
+		limit = min(max(req.GetPageSize(), 1), maxStreamPageSize)
+	}
+	listReq.Limit = &limit
+
+	for page := 0; ; page++ {
+		txs, next, err := s.List(ctx, userID, listReq)
+		if err != nil {
+			return fmt.Errorf("TransactionService.Stream: %w", err)
+		}
+
+		// the first page always goes out so CSV clients get the header
+		if len(txs) > 0 || page == 0 {
+			msg, err := encodeStreamPage(req.GetFormat(), txs, page == 0)
+			if err != nil {
+				return wrapErr("TransactionService.Stream.Encode", err)
+			}
+			if err := send(msg); err != nil {
+				return wrapErr("TransactionService.Stream.Send", err)
+			}
+		}
+
+		if next == nil {
+			return nil
+		}
+		listReq.Cursor = next
+	}
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func encodeStreamPage(format pb.ExportFormat, txs []*pb.Transaction, first bool) (*pb.StreamTransactionsResponse, error) {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_NDJSON:
+		var buf bytes.Buffer
+		opts := protojson.MarshalOptions{UseProtoNames: true}
+		for _, tx := range txs {
+			line, err := opts.Marshal(tx)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+		return &pb.StreamTransactionsResponse{Data: buf.Bytes()}, nil
+
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		if first {
+			if err := w.Write(streamCSVHeader); err != nil {
+				return nil, err
+			}
+		}
+		for _, tx := range txs {
+			if err := w.Write(transactionCSVRecord(tx)); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, err
+		}
+		return &pb.StreamTransactionsResponse{Data: buf.Bytes()}, nil
+
+	default:
+		return &pb.StreamTransactionsResponse{Transactions: txs}, nil
+	}
+}
+
+func transactionCSVRecord(tx *pb.Transaction) []string {
+	tags := make([]string, len(tx.GetTags()))
+	for i, t := range tx.GetTags() {
+		tags[i] = t.GetSlug()
+	}
+
+	var foreignAmount, foreignCurrency, exchangeRate string
+	if tx.ForeignAmount != nil {
+		foreignAmount = formatMoneyAmount(tx.ForeignAmount)
+		foreignCurrency = tx.ForeignAmount.GetCurrencyCode()
+	}
+	if tx.ExchangeRate != nil {
+		exchangeRate = strconv.FormatFloat(tx.GetExchangeRate(), 'f', -1, 64)
+	}
+
+	return []string{
+		strconv.FormatInt(tx.GetId(), 10),
+		tx.GetTxDate().AsTime().UTC().Format(time.RFC3339),
+		strings.ToLower(strings.TrimPrefix(tx.GetStatus().String(), "TRANSACTION_STATUS_")),
+		strconv.FormatInt(tx.GetAccountId(), 10),
+		strings.ToLower(strings.TrimPrefix(tx.GetDirection().String(), "DIRECTION_")),
+		formatMoneyAmount(tx.GetTxAmount()),
+		tx.GetTxAmount().GetCurrencyCode(),
+		tx.GetDescription(),
+		tx.GetMerchant(),
+		formatOptionalInt(tx.MerchantId),
+		formatOptionalInt(tx.CategoryId),
+		strings.Join(tags, ";"),
+		tx.GetUserNotes(),
+		foreignAmount,
+		foreignCurrency,
+		exchangeRate,
+	}
+}
+
+// formatMoneyAmount renders m as a plain decimal such as "-12.05", using the
+// currency's number of decimal places
+func formatMoneyAmount(m *moneypb.Money) string {
+	return money.Format(money.ToMinor(m), m.GetCurrencyCode())
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	tagSlugRe  = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+	tagColorRe = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+)
+
+// ----- interface ---------------------------------------------------------------------------
+
+type TagService interface {
+	Create(ctx context.Context, userID uuid.UUID, slug, color string) (*pb.Tag, error)
+	Get(ctx context.Context, userID uuid.UUID, tagID int64) (*pb.Tag, error)
+	Update(ctx context.Context, userID uuid.UUID, tagID int64, slug, color *string) (*pb.Tag, error)
+	Delete(ctx context.Context, userID uuid.UUID, tagID int64) (int64, error)
+	List(ctx context.Context, userID uuid.UUID) ([]*pb.Tag, error)
+	TagTransactions(ctx context.Context, userID uuid.UUID, transactionIDs, tagIDs []int64) (int64, error)
+	UntagTransactions(ctx context.Context, userID uuid.UUID, transactionIDs, tagIDs []int64) (int64, error)
+}
+
+type tagSvc struct {
+	queries *sqlc.Queries
+	log     *log.Logger
+}
+
+func newTagSvc(queries *sqlc.Queries, logger *log.Logger) TagService {
+	return &tagSvc{queries: queries, log: logger}
+}
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *tagSvc) Create(ctx context.Context, userID uuid.UUID, slug, color string) (*pb.Tag, error) {
+	slug, err := normalizeTagSlug(slug)
+	if err != nil {
+		return nil, wrapErr("TagService.Create", err)
+	}
+
+	if color == "" {
+		color = generateNiceHexColor()
+	} else if !tagColorRe.MatchString(color) {
+		return nil, fmt.Errorf("TagService.Create: color must be a hex color like #a1b2c3: %w", ErrValidation)
+	}
+
+	tag, err := s.queries.CreateTag(ctx, sqlc.CreateTagParams{
+		UserID: userID,
+		Slug:   slug,
+		Color:  color,
+	})
+	if err != nil {
+		return nil, wrapErr("TagService.Create", err)
+	}
+
+	return tagToPb(&tag), nil
+}
+
+func (s *tagSvc) Get(ctx context.Context, userID uuid.UUID, tagID int64) (*pb.Tag, error) {
+	tag, err := s.queries.GetTag(ctx, sqlc.GetTagParams{
+		ID:     tagID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, wrapErr("TagService.Get", err)
+	}
+
+	return tagToPb(&tag), nil
+}
+
+func (s *tagSvc) Update(ctx context.Context, userID uuid.UUID, tagID int64, slug, color *string) (*pb.Tag, error) {
+	params := sqlc.UpdateTagParams{
+		ID:     tagID,
+		UserID: userID,
+	}
+
+	if slug != nil {
+		normalized, err := normalizeTagSlug(*slug)
+		if err != nil {
+			return nil, wrapErr("TagService.Update", err)
+		}
+		params.Slug = &normalized
+	}
+	if color != nil {
+		if !tagColorRe.MatchString(*color) {
+			return nil, fmt.Errorf("TagService.Update: color must be a hex color like #a1b2c3: %w", ErrValidation)
+		}
+		params.Color = color
+	}
+
+	tag, err := s.queries.UpdateTag(ctx, params)
+	if err != nil {
+		return nil, wrapErr("TagService.Update", err)
+	}
+
+	return tagToPb(&tag), nil
+}
+
+func (s *tagSvc) Delete(ctx context.Context, userID uuid.UUID, tagID int64) (int64, error) {
+	affected, err := s.queries.DeleteTag(ctx, sqlc.DeleteTagParams{
+		ID:     tagID,
+		UserID: userID,
+	})
+	if err != nil {
+		return 0, wrapErr("TagService.Delete", err)
+	}
+	return affected, nil
+}
+
+func (s *tagSvc) List(ctx context.Context, userID uuid.UUID) ([]*pb.Tag, error) {
+	rows, err := s.queries.ListTags(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("TagService.List", err)
+	}
+
+	result := make([]*pb.Tag, len(rows))
+	for i := range rows {
+		result[i] = tagToPb(&rows[i])
+	}
+	return result, nil
+}
+
+func (s *tagSvc) TagTransactions(ctx context.Context, userID uuid.UUID, transactionIDs, tagIDs []int64) (int64, error) {
+	if err := s.ensureOwnTags(ctx, userID, tagIDs); err != nil {
+		return 0, wrapErr("TagService.TagTransactions", err)
+	}
+
+	affected, err := s.queries.BulkTagTransactions(ctx, sqlc.BulkTagTransactionsParams{
+		UserID:         userID,
+		TransactionIds: transactionIDs,
+		TagIds:         tagIDs,
+	})
+	if err != nil {
+		return 0, wrapErr("TagService.TagTransactions", err)
+	}
+	return affected, nil
+}
+
+func (s *tagSvc) UntagTransactions(ctx context.Context, userID uuid.UUID, transactionIDs, tagIDs []int64) (int64, error) {
+	affected, err := s.queries.BulkUntagTransactions(ctx, sqlc.BulkUntagTransactionsParams{
+		UserID:         userID,
+		TransactionIds: transactionIDs,
+		TagIds:         tagIDs,
+	})
+	if err != nil {
+		return 0, wrapErr("TagService.UntagTransactions", err)
+	}
+	return affected, nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func tagToPb(t *sqlc.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:        t.ID,
+		Slug:      t.Slug,
+		Color:     t.Color,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// normalizeTagSlug accepts "#Vacation-2026" and stores "vacation-2026"
+func normalizeTagSlug(slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(slug), "#"))
+	if !tagSlugRe.MatchString(slug) {
+		return "", fmt.Errorf("tag %q must be letters, digits, '-' or '_': %w", slug, ErrValidation)
+	}
+	return slug, nil
+}
+
+func (s *tagSvc) ensureOwnTags(ctx context.Context, userID uuid.UUID, tagIDs []int64) error {
+	unique := make(map[int64]bool, len(tagIDs))
+	for _, id := range tagIDs {
+		unique[id] = true
+	}
+
+	found, err := s.queries.CountUserTags(ctx, sqlc.CountUserTagsParams{
+		UserID: userID,
+		TagIds: tagIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if found != int64(len(unique)) {
+		return fmt.Errorf("unknown tag id: %w", ErrValidation)
+	}
+	return nil
+}
@@ -1,29 +1,65 @@
 package service
 
 import (
+	"ariand/internal/db"
 	"ariand/internal/db/sqlc"
 	"ariand/internal/exchange"
+	"ariand/internal/filter"
 	pb "ariand/internal/gen/arian/v1"
+	"ariand/internal/money"
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	txStatusPending int16 = 1
+	txStatusPosted  int16 = 2
+	txStatusVoided  int16 = 3
+)
+
 // ----- interface ---------------------------------------------------------------------------
 
 type TransactionService interface {
-	Create(ctx context.Context, userID uuid.UUID, req *pb.CreateTransactionRequest) ([]*pb.Transaction, error)
+	Create(ctx context.Context, userID uuid.UUID, req *pb.CreateTransactionRequest) ([]*pb.CreateTransactionResult, error)
 	Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Transaction, error)
 	Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateTransactionRequest) error
 	Delete(ctx context.Context, userID uuid.UUID, ids []int64) error
 	List(ctx context.Context, userID uuid.UUID, req *pb.ListTransactionsRequest) ([]*pb.Transaction, *pb.Cursor, error)
 	Categorize(ctx context.Context, userID uuid.UUID, transactionIDs []int64, categoryID int64) error
+	Stream(ctx context.Context, userID uuid.UUID, req *pb.StreamTransactionsRequest, send func(*pb.StreamTransactionsResponse) error) error
+	Aggregate(ctx context.Context, userID uuid.UUID, req *pb.AggregateTransactionsRequest) (*pb.AggregateTransactionsResponse, error)
+	UpdateByFilter(ctx context.Context, userID uuid.UUID, req *pb.UpdateTransactionsByFilterRequest) (int64, error)
+	DeleteByFilter(ctx context.Context, userID uuid.UUID, req *pb.DeleteTransactionsByFilterRequest) (int64, error)
+	Import(ctx context.Context, userID uuid.UUID, req *pb.ImportStatementRequest) (*pb.ImportStatementResponse, error)
+	ListImportProfiles(ctx context.Context, userID uuid.UUID) ([]*pb.ImportProfile, error)
+	SaveImportProfile(ctx context.Context, userID uuid.UUID, req *pb.SaveImportProfileRequest) (*pb.ImportProfile, error)
+	DeleteImportProfile(ctx context.Context, userID uuid.UUID, name string) (int64, error)
+	SetSplits(ctx context.Context, userID uuid.UUID, req *pb.SetTransactionSplitsRequest) ([]*pb.TransactionSplit, error)
+	LinkTransfer(ctx context.Context, userID uuid.UUID, outgoingID, incomingID int64) (*pb.Transfer, error)
+	UnlinkTransfer(ctx context.Context, userID uuid.UUID, txID int64) (int64, error)
+	DetectTransfers(ctx context.Context, userID uuid.UUID, req *pb.DetectTransfersRequest) ([]*pb.Transfer, error)
+	LinkRefund(ctx context.Context, userID uuid.UUID, originalID, refundID int64) (*pb.Refund, error)
+	UnlinkRefund(ctx context.Context, userID uuid.UUID, txID int64) (int64, error)
+	DetectRefunds(ctx context.Context, userID uuid.UUID, req *pb.DetectRefundsRequest) ([]*pb.Refund, error)
+	Search(ctx context.Context, userID uuid.UUID, req *pb.SearchTransactionsRequest) ([]*pb.TransactionSearchResult, bool, error)
+	GetHistory(ctx context.Context, userID uuid.UUID, txID int64) ([]*pb.TransactionChange, error)
+	ListDeleted(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*pb.Transaction, error)
+	Restore(ctx context.Context, userID uuid.UUID, ids []int64) (int64, error)
+	Purge(ctx context.Context, userID uuid.UUID, ids []int64) (int64, error)
 }
 
 type txnSvc struct {
+	db             *db.DB
 	queries        *sqlc.Queries
 	log            *log.Logger
 	catSvc         CategoryService
@@ -32,14 +68,15 @@ type txnSvc struct {
 }
 
 func newTxnSvc(
-	queries *sqlc.Queries,
+	database *db.DB,
 	logger *log.Logger,
 	catSvc CategoryService,
 	ruleSvc RuleService,
 	exchangeClient *exchange.Client,
 ) TransactionService {
 	return &txnSvc{
-		queries:        queries,
+		db:             database,
+		queries:        database.Queries,
 		log:            logger,
 		catSvc:         catSvc,
 		ruleSvc:        ruleSvc,
@@ -49,7 +86,7 @@ func newTxnSvc(
 
 // ----- methods -----------------------------------------------------------------------------
 
-func (s *txnSvc) Create(ctx context.Context, userID uuid.UUID, req *pb.CreateTransactionRequest) ([]*pb.Transaction, error) {
+func (s *txnSvc) Create(ctx context.Context, userID uuid.UUID, req *pb.CreateTransactionRequest) ([]*pb.CreateTransactionResult, error) {
 	paramsList, err := buildCreateTxParamsList(userID, req)
 	if err != nil {
 		return nil, fmt.Errorf("TransactionService.Create: failed to build params: %w", err)
@@ -59,58 +96,138 @@ func (s *txnSvc) Create(ctx context.Context, userID uuid.UUID, req *pb.CreateTra
 		return nil, fmt.Errorf("TransactionService.Create: no transactions provided")
 	}
 
-	// validate all transactions first
-	for i, params := range paramsList {
-		if err := s.validateCreateParams(params); err != nil {
-			return nil, fmt.Errorf("TransactionService.Create: transaction %d invalid: %w", i, err)
-		}
+	return s.createFromParams(ctx, userID, paramsList, req.GetBestEffort())
+}
+
+// createFromParams is the shared insert path for api requests and statement imports.
+// every accepted item is inserted in one database transaction together with the
+// balance resync. without bestEffort a single bad item fails the whole batch, with
+// it invalid, duplicate and unconvertible items are skipped and reported in the results.
+func (s *txnSvc) createFromParams(ctx context.Context, userID uuid.UUID, paramsList []sqlc.CreateTransactionParams, bestEffort bool) ([]*pb.CreateTransactionResult, error) {
+	results := make([]*pb.CreateTransactionResult, len(paramsList))
+	for i := range results {
+		results[i] = &pb.CreateTransactionResult{Index: int32(i)}
 	}
 
-	// process foreign currency conversions
+	emailIDs, err := s.existingEmailIDs(ctx, paramsList)
+	if err != nil {
+		return nil, wrapErr("TransactionService.Create.EmailIDs", err)
+	}
+
+	// validate, dedupe and convert every item before touching the database
+	accounts := make(map[int64]*sqlc.Account)
+	accepted := make([]int, 0, len(paramsList))
 	for i := range paramsList {
-		converted, err := s.processForeignCurrency(ctx, userID, &paramsList[i])
-		if err != nil {
-			return nil, fmt.Errorf("TransactionService.Create: transaction %d currency conversion failed: %w", i, err)
+		status, err := s.prepareCreateParams(ctx, userID, &paramsList[i], accounts, emailIDs)
+		if err == nil {
+			accepted = append(accepted, i)
+			continue
 		}
-		paramsList[i] = *converted
-	}
 
-	// insert transactions
-	created := make([]sqlc.Transaction, 0, len(paramsList))
-	for _, params := range paramsList {
-		tx, err := s.queries.CreateTransaction(ctx, params)
-		if err != nil {
-			return nil, wrapErr("TransactionService.Create.Insert", err)
+		if status == pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_UNSPECIFIED {
+			return nil, wrapErr("TransactionService.Create.Prepare", err)
 		}
-		created = append(created, tx)
+		if !bestEffort {
+			return nil, fmt.Errorf("TransactionService.Create: transaction %d invalid: %w", i, err)
+		}
+
+		reason := err.Error()
+		results[i].Status = status
+		results[i].Reason = &reason
 	}
 
-	// sync balances for all affected accounts (once per account)
-	affectedAccounts := make(map[int64]bool)
-	for _, tx := range created {
-		affectedAccounts[tx.AccountID] = true
+	if len(accepted) == 0 {
+		return results, nil
 	}
-	for accountID := range affectedAccounts {
-		if err := s.queries.SyncAccountBalances(ctx, accountID); err != nil {
-			s.log.Warn("failed to sync account balances", "account_id", accountID, "error", err)
+
+	var created []sqlc.Transaction
+	var inserted []int
+	settled := make(map[int]bool)
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		// posted items that settle a pending authorization update it in place
+		var rows []sqlc.Transaction
+		claimed := []int64{}
+		inserted = make([]int, 0, len(accepted))
+		for _, i := range accepted {
+			tx, ok, err := settlePending(ctx, q, actorFromContext(ctx, userID), &paramsList[i], claimed)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				inserted = append(inserted, i)
+				continue
+			}
+			claimed = append(claimed, tx.ID)
+			settled[i] = true
+			rows = append(rows, tx)
+		}
+
+		if len(inserted) > 0 {
+			newRows, err := q.BulkCreateTransactions(ctx, buildBulkCreateTxParams(paramsList, inserted))
+			if err != nil {
+				return err
+			}
+			if len(newRows) != len(inserted) {
+				return fmt.Errorf("inserted %d of %d transactions", len(newRows), len(inserted))
+			}
+			rows = append(rows, newRows...)
+		}
+
+		// sync balances for all affected accounts (once per account)
+		synced := make(map[int64]bool)
+		for _, tx := range rows {
+			if synced[tx.AccountID] {
+				continue
+			}
+			synced[tx.AccountID] = true
+			if err := q.SyncAccountBalances(ctx, tx.AccountID); err != nil {
+				return fmt.Errorf("sync balances for account %d: %w", tx.AccountID, err)
+			}
 		}
+
+		created = rows
+		return nil
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.Create.Insert", err)
 	}
 
-	// apply rules to transactions that need it
-	for _, tx := range created {
+	// settled rows come first, in the order of accepted
+	order := make([]int, 0, len(accepted))
+	for _, i := range accepted {
+		if settled[i] {
+			order = append(order, i)
+		}
+	}
+	order = append(order, inserted...)
+
+	for i := range created {
+		tx := &created[i]
+
+		// apply rules to transactions that need it
 		needsRules := !tx.CategoryManuallySet || !tx.MerchantManuallySet
 		if needsRules {
 			s.applyRulesToTransaction(ctx, userID, tx.ID)
 		}
+
+		result := results[order[i]]
+		result.Status = pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_CREATED
+		if settled[order[i]] {
+			result.Status = pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_REPLACED_PENDING
+		}
+		result.Transaction = txToPb(tx)
 	}
 
-	// convert to proto
-	result := make([]*pb.Transaction, len(created))
+	createdIDs := make([]int64, len(created))
 	for i := range created {
-		result[i] = txToPb(&created[i])
+		createdIDs[i] = created[i].ID
 	}
+	s.resolveTransactionMerchants(ctx, userID, createdIDs)
+	s.linkNewTransfers(ctx, userID, createdIDs)
+	s.linkNewRefunds(ctx, userID, createdIDs)
+	s.fulfillPlanned(ctx, userID, created)
 
-	return result, nil
+	return results, nil
 }
 
 func (s *txnSvc) Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Transaction, error) {
@@ -122,11 +239,31 @@ func (s *txnSvc) Get(ctx context.Context, userID uuid.UUID, id int64) (*pb.Trans
 		return nil, wrapErr("TransactionService.Get", err)
 	}
 
-	return txToPb(&row), nil
+	tx := txToPb(&row)
+	if err := s.attachSplits(ctx, userID, []*pb.Transaction{tx}); err != nil {
+		return nil, err
+	}
+	if err := s.attachTransfers(ctx, []*pb.Transaction{tx}); err != nil {
+		return nil, err
+	}
+	if err := s.attachRefunds(ctx, []*pb.Transaction{tx}); err != nil {
+		return nil, err
+	}
+	if err := s.attachSharedExpenses(ctx, []*pb.Transaction{tx}); err != nil {
+		return nil, err
+	}
+	if err := s.attachTags(ctx, userID, []*pb.Transaction{tx}); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
 }
 
 func (s *txnSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateTransactionRequest) error {
-	params := buildUpdateTxParams(userID, req)
+	params, err := buildUpdateTxParams(userID, req)
+	if err != nil {
+		return fmt.Errorf("TransactionService.Update: %w", err)
+	}
 
 	tx, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{
 		UserID: params.UserID,
@@ -136,14 +273,51 @@ func (s *txnSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateTra
 		return wrapErr("TransactionService.Update.GetOriginal", err)
 	}
 
-	err = s.queries.UpdateTransaction(ctx, params)
+	accountChanged := params.AccountID != nil && *params.AccountID != tx.AccountID
+	if params.TxAmountCents != nil || params.TxDate != nil || accountChanged || req.ExchangeRate != nil {
+		if err := s.recomputeForeignCurrency(ctx, userID, &tx, &params); err != nil {
+			return fmt.Errorf("TransactionService.Update: currency conversion failed: %w", err)
+		}
+	}
+
+	// splits are pinned to the amount they were made for
+	amountChanged := params.TxAmountCents != nil && *params.TxAmountCents != tx.TxAmountCents
+	currencyChanged := params.TxCurrency != nil && *params.TxCurrency != tx.TxCurrency
+	if amountChanged || currencyChanged {
+		splits, err := s.queries.CountTransactionSplits(ctx, tx.ID)
+		if err != nil {
+			return wrapErr("TransactionService.Update.CountSplits", err)
+		}
+		if splits > 0 {
+			return fmt.Errorf("TransactionService.Update: transaction is split, update or clear its splits first: %w", ErrValidation)
+		}
+		shared, err := s.queries.CountSharedExpenses(ctx, tx.ID)
+		if err != nil {
+			return wrapErr("TransactionService.Update.CountShared", err)
+		}
+		if shared > 0 {
+			return fmt.Errorf("TransactionService.Update: transaction is shared, update or clear its allocation first: %w", ErrValidation)
+		}
+	}
+
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.UpdateTransaction(ctx, params); err != nil {
+			return err
+		}
+
+		updated, err := q.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: params.UserID, ID: params.ID})
+		if err != nil {
+			return err
+		}
+		return recordHistory(ctx, q, diffTransactions(&tx, &updated, actorFromContext(ctx, userID)))
+	})
 	if err != nil {
 		return wrapErr("TransactionService.Update", err)
 	}
 
 	// sync balances if amount, date, direction, or account changed
-	balanceFieldsChanged := params.TxAmountCents != nil || params.TxDate != nil || params.TxDirection != nil
-	accountChanged := params.AccountID != nil && *params.AccountID != tx.AccountID
+	balanceFieldsChanged := params.TxAmountCents != nil || params.TxDate != nil || params.TxDirection != nil ||
+		(params.Status != nil && *params.Status != tx.Status)
 
 	if balanceFieldsChanged || accountChanged {
 		// sync the original account
@@ -168,6 +342,10 @@ func (s *txnSvc) Update(ctx context.Context, userID uuid.UUID, req *pb.UpdateTra
 		s.applyRulesToTransaction(ctx, params.UserID, params.ID)
 	}
 
+	if params.TxDesc != nil || params.Merchant != nil || accountChanged {
+		s.resolveTransactionMerchants(ctx, params.UserID, []int64{params.ID})
+	}
+
 	return nil
 }
 
@@ -199,7 +377,10 @@ func (s *txnSvc) Delete(ctx context.Context, userID uuid.UUID, ids []int64) erro
 }
 
 func (s *txnSvc) List(ctx context.Context, userID uuid.UUID, req *pb.ListTransactionsRequest) ([]*pb.Transaction, *pb.Cursor, error) {
-	params := buildListTxParams(userID, req)
+	params, err := s.listTxParams(ctx, userID, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("TransactionService.List.Filter: %w", err)
+	}
 
 	rows, err := s.queries.ListTransactions(ctx, params)
 	if err != nil {
@@ -211,6 +392,21 @@ func (s *txnSvc) List(ctx context.Context, userID uuid.UUID, req *pb.ListTransac
 	for i := range rows {
 		result[i] = txToPb(&rows[i])
 	}
+	if err := s.attachSplits(ctx, userID, result); err != nil {
+		return nil, nil, err
+	}
+	if err := s.attachTransfers(ctx, result); err != nil {
+		return nil, nil, err
+	}
+	if err := s.attachRefunds(ctx, result); err != nil {
+		return nil, nil, err
+	}
+	if err := s.attachSharedExpenses(ctx, result); err != nil {
+		return nil, nil, err
+	}
+	if err := s.attachTags(ctx, userID, result); err != nil {
+		return nil, nil, err
+	}
 
 	// build next cursor
 	var nextCursor *pb.Cursor
@@ -227,10 +423,31 @@ func (s *txnSvc) List(ctx context.Context, userID uuid.UUID, req *pb.ListTransac
 }
 
 func (s *txnSvc) Categorize(ctx context.Context, userID uuid.UUID, transactionIDs []int64, categoryID int64) error {
-	_, err := s.queries.BulkCategorizeTransactions(ctx, sqlc.BulkCategorizeTransactionsParams{
-		UserID:         userID,
-		TransactionIds: transactionIDs,
-		CategoryID:     categoryID,
+	actor := actorFromContext(ctx, userID)
+	err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		rows, err := q.BulkCategorizeTransactions(ctx, sqlc.BulkCategorizeTransactionsParams{
+			UserID:         userID,
+			TransactionIds: transactionIDs,
+			CategoryID:     categoryID,
+		})
+		if err != nil {
+			return err
+		}
+
+		newCategory := strconv.FormatInt(categoryID, 10)
+		var changes []fieldChange
+		for _, r := range rows {
+			if oldCategory := formatOptionalInt(r.OldCategoryID); oldCategory != newCategory {
+				changes = append(changes, fieldChange{
+					txID:     r.ID,
+					field:    "category_id",
+					oldValue: oldCategory,
+					newValue: newCategory,
+					actor:    actor,
+				})
+			}
+		}
+		return recordHistory(ctx, q, changes)
 	})
 	if err != nil {
 		return wrapErr("TransactionService.Categorize", err)
@@ -240,6 +457,20 @@ func (s *txnSvc) Categorize(ctx context.Context, userID uuid.UUID, transactionID
 
 // ----- param builders ----------------------------------------------------------------------
 
+// listTxParams builds the list params including the filter expression.
+// Parse errors stay reachable, the api layer turns them into details.
+func (s *txnSvc) listTxParams(ctx context.Context, userID uuid.UUID, req *pb.ListTransactionsRequest) (sqlc.ListTransactionsParams, error) {
+	params := buildListTxParams(userID, req)
+	if req.Filter != nil {
+		f, err := filter.Parse(req.GetFilter(), userLocation(ctx, s.queries, userID), userCurrency(ctx, s.queries, userID))
+		if err != nil {
+			return params, fmt.Errorf("%w: %w", ErrValidation, err)
+		}
+		f.Apply(&params)
+	}
+	return params, nil
+}
+
 func buildListTxParams(userID uuid.UUID, req *pb.ListTransactionsRequest) sqlc.ListTransactionsParams {
 	params := sqlc.ListTransactionsParams{
 		UserID: userID,
@@ -266,6 +497,20 @@ func buildListTxParams(userID uuid.UUID, req *pb.ListTransactionsRequest) sqlc.L
 		endTime := fromProtoTimestamp(req.EndDate)
 		params.End = &endTime
 	}
+	if req.AmountMin != nil {
+		minCents := money.ToMinor(req.AmountMin)
+		params.AmountMinCents = &minCents
+	}
+	if req.AmountMax != nil {
+		maxCents := money.ToMinor(req.AmountMax)
+		params.AmountMaxCents = &maxCents
+	}
+	if req.TimeOfDayStart != nil {
+		params.TodStart = timeOfDayToPg(req.TimeOfDayStart)
+	}
+	if req.TimeOfDayEnd != nil {
+		params.TodEnd = timeOfDayToPg(req.TimeOfDayEnd)
+	}
 	if req.Direction != nil {
 		direction := int16(*req.Direction)
 		params.Direction = &direction
@@ -276,6 +521,13 @@ func buildListTxParams(userID uuid.UUID, req *pb.ListTransactionsRequest) sqlc.L
 	if len(req.Categories) > 0 {
 		params.Categories = req.Categories
 	}
+	if len(req.Tags) > 0 {
+		tags := make([]string, len(req.Tags))
+		for i, tag := range req.Tags {
+			tags[i] = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		}
+		params.Tags = tags
+	}
 	if req.MerchantQuery != nil {
 		params.MerchantQ = req.MerchantQuery
 	}
@@ -288,16 +540,28 @@ func buildListTxParams(userID uuid.UUID, req *pb.ListTransactionsRequest) sqlc.L
 	if req.Uncategorized != nil {
 		params.Uncategorized = req.Uncategorized
 	}
+	if len(req.Statuses) > 0 {
+		statuses := make([]int16, len(req.Statuses))
+		for i, status := range req.Statuses {
+			statuses[i] = int16(status)
+		}
+		params.Statuses = statuses
+	}
 
 	return params
 }
 
+func timeOfDayToPg(tod *pb.TimeOfDay) pgtype.Time {
+	clock := time.Duration(tod.GetHours())*time.Hour + time.Duration(tod.GetMinutes())*time.Minute
+	return pgtype.Time{Microseconds: clock.Microseconds(), Valid: true}
+}
+
 func buildCreateTxParamsList(userID uuid.UUID, req *pb.CreateTransactionRequest) ([]sqlc.CreateTransactionParams, error) {
 	paramsList := make([]sqlc.CreateTransactionParams, len(req.Transactions))
 
 	for i, txInput := range req.Transactions {
 		txAmount := txInput.TxAmount
-		txCents := moneyToCents(txAmount)
+		txCents := money.ToMinor(txAmount)
 		txCurrency := txAmount.GetCurrencyCode()
 
 		categoryManuallySet := false
@@ -305,6 +569,7 @@ func buildCreateTxParamsList(userID uuid.UUID, req *pb.CreateTransactionRequest)
 
 		params := sqlc.CreateTransactionParams{
 			UserID:              userID,
+			EmailID:             txInput.EmailId,
 			AccountID:           txInput.GetAccountId(),
 			TxDate:              fromProtoTimestamp(txInput.TxDate),
 			TxAmountCents:       txCents,
@@ -330,11 +595,8 @@ func buildCreateTxParamsList(userID uuid.UUID, req *pb.CreateTransactionRequest)
 		}
 
 		if txInput.ForeignAmount != nil {
-			foreignCents := moneyToCents(txInput.ForeignAmount)
+			foreignCents := money.ToMinor(txInput.ForeignAmount)
 			foreignCurrency := txInput.ForeignAmount.CurrencyCode
-			if foreignCurrency == "" {
-				foreignCurrency = "USD"
-			}
 			params.ForeignAmountCents = &foreignCents
 			params.ForeignCurrency = &foreignCurrency
 			if txInput.ExchangeRate != nil {
@@ -342,13 +604,67 @@ func buildCreateTxParamsList(userID uuid.UUID, req *pb.CreateTransactionRequest)
 			}
 		}
 
+		if txInput.Status != nil && *txInput.Status != pb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED {
+			status := int16(*txInput.Status)
+			params.Status = &status
+		}
+
 		paramsList[i] = params
 	}
 
 	return paramsList, nil
 }
 
-func buildUpdateTxParams(userID uuid.UUID, req *pb.UpdateTransactionRequest) sqlc.UpdateTransactionParams {
+// buildBulkCreateTxParams flattens the accepted items into the column arrays
+// BulkCreateTransactions unnests. zero values are stored as null.
+func buildBulkCreateTxParams(paramsList []sqlc.CreateTransactionParams, indexes []int) sqlc.BulkCreateTransactionsParams {
+	n := len(indexes)
+	bulk := sqlc.BulkCreateTransactionsParams{
+		EmailIds:            make([]string, n),
+		AccountIds:          make([]int64, n),
+		TxDates:             make([]time.Time, n),
+		TxAmountCents:       make([]int64, n),
+		TxCurrencies:        make([]string, n),
+		TxDirections:        make([]int16, n),
+		TxDescs:             make([]string, n),
+		CategoryIds:         make([]int64, n),
+		CategoryManuallySet: make([]bool, n),
+		Merchants:           make([]string, n),
+		MerchantManuallySet: make([]bool, n),
+		UserNotes:           make([]string, n),
+		ForeignAmountCents:  make([]int64, n),
+		ForeignCurrencies:   make([]string, n),
+		ExchangeRates:       make([]float64, n),
+		Statuses:            make([]int16, n),
+	}
+
+	for j, i := range indexes {
+		p := paramsList[i]
+		bulk.EmailIds[j] = deref(p.EmailID)
+		bulk.AccountIds[j] = p.AccountID
+		bulk.TxDates[j] = p.TxDate
+		bulk.TxAmountCents[j] = p.TxAmountCents
+		bulk.TxCurrencies[j] = p.TxCurrency
+		bulk.TxDirections[j] = p.TxDirection
+		bulk.TxDescs[j] = deref(p.TxDesc)
+		bulk.CategoryIds[j] = deref(p.CategoryID)
+		bulk.CategoryManuallySet[j] = deref(p.CategoryManuallySet)
+		bulk.Merchants[j] = deref(p.Merchant)
+		bulk.MerchantManuallySet[j] = deref(p.MerchantManuallySet)
+		bulk.UserNotes[j] = deref(p.UserNotes)
+		bulk.ForeignAmountCents[j] = deref(p.ForeignAmountCents)
+		bulk.ForeignCurrencies[j] = deref(p.ForeignCurrency)
+		bulk.ExchangeRates[j] = deref(p.ExchangeRate)
+		bulk.Statuses[j] = txStatusPosted
+		if p.Status != nil {
+			bulk.Statuses[j] = *p.Status
+		}
+	}
+
+	return bulk
+}
+
+func buildUpdateTxParams(userID uuid.UUID, req *pb.UpdateTransactionRequest) (sqlc.UpdateTransactionParams, error) {
 	params := sqlc.UpdateTransactionParams{
 		ID:     req.GetId(),
 		UserID: userID,
@@ -359,7 +675,10 @@ func buildUpdateTxParams(userID uuid.UUID, req *pb.UpdateTransactionRequest) sql
 		params.TxDate = &txTime
 	}
 	if req.TxAmount != nil {
-		cents := moneyToCents(req.TxAmount)
+		if req.TxAmount.GetCurrencyCode() == "" {
+			return params, fmt.Errorf("tx_amount needs a currency_code: %w", ErrValidation)
+		}
+		cents := money.ToMinor(req.TxAmount)
 		currency := req.TxAmount.CurrencyCode
 		params.TxAmountCents = &cents
 		params.TxCurrency = &currency
@@ -397,11 +716,11 @@ func buildUpdateTxParams(userID uuid.UUID, req *pb.UpdateTransactionRequest) sql
 		}
 	}
 	if req.ForeignAmount != nil {
-		foreignCents := moneyToCents(req.ForeignAmount)
-		foreignCurrency := req.ForeignAmount.CurrencyCode
-		if foreignCurrency == "" {
-			foreignCurrency = "USD"
+		if req.ForeignAmount.GetCurrencyCode() == "" {
+			return params, fmt.Errorf("foreign_amount needs a currency_code: %w", ErrValidation)
 		}
+		foreignCents := money.ToMinor(req.ForeignAmount)
+		foreignCurrency := req.ForeignAmount.CurrencyCode
 		params.ForeignAmountCents = &foreignCents
 		params.ForeignCurrency = &foreignCurrency
 	}
@@ -411,8 +730,12 @@ func buildUpdateTxParams(userID uuid.UUID, req *pb.UpdateTransactionRequest) sql
 	if req.AccountId != nil {
 		params.AccountID = req.AccountId
 	}
+	if req.Status != nil && *req.Status != pb.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED {
+		status := int16(*req.Status)
+		params.Status = &status
+	}
 
-	return params
+	return params, nil
 }
 
 func (s *txnSvc) GetTransactionParams(userID uuid.UUID, id int64) sqlc.GetTransactionParams {
@@ -428,7 +751,7 @@ func txToPb(tx *sqlc.Transaction) *pb.Transaction {
 	proto := &pb.Transaction{
 		Id:                  tx.ID,
 		TxDate:              timestamppb.New(tx.TxDate),
-		TxAmount:            centsToMoney(tx.TxAmountCents, tx.TxCurrency),
+		TxAmount:            money.FromMinor(tx.TxAmountCents, tx.TxCurrency),
 		Direction:           pb.TransactionDirection(tx.TxDirection),
 		AccountId:           tx.AccountID,
 		EmailId:             tx.EmailID,
@@ -437,17 +760,20 @@ func txToPb(tx *sqlc.Transaction) *pb.Transaction {
 		CategoryManuallySet: tx.CategoryManuallySet,
 		Merchant:            tx.Merchant,
 		MerchantManuallySet: tx.MerchantManuallySet,
+		MerchantId:          tx.MerchantID,
 		UserNotes:           tx.UserNotes,
 		CreatedAt:           timestamppb.New(tx.CreatedAt),
 		UpdatedAt:           timestamppb.New(tx.UpdatedAt),
+		DeletedAt:           toProtoTimestamp(tx.DeletedAt),
+		Status:              pb.TransactionStatus(tx.Status),
 	}
 
 	if tx.BalanceAfterCents != nil && tx.BalanceCurrency != nil {
-		proto.BalanceAfter = centsToMoney(*tx.BalanceAfterCents, *tx.BalanceCurrency)
+		proto.BalanceAfter = money.FromMinor(*tx.BalanceAfterCents, *tx.BalanceCurrency)
 	}
 
 	if tx.ForeignAmountCents != nil && tx.ForeignCurrency != nil {
-		proto.ForeignAmount = centsToMoney(*tx.ForeignAmountCents, *tx.ForeignCurrency)
+		proto.ForeignAmount = money.FromMinor(*tx.ForeignAmountCents, *tx.ForeignCurrency)
 	}
 	if tx.ExchangeRate != nil {
 		proto.ExchangeRate = tx.ExchangeRate
@@ -459,6 +785,13 @@ func txToPb(tx *sqlc.Transaction) *pb.Transaction {
 // ----- internal helpers --------------------------------------------------------------------
 
 func (s *txnSvc) validateCreateParams(params sqlc.CreateTransactionParams) error {
+	// without a code the amount can't be read in minor units
+	if params.TxCurrency == "" {
+		return fmt.Errorf("tx_amount needs a currency_code: %w", ErrValidation)
+	}
+	if params.ForeignAmountCents != nil && deref(params.ForeignCurrency) == "" {
+		return fmt.Errorf("foreign_amount needs a currency_code: %w", ErrValidation)
+	}
 	if params.TxAmountCents == 0 {
 		return fmt.Errorf("tx_amount cannot be zero: %w", ErrValidation)
 	}
@@ -472,37 +805,263 @@ func (s *txnSvc) validateCreateParams(params sqlc.CreateTransactionParams) error
 	return nil
 }
 
-func (s *txnSvc) processForeignCurrency(ctx context.Context, userID uuid.UUID, params *sqlc.CreateTransactionParams) (*sqlc.CreateTransactionParams, error) {
-	account, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{
-		UserID: userID,
-		ID:     params.AccountID,
+// prepareCreateParams validates a single item and converts it to the account
+// currency. the returned status says how a failure should be reported; an
+// unspecified status means the error is not the item's fault.
+func (s *txnSvc) prepareCreateParams(
+	ctx context.Context,
+	userID uuid.UUID,
+	params *sqlc.CreateTransactionParams,
+	accounts map[int64]*sqlc.Account,
+	emailIDs map[string]bool,
+) (pb.CreateTransactionStatus, error) {
+	if params.EmailID != nil && emailIDs[*params.EmailID] {
+		return pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_DUPLICATE,
+			fmt.Errorf("email_id %q already exists: %w", *params.EmailID, ErrValidation)
+	}
+
+	if err := s.validateCreateParams(*params); err != nil {
+		return pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_INVALID, err
+	}
+
+	account, ok := accounts[params.AccountID]
+	if !ok {
+		row, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{
+			UserID: userID,
+			ID:     params.AccountID,
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_INVALID,
+				fmt.Errorf("account %d not found: %w", params.AccountID, ErrValidation)
+		}
+		if err != nil {
+			return pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_UNSPECIFIED, fmt.Errorf("failed to fetch account: %w", err)
+		}
+		account = &row.Account
+		accounts[params.AccountID] = account
+	}
+
+	if err := s.processForeignCurrency(params, account); err != nil {
+		// without an override the only failure is the rate lookup, which isn't bad input
+		return pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_RATE_UNAVAILABLE, fmt.Errorf("currency conversion failed: %w", err)
+	}
+
+	if params.EmailID != nil {
+		emailIDs[*params.EmailID] = true
+	}
+
+	return pb.CreateTransactionStatus_CREATE_TRANSACTION_STATUS_CREATED, nil
+}
+
+// settlePending finds the pending authorization a posted item settles and
+// updates it with the posted details, so the id, category, notes and tags
+// survive. ok is false when the item has to be inserted as a new row.
+func settlePending(
+	ctx context.Context,
+	q *sqlc.Queries,
+	actor changeActor,
+	params *sqlc.CreateTransactionParams,
+	claimed []int64,
+) (tx sqlc.Transaction, ok bool, err error) {
+	if params.Status != nil && *params.Status != txStatusPosted {
+		return tx, false, nil
+	}
+
+	pending, err := q.FindPendingMatch(ctx, sqlc.FindPendingMatchParams{
+		AccountID:     params.AccountID,
+		TxDirection:   params.TxDirection,
+		TxCurrency:    params.TxCurrency,
+		TxDate:        params.TxDate,
+		TxAmountCents: params.TxAmountCents,
+		TxDesc:        deref(coalescePtr(params.TxDesc, params.Merchant)),
+		ExcludeIds:    claimed,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return tx, false, nil
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch account: %w", err)
+		return tx, false, fmt.Errorf("find pending match: %w", err)
+	}
+
+	tx, err = q.PostPendingTransaction(ctx, sqlc.PostPendingTransactionParams{
+		ID:                 pending.ID,
+		TxDate:             params.TxDate,
+		TxAmountCents:      params.TxAmountCents,
+		EmailID:            params.EmailID,
+		TxDesc:             params.TxDesc,
+		Merchant:           params.Merchant,
+		ForeignAmountCents: params.ForeignAmountCents,
+		ForeignCurrency:    params.ForeignCurrency,
+		ExchangeRate:       params.ExchangeRate,
+	})
+	if err != nil {
+		return tx, false, fmt.Errorf("post pending transaction %d: %w", pending.ID, err)
+	}
+
+	if err := recordHistory(ctx, q, diffTransactions(&pending, &tx, actor)); err != nil {
+		return tx, false, err
+	}
+	// the posted text replaces the pending one
+	if err := resolveMerchants(ctx, q, actor, sqlc.ResolveTransactionMerchantsParams{TransactionIds: []int64{tx.ID}}); err != nil {
+		return tx, false, err
+	}
+	return tx, true, nil
+}
+
+func (s *txnSvc) existingEmailIDs(ctx context.Context, paramsList []sqlc.CreateTransactionParams) (map[string]bool, error) {
+	var candidates []string
+	for _, params := range paramsList {
+		if params.EmailID != nil {
+			candidates = append(candidates, *params.EmailID)
+		}
 	}
 
-	if params.TxCurrency == account.Account.AnchorCurrency {
+	existing := make(map[string]bool)
+	if len(candidates) == 0 {
+		return existing, nil
+	}
+
+	rows, err := s.queries.ListExistingEmailIDs(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range rows {
+		existing[id] = true
+	}
+
+	return existing, nil
+}
+
+func (s *txnSvc) processForeignCurrency(params *sqlc.CreateTransactionParams, account *sqlc.Account) error {
+	if params.TxCurrency == account.AnchorCurrency {
 		params.ForeignAmountCents = nil
 		params.ForeignCurrency = nil
 		params.ExchangeRate = nil
-		return params, nil
+		return nil
 	}
 
 	foreignAmountCents := params.TxAmountCents
 	foreignCurrency := params.TxCurrency
 
-	rate, err := s.exchangeClient.GetExchangeRate(foreignCurrency, account.Account.AnchorCurrency, &params.TxDate)
+	converted, rate, err := s.convertToAnchor(foreignAmountCents, foreignCurrency, account.AnchorCurrency, params.TxDate, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get exchange rate from %s to %s: %w", foreignCurrency, account.Account.AnchorCurrency, err)
+		return err
 	}
 
-	params.TxAmountCents = int64(float64(foreignAmountCents) * rate)
-	params.TxCurrency = account.Account.AnchorCurrency
+	params.TxAmountCents = converted
+	params.TxCurrency = account.AnchorCurrency
 	params.ForeignAmountCents = &foreignAmountCents
 	params.ForeignCurrency = &foreignCurrency
 	params.ExchangeRate = &rate
 
-	return params, nil
+	return nil
+}
+
+// recomputeForeignCurrency re-derives the conversion of an edited transaction
+// against the anchor currency of the account it ends up in, at the rate for
+// its (possibly new) date. The original amount is the edited one if given,
+// else the stored foreign amount, else the stored amount. An exchange_rate in
+// the request is kept instead of the looked-up rate and marked as set by the
+// user, so later edits keep converting at it until the currency changes.
+func (s *txnSvc) recomputeForeignCurrency(ctx context.Context, userID uuid.UUID, tx *sqlc.Transaction, params *sqlc.UpdateTransactionParams) error {
+	cents, currency := tx.TxAmountCents, tx.TxCurrency
+	switch {
+	case params.TxAmountCents != nil:
+		cents = *params.TxAmountCents
+		if params.TxCurrency != nil && *params.TxCurrency != "" {
+			currency = *params.TxCurrency
+		} else if tx.ForeignCurrency != nil {
+			currency = *tx.ForeignCurrency
+		}
+	case params.ForeignAmountCents != nil && params.ForeignCurrency != nil:
+		cents, currency = *params.ForeignAmountCents, *params.ForeignCurrency
+	case tx.ForeignAmountCents != nil && tx.ForeignCurrency != nil:
+		cents, currency = *tx.ForeignAmountCents, *tx.ForeignCurrency
+	}
+
+	accountID := *coalescePtr(params.AccountID, &tx.AccountID)
+	row, err := s.queries.GetAccount(ctx, sqlc.GetAccountParams{UserID: userID, ID: accountID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("account %d not found: %w", accountID, ErrValidation)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch account: %w", err)
+	}
+	anchor := row.Account.AnchorCurrency
+
+	if currency == anchor {
+		params.TxAmountCents = &cents
+		params.TxCurrency = &anchor
+		params.ClearForeign = true
+		return nil
+	}
+
+	override := params.ExchangeRate
+	if override == nil && tx.ExchangeRateManuallySet && tx.ExchangeRate != nil &&
+		deref(tx.ForeignCurrency) == currency && tx.TxCurrency == anchor {
+		override = tx.ExchangeRate
+	}
+	manuallySet := override != nil
+
+	converted, rate, err := s.convertToAnchor(cents, currency, anchor, *coalescePtr(params.TxDate, &tx.TxDate), override)
+	if err != nil {
+		return err
+	}
+
+	params.TxAmountCents = &converted
+	params.TxCurrency = &anchor
+	params.ForeignAmountCents = &cents
+	params.ForeignCurrency = &currency
+	params.ExchangeRate = &rate
+	params.ExchangeRateManuallySet = &manuallySet
+	params.ClearForeign = false
+	return nil
+}
+
+// convertToAnchor converts cents from currency into anchor at the historical
+// rate for date, or at override when one is given, rounding half up.
+func (s *txnSvc) convertToAnchor(cents int64, currency, anchor string, date time.Time, override *float64) (int64, float64, error) {
+	if override != nil {
+		if *override <= 0 {
+			return 0, 0, fmt.Errorf("exchange_rate must be positive: %w", ErrValidation)
+		}
+		return money.Convert(cents, currency, anchor, *override, money.RoundHalfUp), *override, nil
+	}
+
+	rate, err := s.exchangeClient.GetExchangeRate(currency, anchor, &date)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get exchange rate from %s to %s: %w", currency, anchor, err)
+	}
+	return money.Convert(cents, currency, anchor, rate, money.RoundHalfUp), rate, nil
+}
+
+// attachTags fills in the viewer's own tags; tags are per-user, so on a shared
+// account each member only sees the labels they added.
+func (s *txnSvc) attachTags(ctx context.Context, userID uuid.UUID, txs []*pb.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(txs))
+	byID := make(map[int64]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.Id
+		byID[tx.Id] = tx
+	}
+
+	rows, err := s.queries.ListTagsForTransactions(ctx, sqlc.ListTagsForTransactionsParams{
+		UserID:         userID,
+		TransactionIds: ids,
+	})
+	if err != nil {
+		return wrapErr("TransactionService.ListTags", err)
+	}
+
+	for i := range rows {
+		tx := byID[rows[i].TransactionID]
+		tx.Tags = append(tx.Tags, tagToPb(&rows[i].Tag))
+	}
+	return nil
 }
 
 func (s *txnSvc) applyRulesToTransaction(ctx context.Context, userID uuid.UUID, txID int64) {
@@ -553,7 +1112,34 @@ func (s *txnSvc) applyRulesToTransaction(ctx context.Context, userID uuid.UUID,
 		updateParams.Merchant = result.Merchant
 	}
 
-	if err := s.queries.UpdateTransaction(ctx, updateParams); err != nil {
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		if err := q.UpdateTransaction(ctx, updateParams); err != nil {
+			return err
+		}
+		return recordHistory(ctx, q, ruleChanges(
+			txID,
+			tx.CategoryID, coalescePtr(updateParams.CategoryID, tx.CategoryID),
+			tx.Merchant, coalescePtr(updateParams.Merchant, tx.Merchant),
+			result.CategoryRuleID, result.MerchantRuleID,
+		))
+	})
+	if err != nil {
 		s.log.Warn("failed to update transaction with rule results", "tx_id", txID, "error", err)
 	}
 }
+
+// resolveTransactionMerchants points transactions at the merchant their text
+// resolves to, after rules had their say. Failures are logged, not returned:
+// the transactions are stored.
+func (s *txnSvc) resolveTransactionMerchants(ctx context.Context, userID uuid.UUID, txIDs []int64) {
+	if len(txIDs) == 0 {
+		return
+	}
+
+	err := s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		return resolveMerchants(ctx, q, actorFromContext(ctx, userID), sqlc.ResolveTransactionMerchantsParams{TransactionIds: txIDs})
+	})
+	if err != nil {
+		s.log.Warn("failed to resolve merchants", "count", len(txIDs), "error", err)
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultTransferWindowDays    = 3
+	defaultTransferMinSimilarity = 0.1
+)
+
+// ----- methods -----------------------------------------------------------------------------
+
+func (s *txnSvc) LinkTransfer(ctx context.Context, userID uuid.UUID, outgoingID, incomingID int64) (*pb.Transfer, error) {
+	outgoing, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: outgoingID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkTransfer.GetOutgoing", err)
+	}
+	incoming, err := s.queries.GetTransaction(ctx, sqlc.GetTransactionParams{UserID: userID, ID: incomingID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkTransfer.GetIncoming", err)
+	}
+
+	if outgoing.TxDirection != 2 || incoming.TxDirection != 1 {
+		return nil, fmt.Errorf("TransactionService.LinkTransfer: a transfer links an outgoing transaction to an incoming one: %w", ErrValidation)
+	}
+	if outgoing.AccountID == incoming.AccountID {
+		return nil, fmt.Errorf("TransactionService.LinkTransfer: both transactions are on the same account: %w", ErrValidation)
+	}
+
+	existing, err := s.queries.ListTransfersForTransactions(ctx, []int64{outgoingID, incomingID})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkTransfer.Existing", err)
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("TransactionService.LinkTransfer: transaction is already part of a transfer: %w", ErrValidation)
+	}
+
+	var transfer sqlc.Transfer
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		// a manual link overrides an earlier unlink of the same pair
+		if _, err := q.DeleteDismissedTransfer(ctx, sqlc.DeleteDismissedTransferParams{
+			UserID:       userID,
+			OutgoingTxID: outgoingID,
+			IncomingTxID: incomingID,
+		}); err != nil {
+			return err
+		}
+
+		transfer, err = q.CreateTransfer(ctx, sqlc.CreateTransferParams{
+			UserID:       userID,
+			OutgoingTxID: outgoingID,
+			IncomingTxID: incomingID,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.LinkTransfer", err)
+	}
+
+	return transferToPb(&transfer), nil
+}
+
+func (s *txnSvc) UnlinkTransfer(ctx context.Context, userID uuid.UUID, txID int64) (int64, error) {
+	affected, err := s.queries.DismissTransfer(ctx, sqlc.DismissTransferParams{
+		UserID:        userID,
+		TransactionID: txID,
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.UnlinkTransfer", err)
+	}
+	return affected, nil
+}
+
+func (s *txnSvc) DetectTransfers(ctx context.Context, userID uuid.UUID, req *pb.DetectTransfersRequest) ([]*pb.Transfer, error) {
+	params := buildTransferCandidatesParams(userID, req.GetWindowDays(), req.MinSimilarity)
+	if req.StartDate != nil {
+		start := req.StartDate.AsTime()
+		params.Start = &start
+	}
+	if req.EndDate != nil {
+		end := req.EndDate.AsTime()
+		params.End = &end
+	}
+
+	transfers, err := s.detectTransfers(ctx, params, req.GetDryRun())
+	if err != nil {
+		return nil, wrapErr("TransactionService.DetectTransfers", err)
+	}
+	return transfers, nil
+}
+
+// ----- param builders ----------------------------------------------------------------------
+
+func buildTransferCandidatesParams(userID uuid.UUID, windowDays int32, minSimilarity *float64) sqlc.ListTransferCandidatesParams {
+	params := sqlc.ListTransferCandidatesParams{
+		UserID:        userID,
+		WindowDays:    defaultTransferWindowDays,
+		MinSimilarity: defaultTransferMinSimilarity,
+	}
+	if windowDays > 0 {
+		params.WindowDays = windowDays
+	}
+	if minSimilarity != nil {
+		params.MinSimilarity = float32(*minSimilarity)
+	}
+	return params
+}
+
+// ----- internal helpers --------------------------------------------------------------------
+
+// detectTransfers pairs candidates greedily: the query returns the best matches
+// first, and every transaction ends up in at most one transfer.
+func (s *txnSvc) detectTransfers(ctx context.Context, params sqlc.ListTransferCandidatesParams, dryRun bool) ([]*pb.Transfer, error) {
+	candidates, err := s.queries.ListTransferCandidates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[int64]bool)
+	var pairs []sqlc.ListTransferCandidatesRow
+	for _, c := range candidates {
+		if used[c.OutgoingID] || used[c.IncomingID] {
+			continue
+		}
+		used[c.OutgoingID], used[c.IncomingID] = true, true
+		pairs = append(pairs, c)
+	}
+
+	result := make([]*pb.Transfer, len(pairs))
+	if dryRun {
+		for i, p := range pairs {
+			result[i] = transferCandidateToPb(&p)
+		}
+		return result, nil
+	}
+
+	err = s.db.InTx(ctx, func(q *sqlc.Queries) error {
+		for i, p := range pairs {
+			transfer, err := q.CreateTransfer(ctx, sqlc.CreateTransferParams{
+				UserID:       params.UserID,
+				OutgoingTxID: p.OutgoingID,
+				IncomingTxID: p.IncomingID,
+				AutoDetected: true,
+			})
+			if err != nil {
+				return err
+			}
+			result[i] = transferToPb(&transfer)
+			result[i].Similarity = transferCandidateToPb(&p).Similarity
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) > 0 {
+		s.log.Info("linked transfers", "user_id", params.UserID, "count", len(result))
+	}
+	return result, nil
+}
+
+// linkNewTransfers looks for counterparts of freshly created transactions.
+// Failures are logged, not returned: the transactions themselves are stored.
+func (s *txnSvc) linkNewTransfers(ctx context.Context, userID uuid.UUID, txIDs []int64) {
+	if len(txIDs) == 0 {
+		return
+	}
+
+	params := buildTransferCandidatesParams(userID, 0, nil)
+	params.TransactionIds = txIDs
+
+	if _, err := s.detectTransfers(ctx, params, false); err != nil {
+		s.log.Warn("failed to detect transfers for new transactions", "count", len(txIDs), "error", err)
+	}
+}
+
+func (s *txnSvc) attachTransfers(ctx context.Context, txs []*pb.Transaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(txs))
+	byID := make(map[int64]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.Id
+		byID[tx.Id] = tx
+	}
+
+	rows, err := s.queries.ListTransfersForTransactions(ctx, ids)
+	if err != nil {
+		return wrapErr("TransactionService.ListTransfers", err)
+	}
+
+	for i := range rows {
+		transfer := transferToPb(&rows[i])
+		if tx, ok := byID[rows[i].OutgoingTxID]; ok {
+			tx.Transfer = transfer
+		}
+		if tx, ok := byID[rows[i].IncomingTxID]; ok {
+			tx.Transfer = transfer
+		}
+	}
+	return nil
+}
+
+// ----- conversion helpers ------------------------------------------------------------------
+
+func transferToPb(t *sqlc.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:                    t.ID,
+		OutgoingTransactionId: t.OutgoingTxID,
+		IncomingTransactionId: t.IncomingTxID,
+		AutoDetected:          t.AutoDetected,
+		CreatedAt:             timestamppb.New(t.CreatedAt),
+	}
+}
+
+func transferCandidateToPb(c *sqlc.ListTransferCandidatesRow) *pb.Transfer {
+	similarity := float64(c.Score)
+	return &pb.Transfer{
+		OutgoingTransactionId: c.OutgoingID,
+		IncomingTransactionId: c.IncomingID,
+		AutoDetected:          true,
+		Similarity:            &similarity,
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"ariand/internal/db/sqlc"
+	pb "ariand/internal/gen/arian/v1"
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+const defaultTrashPageSize = 100
+
+// ----- transaction trash -------------------------------------------------------------------
+
+func (s *txnSvc) ListDeleted(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*pb.Transaction, error) {
+	if limit <= 0 {
+		limit = defaultTrashPageSize
+	}
+
+	rows, err := s.queries.ListDeletedTransactions(ctx, sqlc.ListDeletedTransactionsParams{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, wrapErr("TransactionService.ListDeleted", err)
+	}
+
+	result := make([]*pb.Transaction, len(rows))
+	for i := range rows {
+		result[i] = txToPb(&rows[i])
+	}
+	return result, nil
+}
+
+func (s *txnSvc) Restore(ctx context.Context, userID uuid.UUID, ids []int64) (int64, error) {
+	accountIDs, err := s.queries.RestoreTransactions(ctx, sqlc.RestoreTransactionsParams{
+		UserID:         userID,
+		TransactionIds: ids,
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.Restore", err)
+	}
+
+	// restored rows count towards the running balance again
+	synced := make(map[int64]bool)
+	for _, accountID := range accountIDs {
+		if synced[accountID] {
+			continue
+		}
+		synced[accountID] = true
+		if err := s.queries.SyncAccountBalances(ctx, accountID); err != nil {
+			s.log.Warn("failed to sync account balances after restore", "account_id", accountID, "error", err)
+		}
+	}
+
+	return int64(len(accountIDs)), nil
+}
+
+func (s *txnSvc) Purge(ctx context.Context, userID uuid.UUID, ids []int64) (int64, error) {
+	affected, err := s.queries.PurgeTransactions(ctx, sqlc.PurgeTransactionsParams{
+		UserID:         userID,
+		TransactionIds: ids,
+	})
+	if err != nil {
+		return 0, wrapErr("TransactionService.Purge", err)
+	}
+	return affected, nil
+}
+
+// ----- account trash -----------------------------------------------------------------------
+
+func (s *acctSvc) ListDeleted(ctx context.Context, userID uuid.UUID) ([]*pb.Account, error) {
+	rows, err := s.queries.ListDeletedAccounts(ctx, userID)
+	if err != nil {
+		return nil, wrapErr("AccountService.ListDeleted", err)
+	}
+
+	accounts := make([]*pb.Account, len(rows))
+	for i, row := range rows {
+		accounts[i] = accountRowToPb(row.Account, row.BalanceCents, row.BalanceCurrency)
+	}
+	return accounts, nil
+}
+
+func (s *acctSvc) Restore(ctx context.Context, userID uuid.UUID, accountID int64) (int64, error) {
+	affected, err := s.queries.RestoreAccount(ctx, sqlc.RestoreAccountParams{
+		UserID: userID,
+		ID:     accountID,
+	})
+	if err != nil {
+		return 0, wrapErr("AccountService.Restore", err)
+	}
+	return affected, nil
+}
+
+func (s *acctSvc) Purge(ctx context.Context, userID uuid.UUID, accountID int64) (int64, error) {
+	affected, err := s.queries.PurgeAccount(ctx, sqlc.PurgeAccountParams{
+		UserID: userID,
+		ID:     accountID,
+	})
+	if err != nil {
+		return 0, wrapErr("AccountService.Purge", err)
+	}
+	return affected, nil
+}
+
+// ----- retention ---------------------------------------------------------------------------
+
+// TrashPurger permanently removes trashed rows once they are older than the
+// retention window.
+type TrashPurger struct {
+	queries   *sqlc.Queries
+	log       *log.Logger
+	retention time.Duration
+}
+
+func NewTrashPurger(queries *sqlc.Queries, logger *log.Logger, retention time.Duration) *TrashPurger {
+	return &TrashPurger{queries: queries, log: logger, retention: retention}
+}
+
+// Run purges once right away and then every interval until ctx is done.
+// A zero retention keeps trashed rows forever.
+func (p *TrashPurger) Run(ctx context.Context, interval time.Duration) {
+	if p.retention <= 0 {
+		p.log.Info("trash retention disabled, trashed rows are kept until purged by hand")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		p.PurgeExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) PurgeExpired(ctx context.Context) {
+	cutoff := time.Now().Add(-p.retention)
+
+	txs, err := p.queries.PurgeExpiredTransactions(ctx, cutoff)
+	if err != nil {
+		p.log.Warn("failed to purge expired transactions", "error", err)
+	}
+	accounts, err := p.queries.PurgeExpiredAccounts(ctx, cutoff)
+	if err != nil {
+		p.log.Warn("failed to purge expired accounts", "error", err)
+	}
+
+	if txs > 0 || accounts > 0 {
+		p.log.Info("purged expired trash", "transactions", txs, "accounts", accounts, "cutoff", cutoff)
+	}
+}
@@ -1,12 +1,16 @@
 package service
 
 import (
+	"ariand/internal/db/sqlc"
+	"ariand/internal/money"
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/type/date"
-	"google.golang.org/genproto/googleapis/type/money"
+	moneypb "google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -34,6 +38,22 @@ func int32Ptr(i int32) *int32 {
 	return &i
 }
 
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+// coalescePtr returns p unless it is nil, then fallback
+func coalescePtr[T any](p, fallback *T) *T {
+	if p != nil {
+		return p
+	}
+	return fallback
+}
+
 func toProtoTimestamp(t *time.Time) *timestamppb.Timestamp {
 	if t == nil || t.IsZero() {
 		return nil
@@ -48,21 +68,6 @@ func fromProtoTimestamp(ts *timestamppb.Timestamp) time.Time {
 	return ts.AsTime()
 }
 
-func moneyToCents(m *money.Money) int64 {
-	if m == nil {
-		return 0
-	}
-	return m.Units*100 + int64(m.Nanos)/10_000_000
-}
-
-func centsToMoney(cents int64, currency string) *money.Money {
-	return &money.Money{
-		CurrencyCode: currency,
-		Units:        cents / 100,
-		Nanos:        int32((cents % 100) * 10_000_000),
-	}
-}
-
 func dateToTime(d *date.Date) *time.Time {
 	if d == nil {
 		return nil
@@ -81,3 +86,31 @@ func timeToDate(t time.Time) *date.Date {
 		Day:   int32(t.Day()),
 	}
 }
+
+// minorUnits converts m into minor units of currency. Callers check m's own
+// code first; an empty one would otherwise be read with two decimals.
+func minorUnits(m *moneypb.Money, currency string) int64 {
+	return money.ToMinor(&moneypb.Money{CurrencyCode: currency, Units: m.GetUnits(), Nanos: m.GetNanos()})
+}
+
+// userCurrency resolves the user's primary currency, falling back to CAD
+func userCurrency(ctx context.Context, queries *sqlc.Queries, userID uuid.UUID) string {
+	currency, err := queries.GetUserPrimaryCurrency(ctx, userID)
+	if err != nil || currency == "" {
+		return "CAD"
+	}
+	return currency
+}
+
+// userLocation resolves the user's timezone, falling back to UTC
+func userLocation(ctx context.Context, queries *sqlc.Queries, userID uuid.UUID) *time.Location {
+	timezone, err := queries.GetUserTimezone(ctx, userID)
+	if err != nil {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,84 @@
+// Package shares divides an amount of cents between people.
+//
+// Rounding never loses or creates a cent: parts are rounded down and the
+// cents left over go to the parts with the largest remainders, earlier
+// parts first on ties.
+package shares
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Allocate splits total in proportion to weights, e.g. percentages or share
+// counts. A zero weight gets nothing, but at least one must be positive.
+func Allocate(total int64, weights []float64) ([]int64, error) {
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("no weights")
+	}
+
+	var sum float64
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("weight %d must not be negative", i)
+		}
+		sum += w
+	}
+	if sum == 0 {
+		return nil, fmt.Errorf("weights add up to 0")
+	}
+
+	parts := make([]int64, len(weights))
+	remainders := make([]float64, len(weights))
+	var allocated int64
+	for i, w := range weights {
+		exact := float64(total) * w / sum
+		parts[i] = int64(math.Floor(exact))
+		remainders[i] = exact - float64(parts[i])
+		allocated += parts[i]
+	}
+
+	// leftover cents only go to parts with a weight
+	order := make([]int, 0, len(weights))
+	for i, w := range weights {
+		if w > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]] > remainders[order[b]] })
+
+	for i := 0; allocated < total; i++ {
+		parts[order[i%len(order)]]++
+		allocated++
+	}
+	return parts, nil
+}
+
+// Percentages splits total by percentages that add up to 100, allowing for
+// rounding in the second decimal.
+func Percentages(total int64, percentages []float64) ([]int64, error) {
+	var sum float64
+	for _, p := range percentages {
+		sum += p
+	}
+	if math.Abs(sum-100) > 0.01 {
+		return nil, fmt.Errorf("percentages add up to %g, not 100", sum)
+	}
+	return Allocate(total, percentages)
+}
+
+// Exact checks that exact amounts add up to total.
+func Exact(total int64, amounts []int64) ([]int64, error) {
+	var sum int64
+	for i, a := range amounts {
+		if a < 0 {
+			return nil, fmt.Errorf("amount %d is negative", i)
+		}
+		sum += a
+	}
+	if sum != total {
+		return nil, fmt.Errorf("amounts add up to %d cents, not %d", sum, total)
+	}
+	return amounts, nil
+}
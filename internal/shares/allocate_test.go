@@ -0,0 +1,58 @@
+package shares
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAllocate(t *testing.T) {
+	cases := []struct {
+		name    string
+		total   int64
+		weights []float64
+		want    []int64
+	}{
+		{"even", 1000, []float64{1, 1}, []int64{500, 500}},
+		{"thirds", 1000, []float64{1, 1, 1}, []int64{334, 333, 333}},
+		{"shares", 1001, []float64{2, 1}, []int64{667, 334}},
+		{"largest remainder", 100, []float64{3, 3, 1}, []int64{43, 43, 14}},
+		{"zero weight", 1001, []float64{1, 0, 1}, []int64{501, 0, 500}},
+	}
+	for _, c := range cases {
+		got, err := Allocate(c.total, c.weights)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+
+	if _, err := Allocate(100, []float64{0, 0}); err == nil {
+		t.Error("expected an error when every weight is zero")
+	}
+	if _, err := Allocate(100, []float64{1, -1}); err == nil {
+		t.Error("expected an error for a negative weight")
+	}
+}
+
+func TestPercentagesAndExact(t *testing.T) {
+	got, err := Percentages(4999, []float64{33.33, 33.33, 33.34})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sum int64
+	for _, p := range got {
+		sum += p
+	}
+	if sum != 4999 {
+		t.Errorf("parts %v add up to %d", got, sum)
+	}
+
+	if _, err := Percentages(100, []float64{50, 40}); err == nil {
+		t.Error("expected an error for percentages under 100")
+	}
+	if _, err := Exact(100, []int64{60, 30}); err == nil {
+		t.Error("expected an error for amounts under the total")
+	}
+}